@@ -1,5 +1,5 @@
-// Package docs Code generated by swaggo/swag. DO NOT EDIT
-package docs
+// Package docsout Code generated by swaggo/swag. DO NOT EDIT
+package docsout
 
 import "github.com/swaggo/swag"
 
@@ -41,167 +41,56 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/login": {
-            "post": {
-                "description": "Authenticates a user by username and password, and returns a JWT token if successful.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "auth"
-                ],
-                "summary": "User login",
-                "parameters": [
+        "/admin/shelter-registrations": {
+            "get": {
+                "security": [
                     {
-                        "description": "Login Credentials",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/authhandler.Payload"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Returns JWT token",
-                        "schema": {
-                            "$ref": "#/definitions/authhandler.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request when the JSON body cannot be parsed or wrong payload type",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized, when the username or password is incorrect",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error, something went wrong with the server",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
+                        "BearerAuth": []
                     }
-                }
-            }
-        },
-        "/dogs": {
-            "get": {
-                "description": "Retrieves a list of dogs based on provided query parameters like breed, size, and age.",
-                "consumes": [
-                    "application/json"
                 ],
+                "description": "Lists self-registered dog shelters, oldest first. Pending registrations are listed unless another registration-status is given. Only available to admins.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "dogs"
+                    "admin"
                 ],
-                "summary": "Get dogs",
+                "summary": "Get dog shelter registrations",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by dog breed",
-                        "name": "breed",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by dog gender",
-                        "name": "gender",
+                        "description": "Filter by registration status, pending (default), approved or rejected",
+                        "name": "registration-status",
                         "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "Filter by if dog is neutered",
-                        "name": "is_neutered",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Filter by if dog is adopted",
-                        "name": "is_adopted",
+                        "type": "integer",
+                        "description": "Page number for pagination",
+                        "name": "page",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Filter dogs that are from a specific dog shelter",
-                        "name": "shelter_id",
+                        "description": "Number of items per page for pagination",
+                        "name": "limit",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success, returns a list of dogs along with pagination details",
-                        "schema": {
-                            "$ref": "#/definitions/dogdto.DogsAndPaginationLinksDTO"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request if the query parameters are invalid",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error if an error occurs while processing the request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Adds a new dog to the system with the provided dog data in JSON format. shelter_id field is for admins only.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "dogs"
-                ],
-                "summary": "Add a new dog",
-                "parameters": [
-                    {
-                        "description": "Dog Data",
-                        "name": "dog",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dogdto.NewDogDTO"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Success, returns the newly created dog information",
+                        "description": "Success, returns the registrations",
                         "schema": {
-                            "$ref": "#/definitions/dogdto.DogDTO"
+                            "$ref": "#/definitions/dogshelterdto.DogShelterRegistrationsAndPaginationLinksDTO"
                         }
                     },
                     "400": {
-                        "description": "Bad Request, if the JSON body cannot be parsed or mandatory fields are missing",
+                        "description": "Bad Request, if the query parameters are invalid",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized, if the user does not have permission to add a dog",
+                        "description": "Unauthorized, if the requester is not an admin",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -215,23 +104,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/dogs/{id}": {
+        "/admin/shelter-registrations/{id}": {
             "get": {
-                "description": "Retrieves detailed information about a dog specified by its ID.",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Gets the registration of a dog shelter with its review status. Only available to admins.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "dogs"
+                    "admin"
                 ],
-                "summary": "Get a dog by ID",
+                "summary": "Get a dog shelter registration",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Dog ID",
+                        "description": "Dog shelter ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -239,13 +130,25 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success, returns detailed information about the dog",
+                        "description": "Success, returns the registration",
                         "schema": {
-                            "$ref": "#/definitions/dogdto.DogDTO"
+                            "$ref": "#/definitions/dogshelterdto.DogShelterRegistrationDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter format is incorrect",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Not Found, if no dog matches the provided ID",
+                        "description": "Not Found, if no dog shelter matches the provided ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -257,14 +160,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/admin/shelter-registrations/{id}/approve": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates the information for an existing dog specified by its ID with the provided dog data in JSON format.",
+                "description": "Approves a pending dog shelter registration, after which the shelter can log in and is publicly listed. An optional reason is included in the notification sent to the shelter. Only available to admins.",
                 "consumes": [
                     "application/json"
                 ],
@@ -272,42 +177,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dogs"
+                    "admin"
                 ],
-                "summary": "Update dog information",
+                "summary": "Approve a dog shelter registration",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Dog ID",
+                        "description": "Dog shelter ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Dog Update Data",
-                        "name": "dog",
+                        "description": "Reason for the decision",
+                        "name": "decision",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dogdto.UpdateDogDTO"
+                            "$ref": "#/definitions/dogshelterdto.ShelterRegistrationDecisionDTO"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success, returns the updated dog information",
+                        "description": "Success, returns the approved registration",
                         "schema": {
-                            "$ref": "#/definitions/dogdto.DogDTO"
+                            "$ref": "#/definitions/dogshelterdto.DogShelterRegistrationDTO"
                         }
                     },
                     "400": {
-                        "description": "Bad Request, if the JSON body cannot be parsed, mandatory fields are missing, or the dog data is incomplete",
+                        "description": "Bad Request, if the ID parameter format is incorrect or the body cannot be parsed",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized, if the user does not have permission to update the dog",
+                        "description": "Unauthorized, if the requester is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no dog shelter matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict, if the registration has already been reviewed",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -319,14 +235,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/admin/shelter-registrations/{id}/reject": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a dog specified by its ID if the requester has the necessary permissions.",
+                "description": "Rejects a pending dog shelter registration with a required reason, which is included in the notification sent to the shelter. Only available to admins.",
                 "consumes": [
                     "application/json"
                 ],
@@ -334,42 +252,60 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dogs"
+                    "admin"
                 ],
-                "summary": "Delete a dog",
+                "summary": "Reject a dog shelter registration",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Dog ID",
+                        "description": "Dog shelter ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Reason for the decision",
+                        "name": "decision",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dogshelterdto.ShelterRegistrationDecisionDTO"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Dog deleted successfully"
+                    "200": {
+                        "description": "Success, returns the rejected registration",
+                        "schema": {
+                            "$ref": "#/definitions/dogshelterdto.DogShelterRegistrationDTO"
+                        }
                     },
                     "400": {
-                        "description": "Bad Request if the request was malformed",
+                        "description": "Bad Request, if the ID parameter format is incorrect, the body cannot be parsed or the reason is missing",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized if the user does not have permission to delete the dog",
+                        "description": "Unauthorized, if the requester is not an admin",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Not Found if the dog with the specified ID does not exist",
+                        "description": "Not Found, if no dog shelter matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict, if the registration has already been reviewed",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error for any server errors",
+                        "description": "Internal Server Error, if an error occurs while processing the request",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -377,9 +313,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/dogshelters": {
+        "/admins": {
             "get": {
-                "description": "Retrieves a list of dog shelters based on provided query parameters like location and capacity.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves a paginated list of admins, only available to admins.",
                 "consumes": [
                     "application/json"
                 ],
@@ -387,34 +328,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dogshelters"
+                    "admins"
                 ],
-                "summary": "Get dog shelters",
+                "summary": "Get admins",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Filter by name",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by country",
-                        "name": "country",
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Filter by city",
-                        "name": "city",
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success, returns a list of dog shelters",
+                        "description": "Success, returns a list of admins",
                         "schema": {
-                            "$ref": "#/definitions/dogshelterdto.DogSheltersAndPaginationLinksDTO"
+                            "$ref": "#/definitions/admindto.AdminsAndPaginationLinksDTO"
                         }
                     },
                     "400": {
@@ -423,6 +358,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error if an error occurs while processing the request",
                         "schema": {
@@ -437,7 +378,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Adds a new dog shelter to the system with the provided shelter data in JSON format.",
+                "description": "Creates a new admin account, only available to admins. The password must meet the password policy.",
                 "consumes": [
                     "application/json"
                 ],
@@ -445,35 +386,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dogshelters"
+                    "admins"
                 ],
-                "summary": "Add a new dog shelter",
+                "summary": "Create an admin",
                 "parameters": [
                     {
-                        "description": "Dog Shelter Data",
-                        "name": "shelter",
+                        "description": "Username and password of the new admin",
+                        "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dogshelterdto.NewDogShelterDTO"
+                            "$ref": "#/definitions/admindto.NewAdminDTO"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Success, returns the newly created dog shelter information",
+                        "description": "Created, returns the new admin",
                         "schema": {
-                            "$ref": "#/definitions/dogshelterdto.DogShelterDTO"
+                            "$ref": "#/definitions/admindto.AdminDTO"
                         }
                     },
                     "400": {
-                        "description": "Bad Request, if the JSON body cannot be parsed, mandatory fields are missing, or the dog shelter data is incomplete",
+                        "description": "Bad Request, if the body is invalid, the username is taken or the password does not meet the password policy",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized, if the user does not have permission to add a dog shelter",
+                        "description": "Unauthorized, if the requester is not an admin",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -487,9 +428,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/dogshelters/{id}": {
+        "/admins/{id}": {
             "get": {
-                "description": "Retrieves detailed information about a specific dog shelter identified by its unique ID.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves an admin by its ID, only available to admins.",
                 "consumes": [
                     "application/json"
                 ],
@@ -497,13 +443,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dogshelters"
+                    "admins"
                 ],
-                "summary": "Get a dog shelter by ID",
+                "summary": "Get an admin",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Shelter ID",
+                        "description": "Admin ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -511,13 +457,25 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success, returns detailed information about the dog shelter",
+                        "description": "Success, returns the admin",
                         "schema": {
-                            "$ref": "#/definitions/dogshelterdto.DogShelterDTO"
+                            "$ref": "#/definitions/admindto.AdminDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter format is incorrect",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Not Found, if no dog shelter matches the provided ID",
+                        "description": "Not Found, if no admin matches the provided ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -536,7 +494,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates the information for an existing dog shelter specified by its ID with the provided shelter data in JSON format.",
+                "description": "Changes the username of an admin, only available to admins.",
                 "consumes": [
                     "application/json"
                 ],
@@ -544,42 +502,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dogshelters"
+                    "admins"
                 ],
-                "summary": "Update a dog shelter",
+                "summary": "Update an admin",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Shelter ID",
+                        "type": "integer",
+                        "description": "Admin ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Dog Shelter Update Data",
-                        "name": "shelter",
+                        "description": "New username",
+                        "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dogshelterdto.UpdateDogShelterDTO"
+                            "$ref": "#/definitions/admindto.UpdateAdminDTO"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success, returns the updated dog shelter information",
+                        "description": "Success, returns the updated admin",
                         "schema": {
-                            "$ref": "#/definitions/dogshelterdto.DogShelterDTO"
+                            "$ref": "#/definitions/admindto.AdminDTO"
                         }
                     },
                     "400": {
-                        "description": "Bad Request, if the JSON body cannot be parsed, mandatory fields are missing, or the dog shelter data is incomplete",
+                        "description": "Bad Request, if the ID parameter or body is invalid, or the username is taken",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized, if the user does not have permission to update the dog shelter",
+                        "description": "Unauthorized, if the requester is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no admin matches the provided ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -598,7 +562,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a dog shelter specified by its ID if the requester has the necessary permissions.",
+                "description": "Deletes an admin account, only available to admins. The last admin can not be deleted.",
                 "consumes": [
                     "application/json"
                 ],
@@ -606,13 +570,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dogshelters"
+                    "admins"
                 ],
-                "summary": "Delete a dog shelter",
+                "summary": "Delete an admin",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Shelter ID",
+                        "description": "Admin ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -620,28 +584,28 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "204": {
-                        "description": "Dog shelter deleted successfully"
+                        "description": "Admin deleted successfully"
                     },
                     "400": {
-                        "description": "Bad Request if the request was malformed",
+                        "description": "Bad Request, if the ID parameter format is incorrect or the admin is the last one",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized if the user does not have permission to delete the dog shelter",
+                        "description": "Unauthorized, if the requester is not an admin",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Not Found if the dog shelter with the specified ID does not exist",
+                        "description": "Not Found, if no admin matches the provided ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error for any server errors",
+                        "description": "Internal Server Error, if an error occurs while processing the request",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -649,9 +613,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
+        "/admins/{id}/password": {
             "post": {
-                "description": "Adds a new user to the system with the provided user data in JSON format.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the password of the authenticated admin. The current password is required.",
                 "consumes": [
                     "application/json"
                 ],
@@ -659,35 +628,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "admins"
                 ],
-                "summary": "Create a new user",
+                "summary": "Change admin password",
                 "parameters": [
                     {
-                        "description": "New User Data",
-                        "name": "user",
+                        "type": "integer",
+                        "description": "Admin ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Current and new password",
+                        "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/userdto.NewUserDTO"
+                            "$ref": "#/definitions/authdto.ChangePasswordDTO"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Success, returns the newly created user information",
+                    "204": {
+                        "description": "No Content, the password is changed"
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter or body is invalid, or the new password does not meet the password policy",
                         "schema": {
-                            "$ref": "#/definitions/userdto.UserDTO"
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request, if the request body is incomplete or contains invalid data",
+                    "401": {
+                        "description": "Unauthorized, if the current password is wrong or the requester is not the admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no admin matches the provided ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error, if something goes wrong internally",
+                        "description": "Internal Server Error, if an error occurs while processing the request",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -695,14 +680,67 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me": {
-            "get": {
-                "security": [
+        "/auth/login": {
+            "post": {
+                "description": "Authenticates a user by username and password, and returns a JWT token if successful.\nIf the account has MFA enabled, mfa_required is true and a short lived mfa_challenge_token is returned instead, which is exchanged for a JWT at /auth/mfa/challenge.\nIf MFA is required for the role but not yet enrolled, mfa_enrollment_required is true and the token can only be used to enroll.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "User login",
+                "parameters": [
                     {
-                        "BearerAuth": []
+                        "description": "Login Credentials",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authhandler.Payload"
+                        }
                     }
                 ],
-                "description": "Retrieves detailed information about the authenticated user based on the provided credentials.",
+                "responses": {
+                    "200": {
+                        "description": "Returns JWT token or MFA challenge",
+                        "schema": {
+                            "$ref": "#/definitions/authdto.LoginResultDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request when the JSON body cannot be parsed or wrong payload type",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, when the username or password is incorrect",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden, when the account is suspended or the dog shelter registration is not approved",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, something went wrong with the server",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/mfa/challenge": {
+            "post": {
+                "description": "Exchanges the mfa_challenge_token returned by /auth/login and a TOTP code or recovery code for a JWT token. Each TOTP code and recovery code can only be used once.",
                 "consumes": [
                     "application/json"
                 ],
@@ -710,18 +748,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "auth/mfa"
+                ],
+                "summary": "Complete MFA login challenge",
+                "parameters": [
+                    {
+                        "description": "Challenge token and TOTP code or recovery code",
+                        "name": "challenge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authdto.MfaChallengeDTO"
+                        }
+                    }
                 ],
-                "summary": "Get authenticated user information",
                 "responses": {
                     "200": {
-                        "description": "Success, returns detailed information about the authenticated user",
+                        "description": "Returns JWT token",
                         "schema": {
-                            "$ref": "#/definitions/userdto.UserDTO"
+                            "$ref": "#/definitions/authdto.LoginResultDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the body cannot be parsed or the code is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized, if the user credentials do not match or are invalid",
+                        "description": "Unauthorized, if the challenge token is invalid or expired",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -735,14 +790,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}": {
-            "delete": {
+        "/auth/mfa/disable": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a user identified by its unique ID, provided the requester has the necessary permissions.",
+                "description": "Disables MFA for the authenticated admin or dog shelter. Requires a valid TOTP code or recovery code. Not allowed when MFA is required for the role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -750,36 +805,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "auth/mfa"
                 ],
-                "summary": "Delete a user",
+                "summary": "Disable MFA",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "TOTP code or recovery code, challenge_token is ignored",
+                        "name": "code",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authdto.MfaChallengeDTO"
+                        }
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "User deleted successfully"
+                        "description": "No Content, mfa is disabled"
                     },
                     "400": {
-                        "description": "Bad Request, if the ID parameter format is incorrect",
+                        "description": "Bad Request, if the body cannot be parsed or the code is invalid",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized, if the requester does not have permission to delete the user",
+                        "description": "Unauthorized, if the account is not an admin or dog shelter",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden, if mfa is required for the role",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Not Found, if no user matches the provided ID",
+                        "description": "Not Found, if mfa is not enrolled",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -793,61 +856,97 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}/webhook": {
-            "get": {
+        "/auth/mfa/enroll": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves detailed information about a specific user webhook identified by its unique ID for the authenticated user.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Generates a new TOTP secret and recovery codes for the authenticated admin or dog shelter. The secret and recovery codes are only shown once. The enrollment is activated with /auth/mfa/verify.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users/{id}/webhook"
+                    "auth/mfa"
                 ],
-                "summary": "Get a user webhook",
-                "parameters": [
+                "summary": "Start MFA enrollment",
+                "responses": {
+                    "201": {
+                        "description": "Success, returns the secret, provisioning uri and recovery codes",
+                        "schema": {
+                            "$ref": "#/definitions/authdto.MfaEnrollmentDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the account is not an admin or dog shelter",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict, if mfa is already enabled",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/mfa/policies": {
+            "get": {
+                "security": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "BearerAuth": []
                     }
                 ],
+                "description": "Retrieves whether MFA is required for each role that can enroll in MFA. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth/mfa"
+                ],
+                "summary": "Get MFA policies",
                 "responses": {
                     "200": {
-                        "description": "Success, returns detailed information about the user webhook",
+                        "description": "Success, returns the mfa policies",
                         "schema": {
-                            "$ref": "#/definitions/userwebhookdto.UserWebhookDTO"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/authdto.MfaPolicyDTO"
+                            }
                         }
                     },
                     "401": {
-                        "description": "Unauthorized, if the user credentials are invalid or do not grant access to the requested resource",
+                        "description": "Unauthorized, if the account is not an admin",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found, if no resource matches the provided ID or the webhook does not belong to the user",
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/auth/mfa/policies/{role}": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates information for a specific user webhook identified by its unique ID for the authenticated user based on the provided data in JSON format. Secret must be minimum 12 characters.",
+                "description": "Sets whether MFA is required for a role. Accounts of the role without MFA will only be able to enroll after login. Admin only.",
                 "consumes": [
                     "application/json"
                 ],
@@ -855,48 +954,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users/{id}/webhook"
+                    "auth/mfa"
                 ],
-                "summary": "Update a user webhook",
+                "summary": "Update MFA policy",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
+                        "type": "string",
+                        "description": "Role, admin or dog_shelter",
+                        "name": "role",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update Webhook Data",
-                        "name": "data",
+                        "description": "Policy data",
+                        "name": "policy",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/userwebhookdto.UpdateUserWebhookDTO"
+                            "$ref": "#/definitions/authdto.UpdateMfaPolicyDTO"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success, returns the updated user webhook information",
+                        "description": "Success, returns the updated policy",
                         "schema": {
-                            "$ref": "#/definitions/userwebhookdto.UserWebhookDTO"
+                            "$ref": "#/definitions/authdto.MfaPolicyDTO"
                         }
                     },
                     "400": {
-                        "description": "Bad Request, if the request data is incomplete or has invalid values",
+                        "description": "Bad Request, if the body cannot be parsed or the policy data is invalid",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized, if the user credentials do not match or are invalid",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found, if no matching webhook resource is found for the given ID",
+                        "description": "Unauthorized, if the account is not an admin",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -908,14 +1001,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/auth/mfa/verify": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Adds a new webhook for the authenticated user based on the provided webhook data in JSON format. Secret must be minimum 12 characters.",
+                "description": "Activates the pending TOTP enrollment of the authenticated admin or dog shelter by verifying a code from the authenticator app. After activation, login requires a second factor.",
                 "consumes": [
                     "application/json"
                 ],
@@ -923,48 +1018,311 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users/{id}/webhook"
+                    "auth/mfa"
                 ],
-                "summary": "Create a new user webhook",
+                "summary": "Verify MFA enrollment",
+                "parameters": [
+                    {
+                        "description": "TOTP code",
+                        "name": "code",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authdto.MfaCodeDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content, mfa is enabled"
+                    },
+                    "400": {
+                        "description": "Bad Request, if the body cannot be parsed or the code is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the account is not an admin or dog shelter",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no enrollment has been started",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict, if mfa is already enabled",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/password-reset": {
+            "post": {
+                "description": "Sends a single use password reset token to the dog shelter or user with the username, through the configured notifier. The token expires after 30 minutes.\nThe response is the same whether or not the username exists.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Request password reset",
+                "parameters": [
+                    {
+                        "description": "Username of the account",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authdto.ForgotPasswordDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted, a reset token is sent if the account exists"
+                    },
+                    "400": {
+                        "description": "Bad Request, if the body cannot be parsed or the username is missing",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/password-reset/confirm": {
+            "post": {
+                "description": "Sets a new password for the account the reset token was issued to. The token can only be used once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Confirm password reset",
+                "parameters": [
+                    {
+                        "description": "Reset token and new password",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authdto.ResetPasswordDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content, the password is changed"
+                    },
+                    "400": {
+                        "description": "Bad Request, if the body is invalid, the new password does not meet the password policy, or the token is invalid, used or expired",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/breeds": {
+            "get": {
+                "description": "Retrieves the breeds that dogs reference, in alphabetical order.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "breeds"
+                ],
+                "summary": "Get breeds",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by breeds with a name or an alias starting with the value, ignoring case",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by size group, one of toy, small, medium, large and giant",
+                        "name": "size-group",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Filter by FCI group, 1 to 10",
+                        "name": "fci-group",
+                        "in": "query"
                     },
                     {
-                        "description": "Webhook Data",
-                        "name": "webhook",
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of breeds per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns a list of breeds",
+                        "schema": {
+                            "$ref": "#/definitions/breeddto.BreedsAndPaginationLinksDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request if the query parameters are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Adds a breed with its aliases. Names and aliases are unique across all breeds, ignoring case. Only available to admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "breeds"
+                ],
+                "summary": "Create a breed",
+                "parameters": [
+                    {
+                        "description": "The breed",
+                        "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/userwebhookdto.NewUserWebhookDTO"
+                            "$ref": "#/definitions/breeddto.NewBreedDTO"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Success, returns the newly created user webhook information",
+                        "description": "Created, returns the breed",
                         "schema": {
-                            "$ref": "#/definitions/userwebhookdto.UserWebhookDTO"
+                            "$ref": "#/definitions/breeddto.BreedDTO"
                         }
                     },
                     "400": {
-                        "description": "Bad Request, if the JSON body cannot be parsed, mandatory fields are missing, or the webhook data is incomplete",
+                        "description": "Bad Request, if the body is invalid",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized, if the user credentials do not match or are invalid",
+                        "description": "Unauthorized, if the requester is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict, if the name or an alias is used by another breed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/breeds/{id}": {
+            "get": {
+                "description": "Retrieves a breed with its aliases.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "breeds"
+                ],
+                "summary": "Get a breed",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Breed ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK, returns the breed",
+                        "schema": {
+                            "$ref": "#/definitions/breeddto.BreedDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter is not a number",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Not Found, if the specified user does not exist",
+                        "description": "Not Found, if no breed matches the provided ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -977,13 +1335,16 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
                     }
                 ],
-                "description": "Deletes a webhook for the authenticated user based on the provided webhook ID.",
+                "description": "Changes the provided fields of the breed. Aliases replace all aliases, and dogs of a renamed breed get the new name. Only available to admins.",
                 "consumes": [
                     "application/json"
                 ],
@@ -991,107 +1352,7113 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users/{id}/webhook"
+                    "breeds"
                 ],
-                "summary": "Delete a webhook",
+                "summary": "Update a breed",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Webhook ID",
+                        "description": "Breed ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "The fields to change",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/breeddto.UpdateBreedDTO"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Webhook deleted successfully"
+                    "200": {
+                        "description": "OK, returns the breed",
+                        "schema": {
+                            "$ref": "#/definitions/breeddto.BreedDTO"
+                        }
                     },
                     "400": {
-                        "description": "Bad Request - No webhook found or ID must be a number",
+                        "description": "Bad Request, if the ID parameter or the body is invalid",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized - Invalid user credentials",
+                        "description": "Unauthorized, if the requester is not an admin",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Not Found - No webhook found",
+                        "description": "Not Found, if no breed matches the provided ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error - Something went wrong internally, try again later",
+                    "409": {
+                        "description": "Conflict, if the name or an alias is used by another breed",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
-                    }
-                }
-            }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes a breed that no dog has. A breed of dogs is merged into another breed instead. Only available to admins.",
+                "tags": [
+                    "breeds"
+                ],
+                "summary": "Delete a breed",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Breed ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content, the breed is deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter is not a number",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no breed matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict, if dogs have the breed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/breeds/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Moves the dogs of the source breed to the breed, makes the name and aliases of the source aliases of the breed, and deletes the source. Only available to admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "breeds"
+                ],
+                "summary": "Merge a breed into another",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the breed that is kept",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The breed that is merged",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/breeddto.MergeBreedDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK, returns the merged breed",
+                        "schema": {
+                            "$ref": "#/definitions/breeddto.BreedDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter or the body is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if either breed does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves a list of dogs based on provided query parameters like breed, size, and age. Authentication is optional. Admins and members of the dog's shelter also get favorited_count.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Get dogs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by the name or an alias of the primary or secondary breed, ignoring case",
+                        "name": "breed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter by the primary or secondary breed",
+                        "name": "breed-id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by dog gender",
+                        "name": "gender",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by if dog is neutered",
+                        "name": "is_neutered",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by if dog is adopted",
+                        "name": "is_adopted",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status, several statuses can be given separated by commas. Valid statuses are available, reserved, on_hold, in_foster, adopted, returned and deceased",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by dogs that are good with all of the comma separated groups children, dogs, cats and small-animals",
+                        "name": "good-with",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by any of the comma separated energy levels low, medium and high",
+                        "name": "energy-level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by any of the comma separated sizes toy, small, medium, large and giant",
+                        "name": "dog-size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by if dog has a vaccination that is not overdue",
+                        "name": "vaccinated",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filter dogs that are from a specific dog shelter",
+                        "name": "shelter_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by distance to a point formatted as lat,lng, and return the distance in kilometers",
+                        "name": "near",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Only return results within this many kilometers of near",
+                        "name": "radius-km",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns a list of dogs along with pagination details",
+                        "schema": {
+                            "$ref": "#/definitions/dogdto.DogsAndPaginationLinksDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request if the query parameters are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if provided credentials are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Adds a new dog to the system with the provided dog data in JSON format. shelter_id field is for admins only. New dogs are available unless status or is_adopted is given. The breed is given by primary_breed_id, or by the name or an alias of a breed in breed, and secondary_breed_id makes the dog a mixed breed. The good_with flags are yes, no or unknown, and are derived from friendly_with for older clients that only send friendly_with.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Add a new dog",
+                "parameters": [
+                    {
+                        "description": "Dog Data",
+                        "name": "dog",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dogdto.NewDogDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success, returns the newly created dog information",
+                        "schema": {
+                            "$ref": "#/definitions/dogdto.DogDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the JSON body cannot be parsed or mandatory fields are missing",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the user does not have permission to add a dog",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogs/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves detailed information about a dog specified by its ID. Authentication is optional. Admins and members of the dog's shelter also get favorited_count.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Get a dog by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns detailed information about the dog",
+                        "schema": {
+                            "$ref": "#/definitions/dogdto.DogDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if provided credentials are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Updates the information for an existing dog specified by its ID with the provided dog data in JSON format. The status follows the lifecycle available → reserved/on_hold/in_foster → adopted → returned, and deceased is final. Changes are recorded in the status history of the dog. Setting is_adopted is the same as setting the status to adopted, or returning an adopted dog. A breed is resolved through the names and aliases of the breeds, and a secondary_breed_id of 0 removes the secondary breed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Update dog information",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dog Update Data",
+                        "name": "dog",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dogdto.UpdateDogDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the updated dog information",
+                        "schema": {
+                            "$ref": "#/definitions/dogdto.DogDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the JSON body cannot be parsed, mandatory fields are missing, or the dog data is incomplete",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the user does not have permission to update the dog",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict, if the dog cannot go from its current status to the new status",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes a dog specified by its ID if the requester has the necessary permissions.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Delete a dog",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Dog deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad Request if the request was malformed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized if the user does not have permission to delete the dog",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found if the dog with the specified ID does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error for any server errors",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogs/{id}/medical-records": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns a public summary with neutering, vaccinations and the number of treatments. Admins and members of the dog's shelter also get every record, including treatments and notes. Authentication is optional.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Get the medical record of a dog",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK, returns the medical record",
+                        "schema": {
+                            "$ref": "#/definitions/dogmedicaldto.DogMedicalRecordsDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if provided credentials are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Adds a vaccination, treatment or note. Dates are formatted as YYYY-MM-DD and cannot be in the future. Only vaccinations can have a next_due_on date. Only available to admins and members of the dog's shelter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Add a medical record entry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The record",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dogmedicaldto.NewDogMedicalRecordDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created, returns the record",
+                        "schema": {
+                            "$ref": "#/definitions/dogmedicaldto.DogMedicalRecordDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter or body is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester may not manage the medical record",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogs/{id}/medical-records/{recordId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves a vaccination, treatment or note. Only available to admins and members of the dog's shelter.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Get a medical record entry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Medical record ID",
+                        "name": "recordId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK, returns the record",
+                        "schema": {
+                            "$ref": "#/definitions/dogmedicaldto.DogMedicalRecordDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if an ID parameter is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester may not read the full medical record",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no record of the dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Changes the provided fields of the record. An empty next_due_on removes the due date. Only available to admins and members of the dog's shelter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Update a medical record entry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Medical record ID",
+                        "name": "recordId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The fields to change",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dogmedicaldto.UpdateDogMedicalRecordDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK, returns the record",
+                        "schema": {
+                            "$ref": "#/definitions/dogmedicaldto.DogMedicalRecordDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if an ID parameter or the body is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester may not manage the medical record",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no record of the dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Removes a vaccination, treatment or note. Only available to admins and members of the dog's shelter.",
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Delete a medical record entry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Medical record ID",
+                        "name": "recordId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Record deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad Request, if an ID parameter is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester may not manage the medical record",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no record of the dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogs/{id}/photos": {
+            "get": {
+                "description": "Lists the photos of the dog in display order, with links to the original and the thumbnails.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Get dog photos",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK, returns the gallery",
+                        "schema": {
+                            "$ref": "#/definitions/dogphotodto.DogPhotoGalleryDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Adds a JPEG, PNG or GIF photo last in the gallery of the dog. Large, medium and small JPEG thumbnails are generated. The first photo of a dog becomes its primary photo. Only available to admins and members of the dog's shelter.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Upload a dog photo",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "The photo",
+                        "name": "photo",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Make the photo the primary photo of the dog",
+                        "name": "is_primary",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created, returns the photo",
+                        "schema": {
+                            "$ref": "#/definitions/dogphotodto.DogPhotoDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter is invalid or the file is not a supported image",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester may not manage the photos of the dog",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large, if the photo exceeds the size limit",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogs/{id}/photos/{photoId}": {
+            "get": {
+                "description": "Retrieves the metadata of a photo, with links to the original and the thumbnails.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Get a dog photo",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Photo ID",
+                        "name": "photoId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK, returns the photo",
+                        "schema": {
+                            "$ref": "#/definitions/dogphotodto.DogPhotoDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if an ID parameter is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no photo of the dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Moves the photo to another position in the gallery and/or makes it the primary photo of the dog. Only available to admins and members of the dog's shelter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Update a dog photo",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Photo ID",
+                        "name": "photoId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New position and/or is_primary true",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dogphotodto.UpdateDogPhotoDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK, returns the photo",
+                        "schema": {
+                            "$ref": "#/definitions/dogphotodto.DogPhotoDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if an ID parameter or the body is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester may not manage the photos of the dog",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no photo of the dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Removes the photo and its thumbnails. If it was the primary photo, the first remaining photo becomes primary. Only available to admins and members of the dog's shelter.",
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Delete a dog photo",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Photo ID",
+                        "name": "photoId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Photo deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad Request, if an ID parameter is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester may not manage the photos of the dog",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no photo of the dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogs/{id}/photos/{photoId}/{size}": {
+            "get": {
+                "description": "Streams the original upload or one of the JPEG thumbnails. Files never change once stored, so they may be cached indefinitely.",
+                "produces": [
+                    "image/jpeg",
+                    "image/png",
+                    "image/gif"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Download a dog photo",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Photo ID",
+                        "name": "photoId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "original, large, medium or small",
+                        "name": "size",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK, returns the image",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if an ID parameter or the size is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no photo of the dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogs/{id}/status-history": {
+            "get": {
+                "description": "Retrieves the current status of a dog, the statuses it can move to, and every status change with its timestamp, oldest first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Get the status history of a dog",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the status history",
+                        "schema": {
+                            "$ref": "#/definitions/dogdto.DogStatusHistoryDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogs/{id}/transfers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns all transfers of the dog, oldest first. Only available to admins and members of the dog's current shelter.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Get the transfer history of a dog",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK, returns the transfers",
+                        "schema": {
+                            "$ref": "#/definitions/dogtransferdto.DogTransferListDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester may not view the transfers",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Proposes to move the dog to another dog shelter. The dog stays at its current shelter until the destination accepts. Only available to admins and owners and managers of the dog's shelter. A dog can only have one pending transfer.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Propose a dog transfer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Destination shelter and an optional note",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dogtransferdto.NewDogTransferDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created, returns the transfer",
+                        "schema": {
+                            "$ref": "#/definitions/dogtransferdto.DogTransferDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter or body is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester may not transfer the dog",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict, if the dog already has a pending transfer or is adopted or deceased",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogs/{id}/transfers/{transferId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns the transfer. Available to admins and members of the source and destination shelters.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Get a dog transfer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Transfer ID",
+                        "name": "transferId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK, returns the transfer",
+                        "schema": {
+                            "$ref": "#/definitions/dogtransferdto.DogTransferDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if an ID parameter is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester may not view the transfer",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no transfer of the dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogs/{id}/transfers/{transferId}/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Accepts the pending transfer and moves the dog to the destination shelter. Subscribers to the dog_transferred webhook are notified. Only available to admins and owners and managers of the destination shelter.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Accept a dog transfer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Transfer ID",
+                        "name": "transferId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK, returns the accepted transfer",
+                        "schema": {
+                            "$ref": "#/definitions/dogtransferdto.DogTransferDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if an ID parameter is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester may not accept the transfer",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no transfer of the dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict, if the transfer is no longer pending or the dog has left the source shelter",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogs/{id}/transfers/{transferId}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Withdraws the pending transfer. Only available to admins and owners and managers of the source shelter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Cancel a dog transfer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Transfer ID",
+                        "name": "transferId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional reason",
+                        "name": "payload",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dogtransferdto.DogTransferDecisionDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK, returns the transfer",
+                        "schema": {
+                            "$ref": "#/definitions/dogtransferdto.DogTransferDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if an ID parameter or the body is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester may not cancel the transfer",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no transfer of the dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict, if the transfer is no longer pending",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogs/{id}/transfers/{transferId}/decline": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Declines the pending transfer, the dog stays at its current shelter. Only available to admins and owners and managers of the destination shelter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogs"
+                ],
+                "summary": "Decline a dog transfer",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Transfer ID",
+                        "name": "transferId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional reason",
+                        "name": "payload",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dogtransferdto.DogTransferDecisionDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK, returns the transfer",
+                        "schema": {
+                            "$ref": "#/definitions/dogtransferdto.DogTransferDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if an ID parameter or the body is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester may not decline the transfer",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no transfer of the dog matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict, if the transfer is no longer pending",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogshelters": {
+            "get": {
+                "description": "Retrieves a list of dog shelters based on provided query parameters like location and capacity.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogshelters"
+                ],
+                "summary": "Get dog shelters",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by country",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by city",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by shelters that are open, or closed, at the moment in their own timezone",
+                        "name": "open-now",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by distance to a point formatted as lat,lng, and return the distance in kilometers",
+                        "name": "near",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Only return results within this many kilometers of near",
+                        "name": "radius-km",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns a list of dog shelters",
+                        "schema": {
+                            "$ref": "#/definitions/dogshelterdto.DogSheltersAndPaginationLinksDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request if the query parameters are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Adds a new dog shelter to the system with the provided shelter data in JSON format. The coordinates are geocoded from the address unless latitude and longitude are given.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogshelters"
+                ],
+                "summary": "Add a new dog shelter",
+                "parameters": [
+                    {
+                        "description": "Dog Shelter Data",
+                        "name": "shelter",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dogshelterdto.NewDogShelterDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success, returns the newly created dog shelter information",
+                        "schema": {
+                            "$ref": "#/definitions/dogshelterdto.DogShelterDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the JSON body cannot be parsed, mandatory fields are missing, the dog shelter data is incomplete, or the password does not meet the password policy",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the user does not have permission to add a dog shelter",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict, if the username is used by another account or registration",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogshelters/registrations": {
+            "post": {
+                "description": "Registers a dog shelter without an account. The shelter is pending until an admin approves it, and can not log in or be listed before that. The decision is sent to the contact email.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogshelters"
+                ],
+                "summary": "Register a dog shelter",
+                "parameters": [
+                    {
+                        "description": "Dog Shelter Registration Data",
+                        "name": "registration",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dogshelterdto.NewDogShelterRegistrationDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted, returns the pending registration",
+                        "schema": {
+                            "$ref": "#/definitions/dogshelterdto.DogShelterRegistrationDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the JSON body cannot be parsed, fields are missing, the contact email is invalid, or the password does not meet the password policy",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict, if the username is used by another account or registration",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogshelters/staff-invitations/accept": {
+            "post": {
+                "description": "Creates a staff account with the token from an invitation email. The shelter, role and email are taken from the invitation, and the staff member logs in with the chosen username and password.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogshelters"
+                ],
+                "summary": "Accept a staff invitation",
+                "parameters": [
+                    {
+                        "description": "Invitation token and account credentials",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/shelterstaffdto.AcceptStaffInvitationDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created, returns the new staff member",
+                        "schema": {
+                            "$ref": "#/definitions/shelterstaffdto.ShelterStaffDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the body is invalid or the password does not meet the password policy",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the invitation does not exist, is already accepted or has expired",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict, if the username is used by another account or registration",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogshelters/staff/{id}/password": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the password of the authenticated staff member. The current password is required, and outstanding password reset tokens are invalidated.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogshelters"
+                ],
+                "summary": "Change shelter staff password",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Staff member ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Current and new password",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authdto.ChangePasswordDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content, the password is changed"
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter or body is invalid, or the new password does not meet the password policy",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the current password is wrong or the requester is not the staff member",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no staff member matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogshelters/{id}": {
+            "get": {
+                "description": "Retrieves detailed information about a specific dog shelter identified by its unique ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogshelters"
+                ],
+                "summary": "Get a dog shelter by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shelter ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns detailed information about the dog shelter",
+                        "schema": {
+                            "$ref": "#/definitions/dogshelterdto.DogShelterDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no dog shelter matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Updates the information for an existing dog shelter specified by its ID with the provided shelter data in JSON format. Changing the address geocodes the shelter again, unless latitude and longitude are given as a manual override.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogshelters"
+                ],
+                "summary": "Update a dog shelter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Shelter ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dog Shelter Update Data",
+                        "name": "shelter",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dogshelterdto.UpdateDogShelterDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the updated dog shelter information",
+                        "schema": {
+                            "$ref": "#/definitions/dogshelterdto.DogShelterDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the JSON body cannot be parsed, mandatory fields are missing, or the dog shelter data is incomplete",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the user does not have permission to update the dog shelter",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes a dog shelter specified by its ID if the requester has the necessary permissions.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogshelters"
+                ],
+                "summary": "Delete a dog shelter",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shelter ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Dog shelter deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad Request if the request was malformed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized if the user does not have permission to delete the dog shelter",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found if the dog shelter with the specified ID does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error for any server errors",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogshelters/{id}/password": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the password of the authenticated dog shelter. The current password is required, and outstanding password reset tokens are invalidated.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogshelters"
+                ],
+                "summary": "Change dog shelter password",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog shelter ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Current and new password",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authdto.ChangePasswordDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content, the password is changed"
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter or body is invalid, or the new password does not meet the password policy",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the current password is wrong or the requester is not the dog shelter",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no dog shelter matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogshelters/{id}/staff": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the staff accounts of a dog shelter with their roles. Available to admins and members of the shelter. Emails are only shown to owners and managers.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogshelters"
+                ],
+                "summary": "Get dog shelter staff",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog shelter ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the staff of the shelter",
+                        "schema": {
+                            "$ref": "#/definitions/shelterstaffdto.ShelterStaffListDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter format is incorrect",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not an admin or a member of the shelter",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no dog shelter matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogshelters/{id}/staff-invitations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the invitations of the shelter that have not been accepted and have not expired. Only available to owners of the shelter.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogshelters"
+                ],
+                "summary": "Get open staff invitations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog shelter ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the open invitations",
+                        "schema": {
+                            "$ref": "#/definitions/shelterstaffdto.StaffInvitationListDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter format is incorrect",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not an owner of the shelter",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sends an invitation token to the email address. The invited person creates a staff account with the given role by accepting the invitation within 7 days. Only available to owners of the shelter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogshelters"
+                ],
+                "summary": "Invite a staff member",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog shelter ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Email and role of the invited staff member",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/shelterstaffdto.NewStaffInvitationDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created, returns the invitation",
+                        "schema": {
+                            "$ref": "#/definitions/shelterstaffdto.StaffInvitationDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter or body is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not an owner of the shelter",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no dog shelter matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogshelters/{id}/staff-invitations/{invitationId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revokes an invitation that has not been accepted yet. Only available to owners of the shelter.",
+                "tags": [
+                    "dogshelters"
+                ],
+                "summary": "Revoke a staff invitation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog shelter ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Invitation ID",
+                        "name": "invitationId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content, the invitation is revoked"
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameters are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not an owner of the shelter",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no open invitation matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/dogshelters/{id}/staff/{staffId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the role of a staff member to owner, manager or volunteer. Only available to owners of the shelter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dogshelters"
+                ],
+                "summary": "Change the role of a staff member",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog shelter ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Staff member ID",
+                        "name": "staffId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New role",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/shelterstaffdto.UpdateShelterStaffDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the updated staff member",
+                        "schema": {
+                            "$ref": "#/definitions/shelterstaffdto.ShelterStaffDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameters or body are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not an owner of the shelter",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the staff member is not part of the shelter",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a staff account from the shelter. Available to admins, owners of the shelter and to staff members removing themselves.",
+                "tags": [
+                    "dogshelters"
+                ],
+                "summary": "Remove a staff member",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dog shelter ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Staff member ID",
+                        "name": "staffId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content, the staff member is removed"
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameters are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester may not remove the staff member",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the staff member is not part of the shelter",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/oauth/authorize": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Validates an authorization code request for the authenticated user and returns the client and scopes to consent to. PKCE with code_challenge_method S256 is required. The decision is sent with POST /oauth/authorize.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oauth"
+                ],
+                "summary": "Start an oauth authorization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Must be code",
+                        "name": "response_type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client ID",
+                        "name": "client_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Registered redirect uri, optional if the client has only one",
+                        "name": "redirect_uri",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Space delimited scopes, defaults to all scopes of the client",
+                        "name": "scope",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque value returned in the redirect",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "PKCE code challenge",
+                        "name": "code_challenge",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Must be S256",
+                        "name": "code_challenge_method",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the consent prompt",
+                        "schema": {
+                            "$ref": "#/definitions/oauthdto.ConsentPromptDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the authorization request is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/oauthdto.OAuthErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the account is not a user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Records the decision of the authenticated user for an authorization request. When approved, the consent is saved and the returned redirect uri contains an authorization code valid for 10 minutes, otherwise it contains error=access_denied.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oauth"
+                ],
+                "summary": "Approve or deny an oauth authorization",
+                "parameters": [
+                    {
+                        "description": "The authorization request parameters and the decision",
+                        "name": "decision",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oauthdto.AuthorizeDecisionDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the uri to redirect the user to",
+                        "schema": {
+                            "$ref": "#/definitions/oauthdto.AuthorizeResultDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the authorization request is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/oauthdto.OAuthErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the account is not a user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/oauth/clients": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists all registered oauth clients. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oauth"
+                ],
+                "summary": "List oauth clients",
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the clients",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/oauthdto.OAuthClientDTO"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the account is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registers a third party application as an oauth client. Confidential clients get a client secret that is only shown in this response. The client_credentials grant requires a confidential client with an owner_user_id, the account the client acts as. Admin only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oauth"
+                ],
+                "summary": "Register an oauth client",
+                "parameters": [
+                    {
+                        "description": "Client data",
+                        "name": "client",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/oauthdto.NewOAuthClientDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success, returns the client including the client secret",
+                        "schema": {
+                            "$ref": "#/definitions/oauthdto.CreatedOAuthClientDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the JSON body cannot be parsed or the client data is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the account is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/oauth/clients/{clientId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes an oauth client together with its consents and refresh tokens. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oauth"
+                ],
+                "summary": "Delete an oauth client",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client ID",
+                        "name": "clientId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Client deleted successfully"
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the account is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no client with the id exists",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/oauth/token": {
+            "post": {
+                "description": "Issues an access token for the authorization_code, refresh_token and client_credentials grants. Confidential clients authenticate with client_id and client_secret in the body or with HTTP Basic authentication. Refresh tokens are rotated on every use.",
+                "consumes": [
+                    "application/x-www-form-urlencoded",
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oauth"
+                ],
+                "summary": "Issue oauth tokens",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "authorization_code, refresh_token or client_credentials",
+                        "name": "grant_type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Authorization code, for the authorization_code grant",
+                        "name": "code",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Redirect uri used in the authorization request, required if it was included in the authorization request",
+                        "name": "redirect_uri",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "PKCE code verifier, for the authorization_code grant",
+                        "name": "code_verifier",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Refresh token, for the refresh_token grant",
+                        "name": "refresh_token",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client ID, unless HTTP Basic authentication is used",
+                        "name": "client_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client secret for confidential clients, unless HTTP Basic authentication is used",
+                        "name": "client_secret",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Space delimited scopes to narrow the token to",
+                        "name": "scope",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the tokens",
+                        "schema": {
+                            "$ref": "#/definitions/oauthdto.TokenResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the token request is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/oauthdto.OAuthErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the client authentication failed",
+                        "schema": {
+                            "$ref": "#/definitions/oauthdto.OAuthErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves a paginated list of users, only available to admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by usernames starting with the value, ignoring case",
+                        "name": "username-prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by account status, active or suspended",
+                        "name": "account-status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns a list of users",
+                        "schema": {
+                            "$ref": "#/definitions/userdto.UsersAndPaginationLinksDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request if the query parameters are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a new user to the system with the provided user data in JSON format.\nAn email is required, and a verification token is mailed to it. Webhooks can not be created until the email is verified.\nThe password must meet the password policy, and can not be a common or breached password or the same as the username.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Create a new user",
+                "parameters": [
+                    {
+                        "description": "New User Data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userdto.NewUserDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success, returns the newly created user information",
+                        "schema": {
+                            "$ref": "#/definitions/userdto.UserDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the request body is incomplete, contains invalid data or the password does not meet the password policy",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if something goes wrong internally",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/email-verification": {
+            "post": {
+                "description": "Verifies the email of a user with the token from the verification email. The token can only be used once, and expires after 24 hours.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Verify email",
+                "parameters": [
+                    {
+                        "description": "Verification token",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userdto.VerifyEmailDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content, the email is verified"
+                    },
+                    "400": {
+                        "description": "Bad Request, if the body cannot be parsed or the token is invalid, used or expired",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves detailed information about the authenticated user based on the provided credentials.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get authenticated user information",
+                "responses": {
+                    "200": {
+                        "description": "Success, returns detailed information about the authenticated user",
+                        "schema": {
+                            "$ref": "#/definitions/userdto.UserDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the user credentials do not match or are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/recommendations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Scores the available dogs against the household and preferences of the authenticated user, and returns them with the best matches first. Each recommendation explains the score of every criterion: children, other_pets, size, energy, distance, fee, breed, gender and age. Criteria that the user has not answered are not applicable and do not count. The distance is measured from near, or else from the city of the user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get dog recommendations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page of recommendations",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Recommendations per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Measure the distance from a point formatted as lat,lng instead of the city of the user",
+                        "name": "near",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Only recommend dogs within this many kilometers of near",
+                        "name": "radius-km",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the recommended dogs along with pagination details",
+                        "schema": {
+                            "$ref": "#/definitions/dogdto.DogRecommendationsAndPaginationLinksDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request if the query parameters are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the request is not made by a user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the profile of a user. Users can read their own profile and admins any profile. Dog shelters can read the adopter profile of any user, without email and phone.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get a user profile",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the user profile",
+                        "schema": {
+                            "$ref": "#/definitions/userdto.UserDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter format is incorrect",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not allowed to read the profile",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no user matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replaces the whole profile of a user, omitted fields are cleared. Email is required, and a changed email has to be verified again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Replace a user profile",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Profile data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userdto.UpdateUserDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the updated user profile",
+                        "schema": {
+                            "$ref": "#/definitions/userdto.UserDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter or the profile data is invalid, or the email is already registered",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not the user or an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no user matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes a user identified by its unique ID, provided the requester has the necessary permissions. The personal data of the user, its credentials and integrations are erased, and the account is kept anonymized for records that must be retained.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "User deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter format is incorrect",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester does not have permission to delete the user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no user matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Updates the given fields of a user profile. A changed email has to be verified again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update a user profile",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Profile data",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userdto.UpdateUserDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the updated user profile",
+                        "schema": {
+                            "$ref": "#/definitions/userdto.UserDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter or the profile data is invalid, or the email is already registered",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not the user or an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no user matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/api-keys": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the api keys of the user, including revoked keys and when each key was last used. The keys themselves are never returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/api-keys"
+                ],
+                "summary": "List api keys",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the api keys",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/userapikeydto.ApiKeyDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the id is not a number",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the user credentials do not match or are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the specified user does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a long lived api key for machine integrations. The key is only shown in this response and is sent in the X-API-Key header. Valid scopes are dogs:read, dogs:write, dogshelters:read, dogshelters:write, users:read and users:write.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/api-keys"
+                ],
+                "summary": "Create a new api key",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Api key data",
+                        "name": "apikey",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userapikeydto.NewApiKeyDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success, returns the api key including the plain text key",
+                        "schema": {
+                            "$ref": "#/definitions/userapikeydto.CreatedApiKeyDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the JSON body cannot be parsed or the api key data is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the user credentials do not match or are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the specified user does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/api-keys/{keyId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revokes an api key of the user. Revoked keys can no longer be used but are still listed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/api-keys"
+                ],
+                "summary": "Revoke an api key",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Api key ID",
+                        "name": "keyId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the revoked api key",
+                        "schema": {
+                            "$ref": "#/definitions/userapikeydto.ApiKeyDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if an id is not a number",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the user credentials do not match or are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if there is no active api key with the id",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/email-verification": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sends a new verification token to the email of the authenticated user, which invalidates earlier tokens. Nothing is sent if the email is already verified.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Resend verification email",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted, the verification email is sent"
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter is not a number or the user has no email",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not the user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no user matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a JSON archive of the data held about the user: the profile, the webhook, api keys, owned oauth clients, oauth consents, whether MFA is enabled, favorites, saved searches and in-app notifications. Secrets and hashes are not included. Available to the user itself and admins, but not with api keys or oauth tokens.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Export user data",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the archive as an attachment",
+                        "schema": {
+                            "$ref": "#/definitions/userdto.UserExportDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter format is incorrect",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not the user or an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no user matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/favorites": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves a page of the favorite dogs of the user. The dog filters of GET /dogs can be used. Only available to the user itself and admins.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/favorites"
+                ],
+                "summary": "Get the favorite dogs of a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page of favorites",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Favorites per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status, several statuses can be given separated by commas",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the favorite dogs along with pagination details",
+                        "schema": {
+                            "$ref": "#/definitions/dogdto.DogsAndPaginationLinksDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the id is not a number or the query parameters are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the request is not made by the user or an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the user does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/favorites/{dogId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Saves the dog to the favorites of the user. Adding a dog that already is a favorite changes nothing. The user is notified when the status of a favorite dog changes, with a notification and the favorite_status_changed webhook. Only the user itself can add favorites.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/favorites"
+                ],
+                "summary": "Add a favorite dog",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "dogId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content, the dog is a favorite of the user"
+                    },
+                    "400": {
+                        "description": "Bad Request, if an id is not a number",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the request is not made by the user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the user or the dog does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Removes the dog from the favorites of the user. Only the user itself can remove favorites.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/favorites"
+                ],
+                "summary": "Remove a favorite dog",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Dog ID",
+                        "name": "dogId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content, the dog is removed from the favorites"
+                    },
+                    "400": {
+                        "description": "Bad Request, if an id is not a number",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the request is not made by the user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the dog is not a favorite of the user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves a page of the in-app notifications of the user, newest first. The notifications are created from the same events as the webhooks: new and transferred dogs for every active user, the status changes of the favorite dogs of the user, and the matches of saved searches with the inbox channel. The data of a notification is the payload of its webhook without the secret. Only available to the user itself.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/notifications"
+                ],
+                "summary": "Get the notifications of a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page of notifications",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Notifications per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only unread notifications if true, only read notifications if false",
+                        "name": "unread",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the notifications along with pagination details",
+                        "schema": {
+                            "$ref": "#/definitions/usernotificationdto.NotificationsAndPaginationLinksDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the id is not a number or the query parameters are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the request is not made by the user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the user does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/notifications/read": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Marks every unread notification of the user as read. Only available to the user itself.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/notifications"
+                ],
+                "summary": "Mark all notifications as read",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content, the notifications are marked as read"
+                    },
+                    "400": {
+                        "description": "Bad Request, if the id is not a number",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the request is not made by the user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the user does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/notifications/unread-count": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the number of unread notifications of the user. Only available to the user itself.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/notifications"
+                ],
+                "summary": "Get the unread notification count of a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the unread count",
+                        "schema": {
+                            "$ref": "#/definitions/usernotificationdto.UnreadCountDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the id is not a number",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the request is not made by the user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the user does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/notifications/{notificationId}/read": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Marks the notification as read. Marking a notification that is already read keeps the time it was first read. Only available to the user itself.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/notifications"
+                ],
+                "summary": "Mark a notification as read",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Notification ID",
+                        "name": "notificationId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK, returns the notification",
+                        "schema": {
+                            "$ref": "#/definitions/usernotificationdto.NotificationDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if an id is not a number",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the request is not made by the user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the user has no notification with the id",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/oauth-consents": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the oauth clients the user has authorized and the scopes they were granted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/oauth-consents"
+                ],
+                "summary": "List oauth consents",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the consents",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/oauthdto.OAuthConsentDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the id is not a number",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the user credentials do not match or are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the specified user does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/oauth-consents/{clientId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Withdraws the consent given to an oauth client and revokes its refresh tokens. Access tokens already issued stay valid until they expire.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/oauth-consents"
+                ],
+                "summary": "Withdraw an oauth consent",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client ID",
+                        "name": "clientId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Consent withdrawn successfully"
+                    },
+                    "400": {
+                        "description": "Bad Request, if the id is not a number",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the user credentials do not match or are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if there is no consent for the client",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/password": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the password of the authenticated user. The current password is required, and all oauth refresh tokens issued for the user are revoked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Change user password",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Current and new password",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/authdto.ChangePasswordDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content, the password is changed"
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter or body is invalid, or the new password does not meet the password policy",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the current password is wrong or the requester is not the user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no user matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/saved-searches": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves all saved searches of the user. Only available to the user itself and admins.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/saved-searches"
+                ],
+                "summary": "Get the saved searches of a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the saved searches",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/usersavedsearchdto.SavedSearchDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the id is not a number",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the request is not made by the user or an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the user does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Saves a GET /dogs query string under a name. The dog filters and the near and radius-km params can be used. When a dog is created or updated and matches the search for the first time, the user is alerted on the channel of the search, either the inbox or the saved_search_match webhook. Instant searches alert for every dog, and daily searches send one digest a day. The channel defaults to inbox and the frequency to instant. Only the user itself can save searches.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/saved-searches"
+                ],
+                "summary": "Save a search",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The saved search",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usersavedsearchdto.NewSavedSearchDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created, returns the saved search",
+                        "schema": {
+                            "$ref": "#/definitions/usersavedsearchdto.SavedSearchDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the id is not a number or the body is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the request is not made by the user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the user does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/saved-searches/{searchId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves a saved search of the user. The dogs link runs the search against all dogs. Only available to the user itself and admins.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/saved-searches"
+                ],
+                "summary": "Get a saved search",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Saved search ID",
+                        "name": "searchId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the saved search",
+                        "schema": {
+                            "$ref": "#/definitions/usersavedsearchdto.SavedSearchDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if an id is not a number",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the request is not made by the user or an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the user has no saved search with the id",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Changes the provided fields of the saved search. Dogs that already matched the search are not sent again. Only the user itself can update saved searches.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/saved-searches"
+                ],
+                "summary": "Update a saved search",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Saved search ID",
+                        "name": "searchId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "The fields to change",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usersavedsearchdto.NewSavedSearchDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK, returns the saved search",
+                        "schema": {
+                            "$ref": "#/definitions/usersavedsearchdto.SavedSearchDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if an id is not a number or the body is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the request is not made by the user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the user has no saved search with the id",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes the saved search. Matches waiting for the daily digest are not sent. Only the user itself can delete saved searches.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/saved-searches"
+                ],
+                "summary": "Delete a saved search",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Saved search ID",
+                        "name": "searchId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content, the saved search is deleted"
+                    },
+                    "400": {
+                        "description": "Bad Request, if an id is not a number",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the request is not made by the user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the user has no saved search with the id",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/suspension": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Suspends a user. Suspended users can not log in, and their tokens, api keys and oauth clients are rejected until the user is unsuspended. Only available to admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Suspend a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the updated user",
+                        "schema": {
+                            "$ref": "#/definitions/userdto.UserDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter format is incorrect",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no user matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lifts the suspension of a user. Only available to admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Unsuspend a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the updated user",
+                        "schema": {
+                            "$ref": "#/definitions/userdto.UserDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the ID parameter format is incorrect",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the requester is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no user matches the provided ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/webhook": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves detailed information about a specific user webhook identified by its unique ID for the authenticated user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/webhook"
+                ],
+                "summary": "Get a user webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns detailed information about the user webhook",
+                        "schema": {
+                            "$ref": "#/definitions/userwebhookdto.UserWebhookDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the user credentials are invalid or do not grant access to the requested resource",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no resource matches the provided ID or the webhook does not belong to the user",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Updates information for a specific user webhook identified by its unique ID for the authenticated user based on the provided data in JSON format. Secret must be minimum 12 characters.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/webhook"
+                ],
+                "summary": "Update a user webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Webhook Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userwebhookdto.UpdateUserWebhookDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success, returns the updated user webhook information",
+                        "schema": {
+                            "$ref": "#/definitions/userwebhookdto.UserWebhookDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the request data is incomplete or has invalid values",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the user credentials do not match or are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if no matching webhook resource is found for the given ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Adds a new webhook for the authenticated user based on the provided webhook data in JSON format. Secret must be minimum 12 characters.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/webhook"
+                ],
+                "summary": "Create a new user webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook Data",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userwebhookdto.NewUserWebhookDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Success, returns the newly created user webhook information",
+                        "schema": {
+                            "$ref": "#/definitions/userwebhookdto.UserWebhookDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request, if the JSON body cannot be parsed, mandatory fields are missing, or the webhook data is incomplete",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized, if the user credentials do not match or are invalid",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden, if the email of the user is not verified",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found, if the specified user does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error, if an error occurs while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes a webhook for the authenticated user based on the provided webhook ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users/{id}/webhook"
+                ],
+                "summary": "Delete a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Webhook deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad Request - No webhook found or ID must be a number",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid user credentials",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found - No webhook found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error - Something went wrong internally, try again later",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
         }
     },
     "definitions": {
+        "admindto.AdminDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "links": {
+                    "$ref": "#/definitions/admindto.AdminLinksDTO"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "admindto.AdminLinksDTO": {
+            "type": "object",
+            "properties": {
+                "self_link": {
+                    "type": "string"
+                }
+            }
+        },
+        "admindto.AdminsAndPaginationLinksDTO": {
+            "type": "object",
+            "properties": {
+                "admin_data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admindto.AdminDTO"
+                    }
+                },
+                "pagination_links": {
+                    "$ref": "#/definitions/dto.PaginationLinksDTO"
+                }
+            }
+        },
+        "admindto.NewAdminDTO": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "admindto.UpdateAdminDTO": {
+            "type": "object",
+            "properties": {
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "authdto.ChangePasswordDTO": {
+            "type": "object",
+            "properties": {
+                "current_password": {
+                    "type": "string"
+                },
+                "new_password": {
+                    "type": "string"
+                }
+            }
+        },
+        "authdto.ForgotPasswordDTO": {
+            "type": "object",
+            "properties": {
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "authdto.LoginResultDTO": {
+            "type": "object",
+            "properties": {
+                "mfa_challenge_token": {
+                    "type": "string"
+                },
+                "mfa_enrollment_required": {
+                    "type": "boolean"
+                },
+                "mfa_required": {
+                    "type": "boolean"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "authdto.MfaChallengeDTO": {
+            "type": "object",
+            "properties": {
+                "challenge_token": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "recovery_code": {
+                    "type": "string"
+                }
+            }
+        },
+        "authdto.MfaCodeDTO": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "authdto.MfaEnrollmentDTO": {
+            "type": "object",
+            "properties": {
+                "provisioning_uri": {
+                    "type": "string"
+                },
+                "recovery_codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "secret": {
+                    "type": "string"
+                }
+            }
+        },
+        "authdto.MfaPolicyDTO": {
+            "type": "object",
+            "properties": {
+                "is_required": {
+                    "type": "boolean"
+                },
+                "role": {
+                    "$ref": "#/definitions/model.UserRole"
+                }
+            }
+        },
+        "authdto.ResetPasswordDTO": {
+            "type": "object",
+            "properties": {
+                "new_password": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "authdto.UpdateMfaPolicyDTO": {
+            "type": "object",
+            "properties": {
+                "is_required": {
+                    "type": "boolean"
+                }
+            }
+        },
         "authhandler.Payload": {
             "type": "object",
             "properties": {
-                "password": {
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "breeddto.BreedDTO": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "fci_group": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "links": {
+                    "$ref": "#/definitions/breeddto.BreedLinksDTO"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "size_group": {
+                    "type": "string"
+                }
+            }
+        },
+        "breeddto.BreedLinksDTO": {
+            "type": "object",
+            "properties": {
+                "dogs_link": {
+                    "description": "DogsLink lists the dogs with the breed as their primary or secondary breed.",
+                    "type": "string"
+                },
+                "self_link": {
+                    "type": "string"
+                }
+            }
+        },
+        "breeddto.BreedsAndPaginationLinksDTO": {
+            "type": "object",
+            "properties": {
+                "breed_data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/breeddto.BreedDTO"
+                    }
+                },
+                "pagination_links": {
+                    "$ref": "#/definitions/dto.PaginationLinksDTO"
+                }
+            }
+        },
+        "breeddto.MergeBreedDTO": {
+            "type": "object",
+            "properties": {
+                "source_breed_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "breeddto.NewBreedDTO": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "fci_group": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "size_group": {
+                    "type": "string"
+                }
+            }
+        },
+        "breeddto.UpdateBreedDTO": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "fci_group": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "size_group": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogdto.DogDTO": {
+            "type": "object",
+            "properties": {
+                "adoption_fee": {
+                    "type": "integer"
+                },
+                "birth_date": {
+                    "type": "string"
+                },
+                "breed": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "distance_km": {
+                    "type": "number"
+                },
+                "energy_level": {
+                    "type": "string"
+                },
+                "favorited_count": {
+                    "description": "FavoritedCount is how many users have the dog as a favorite, only shown to admins and members of its shelter.",
+                    "type": "integer"
+                },
+                "friendly_with": {
+                    "description": "FriendlyWith describes good_with for older clients.",
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "good_with": {
+                    "$ref": "#/definitions/dogdto.GoodWithDTO"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "is_adopted": {
+                    "type": "boolean"
+                },
+                "is_neutered": {
+                    "type": "boolean"
+                },
+                "links": {
+                    "$ref": "#/definitions/dogdto.DogLinksDTO"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "primary_breed_id": {
+                    "description": "PrimaryBreedId and SecondaryBreedId reference breeds, a mixed breed dog has both.",
+                    "type": "integer"
+                },
+                "secondary_breed_id": {
+                    "type": "integer"
+                },
+                "shelter_id": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "status_changed_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogdto.DogLinksDTO": {
+            "type": "object",
+            "properties": {
+                "medical_records_link": {
+                    "type": "string"
+                },
+                "photos_link": {
+                    "type": "string"
+                },
+                "primary_breed_link": {
+                    "type": "string"
+                },
+                "primary_photo_link": {
+                    "description": "PrimaryPhotoLink is the medium thumbnail of the primary photo, if the dog has photos.",
+                    "type": "string"
+                },
+                "secondary_breed_link": {
+                    "type": "string"
+                },
+                "self_link": {
+                    "type": "string"
+                },
+                "shelter_link": {
+                    "type": "string"
+                },
+                "status_history_link": {
+                    "type": "string"
+                },
+                "transfers_link": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogdto.DogRecommendationDTO": {
+            "type": "object",
+            "properties": {
+                "criteria": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogdto.RecommendationCriterionScoreDTO"
+                    }
+                },
+                "dog": {
+                    "$ref": "#/definitions/dogdto.DogDTO"
+                },
+                "score": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dogdto.DogRecommendationsAndPaginationLinksDTO": {
+            "type": "object",
+            "properties": {
+                "pagination_links": {
+                    "$ref": "#/definitions/dto.PaginationLinksDTO"
+                },
+                "recommendations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogdto.DogRecommendationDTO"
+                    }
+                }
+            }
+        },
+        "dogdto.DogStatusChangeDTO": {
+            "type": "object",
+            "properties": {
+                "changed_at": {
+                    "type": "string"
+                },
+                "dog_id": {
+                    "type": "integer"
+                },
+                "from_status": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogdto.DogStatusHistoryDTO": {
+            "type": "object",
+            "properties": {
+                "allowed_transitions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/dogdto.DogStatusHistoryLinksDTO"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "status_history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogdto.DogStatusChangeDTO"
+                    }
+                }
+            }
+        },
+        "dogdto.DogStatusHistoryLinksDTO": {
+            "type": "object",
+            "properties": {
+                "dog_link": {
+                    "type": "string"
+                },
+                "self_link": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogdto.DogsAndPaginationLinksDTO": {
+            "type": "object",
+            "properties": {
+                "dogs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogdto.DogDTO"
+                    }
+                },
+                "pagination_links": {
+                    "$ref": "#/definitions/dto.PaginationLinksDTO"
+                }
+            }
+        },
+        "dogdto.GoodWithDTO": {
+            "type": "object",
+            "properties": {
+                "cats": {
+                    "type": "string"
+                },
+                "children": {
+                    "type": "string"
+                },
+                "dogs": {
+                    "type": "string"
+                },
+                "small_animals": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogdto.NewDogDTO": {
+            "type": "object",
+            "properties": {
+                "adoption_fee": {
+                    "type": "integer"
+                },
+                "birth_date": {
+                    "type": "string"
+                },
+                "breed": {
+                    "description": "Breed is resolved to a breed by its name or an alias, when primary_breed_id is not set.",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "energy_level": {
+                    "description": "EnergyLevel is low, medium or high.",
+                    "type": "string"
+                },
+                "friendly_with": {
+                    "description": "FriendlyWith is kept for older clients. It sets good_with when good_with is not given, and is\nderived from good_with otherwise.",
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "good_with": {
+                    "$ref": "#/definitions/dogdto.UpdateGoodWithDTO"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "is_adopted": {
+                    "type": "boolean"
+                },
+                "is_neutered": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "primary_breed_id": {
+                    "type": "integer"
+                },
+                "secondary_breed_id": {
+                    "type": "integer"
+                },
+                "shelter_id": {
+                    "type": "integer"
+                },
+                "size": {
+                    "description": "Size is toy, small, medium, large or giant, and defaults to the size of the primary breed.",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "status_note": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogdto.RecommendationCriterionScoreDTO": {
+            "type": "object",
+            "properties": {
+                "applicable": {
+                    "type": "boolean"
+                },
+                "criterion": {
+                    "type": "string"
+                },
+                "explanation": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "dogdto.UpdateDogDTO": {
+            "type": "object",
+            "properties": {
+                "adoption_fee": {
+                    "type": "integer"
+                },
+                "birth_date": {
+                    "type": "string"
+                },
+                "breed": {
+                    "description": "Breed is resolved to a breed by its name or an alias, when primary_breed_id is not set.",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "energy_level": {
+                    "description": "An empty energy_level or size removes it.",
+                    "type": "string"
+                },
+                "friendly_with": {
+                    "description": "FriendlyWith is kept for older clients. It sets good_with when good_with is not given, and is\nderived from good_with otherwise.",
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "good_with": {
+                    "$ref": "#/definitions/dogdto.UpdateGoodWithDTO"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "is_adopted": {
+                    "type": "boolean"
+                },
+                "is_neutered": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "primary_breed_id": {
+                    "type": "integer"
+                },
+                "secondary_breed_id": {
+                    "description": "SecondaryBreedId of 0 removes the secondary breed.",
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "status_note": {
+                    "description": "StatusNote is saved in the status history when the status changes.",
+                    "type": "string"
+                }
+            }
+        },
+        "dogdto.UpdateGoodWithDTO": {
+            "type": "object",
+            "properties": {
+                "cats": {
+                    "type": "string"
+                },
+                "children": {
+                    "type": "string"
+                },
+                "dogs": {
+                    "type": "string"
+                },
+                "small_animals": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogmedicaldto.DogMedicalRecordDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "dog_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "links": {
+                    "$ref": "#/definitions/dogmedicaldto.DogMedicalRecordDtoLinks"
+                },
+                "next_due_on": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "record_date": {
+                    "type": "string"
+                },
+                "record_type": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogmedicaldto.DogMedicalRecordDtoLinks": {
+            "type": "object",
+            "properties": {
+                "dog_link": {
+                    "type": "string"
+                },
+                "self_link": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogmedicaldto.DogMedicalRecordsDTO": {
+            "type": "object",
+            "properties": {
+                "is_full_record": {
+                    "type": "boolean"
+                },
+                "links": {
+                    "$ref": "#/definitions/dogmedicaldto.DogMedicalRecordsDtoLinks"
+                },
+                "record_data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogmedicaldto.DogMedicalRecordDTO"
+                    }
+                },
+                "summary": {
+                    "$ref": "#/definitions/dogmedicaldto.DogMedicalSummaryDTO"
+                }
+            }
+        },
+        "dogmedicaldto.DogMedicalRecordsDtoLinks": {
+            "type": "object",
+            "properties": {
+                "dog_link": {
+                    "type": "string"
+                },
+                "self_link": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogmedicaldto.DogMedicalSummaryDTO": {
+            "type": "object",
+            "properties": {
+                "dog_id": {
+                    "type": "integer"
+                },
+                "is_neutered": {
+                    "type": "boolean"
+                },
+                "is_vaccinated": {
+                    "type": "boolean"
+                },
+                "treatment_count": {
+                    "type": "integer"
+                },
+                "vaccinations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogmedicaldto.VaccinationSummaryDTO"
+                    }
+                }
+            }
+        },
+        "dogmedicaldto.NewDogMedicalRecordDTO": {
+            "type": "object",
+            "properties": {
+                "next_due_on": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "record_date": {
+                    "type": "string"
+                },
+                "record_type": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogmedicaldto.UpdateDogMedicalRecordDTO": {
+            "type": "object",
+            "properties": {
+                "next_due_on": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "record_date": {
+                    "type": "string"
+                },
+                "record_type": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogmedicaldto.VaccinationSummaryDTO": {
+            "type": "object",
+            "properties": {
+                "administered_on": {
+                    "type": "string"
+                },
+                "is_current": {
+                    "type": "boolean"
+                },
+                "next_due_on": {
+                    "type": "string"
+                },
+                "vaccine_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogphotodto.DogPhotoDTO": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "dog_id": {
+                    "type": "integer"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_primary": {
+                    "type": "boolean"
+                },
+                "links": {
+                    "$ref": "#/definitions/dogphotodto.DogPhotoDtoLinks"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "size_bytes": {
+                    "type": "integer"
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dogphotodto.DogPhotoDtoLinks": {
+            "type": "object",
+            "properties": {
+                "dog_link": {
+                    "type": "string"
+                },
+                "large_link": {
+                    "type": "string"
+                },
+                "medium_link": {
+                    "type": "string"
+                },
+                "original_link": {
+                    "type": "string"
+                },
+                "self_link": {
+                    "type": "string"
+                },
+                "small_link": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogphotodto.DogPhotoGalleryDTO": {
+            "type": "object",
+            "properties": {
+                "photo_data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogphotodto.DogPhotoDTO"
+                    }
+                }
+            }
+        },
+        "dogphotodto.UpdateDogPhotoDTO": {
+            "type": "object",
+            "properties": {
+                "is_primary": {
+                    "type": "boolean"
+                },
+                "position": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dogshelterdto.DogShelterDTO": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "distance_km": {
+                    "type": "number"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_open_now": {
+                    "type": "boolean"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "links": {
+                    "$ref": "#/definitions/dogshelterdto.DogShelterDtoLinks"
+                },
+                "location_accuracy": {
+                    "type": "string"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "occupancy": {
+                    "type": "integer"
+                },
+                "opening_hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogshelterdto.OpeningHoursDTO"
+                    }
+                },
+                "opening_hours_exceptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogshelterdto.OpeningHoursExceptionDTO"
+                    }
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogshelterdto.DogShelterDtoLinks": {
+            "type": "object",
+            "properties": {
+                "dogs_link": {
+                    "type": "string"
+                },
+                "self_link": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogshelterdto.DogShelterRegistrationDTO": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "contact_email": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "links": {
+                    "$ref": "#/definitions/dogshelterdto.DogShelterRegistrationDtoLinks"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "registered_at": {
+                    "type": "string"
+                },
+                "review_reason": {
+                    "type": "string"
+                },
+                "reviewed_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogshelterdto.DogShelterRegistrationDtoLinks": {
+            "type": "object",
+            "properties": {
+                "approve_link": {
+                    "type": "string"
+                },
+                "reject_link": {
+                    "type": "string"
+                },
+                "self_link": {
+                    "type": "string"
+                },
+                "shelter_link": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogshelterdto.DogShelterRegistrationsAndPaginationLinksDTO": {
+            "type": "object",
+            "properties": {
+                "pagination_links": {
+                    "$ref": "#/definitions/dto.PaginationLinksDTO"
+                },
+                "registration_data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogshelterdto.DogShelterRegistrationDTO"
+                    }
+                }
+            }
+        },
+        "dogshelterdto.DogSheltersAndPaginationLinksDTO": {
+            "type": "object",
+            "properties": {
+                "dog_shelter_data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogshelterdto.DogShelterDTO"
+                    }
+                },
+                "pagination_links": {
+                    "$ref": "#/definitions/dto.PaginationLinksDTO"
+                }
+            }
+        },
+        "dogshelterdto.NewDogShelterDTO": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number",
+                    "example": 56.6634
+                },
+                "longitude": {
+                    "type": "number",
+                    "example": 16.3568
+                },
+                "name": {
+                    "type": "string"
+                },
+                "opening_hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogshelterdto.OpeningHoursDTO"
+                    }
+                },
+                "opening_hours_exceptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogshelterdto.OpeningHoursExceptionDTO"
+                    }
+                },
+                "password": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Europe/Stockholm"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogshelterdto.NewDogShelterRegistrationDTO": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "contact_email": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number",
+                    "example": 56.6634
+                },
+                "longitude": {
+                    "type": "number",
+                    "example": 16.3568
+                },
+                "name": {
+                    "type": "string"
+                },
+                "opening_hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogshelterdto.OpeningHoursDTO"
+                    }
+                },
+                "opening_hours_exceptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogshelterdto.OpeningHoursExceptionDTO"
+                    }
+                },
+                "password": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Europe/Stockholm"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogshelterdto.OpeningHoursDTO": {
+            "type": "object",
+            "properties": {
+                "closes": {
+                    "type": "string",
+                    "example": "16:00"
+                },
+                "opens": {
+                    "type": "string",
+                    "example": "10:00"
+                },
+                "weekday": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dogshelterdto.OpeningHoursExceptionDTO": {
+            "type": "object",
+            "properties": {
+                "closes": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2024-12-24"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "opens": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogshelterdto.ShelterRegistrationDecisionDTO": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogshelterdto.UpdateDogShelterDTO": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number",
+                    "example": 56.6634
+                },
+                "longitude": {
+                    "type": "number",
+                    "example": 16.3568
+                },
+                "name": {
+                    "type": "string"
+                },
+                "opening_hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogshelterdto.OpeningHoursDTO"
+                    }
+                },
+                "opening_hours_exceptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogshelterdto.OpeningHoursExceptionDTO"
+                    }
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Europe/Stockholm"
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogtransferdto.DogTransferDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "decided_at": {
+                    "type": "string"
+                },
+                "decision_reason": {
+                    "type": "string"
+                },
+                "dog_id": {
+                    "type": "integer"
+                },
+                "from_shelter_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "links": {
+                    "$ref": "#/definitions/dogtransferdto.DogTransferDtoLinks"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "to_shelter_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dogtransferdto.DogTransferDecisionDTO": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogtransferdto.DogTransferDtoLinks": {
+            "type": "object",
+            "properties": {
+                "dog_link": {
+                    "type": "string"
+                },
+                "from_shelter_link": {
+                    "type": "string"
+                },
+                "self_link": {
+                    "type": "string"
+                },
+                "to_shelter_link": {
+                    "type": "string"
+                }
+            }
+        },
+        "dogtransferdto.DogTransferListDTO": {
+            "type": "object",
+            "properties": {
+                "transfer_data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dogtransferdto.DogTransferDTO"
+                    }
+                }
+            }
+        },
+        "dogtransferdto.NewDogTransferDTO": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "type": "string"
+                },
+                "to_shelter_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.EntryPointLinksDTO": {
+            "type": "object",
+            "properties": {
+                "authentication_url": {
+                    "type": "string"
+                },
+                "breeds_url": {
+                    "type": "string"
+                },
+                "dog_shelters_url": {
+                    "type": "string"
+                },
+                "dogs_url": {
+                    "type": "string"
+                },
+                "open_api": {
+                    "type": "string"
+                },
+                "users_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PaginationLinksDTO": {
+            "type": "object",
+            "properties": {
+                "first": {
+                    "type": "string"
+                },
+                "last": {
+                    "type": "string"
+                },
+                "next": {
+                    "type": "string"
+                },
+                "previous": {
+                    "type": "string"
+                },
+                "self": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.OAuthGrantType": {
+            "type": "string",
+            "enum": [
+                "authorization_code",
+                "refresh_token",
+                "client_credentials"
+            ],
+            "x-enum-varnames": [
+                "AUTHORIZATION_CODE",
+                "REFRESH_TOKEN",
+                "CLIENT_CREDENTIALS"
+            ]
+        },
+        "model.UserRole": {
+            "type": "string",
+            "enum": [
+                "admin",
+                "dog_shelter",
+                "shelter_staff",
+                "user"
+            ],
+            "x-enum-varnames": [
+                "ADMIN",
+                "DOGSHELTER",
+                "SHELTERSTAFF",
+                "USER"
+            ]
+        },
+        "model.WebhookAction": {
+            "type": "string",
+            "enum": [
+                "new_dog_added",
+                "dog_transferred",
+                "favorite_status_changed",
+                "saved_search_match"
+            ],
+            "x-enum-varnames": [
+                "NEW_DOG_ADDED",
+                "DOG_TRANSFERRED",
+                "FAVORITE_STATUS_CHANGED",
+                "SAVED_SEARCH_MATCH"
+            ]
+        },
+        "oauthdto.AuthorizeDecisionDTO": {
+            "type": "object",
+            "properties": {
+                "approve": {
+                    "type": "boolean"
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "code_challenge": {
+                    "type": "string"
+                },
+                "code_challenge_method": {
+                    "type": "string"
+                },
+                "redirect_uri": {
+                    "type": "string"
+                },
+                "response_type": {
+                    "type": "string"
+                },
+                "scope": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string"
+                }
+            }
+        },
+        "oauthdto.AuthorizeResultDTO": {
+            "type": "object",
+            "properties": {
+                "redirect_to": {
+                    "type": "string"
+                }
+            }
+        },
+        "oauthdto.ConsentPromptDTO": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "client_name": {
+                    "type": "string"
+                },
+                "has_consent": {
+                    "type": "boolean"
+                },
+                "redirect_uri": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "oauthdto.CreatedOAuthClientDTO": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "client_secret": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "grant_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.OAuthGrantType"
+                    }
+                },
+                "is_confidential": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner_user_id": {
+                    "type": "integer"
+                },
+                "redirect_uris": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "oauthdto.NewOAuthClientDTO": {
+            "type": "object",
+            "properties": {
+                "grant_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.OAuthGrantType"
+                    }
+                },
+                "is_confidential": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner_user_id": {
+                    "type": "integer"
+                },
+                "redirect_uris": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "oauthdto.OAuthClientDTO": {
+            "type": "object",
+            "properties": {
+                "client_id": {
                     "type": "string"
                 },
-                "username": {
+                "created_at": {
+                    "type": "string"
+                },
+                "grant_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.OAuthGrantType"
+                    }
+                },
+                "is_confidential": {
+                    "type": "boolean"
+                },
+                "name": {
                     "type": "string"
+                },
+                "owner_user_id": {
+                    "type": "integer"
+                },
+                "redirect_uris": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "authhandler.Response": {
+        "oauthdto.OAuthConsentDTO": {
             "type": "object",
             "properties": {
-                "token": {
+                "client_id": {
+                    "type": "string"
+                },
+                "granted_at": {
                     "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
-        "dogdto.DogDTO": {
+        "oauthdto.OAuthErrorResponse": {
             "type": "object",
             "properties": {
-                "adoption_fee": {
+                "error": {
+                    "type": "string"
+                },
+                "error_description": {
+                    "type": "string"
+                }
+            }
+        },
+        "oauthdto.TokenResponseDTO": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "expires_in": {
                     "type": "integer"
                 },
-                "birth_date": {
+                "refresh_token": {
                     "type": "string"
                 },
-                "breed": {
+                "scope": {
                     "type": "string"
                 },
-                "description": {
+                "token_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "shelterstaffdto.AcceptStaffInvitationDTO": {
+            "type": "object",
+            "properties": {
+                "password": {
                     "type": "string"
                 },
-                "friendly_with": {
+                "token": {
                     "type": "string"
                 },
-                "gender": {
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "shelterstaffdto.NewStaffInvitationDTO": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "shelterstaffdto.ShelterStaffDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "image_url": {
+                "links": {
+                    "$ref": "#/definitions/shelterstaffdto.ShelterStaffDtoLinks"
+                },
+                "role": {
                     "type": "string"
                 },
-                "is_adopted": {
-                    "type": "boolean"
+                "shelter_id": {
+                    "type": "integer"
                 },
-                "is_neutered": {
-                    "type": "boolean"
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "shelterstaffdto.ShelterStaffDtoLinks": {
+            "type": "object",
+            "properties": {
+                "self_link": {
+                    "type": "string"
+                },
+                "shelter_link": {
+                    "type": "string"
+                }
+            }
+        },
+        "shelterstaffdto.ShelterStaffListDTO": {
+            "type": "object",
+            "properties": {
+                "staff_data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/shelterstaffdto.ShelterStaffDTO"
+                    }
+                }
+            }
+        },
+        "shelterstaffdto.StaffInvitationDTO": {
+            "type": "object",
+            "properties": {
+                "accepted_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
                 },
                 "links": {
-                    "$ref": "#/definitions/dogdto.DogLinksDTO"
+                    "$ref": "#/definitions/shelterstaffdto.StaffInvitationDtoLinks"
                 },
-                "name": {
+                "role": {
                     "type": "string"
                 },
                 "shelter_id": {
@@ -1099,277 +8466,494 @@ const docTemplate = `{
                 }
             }
         },
-        "dogdto.DogLinksDTO": {
+        "shelterstaffdto.StaffInvitationDtoLinks": {
             "type": "object",
             "properties": {
                 "self_link": {
                     "type": "string"
                 },
-                "shelter_link": {
+                "shelter_link": {
+                    "type": "string"
+                }
+            }
+        },
+        "shelterstaffdto.StaffInvitationListDTO": {
+            "type": "object",
+            "properties": {
+                "invitation_data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/shelterstaffdto.StaffInvitationDTO"
+                    }
+                }
+            }
+        },
+        "shelterstaffdto.UpdateShelterStaffDTO": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "userapikeydto.ApiKeyDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "userapikeydto.CreatedApiKeyDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "userapikeydto.NewApiKeyDTO": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "userdto.NewUserDTO": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "userdto.UpdateUserDTO": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "household": {
+                    "$ref": "#/definitions/userdto.UserHouseholdDTO"
+                },
+                "phone": {
                     "type": "string"
-                }
-            }
-        },
-        "dogdto.DogsAndPaginationLinksDTO": {
-            "type": "object",
-            "properties": {
-                "dogs": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dogdto.DogDTO"
-                    }
                 },
-                "pagination_links": {
-                    "$ref": "#/definitions/dto.PaginationLinksDTO"
+                "preferences": {
+                    "$ref": "#/definitions/userdto.UserPreferencesDTO"
                 }
             }
         },
-        "dogdto.NewDogDTO": {
+        "userdto.UserDTO": {
             "type": "object",
             "properties": {
-                "adoption_fee": {
-                    "type": "integer"
-                },
-                "birth_date": {
+                "city": {
                     "type": "string"
                 },
-                "breed": {
+                "country": {
                     "type": "string"
                 },
-                "description": {
+                "email": {
                     "type": "string"
                 },
-                "friendly_with": {
+                "email_status": {
                     "type": "string"
                 },
-                "gender": {
+                "full_name": {
                     "type": "string"
                 },
-                "image_url": {
+                "household": {
+                    "$ref": "#/definitions/userdto.UserHouseholdDTO"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "links": {
+                    "$ref": "#/definitions/userdto.UserLinksDTO"
+                },
+                "phone": {
                     "type": "string"
                 },
-                "is_adopted": {
-                    "type": "boolean"
+                "preferences": {
+                    "$ref": "#/definitions/userdto.UserPreferencesDTO"
                 },
-                "is_neutered": {
-                    "type": "boolean"
+                "status": {
+                    "type": "string"
                 },
-                "name": {
+                "suspended_at": {
                     "type": "string"
                 },
-                "shelter_id": {
-                    "type": "integer"
+                "username": {
+                    "type": "string"
+                },
+                "webhook": {
+                    "$ref": "#/definitions/userwebhookdto.UserWebhookDTO"
                 }
             }
         },
-        "dogdto.UpdateDogDTO": {
+        "userdto.UserExportDTO": {
             "type": "object",
             "properties": {
-                "adoption_fee": {
-                    "type": "integer"
+                "api_keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/userapikeydto.ApiKeyDTO"
+                    }
                 },
-                "birth_date": {
+                "exported_at": {
                     "type": "string"
                 },
-                "breed": {
-                    "type": "string"
+                "favorites": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/userfavoritedto.FavoriteDTO"
+                    }
                 },
-                "description": {
-                    "type": "string"
+                "mfa_enabled": {
+                    "type": "boolean"
                 },
-                "friendly_with": {
-                    "type": "string"
+                "notifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usernotificationdto.NotificationDTO"
+                    }
                 },
-                "gender": {
-                    "type": "string"
+                "oauth_clients": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/oauthdto.OAuthClientDTO"
+                    }
                 },
-                "image_url": {
-                    "type": "string"
+                "oauth_consents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/oauthdto.OAuthConsentDTO"
+                    }
                 },
-                "is_adopted": {
+                "profile": {
+                    "$ref": "#/definitions/userdto.UserDTO"
+                },
+                "saved_searches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usersavedsearchdto.SavedSearchDTO"
+                    }
+                },
+                "webhook": {
+                    "$ref": "#/definitions/userwebhookdto.UserWebhookDTO"
+                }
+            }
+        },
+        "userdto.UserHouseholdDTO": {
+            "type": "object",
+            "properties": {
+                "has_children": {
                     "type": "boolean"
                 },
-                "is_neutered": {
+                "has_garden": {
                     "type": "boolean"
                 },
-                "name": {
-                    "type": "string"
+                "has_other_pets": {
+                    "type": "boolean"
                 }
             }
         },
-        "dogshelterdto.DogShelterDTO": {
+        "userdto.UserLinksDTO": {
             "type": "object",
             "properties": {
-                "address": {
+                "favorites_link": {
                     "type": "string"
                 },
-                "city": {
+                "notifications_link": {
                     "type": "string"
                 },
-                "country": {
+                "saved_searches_link": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
-                },
-                "links": {
-                    "$ref": "#/definitions/dogshelterdto.DogShelterDtoLinks"
-                },
-                "name": {
+                "self_link": {
                     "type": "string"
                 },
-                "website": {
+                "webhook_link": {
                     "type": "string"
                 }
             }
         },
-        "dogshelterdto.DogShelterDtoLinks": {
+        "userdto.UserPreferencesDTO": {
             "type": "object",
             "properties": {
-                "dogs_link": {
-                    "type": "string"
+                "breeds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "self_link": {
+                "energy_levels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "gender": {
                     "type": "string"
+                },
+                "max_adoption_fee": {
+                    "type": "integer"
+                },
+                "max_age_years": {
+                    "type": "integer"
+                },
+                "max_distance_km": {
+                    "type": "number"
+                },
+                "min_age_years": {
+                    "type": "integer"
+                },
+                "sizes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "dogshelterdto.DogSheltersAndPaginationLinksDTO": {
+        "userdto.UsersAndPaginationLinksDTO": {
             "type": "object",
             "properties": {
-                "dog_shelter_data": {
+                "pagination_links": {
+                    "$ref": "#/definitions/dto.PaginationLinksDTO"
+                },
+                "user_data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dogshelterdto.DogShelterDTO"
+                        "$ref": "#/definitions/userdto.UserDTO"
                     }
-                },
-                "pagination_links": {
-                    "$ref": "#/definitions/dto.PaginationLinksDTO"
                 }
             }
         },
-        "dogshelterdto.NewDogShelterDTO": {
+        "userdto.VerifyEmailDTO": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "city": {
-                    "type": "string"
-                },
-                "country": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "password": {
+                "token": {
                     "type": "string"
+                }
+            }
+        },
+        "userfavoritedto.FavoriteDTO": {
+            "type": "object",
+            "properties": {
+                "dog_id": {
+                    "type": "integer"
                 },
-                "username": {
+                "dog_link": {
                     "type": "string"
                 },
-                "website": {
+                "favorited_at": {
                     "type": "string"
                 }
             }
         },
-        "dogshelterdto.UpdateDogShelterDTO": {
+        "usernotificationdto.NotificationDTO": {
             "type": "object",
             "properties": {
-                "address": {
+                "body": {
                     "type": "string"
                 },
-                "city": {
+                "created_at": {
                     "type": "string"
                 },
-                "country": {
+                "data": {
+                    "description": "Data is the payload of the event, the same as the webhook of the event without the secret.",
+                    "type": "object"
+                },
+                "event": {
                     "type": "string"
                 },
-                "name": {
+                "id": {
+                    "type": "integer"
+                },
+                "is_read": {
+                    "type": "boolean"
+                },
+                "links": {
+                    "$ref": "#/definitions/usernotificationdto.NotificationLinksDTO"
+                },
+                "read_at": {
                     "type": "string"
                 },
-                "website": {
+                "title": {
                     "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.EntryPointLinksDTO": {
+        "usernotificationdto.NotificationLinksDTO": {
             "type": "object",
             "properties": {
-                "authentication_url": {
-                    "type": "string"
-                },
-                "dog_shelters_url": {
+                "read": {
+                    "description": "ReadLink marks the notification as read with a PUT request.",
                     "type": "string"
+                }
+            }
+        },
+        "usernotificationdto.NotificationsAndPaginationLinksDTO": {
+            "type": "object",
+            "properties": {
+                "notifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usernotificationdto.NotificationDTO"
+                    }
                 },
-                "dogs_url": {
-                    "type": "string"
+                "pagination_links": {
+                    "$ref": "#/definitions/dto.PaginationLinksDTO"
                 },
-                "users_url": {
-                    "type": "string"
+                "unread_count": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.ErrorResponse": {
+        "usernotificationdto.UnreadCountDTO": {
             "type": "object",
             "properties": {
-                "error": {
-                    "type": "string"
+                "unread_count": {
+                    "type": "integer"
                 }
             }
         },
-        "dto.PaginationLinksDTO": {
+        "usersavedsearchdto.NewSavedSearchDTO": {
             "type": "object",
             "properties": {
-                "first": {
-                    "type": "string"
-                },
-                "last": {
+                "channel": {
                     "type": "string"
                 },
-                "next": {
+                "frequency": {
                     "type": "string"
                 },
-                "previous": {
+                "name": {
                     "type": "string"
                 },
-                "self": {
+                "query": {
+                    "description": "Query is a GET /dogs query string, such as breed=beagle\u0026good-with=children.",
                     "type": "string"
                 }
             }
         },
-        "model.WebhookAction": {
-            "type": "string",
-            "enum": [
-                "new_dog_added"
-            ],
-            "x-enum-varnames": [
-                "NEW_DOG_ADDED"
-            ]
-        },
-        "userdto.NewUserDTO": {
+        "usersavedsearchdto.SavedSearchDTO": {
             "type": "object",
             "properties": {
-                "password": {
+                "channel": {
                     "type": "string"
                 },
-                "username": {
+                "created_at": {
+                    "type": "string"
+                },
+                "frequency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "links": {
+                    "$ref": "#/definitions/usersavedsearchdto.SavedSearchLinksDTO"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "query": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
-        "userdto.UserDTO": {
+        "usersavedsearchdto.SavedSearchLinksDTO": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "integer"
-                },
-                "username": {
+                "dogs": {
+                    "description": "DogsLink runs the search against all dogs.",
                     "type": "string"
                 },
-                "webhook": {
-                    "$ref": "#/definitions/userwebhookdto.UserWebhookDTO"
+                "self": {
+                    "type": "string"
                 }
             }
         },
@@ -1426,6 +9010,11 @@ const docTemplate = `{
         }
     },
     "securityDefinitions": {
+        "ApiKeyAuth": {
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
         "BearerAuth": {
             "type": "apiKey",
             "name": "Authorization",
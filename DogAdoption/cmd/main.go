@@ -3,10 +3,16 @@ package main
 import (
 	"1dv027/aad/internal/config"
 	"1dv027/aad/internal/router"
+	"1dv027/aad/internal/scheduler"
+	"1dv027/aad/internal/service"
 	"context"
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	// Shelter timezones are resolved on hosts without a zoneinfo database.
+	_ "time/tzdata"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
@@ -26,6 +32,10 @@ import (
 // @in header
 // @name Authorization
 
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name X-API-Key
+
 // @externalDocs.description  OpenAPI
 // @externalDocs.url          https://swagger.io/resources/open-api/
 func main() {
@@ -51,10 +61,105 @@ func main() {
 		CryptographySecretKey: os.Getenv("CRYPTO_KEY"),
 		BasePath:              os.Getenv("BASE_PATH"),
 		JwtSigningKey:         os.Getenv("JWT_SIGNING_KEY"),
+		NotificationLogPath:   os.Getenv("NOTIFICATION_LOG_PATH"),
+		PasswordHashing: service.PasswordHashingConfig{
+			Algorithm:         os.Getenv("PASSWORD_HASH_ALGORITHM"),
+			BcryptCost:        getEnvInt("PASSWORD_BCRYPT_COST"),
+			Argon2MemoryKiB:   uint32(getEnvInt("PASSWORD_ARGON2_MEMORY_KIB")),
+			Argon2Iterations:  uint32(getEnvInt("PASSWORD_ARGON2_ITERATIONS")),
+			Argon2Parallelism: uint8(getEnvInt("PASSWORD_ARGON2_PARALLELISM")),
+		},
+		Mail: config.MailConfig{
+			SmtpHost:        os.Getenv("MAIL_SMTP_HOST"),
+			SmtpPort:        os.Getenv("MAIL_SMTP_PORT"),
+			SmtpUsername:    os.Getenv("MAIL_SMTP_USERNAME"),
+			SmtpPassword:    os.Getenv("MAIL_SMTP_PASSWORD"),
+			From:            os.Getenv("MAIL_FROM"),
+			OutboxDirectory: os.Getenv("MAIL_OUTBOX_DIR"),
+		},
+		Geocoding: config.GeocodingConfig{
+			GazetteerPath: os.Getenv("GEOCODER_GAZETTEER_PATH"),
+			HttpEndpoint:  os.Getenv("GEOCODER_HTTP_ENDPOINT"),
+			HttpApiKey:    os.Getenv("GEOCODER_HTTP_API_KEY"),
+			HttpUserAgent: os.Getenv("GEOCODER_HTTP_USER_AGENT"),
+		},
+		Photos: config.PhotoConfig{
+			StoragePath:     os.Getenv("PHOTO_STORAGE_PATH"),
+			MaxUploadBytes:  getEnvInt("PHOTO_MAX_UPLOAD_BYTES"),
+			MaxPhotosPerDog: getEnvInt("PHOTO_MAX_PER_DOG"),
+		},
+		Notifications: config.NotificationsConfig{
+			MaxPerUser:    getEnvInt("NOTIFICATIONS_MAX_PER_USER"),
+			RetentionDays: getEnvInt("NOTIFICATIONS_RETENTION_DAYS"),
+		},
+		Scheduler: config.SchedulerConfig{
+			Disabled:                  getEnvBool("SCHEDULER_DISABLED"),
+			VaccinationDueCron:        os.Getenv("SCHEDULER_VACCINATION_DUE_CRON"),
+			VaccinationLeadDays:       getEnvInt("SCHEDULER_VACCINATION_LEAD_DAYS"),
+			FollowupCron:              os.Getenv("SCHEDULER_FOLLOWUP_CRON"),
+			FollowupDays:              getEnvIntList("SCHEDULER_FOLLOWUP_DAYS"),
+			SavedSearchDigestCron:     os.Getenv("SCHEDULER_SAVED_SEARCH_DIGEST_CRON"),
+			NotificationRetentionCron: os.Getenv("SCHEDULER_NOTIFICATION_RETENTION_CRON"),
+		},
+		PasswordPolicy: service.PasswordPolicyConfig{
+			MinLength:        getEnvInt("PASSWORD_MIN_LENGTH"),
+			MaxLength:        getEnvInt("PASSWORD_MAX_LENGTH"),
+			RequireUppercase: getEnvBool("PASSWORD_REQUIRE_UPPERCASE"),
+			RequireLowercase: getEnvBool("PASSWORD_REQUIRE_LOWERCASE"),
+			RequireDigit:     getEnvBool("PASSWORD_REQUIRE_DIGIT"),
+			RequireSymbol:    getEnvBool("PASSWORD_REQUIRE_SYMBOL"),
+		},
 	}
 
 	container := config.SetupContainer(containerConfig)
 
+	if !containerConfig.Scheduler.Disabled {
+		jobScheduler := container.Resolve("Scheduler", config.Singleton).(*scheduler.Scheduler)
+		jobScheduler.Start(context)
+	}
+
 	router := router.NewRouter(container)
 	router.StartRouter()
 }
+
+// getEnvInt returns 0 for unset variables, so that the default is used.
+func getEnvInt(name string) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return 0
+	}
+	intValue, err := strconv.Atoi(value)
+	if err != nil || intValue < 0 {
+		log.Fatalf("Environment variable %s must be a positive number", name)
+	}
+	return intValue
+}
+
+// getEnvIntList reads a comma separated list of positive numbers, nil for unset variables.
+func getEnvIntList(name string) []int {
+	value := os.Getenv(name)
+	if value == "" {
+		return nil
+	}
+	var intValues []int
+	for _, part := range strings.Split(value, ",") {
+		intValue, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || intValue <= 0 {
+			log.Fatalf("Environment variable %s must be a comma separated list of positive numbers", name)
+		}
+		intValues = append(intValues, intValue)
+	}
+	return intValues
+}
+
+func getEnvBool(name string) bool {
+	value := os.Getenv(name)
+	if value == "" {
+		return false
+	}
+	boolValue, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Fatalf("Environment variable %s must be true or false", name)
+	}
+	return boolValue
+}
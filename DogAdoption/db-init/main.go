@@ -39,19 +39,62 @@ func main() {
 		os.Exit(1)
 	}
 
+	err = db.CreateShelterOpeningHoursSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.CreateBreedsSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
 	err = db.CreateDogsSchema(conn)
 	if err != nil {
 		fmt.Fprint(os.Stderr, err.Error())
 		os.Exit(1)
 	}
 
+	err = db.CreateDogTransfersSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.CreateDogPhotosSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.CreateDogMedicalRecordsSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.CreateSchedulerSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
 	shelterQuery := `
 	INSERT INTO DogShelters (
 		name, website, country, city, address, username, password
 	) VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
 
-	cryptoService, err := service.NewCryptographyService(os.Getenv("CRYPTO_KEY"))
+	// Seeded passwords use the default hashing, and are rehashed on login if the application is configured stronger.
+	passwordHasher, err := service.NewPasswordHasher(service.PasswordHashingConfig{})
+	if err != nil {
+		fmt.Fprint(os.Stderr, "Failed to create password hasher")
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+	cryptoService, err := service.NewCryptographyService(os.Getenv("CRYPTO_KEY"), passwordHasher)
 	if err != nil {
 		fmt.Fprint(os.Stderr, "Failed to create cryptography service")
 		fmt.Fprint(os.Stderr, err.Error())
@@ -103,9 +146,13 @@ func main() {
 		dog := data.GenerateDog(1)
 		dogQuery := `
 		INSERT INTO Dogs (
-			name, description, birth_date, breed, is_neutered, shelter_id, image_url, adoption_fee, is_adopted, friendly_with, gender
+			name, description, birth_date, breed, is_neutered, shelter_id, image_url, adoption_fee, status, friendly_with, gender
 		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		`
+		status := "available"
+		if dog.IsAdopted {
+			status = "adopted"
+		}
 		_, err = conn.Exec(ctx, dogQuery,
 			dog.Name,
 			dog.Description,
@@ -115,7 +162,7 @@ func main() {
 			dog.ShelterID,
 			dog.ImageURL,
 			dog.AdoptionFee,
-			dog.IsAdopted,
+			status,
 			dog.FriendlyWith,
 			dog.Gender,
 		)
@@ -126,6 +173,18 @@ func main() {
 		}
 	}
 
+	err = db.NormalizeDogBreeds(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.NormalizeDogCompatibility(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
 	err = db.CreateAdminsSchema(conn)
 	if err != nil {
 		fmt.Fprint(os.Stderr, "Failed to create admin table")
@@ -161,7 +220,8 @@ func main() {
 		os.Exit(1)
 	}
 
-	_, err = conn.Exec(ctx, `INSERT INTO Users (username, password) VALUES ($1, $2)`, "testuser", testuserPassword)
+	userQuery := `INSERT INTO Users (username, password, email, email_status) VALUES ($1, $2, $3, 'verified')`
+	_, err = conn.Exec(ctx, userQuery, "testuser", testuserPassword, "testuser@example.com")
 	if err != nil {
 		fmt.Fprint(os.Stderr, "Failed to add testuser to table")
 		fmt.Fprint(os.Stderr, err.Error())
@@ -175,7 +235,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	_, err = conn.Exec(ctx, `INSERT INTO Users (username, password) VALUES ($1, $2)`, "testuser2", testuser2Password)
+	_, err = conn.Exec(ctx, userQuery, "testuser2", testuser2Password, "testuser2@example.com")
 	if err != nil {
 		fmt.Fprint(os.Stderr, "Failed to add testuser2 to table")
 		fmt.Fprint(os.Stderr, err.Error())
@@ -204,4 +264,102 @@ func main() {
 		fmt.Fprint(os.Stderr, err.Error())
 		os.Exit(1)
 	}
+
+	err = db.CreateUserFavoritesSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, "Failed to create user favorites table")
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.CreateSavedSearchesSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, "Failed to create saved searches tables")
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.CreateUserNotificationsSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, "Failed to create user notifications table")
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.CreateMfaEnrollmentsSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, "Failed to create mfa enrollments table")
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.CreateMfaPoliciesSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, "Failed to create mfa policies table")
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.CreateUserApiKeysSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, "Failed to create user api keys table")
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.CreateOAuthClientsSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, "Failed to create oauth clients table")
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.CreateOAuthConsentsSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, "Failed to create oauth consents table")
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.CreateOAuthAuthorizationCodesSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, "Failed to create oauth authorization codes table")
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.CreateOAuthRefreshTokensSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, "Failed to create oauth refresh tokens table")
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.CreatePasswordResetTokensSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, "Failed to create password reset tokens table")
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.CreateEmailVerificationTokensSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, "Failed to create email verification tokens table")
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.CreateShelterStaffSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, "Failed to create shelter staff table")
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+
+	err = db.CreateShelterStaffInvitationsSchema(conn)
+	if err != nil {
+		fmt.Fprint(os.Stderr, "Failed to create shelter staff invitations table")
+		fmt.Fprint(os.Stderr, err.Error())
+		os.Exit(1)
+	}
 }
@@ -20,6 +20,20 @@ func CreateDogSheltersSchema(conn *pgx.Conn) error {
 		username TEXT NOT NULL,
 		password TEXT NOT NULL
 	);
+	ALTER TABLE DogShelters ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'approved';
+	ALTER TABLE DogShelters ADD COLUMN IF NOT EXISTS contact_email TEXT;
+	ALTER TABLE DogShelters ADD COLUMN IF NOT EXISTS registered_at TIMESTAMPTZ NOT NULL DEFAULT now();
+	ALTER TABLE DogShelters ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMPTZ;
+	ALTER TABLE DogShelters ADD COLUMN IF NOT EXISTS review_reason TEXT;
+	CREATE INDEX IF NOT EXISTS dogshelters_status_idx ON DogShelters (status);
+	CREATE UNIQUE INDEX IF NOT EXISTS dogshelters_username_idx ON DogShelters (username);
+	ALTER TABLE DogShelters ADD COLUMN IF NOT EXISTS phone TEXT;
+	ALTER TABLE DogShelters ADD COLUMN IF NOT EXISTS email TEXT;
+	ALTER TABLE DogShelters ADD COLUMN IF NOT EXISTS capacity INTEGER CHECK (capacity >= 0);
+	ALTER TABLE DogShelters ADD COLUMN IF NOT EXISTS timezone TEXT NOT NULL DEFAULT 'UTC';
+	ALTER TABLE DogShelters ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90);
+	ALTER TABLE DogShelters ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180);
+	ALTER TABLE DogShelters ADD COLUMN IF NOT EXISTS geocode_accuracy TEXT;
 	`
 
 	_, err := conn.Exec(ctx, query)
@@ -29,6 +43,180 @@ func CreateDogSheltersSchema(conn *pgx.Conn) error {
 	return nil
 }
 
+// CreateShelterOpeningHoursSchema creates the weekly opening hours of the shelters and the
+// exceptions to them. Times are in the local time of the shelter.
+func CreateShelterOpeningHoursSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS ShelterOpeningHours (
+		id SERIAL PRIMARY KEY,
+		shelter_id INTEGER NOT NULL,
+		weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+		opens_at TIME NOT NULL,
+		closes_at TIME NOT NULL CHECK (closes_at > opens_at),
+		FOREIGN KEY (shelter_id) REFERENCES DogShelters(id) ON DELETE CASCADE
+	);
+	CREATE INDEX IF NOT EXISTS shelteropeninghours_shelter_idx ON ShelterOpeningHours (shelter_id, weekday);
+	CREATE TABLE IF NOT EXISTS ShelterOpeningHoursExceptions (
+		id SERIAL PRIMARY KEY,
+		shelter_id INTEGER NOT NULL,
+		date DATE NOT NULL,
+		opens_at TIME,
+		closes_at TIME,
+		note TEXT,
+		CHECK ((opens_at IS NULL AND closes_at IS NULL) OR closes_at > opens_at),
+		UNIQUE (shelter_id, date),
+		FOREIGN KEY (shelter_id) REFERENCES DogShelters(id) ON DELETE CASCADE
+	);
+	`
+
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating ShelterOpeningHours schema: %v", err)
+	}
+	return nil
+}
+
+// CreateBreedsSchema creates the breed taxonomy that dogs reference. Aliases and names are unique
+// regardless of case, so that a breed written in any form resolves to one canonical breed.
+func CreateBreedsSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS Breeds (
+		id SERIAL PRIMARY KEY,
+		name VARCHAR(64) NOT NULL,
+		size_group TEXT CHECK (size_group IN ('toy', 'small', 'medium', 'large', 'giant')),
+		fci_group INTEGER CHECK (fci_group BETWEEN 1 AND 10),
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	);
+	CREATE UNIQUE INDEX IF NOT EXISTS breeds_name_idx ON Breeds (lower(name));
+
+	CREATE TABLE IF NOT EXISTS BreedAliases (
+		id SERIAL PRIMARY KEY,
+		breed_id INTEGER NOT NULL,
+		alias VARCHAR(64) NOT NULL,
+		FOREIGN KEY (breed_id) REFERENCES Breeds(id) ON DELETE CASCADE
+	);
+	CREATE UNIQUE INDEX IF NOT EXISTS breed_aliases_alias_idx ON BreedAliases (lower(alias));
+	CREATE INDEX IF NOT EXISTS breed_aliases_breed_idx ON BreedAliases (breed_id);
+
+	INSERT INTO Breeds (name, size_group, fci_group) VALUES
+		('Labrador Retriever', 'large', 8),
+		('Golden Retriever', 'large', 8),
+		('German Shepherd', 'large', 1),
+		('Beagle', 'medium', 6),
+		('Bulldog', 'medium', 2),
+		('French Bulldog', 'small', 9),
+		('Poodle', 'medium', 9),
+		('Finnish Lapphund', 'medium', 5),
+		('Border Collie', 'medium', 1),
+		('Dachshund', 'small', 4),
+		('Chihuahua', 'toy', 9),
+		('Siberian Husky', 'medium', 5),
+		('Rottweiler', 'large', 2),
+		('Jack Russell Terrier', 'small', 3),
+		('Mixed Breed', NULL, NULL)
+	ON CONFLICT DO NOTHING;
+
+	INSERT INTO BreedAliases (breed_id, alias)
+	SELECT Breeds.id, aliases.alias
+	FROM (VALUES
+		('Labrador Retriever', 'Labrador'),
+		('Labrador Retriever', 'Lab'),
+		('Golden Retriever', 'Golden'),
+		('German Shepherd', 'German Shepherd Dog'),
+		('German Shepherd', 'Shepherd'),
+		('German Shepherd', 'Alsatian'),
+		('Bulldog', 'English Bulldog'),
+		('Bulldog', 'British Bulldog'),
+		('French Bulldog', 'Frenchie'),
+		('Poodle', 'Standard Poodle'),
+		('Poodle', 'Miniature Poodle'),
+		('Poodle', 'Toy Poodle'),
+		('Finnish Lapphund', 'Lapphund'),
+		('Dachshund', 'Teckel'),
+		('Siberian Husky', 'Husky'),
+		('Jack Russell Terrier', 'Jack Russell'),
+		('Mixed Breed', 'Mixed'),
+		('Mixed Breed', 'Mongrel'),
+		('Mixed Breed', 'Mutt')
+	) AS aliases (breed, alias)
+	JOIN Breeds ON lower(Breeds.name) = lower(aliases.breed)
+	ON CONFLICT DO NOTHING;
+	`
+
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating Breed schema: %v", err)
+	}
+	return nil
+}
+
+// NormalizeDogBreeds links dogs to the breed taxonomy through their free text breed, and rewrites it
+// to the canonical name. Breeds that match neither a name nor an alias are added to the taxonomy.
+func NormalizeDogBreeds(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	INSERT INTO Breeds (name)
+	SELECT DISTINCT ON (lower(btrim(breed))) left(btrim(breed), 64)
+	FROM Dogs
+	WHERE primary_breed_id IS NULL AND btrim(breed) <> ''
+		AND NOT EXISTS (SELECT 1 FROM Breeds WHERE lower(Breeds.name) = lower(btrim(Dogs.breed)))
+		AND NOT EXISTS (SELECT 1 FROM BreedAliases WHERE lower(BreedAliases.alias) = lower(btrim(Dogs.breed)))
+	ON CONFLICT DO NOTHING;
+
+	UPDATE Dogs SET primary_breed_id = matches.breed_id
+	FROM (
+		SELECT id AS breed_id, lower(name) AS match FROM Breeds
+		UNION
+		SELECT breed_id, lower(alias) AS match FROM BreedAliases
+	) AS matches
+	WHERE Dogs.primary_breed_id IS NULL AND matches.match = lower(btrim(Dogs.breed));
+
+	UPDATE Dogs SET breed = Breeds.name
+	FROM Breeds
+	WHERE Dogs.primary_breed_id = Breeds.id AND Dogs.breed <> Breeds.name;
+	`
+
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error normalizing dog breeds: %v", err)
+	}
+	return nil
+}
+
+// NormalizeDogCompatibility derives the compatibility flags of dogs from their free text friendly_with,
+// and their size from the size of their primary breed. Only dogs without any known flag or size are
+// changed, so flags set by shelters are kept.
+func NormalizeDogCompatibility(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	UPDATE Dogs SET
+		good_with_children = CASE WHEN lower(btrim(friendly_with)) = 'none' THEN 'no'
+			WHEN friendly_with ~* '(child|kid)' THEN 'yes' ELSE 'unknown' END,
+		good_with_dogs = CASE WHEN lower(btrim(friendly_with)) = 'none' THEN 'no'
+			WHEN friendly_with ~* 'dog' THEN 'yes' ELSE 'unknown' END,
+		good_with_cats = CASE WHEN lower(btrim(friendly_with)) = 'none' THEN 'no'
+			WHEN friendly_with ~* 'cat' THEN 'yes' ELSE 'unknown' END,
+		good_with_small_animals = CASE WHEN lower(btrim(friendly_with)) = 'none' THEN 'no'
+			WHEN friendly_with ~* '(small animal|rabbit|hamster|guinea pig)' THEN 'yes' ELSE 'unknown' END
+	WHERE good_with_children = 'unknown' AND good_with_dogs = 'unknown' AND good_with_cats = 'unknown'
+		AND good_with_small_animals = 'unknown' AND btrim(friendly_with) <> '';
+
+	UPDATE Dogs SET size = Breeds.size_group
+	FROM Breeds
+	WHERE Dogs.size IS NULL AND Dogs.primary_breed_id = Breeds.id AND Breeds.size_group IS NOT NULL;
+	`
+
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error normalizing dog compatibility: %v", err)
+	}
+	return nil
+}
+
+// CreateDogsSchema creates the dogs and their status history. Dogs created before the status
+// lifecycle get their status from the is_adopted column, which is then dropped.
 func CreateDogsSchema(conn *pgx.Conn) error {
 	ctx := context.Background()
 	query := `
@@ -42,11 +230,58 @@ func CreateDogsSchema(conn *pgx.Conn) error {
 		shelter_id INTEGER NOT NULL,
 		image_url TEXT,
 		adoption_fee INTEGER NOT NULL,
-		is_adopted BOOLEAN NOT NULL,
-		friendly_with TEXT,
+		friendly_with TEXT NOT NULL DEFAULT '',
 		gender TEXT NOT NULL CHECK (gender IN ('male', 'female')),
+		status TEXT NOT NULL DEFAULT 'available'
+			CHECK (status IN ('available', 'reserved', 'on_hold', 'in_foster', 'adopted', 'returned', 'deceased')),
+		status_changed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		primary_breed_id INTEGER REFERENCES Breeds(id),
+		secondary_breed_id INTEGER REFERENCES Breeds(id),
+		good_with_children TEXT NOT NULL DEFAULT 'unknown' CHECK (good_with_children IN ('yes', 'no', 'unknown')),
+		good_with_dogs TEXT NOT NULL DEFAULT 'unknown' CHECK (good_with_dogs IN ('yes', 'no', 'unknown')),
+		good_with_cats TEXT NOT NULL DEFAULT 'unknown' CHECK (good_with_cats IN ('yes', 'no', 'unknown')),
+		good_with_small_animals TEXT NOT NULL DEFAULT 'unknown' CHECK (good_with_small_animals IN ('yes', 'no', 'unknown')),
+		energy_level TEXT CHECK (energy_level IN ('low', 'medium', 'high')),
+		size TEXT CHECK (size IN ('toy', 'small', 'medium', 'large', 'giant')),
 		FOREIGN KEY (shelter_id) REFERENCES DogShelters(id) ON DELETE CASCADE
 	);
+	ALTER TABLE Dogs ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'available'
+		CHECK (status IN ('available', 'reserved', 'on_hold', 'in_foster', 'adopted', 'returned', 'deceased'));
+	ALTER TABLE Dogs ADD COLUMN IF NOT EXISTS status_changed_at TIMESTAMPTZ NOT NULL DEFAULT now();
+	DO $$
+	BEGIN
+		IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'dogs' AND column_name = 'is_adopted') THEN
+			UPDATE Dogs SET status = 'adopted' WHERE is_adopted;
+			ALTER TABLE Dogs DROP COLUMN is_adopted;
+		END IF;
+	END $$;
+	CREATE INDEX IF NOT EXISTS dogs_status_idx ON Dogs (status);
+	ALTER TABLE Dogs ADD COLUMN IF NOT EXISTS primary_breed_id INTEGER REFERENCES Breeds(id);
+	ALTER TABLE Dogs ADD COLUMN IF NOT EXISTS secondary_breed_id INTEGER REFERENCES Breeds(id);
+	CREATE INDEX IF NOT EXISTS dogs_primary_breed_idx ON Dogs (primary_breed_id);
+	CREATE INDEX IF NOT EXISTS dogs_secondary_breed_idx ON Dogs (secondary_breed_id);
+	ALTER TABLE Dogs ADD COLUMN IF NOT EXISTS good_with_children TEXT NOT NULL DEFAULT 'unknown'
+		CHECK (good_with_children IN ('yes', 'no', 'unknown'));
+	ALTER TABLE Dogs ADD COLUMN IF NOT EXISTS good_with_dogs TEXT NOT NULL DEFAULT 'unknown'
+		CHECK (good_with_dogs IN ('yes', 'no', 'unknown'));
+	ALTER TABLE Dogs ADD COLUMN IF NOT EXISTS good_with_cats TEXT NOT NULL DEFAULT 'unknown'
+		CHECK (good_with_cats IN ('yes', 'no', 'unknown'));
+	ALTER TABLE Dogs ADD COLUMN IF NOT EXISTS good_with_small_animals TEXT NOT NULL DEFAULT 'unknown'
+		CHECK (good_with_small_animals IN ('yes', 'no', 'unknown'));
+	ALTER TABLE Dogs ADD COLUMN IF NOT EXISTS energy_level TEXT CHECK (energy_level IN ('low', 'medium', 'high'));
+	ALTER TABLE Dogs ADD COLUMN IF NOT EXISTS size TEXT CHECK (size IN ('toy', 'small', 'medium', 'large', 'giant'));
+	UPDATE Dogs SET friendly_with = '' WHERE friendly_with IS NULL;
+
+	CREATE TABLE IF NOT EXISTS DogStatusHistory (
+		id SERIAL PRIMARY KEY,
+		dog_id INTEGER NOT NULL,
+		from_status TEXT,
+		to_status TEXT NOT NULL,
+		note TEXT,
+		changed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		FOREIGN KEY (dog_id) REFERENCES Dogs(id) ON DELETE CASCADE
+	);
+	CREATE INDEX IF NOT EXISTS dog_status_history_dog_idx ON DogStatusHistory (dog_id, changed_at);
 	`
 
 	_, err := conn.Exec(ctx, query)
@@ -56,6 +291,125 @@ func CreateDogsSchema(conn *pgx.Conn) error {
 	return nil
 }
 
+// CreateDogPhotosSchema creates the photo galleries of dogs. The files are kept in the blob store,
+// and a dog has at most one primary photo.
+func CreateDogPhotosSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS DogPhotos (
+		id SERIAL PRIMARY KEY,
+		dog_id INTEGER NOT NULL,
+		position INTEGER NOT NULL CHECK (position > 0),
+		is_primary BOOLEAN NOT NULL DEFAULT false,
+		content_type TEXT NOT NULL,
+		width INTEGER NOT NULL,
+		height INTEGER NOT NULL,
+		size_bytes INTEGER NOT NULL,
+		storage_key TEXT NOT NULL UNIQUE,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		FOREIGN KEY (dog_id) REFERENCES Dogs(id) ON DELETE CASCADE
+	);
+	CREATE INDEX IF NOT EXISTS dog_photos_dog_idx ON DogPhotos (dog_id, position);
+	CREATE UNIQUE INDEX IF NOT EXISTS dog_photos_primary_idx ON DogPhotos (dog_id) WHERE is_primary;
+	`
+
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating DogPhotos schema: %v", err)
+	}
+	return nil
+}
+
+// CreateDogMedicalRecordsSchema creates the medical history of dogs. Only vaccinations can have a
+// next due date, and it cannot be before the vaccination.
+func CreateDogMedicalRecordsSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS DogMedicalRecords (
+		id SERIAL PRIMARY KEY,
+		dog_id INTEGER NOT NULL,
+		record_type TEXT NOT NULL CHECK (record_type IN ('vaccination', 'treatment', 'note')),
+		title VARCHAR(100) NOT NULL,
+		record_date DATE NOT NULL,
+		next_due_on DATE,
+		notes TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		FOREIGN KEY (dog_id) REFERENCES Dogs(id) ON DELETE CASCADE,
+		CHECK (next_due_on IS NULL OR (record_type = 'vaccination' AND next_due_on >= record_date))
+	);
+	CREATE INDEX IF NOT EXISTS dog_medical_records_dog_idx ON DogMedicalRecords (dog_id, record_date);
+	CREATE INDEX IF NOT EXISTS dog_medical_records_vaccination_idx ON DogMedicalRecords (dog_id, next_due_on)
+		WHERE record_type = 'vaccination';
+	`
+
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating DogMedicalRecords schema: %v", err)
+	}
+	return nil
+}
+
+// CreateSchedulerSchema creates the persisted state of the background jobs, and the reminders that
+// were sent so that each reminder is only sent once.
+func CreateSchedulerSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS ScheduledJobs (
+		name TEXT PRIMARY KEY,
+		schedule TEXT NOT NULL,
+		next_run_at TIMESTAMPTZ NOT NULL,
+		last_started_at TIMESTAMPTZ,
+		last_finished_at TIMESTAMPTZ,
+		last_error TEXT
+	);
+	CREATE TABLE IF NOT EXISTS SentReminders (
+		id SERIAL PRIMARY KEY,
+		kind TEXT NOT NULL,
+		reference_id INTEGER NOT NULL,
+		due_on DATE NOT NULL,
+		sent_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		UNIQUE (kind, reference_id, due_on)
+	);
+	`
+
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating scheduler schema: %v", err)
+	}
+	return nil
+}
+
+// CreateDogTransfersSchema creates the transfers of dogs between shelters. A dog can only have one
+// pending transfer at a time.
+func CreateDogTransfersSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS DogTransfers (
+		id SERIAL PRIMARY KEY,
+		dog_id INTEGER NOT NULL,
+		from_shelter_id INTEGER NOT NULL,
+		to_shelter_id INTEGER NOT NULL CHECK (to_shelter_id <> from_shelter_id),
+		status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'declined', 'cancelled')),
+		note TEXT,
+		decision_reason TEXT,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		decided_at TIMESTAMPTZ,
+		FOREIGN KEY (dog_id) REFERENCES Dogs(id) ON DELETE CASCADE,
+		FOREIGN KEY (from_shelter_id) REFERENCES DogShelters(id) ON DELETE CASCADE,
+		FOREIGN KEY (to_shelter_id) REFERENCES DogShelters(id) ON DELETE CASCADE
+	);
+	CREATE INDEX IF NOT EXISTS dogtransfers_dog_idx ON DogTransfers (dog_id, created_at);
+	CREATE UNIQUE INDEX IF NOT EXISTS dogtransfers_pending_idx ON DogTransfers (dog_id) WHERE status = 'pending';
+	`
+
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating DogTransfers schema: %v", err)
+	}
+	return nil
+}
+
 func CreateAdminsSchema(conn *pgx.Conn) error {
 	ctx := context.Background()
 	query := `
@@ -63,7 +417,8 @@ func CreateAdminsSchema(conn *pgx.Conn) error {
 		id SERIAL PRIMARY KEY,
 		username TEXT NOT NULL,
 		password TEXT NOT NULL
-	)
+	);
+	CREATE UNIQUE INDEX IF NOT EXISTS admins_username_idx ON Admins (username);
 	`
 	_, err := conn.Exec(ctx, query)
 	if err != nil {
@@ -78,8 +433,34 @@ func CreateUsersSchema(conn *pgx.Conn) error {
 	CREATE TABLE IF NOT EXISTS Users (
 		id SERIAL PRIMARY KEY,
 		username TEXT UNIQUE NOT NULL,
-		password TEXT NOT NULL
+		password TEXT NOT NULL,
+		email TEXT UNIQUE,
+		email_status TEXT NOT NULL DEFAULT 'pending',
+		full_name TEXT,
+		phone TEXT,
+		city TEXT,
+		country TEXT,
+		has_children BOOLEAN,
+		has_other_pets BOOLEAN,
+		has_garden BOOLEAN,
+		preferences JSONB NOT NULL DEFAULT '{}',
+		status TEXT NOT NULL DEFAULT 'active',
+		suspended_at TIMESTAMPTZ,
+		deleted_at TIMESTAMPTZ
 	);
+	ALTER TABLE Users ADD COLUMN IF NOT EXISTS email TEXT UNIQUE;
+	ALTER TABLE Users ADD COLUMN IF NOT EXISTS email_status TEXT NOT NULL DEFAULT 'pending';
+	ALTER TABLE Users ADD COLUMN IF NOT EXISTS full_name TEXT;
+	ALTER TABLE Users ADD COLUMN IF NOT EXISTS phone TEXT;
+	ALTER TABLE Users ADD COLUMN IF NOT EXISTS city TEXT;
+	ALTER TABLE Users ADD COLUMN IF NOT EXISTS country TEXT;
+	ALTER TABLE Users ADD COLUMN IF NOT EXISTS has_children BOOLEAN;
+	ALTER TABLE Users ADD COLUMN IF NOT EXISTS has_other_pets BOOLEAN;
+	ALTER TABLE Users ADD COLUMN IF NOT EXISTS has_garden BOOLEAN;
+	ALTER TABLE Users ADD COLUMN IF NOT EXISTS preferences JSONB NOT NULL DEFAULT '{}';
+	ALTER TABLE Users ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'active';
+	ALTER TABLE Users ADD COLUMN IF NOT EXISTS suspended_at TIMESTAMPTZ;
+	ALTER TABLE Users ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
 	`
 	_, err := conn.Exec(ctx, query)
 	if err != nil {
@@ -107,3 +488,321 @@ func CreateUserWebhooksSchema(conn *pgx.Conn) error {
 	}
 	return nil
 }
+
+// CreateUserFavoritesSchema creates the dogs that users have saved to their favorites. A dog can only
+// be a favorite of a user once, and favorites are removed together with the dog.
+func CreateUserFavoritesSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS UserFavorites (
+		user_id INTEGER NOT NULL,
+		dog_id INTEGER NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		PRIMARY KEY (user_id, dog_id),
+		FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE,
+		FOREIGN KEY (dog_id) REFERENCES Dogs(id) ON DELETE CASCADE
+	);
+	CREATE INDEX IF NOT EXISTS user_favorites_dog_idx ON UserFavorites (dog_id);
+	`
+
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating UserFavorites schema: %v", err)
+	}
+	return nil
+}
+
+// CreateSavedSearchesSchema creates the searches that users have saved, and the dogs that matched them.
+// A dog matches a saved search at most once, and matches that are waiting for the daily digest have no
+// notified_at.
+func CreateSavedSearchesSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS SavedSearches (
+		id SERIAL PRIMARY KEY,
+		user_id INTEGER NOT NULL,
+		name TEXT NOT NULL,
+		query TEXT NOT NULL,
+		channel TEXT NOT NULL CHECK (channel IN ('webhook', 'inbox')),
+		frequency TEXT NOT NULL CHECK (frequency IN ('instant', 'daily')),
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE
+	);
+	CREATE INDEX IF NOT EXISTS saved_searches_user_idx ON SavedSearches (user_id);
+	CREATE TABLE IF NOT EXISTS SavedSearchMatches (
+		saved_search_id INTEGER NOT NULL,
+		dog_id INTEGER NOT NULL,
+		matched_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		notified_at TIMESTAMPTZ,
+		PRIMARY KEY (saved_search_id, dog_id),
+		FOREIGN KEY (saved_search_id) REFERENCES SavedSearches(id) ON DELETE CASCADE,
+		FOREIGN KEY (dog_id) REFERENCES Dogs(id) ON DELETE CASCADE
+	);
+	CREATE INDEX IF NOT EXISTS saved_search_matches_pending_idx ON SavedSearchMatches (saved_search_id)
+		WHERE notified_at IS NULL;
+	`
+
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating SavedSearches schema: %v", err)
+	}
+	return nil
+}
+
+// CreateUserNotificationsSchema creates the in-app inbox of users. The data is the payload of the event that
+// the notification was made from.
+func CreateUserNotificationsSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS UserNotifications (
+		id SERIAL PRIMARY KEY,
+		user_id INTEGER NOT NULL,
+		event TEXT NOT NULL,
+		title TEXT NOT NULL,
+		body TEXT NOT NULL,
+		data JSONB NOT NULL DEFAULT '{}',
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		read_at TIMESTAMPTZ,
+		FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE
+	);
+	CREATE INDEX IF NOT EXISTS user_notifications_user_idx ON UserNotifications (user_id, created_at DESC, id DESC);
+	CREATE INDEX IF NOT EXISTS user_notifications_unread_idx ON UserNotifications (user_id) WHERE read_at IS NULL;
+	`
+
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating UserNotifications schema: %v", err)
+	}
+	return nil
+}
+
+func CreateMfaEnrollmentsSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS MfaEnrollments (
+		id SERIAL PRIMARY KEY,
+		account_id INTEGER NOT NULL,
+		account_role TEXT NOT NULL,
+		secret TEXT NOT NULL,
+		is_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+		recovery_codes TEXT[] NOT NULL,
+		last_used_step BIGINT NOT NULL DEFAULT 0,
+		UNIQUE (account_id, account_role)
+	);
+	`
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating MfaEnrollments schema: %v", err)
+	}
+	return nil
+}
+
+func CreateMfaPoliciesSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS MfaPolicies (
+		role TEXT PRIMARY KEY,
+		is_required BOOLEAN NOT NULL DEFAULT FALSE
+	);
+	`
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating MfaPolicies schema: %v", err)
+	}
+	return nil
+}
+
+func CreateUserApiKeysSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS UserApiKeys (
+		id SERIAL PRIMARY KEY,
+		user_id INTEGER NOT NULL,
+		name TEXT NOT NULL,
+		key_prefix TEXT NOT NULL,
+		key_hash TEXT UNIQUE NOT NULL,
+		scopes TEXT[] NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+		last_used_at TIMESTAMPTZ,
+		revoked_at TIMESTAMPTZ,
+		FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE
+	);
+	`
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating UserApiKeys schema: %v", err)
+	}
+	return nil
+}
+
+func CreateOAuthClientsSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS OAuthClients (
+		id SERIAL PRIMARY KEY,
+		client_id TEXT UNIQUE NOT NULL,
+		client_secret_hash TEXT NOT NULL DEFAULT '',
+		name TEXT NOT NULL,
+		redirect_uris TEXT[] NOT NULL,
+		scopes TEXT[] NOT NULL,
+		grant_types TEXT[] NOT NULL,
+		is_confidential BOOLEAN NOT NULL,
+		owner_user_id INTEGER,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+		FOREIGN KEY (owner_user_id) REFERENCES Users(id) ON DELETE SET NULL
+	);
+	`
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating OAuthClients schema: %v", err)
+	}
+	return nil
+}
+
+func CreateOAuthConsentsSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS OAuthConsents (
+		id SERIAL PRIMARY KEY,
+		user_id INTEGER NOT NULL,
+		client_id TEXT NOT NULL,
+		scopes TEXT[] NOT NULL,
+		granted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+		UNIQUE (user_id, client_id),
+		FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE,
+		FOREIGN KEY (client_id) REFERENCES OAuthClients(client_id) ON DELETE CASCADE
+	);
+	`
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating OAuthConsents schema: %v", err)
+	}
+	return nil
+}
+
+func CreateOAuthAuthorizationCodesSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS OAuthAuthorizationCodes (
+		code_hash TEXT PRIMARY KEY,
+		client_id TEXT NOT NULL,
+		user_id INTEGER NOT NULL,
+		redirect_uri TEXT NOT NULL,
+		scopes TEXT[] NOT NULL,
+		code_challenge TEXT NOT NULL,
+		expires_at TIMESTAMPTZ NOT NULL,
+		is_used BOOLEAN NOT NULL DEFAULT FALSE,
+		FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE,
+		FOREIGN KEY (client_id) REFERENCES OAuthClients(client_id) ON DELETE CASCADE
+	);
+	ALTER TABLE OAuthAuthorizationCodes ADD COLUMN IF NOT EXISTS redirect_uri_supplied BOOLEAN NOT NULL DEFAULT TRUE;
+	`
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating OAuthAuthorizationCodes schema: %v", err)
+	}
+	return nil
+}
+
+func CreateOAuthRefreshTokensSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS OAuthRefreshTokens (
+		token_hash TEXT PRIMARY KEY,
+		client_id TEXT NOT NULL,
+		user_id INTEGER NOT NULL,
+		scopes TEXT[] NOT NULL,
+		expires_at TIMESTAMPTZ NOT NULL,
+		revoked_at TIMESTAMPTZ,
+		FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE,
+		FOREIGN KEY (client_id) REFERENCES OAuthClients(client_id) ON DELETE CASCADE
+	);
+	`
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating OAuthRefreshTokens schema: %v", err)
+	}
+	return nil
+}
+
+func CreatePasswordResetTokensSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS PasswordResetTokens (
+		token_hash TEXT PRIMARY KEY,
+		account_id INTEGER NOT NULL,
+		account_role TEXT NOT NULL,
+		expires_at TIMESTAMPTZ NOT NULL,
+		used_at TIMESTAMPTZ
+	);
+	`
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating PasswordResetTokens schema: %v", err)
+	}
+	return nil
+}
+
+func CreateEmailVerificationTokensSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS EmailVerificationTokens (
+		token_hash TEXT PRIMARY KEY,
+		user_id INTEGER NOT NULL,
+		email TEXT NOT NULL,
+		expires_at TIMESTAMPTZ NOT NULL,
+		used_at TIMESTAMPTZ,
+		FOREIGN KEY (user_id) REFERENCES Users(id) ON DELETE CASCADE
+	);
+	`
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating EmailVerificationTokens schema: %v", err)
+	}
+	return nil
+}
+
+func CreateShelterStaffSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS ShelterStaff (
+		id SERIAL PRIMARY KEY,
+		shelter_id INTEGER NOT NULL,
+		username TEXT NOT NULL UNIQUE,
+		password TEXT NOT NULL,
+		role TEXT NOT NULL,
+		email TEXT NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		FOREIGN KEY (shelter_id) REFERENCES DogShelters(id) ON DELETE CASCADE
+	);
+	CREATE INDEX IF NOT EXISTS shelterstaff_shelter_id_idx ON ShelterStaff (shelter_id);
+	`
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating ShelterStaff schema: %v", err)
+	}
+	return nil
+}
+
+func CreateShelterStaffInvitationsSchema(conn *pgx.Conn) error {
+	ctx := context.Background()
+	query := `
+	CREATE TABLE IF NOT EXISTS ShelterStaffInvitations (
+		id SERIAL PRIMARY KEY,
+		shelter_id INTEGER NOT NULL,
+		email TEXT NOT NULL,
+		role TEXT NOT NULL,
+		token_hash TEXT NOT NULL UNIQUE,
+		expires_at TIMESTAMPTZ NOT NULL,
+		accepted_at TIMESTAMPTZ,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+		FOREIGN KEY (shelter_id) REFERENCES DogShelters(id) ON DELETE CASCADE
+	);
+	`
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error creating ShelterStaffInvitations schema: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrBlobNotFound is returned when no blob is stored under the key.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore stores binary files, such as dog photos, under slash separated keys.
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	// DeletePrefix deletes every blob whose key starts with the prefix followed by a slash.
+	DeletePrefix(ctx context.Context, prefix string) error
+}
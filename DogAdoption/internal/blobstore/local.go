@@ -0,0 +1,101 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// LocalBlobStore keeps blobs as files below a root directory.
+type LocalBlobStore struct {
+	root string
+}
+
+func NewLocalBlobStore(root string) (LocalBlobStore, error) {
+	if root == "" {
+		root = "blob-storage"
+	}
+	err := os.MkdirAll(root, 0700)
+	if err != nil {
+		return LocalBlobStore{}, err
+	}
+	return LocalBlobStore{
+		root: root,
+	}, nil
+}
+
+// Put writes the content to a temporary file first, so that readers never see a partial blob.
+func (l LocalBlobStore) Put(ctx context.Context, key string, content io.Reader) error {
+	filePath, err := l.filePath(key)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(filePath), 0700)
+	if err != nil {
+		return err
+	}
+	tempFile, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+
+	_, err = io.Copy(tempFile, content)
+	if err != nil {
+		tempFile.Close()
+		return err
+	}
+	err = tempFile.Close()
+	if err != nil {
+		return err
+	}
+	return os.Rename(tempFile.Name(), filePath)
+}
+
+func (l LocalBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	filePath, err := l.filePath(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrBlobNotFound
+	}
+	return file, err
+}
+
+// Delete does not fail for missing blobs, so that cleanups can be retried.
+func (l LocalBlobStore) Delete(ctx context.Context, key string) error {
+	filePath, err := l.filePath(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(filePath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (l LocalBlobStore) DeletePrefix(ctx context.Context, prefix string) error {
+	dirPath, err := l.filePath(prefix)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(dirPath)
+}
+
+// filePath rejects keys that would resolve outside of the root directory.
+func (l LocalBlobStore) filePath(key string) (string, error) {
+	cleanKey := path.Clean("/" + key)
+	if key == "" || cleanKey == "/" || cleanKey != "/"+key || strings.Contains(key, "\\") {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(l.root, filepath.FromSlash(key)), nil
+}
@@ -1,23 +1,57 @@
 package config
 
 import (
+	"1dv027/aad/internal/blobstore"
 	dataaccess "1dv027/aad/internal/data-access"
+	"1dv027/aad/internal/geocoder"
 	"1dv027/aad/internal/handlers"
+	adminhandler "1dv027/aad/internal/handlers/admin"
 	apihandler "1dv027/aad/internal/handlers/api"
 	authhandler "1dv027/aad/internal/handlers/auth"
+	mfahandler "1dv027/aad/internal/handlers/auth/mfa"
+	breedhandler "1dv027/aad/internal/handlers/breed"
 	doghandler "1dv027/aad/internal/handlers/dog"
 	dogshelterhandler "1dv027/aad/internal/handlers/dog-shelter"
+	shelterstaffhandler "1dv027/aad/internal/handlers/dog-shelter/staff"
+	dogmedicalhandler "1dv027/aad/internal/handlers/dog/medical"
+	dogphotohandler "1dv027/aad/internal/handlers/dog/photos"
+	dogtransferhandler "1dv027/aad/internal/handlers/dog/transfers"
 	"1dv027/aad/internal/handlers/middleware"
+	oauthhandler "1dv027/aad/internal/handlers/oauth"
 	userhandler "1dv027/aad/internal/handlers/user"
+	userapikeyhandler "1dv027/aad/internal/handlers/user/api-key"
+	useremailverificationhandler "1dv027/aad/internal/handlers/user/email-verification"
+	userfavoritehandler "1dv027/aad/internal/handlers/user/favorite"
 	usermehandler "1dv027/aad/internal/handlers/user/me"
+	usernotificationhandler "1dv027/aad/internal/handlers/user/notification"
+	usersavedsearchhandler "1dv027/aad/internal/handlers/user/saved-search"
 	userwebhookhandler "1dv027/aad/internal/handlers/user/webhook"
+	"1dv027/aad/internal/mailer"
+	"1dv027/aad/internal/model"
+	"1dv027/aad/internal/notifier"
 	"1dv027/aad/internal/repository"
+	"1dv027/aad/internal/scheduler"
 	"1dv027/aad/internal/service"
+	adminsservice "1dv027/aad/internal/service/admins"
 	apiservice "1dv027/aad/internal/service/api"
 	authservice "1dv027/aad/internal/service/auth"
+	mfaservice "1dv027/aad/internal/service/auth/mfa"
+	passwordservice "1dv027/aad/internal/service/auth/password"
+	breedsservice "1dv027/aad/internal/service/breeds"
 	dogsheltersservice "1dv027/aad/internal/service/dog-shelter"
+	shelterstaffservice "1dv027/aad/internal/service/dog-shelter/staff"
 	dogsservice "1dv027/aad/internal/service/dogs"
+	dogmedicalservice "1dv027/aad/internal/service/dogs/medical"
+	dogphotoservice "1dv027/aad/internal/service/dogs/photos"
+	dogtransferservice "1dv027/aad/internal/service/dogs/transfers"
+	oauthservice "1dv027/aad/internal/service/oauth"
+	reminderservice "1dv027/aad/internal/service/reminders"
 	usersservice "1dv027/aad/internal/service/users"
+	userapikeyservice "1dv027/aad/internal/service/users/api-key"
+	useremailverificationservice "1dv027/aad/internal/service/users/email-verification"
+	userfavoriteservice "1dv027/aad/internal/service/users/favorite"
+	usernotificationservice "1dv027/aad/internal/service/users/notification"
+	usersavedsearchservice "1dv027/aad/internal/service/users/saved-search"
 	userwebhookservice "1dv027/aad/internal/service/users/webhook"
 	"1dv027/aad/internal/webhook"
 	"fmt"
@@ -31,6 +65,72 @@ type ContainerConfig struct {
 	CryptographySecretKey string
 	BasePath              string
 	JwtSigningKey         string
+	NotificationLogPath   string
+	PasswordHashing       service.PasswordHashingConfig
+	PasswordPolicy        service.PasswordPolicyConfig
+	Mail                  MailConfig
+	Geocoding             GeocodingConfig
+	Photos                PhotoConfig
+	Notifications         NotificationsConfig
+	Scheduler             SchedulerConfig
+}
+
+// SchedulerConfig configures the background jobs that remind shelters of due vaccinations and
+// adoption follow-ups, send the daily digests of saved searches and delete expired notifications.
+// Schedules are cron expressions in UTC, and empty values use the defaults.
+type SchedulerConfig struct {
+	Disabled                  bool
+	VaccinationDueCron        string
+	VaccinationLeadDays       int
+	FollowupCron              string
+	FollowupDays              []int
+	SavedSearchDigestCron     string
+	NotificationRetentionCron string
+}
+
+// NotificationsConfig limits the in-app notifications kept per user. Zero values use the defaults of the inbox.
+type NotificationsConfig struct {
+	MaxPerUser    int
+	RetentionDays int
+}
+
+// schedulerLockKey is the Postgres advisory lock that elects the instance running the scheduler.
+const schedulerLockKey = 7_140_221_001
+
+func withDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
+
+// PhotoConfig configures the storage of dog photos. Photos are stored on the local file system under
+// StoragePath. Zero limits use the defaults of the upload service.
+type PhotoConfig struct {
+	StoragePath     string
+	MaxUploadBytes  int
+	MaxPhotosPerDog int
+}
+
+// GeocodingConfig configures the geocoding of shelter addresses. An external provider with a Nominatim
+// compatible search API is used when HttpEndpoint is set, and the offline gazetteer otherwise. The bundled
+// gazetteer is used when GazetteerPath is empty.
+type GeocodingConfig struct {
+	GazetteerPath string
+	HttpEndpoint  string
+	HttpApiKey    string
+	HttpUserAgent string
+}
+
+// MailConfig configures the mailer. Emails are sent with SMTP when SmtpHost is set,
+// and written to OutboxDirectory otherwise.
+type MailConfig struct {
+	SmtpHost        string
+	SmtpPort        string
+	SmtpUsername    string
+	SmtpPassword    string
+	From            string
+	OutboxDirectory string
 }
 
 // Setup for the IoC container
@@ -41,12 +141,57 @@ func SetupContainer(config ContainerConfig) *Container {
 	c.ProvideSingleton("AdminsDataAccess", func() any {
 		return dataaccess.NewAdminsDataAccess(config.DatabaseConnector)
 	})
+	c.ProvideSingleton("BreedsDataAccess", func() any {
+		return dataaccess.NewBreedsDataAccess(config.DatabaseConnector)
+	})
 	c.ProvideSingleton("DogsDataAccess", func() any {
 		return dataaccess.NewDogsDataAccess(config.DatabaseConnector)
 	})
 	c.ProvideSingleton("DogSheltersDataAccess", func() any {
 		return dataaccess.NewDogSheltersDataAccess(config.DatabaseConnector)
 	})
+	c.ProvideSingleton("DogMedicalRecordsDataAccess", func() any {
+		return dataaccess.NewDogMedicalRecordsDataAccess(config.DatabaseConnector)
+	})
+	c.ProvideSingleton("DogPhotosDataAccess", func() any {
+		return dataaccess.NewDogPhotosDataAccess(config.DatabaseConnector)
+	})
+	c.ProvideSingleton("DogTransfersDataAccess", func() any {
+		return dataaccess.NewDogTransfersDataAccess(config.DatabaseConnector)
+	})
+	c.ProvideSingleton("EmailVerificationsDataAccess", func() any {
+		return dataaccess.NewEmailVerificationsDataAccess(config.DatabaseConnector)
+	})
+	c.ProvideSingleton("MfaDataAccess", func() any {
+		return dataaccess.NewMfaDataAccess(config.DatabaseConnector)
+	})
+	c.ProvideSingleton("OAuthDataAccess", func() any {
+		return dataaccess.NewOAuthDataAccess(config.DatabaseConnector)
+	})
+	c.ProvideSingleton("PasswordsDataAccess", func() any {
+		return dataaccess.NewPasswordsDataAccess(config.DatabaseConnector)
+	})
+	c.ProvideSingleton("RemindersDataAccess", func() any {
+		return dataaccess.NewRemindersDataAccess(config.DatabaseConnector)
+	})
+	c.ProvideSingleton("ScheduledJobsDataAccess", func() any {
+		return dataaccess.NewScheduledJobsDataAccess(config.DatabaseConnector)
+	})
+	c.ProvideSingleton("SavedSearchesDataAccess", func() any {
+		return dataaccess.NewSavedSearchesDataAccess(config.DatabaseConnector)
+	})
+	c.ProvideSingleton("UserNotificationsDataAccess", func() any {
+		return dataaccess.NewUserNotificationsDataAccess(config.DatabaseConnector)
+	})
+	c.ProvideSingleton("ShelterStaffDataAccess", func() any {
+		return dataaccess.NewShelterStaffDataAccess(config.DatabaseConnector)
+	})
+	c.ProvideSingleton("UserApiKeysDataAccess", func() any {
+		return dataaccess.NewUsersApiKeysDataAccess(config.DatabaseConnector)
+	})
+	c.ProvideSingleton("UserFavoritesDataAccess", func() any {
+		return dataaccess.NewUserFavoritesDataAccess(config.DatabaseConnector)
+	})
 	c.ProvideSingleton("UserWebhooksDataAccess", func() any {
 		return dataaccess.NewUsersWebhookDataAccess(config.DatabaseConnector)
 	})
@@ -55,23 +200,130 @@ func SetupContainer(config ContainerConfig) *Container {
 	})
 
 	// Repository layer
+	c.ProvideSingleton("AdminsRepository", func() any {
+		adminsDataAccess := c.Resolve("AdminsDataAccess", Singleton).(repository.AdminsDataAccess)
+		dogSheltersDataAccess := c.Resolve("DogSheltersDataAccess", Singleton).(repository.GetDogSheltersDataAccess)
+		staffDataAccess := c.Resolve("ShelterStaffDataAccess", Singleton).(repository.GetShelterStaffDataAccess)
+		usersDataAccess := c.Resolve("UsersDataAccess", Singleton).(repository.GetUsersDataAccess)
+		return repository.NewAdminsRepository(adminsDataAccess, dogSheltersDataAccess, staffDataAccess, usersDataAccess)
+	})
+	c.ProvideSingleton("BreedsRepository", func() any {
+		breedsDataAccess := c.Resolve("BreedsDataAccess", Singleton).(repository.BreedsDataAccess)
+		return repository.NewBreedsRepository(breedsDataAccess)
+	})
 	c.ProvideSingleton("DogSheltersRepository", func() any {
 		dogSheltersDataAccess := c.Resolve("DogSheltersDataAccess", Singleton).(repository.DogSheltersDataAccess)
-		return repository.NewDogSheltersRepository(dogSheltersDataAccess)
+		adminsDataAccess := c.Resolve("AdminsDataAccess", Singleton).(repository.GetAdminsDataAccess)
+		staffDataAccess := c.Resolve("ShelterStaffDataAccess", Singleton).(repository.GetShelterStaffDataAccess)
+		usersDataAccess := c.Resolve("UsersDataAccess", Singleton).(repository.GetUsersDataAccess)
+		return repository.NewDogSheltersRepository(dogSheltersDataAccess, adminsDataAccess, staffDataAccess, usersDataAccess)
 	})
 	c.ProvideSingleton("DogsRepository", func() any {
 		dogsDataAccess := c.Resolve("DogsDataAccess", Singleton).(repository.DogsDataAccess)
 		return repository.NewDogsRepository(dogsDataAccess)
 	})
+	c.ProvideSingleton("DogMedicalRecordsRepository", func() any {
+		medicalRecordsDataAccess := c.Resolve("DogMedicalRecordsDataAccess", Singleton).(repository.DogMedicalRecordsDataAccess)
+		dogsDataAccess := c.Resolve("DogsDataAccess", Singleton).(repository.GetDogByIdDataAccess)
+		return repository.NewDogMedicalRecordsRepository(medicalRecordsDataAccess, dogsDataAccess)
+	})
+	c.ProvideSingleton("DogPhotosRepository", func() any {
+		photosDataAccess := c.Resolve("DogPhotosDataAccess", Singleton).(repository.DogPhotosDataAccess)
+		dogsDataAccess := c.Resolve("DogsDataAccess", Singleton).(repository.GetDogByIdDataAccess)
+		return repository.NewDogPhotosRepository(photosDataAccess, dogsDataAccess)
+	})
+	c.ProvideSingleton("DogTransfersRepository", func() any {
+		transfersDataAccess := c.Resolve("DogTransfersDataAccess", Singleton).(repository.DogTransfersDataAccess)
+		dogsDataAccess := c.Resolve("DogsDataAccess", Singleton).(repository.GetDogByIdDataAccess)
+		shelterByIdDataAccess := c.Resolve("DogSheltersDataAccess", Singleton).(repository.GetDogShelterByIdDataAccess)
+		return repository.NewDogTransfersRepository(transfersDataAccess, dogsDataAccess, shelterByIdDataAccess)
+	})
+	c.ProvideSingleton("EmailVerificationsRepository", func() any {
+		emailVerificationsDataAccess := c.Resolve("EmailVerificationsDataAccess", Singleton).(repository.EmailVerificationsDataAccess)
+		usersDataAccess := c.Resolve("UsersDataAccess", Singleton).(repository.GetUserByIdDataAccess)
+		return repository.NewEmailVerificationsRepository(emailVerificationsDataAccess, usersDataAccess)
+	})
 	c.ProvideSingleton("LoginRepository", func() any {
 		adminsDataAccess := c.Resolve("AdminsDataAccess", Singleton).(repository.GetAdminsDataAccess)
 		dogSheltersDataAccess := c.Resolve("DogSheltersDataAccess", Singleton).(repository.GetDogSheltersDataAccess)
+		staffDataAccess := c.Resolve("ShelterStaffDataAccess", Singleton).(repository.GetShelterStaffDataAccess)
 		usersDataAccess := c.Resolve("UsersDataAccess", Singleton).(repository.GetUsersDataAccess)
-		return repository.NewLoginRepository(adminsDataAccess, dogSheltersDataAccess, usersDataAccess)
+		return repository.NewLoginRepository(adminsDataAccess, dogSheltersDataAccess, staffDataAccess, usersDataAccess)
+	})
+	c.ProvideSingleton("MfaRepository", func() any {
+		mfaDataAccess := c.Resolve("MfaDataAccess", Singleton).(repository.MfaDataAccess)
+		return repository.NewMfaRepository(mfaDataAccess)
+	})
+	c.ProvideSingleton("OAuthRepository", func() any {
+		oauthDataAccess := c.Resolve("OAuthDataAccess", Singleton).(repository.OAuthDataAccess)
+		usersDataAccess := c.Resolve("UsersDataAccess", Singleton).(repository.GetUserByIdDataAccess)
+		return repository.NewOAuthRepository(oauthDataAccess, usersDataAccess)
+	})
+	c.ProvideSingleton("PasswordsRepository", func() any {
+		passwordsDataAccess := c.Resolve("PasswordsDataAccess", Singleton).(repository.PasswordsDataAccess)
+		dogSheltersDataAccess := c.Resolve("DogSheltersDataAccess", Singleton).(repository.GetDogSheltersDataAccess)
+		staffDataAccess := c.Resolve("ShelterStaffDataAccess", Singleton).(repository.GetShelterStaffDataAccess)
+		usersDataAccess := c.Resolve("UsersDataAccess", Singleton).(repository.GetUsersDataAccess)
+		return repository.NewPasswordsRepository(passwordsDataAccess, dogSheltersDataAccess, staffDataAccess, usersDataAccess)
+	})
+	c.ProvideSingleton("RemindersRepository", func() any {
+		remindersDataAccess := c.Resolve("RemindersDataAccess", Singleton).(repository.RemindersDataAccess)
+		staffDataAccess := c.Resolve("ShelterStaffDataAccess", Singleton).(repository.ReminderStaffDataAccess)
+		return repository.NewRemindersRepository(remindersDataAccess, staffDataAccess)
+	})
+	c.ProvideSingleton("ShelterRegistrationsRepository", func() any {
+		dogSheltersDataAccess := c.Resolve("DogSheltersDataAccess", Singleton).(repository.ShelterRegistrationsDataAccess)
+		adminsDataAccess := c.Resolve("AdminsDataAccess", Singleton).(repository.GetAdminsDataAccess)
+		staffDataAccess := c.Resolve("ShelterStaffDataAccess", Singleton).(repository.GetShelterStaffDataAccess)
+		usersDataAccess := c.Resolve("UsersDataAccess", Singleton).(repository.GetUsersDataAccess)
+		return repository.NewShelterRegistrationsRepository(dogSheltersDataAccess, adminsDataAccess, staffDataAccess, usersDataAccess)
+	})
+	c.ProvideSingleton("ShelterStaffRepository", func() any {
+		staffDataAccess := c.Resolve("ShelterStaffDataAccess", Singleton).(repository.ShelterStaffDataAccess)
+		adminsDataAccess := c.Resolve("AdminsDataAccess", Singleton).(repository.GetAdminsDataAccess)
+		dogSheltersDataAccess := c.Resolve("DogSheltersDataAccess", Singleton).(repository.GetDogSheltersDataAccess)
+		usersDataAccess := c.Resolve("UsersDataAccess", Singleton).(repository.GetUsersDataAccess)
+		shelterByIdDataAccess := c.Resolve("DogSheltersDataAccess", Singleton).(repository.GetDogShelterByIdDataAccess)
+		return repository.NewShelterStaffRepository(staffDataAccess, adminsDataAccess, dogSheltersDataAccess, usersDataAccess, shelterByIdDataAccess)
+	})
+	c.ProvideSingleton("UserApiKeysRepository", func() any {
+		userApiKeysDataAccess := c.Resolve("UserApiKeysDataAccess", Singleton).(repository.UserApiKeysDataAccess)
+		usersDataAccess := c.Resolve("UsersDataAccess", Singleton).(repository.GetUserByIdDataAccess)
+		return repository.NewUserApiKeysRepository(userApiKeysDataAccess, usersDataAccess)
+	})
+	c.ProvideSingleton("UserExportsRepository", func() any {
+		usersDataAccess := c.Resolve("UsersDataAccess", Singleton).(repository.GetUserByIdDataAccess)
+		webhooksDataAccess := c.Resolve("UserWebhooksDataAccess", Singleton).(repository.ExportWebhooksDataAccess)
+		apiKeysDataAccess := c.Resolve("UserApiKeysDataAccess", Singleton).(repository.ExportApiKeysDataAccess)
+		oauthDataAccess := c.Resolve("OAuthDataAccess", Singleton).(repository.ExportOAuthDataAccess)
+		mfaDataAccess := c.Resolve("MfaDataAccess", Singleton).(repository.ExportMfaDataAccess)
+		favoritesDataAccess := c.Resolve("UserFavoritesDataAccess", Singleton).(repository.ExportFavoritesDataAccess)
+		savedSearchesDataAccess := c.Resolve("SavedSearchesDataAccess", Singleton).(repository.ExportSavedSearchesDataAccess)
+		notificationsDataAccess := c.Resolve("UserNotificationsDataAccess", Singleton).(repository.ExportNotificationsDataAccess)
+		return repository.NewUserExportsRepository(usersDataAccess, webhooksDataAccess, apiKeysDataAccess, oauthDataAccess,
+			mfaDataAccess, favoritesDataAccess, savedSearchesDataAccess, notificationsDataAccess)
+	})
+	c.ProvideSingleton("UserFavoritesRepository", func() any {
+		userFavoritesDataAccess := c.Resolve("UserFavoritesDataAccess", Singleton).(repository.UserFavoritesDataAccess)
+		usersDataAccess := c.Resolve("UsersDataAccess", Singleton).(repository.GetUserByIdDataAccess)
+		dogsDataAccess := c.Resolve("DogsDataAccess", Singleton).(repository.FavoriteDogsDataAccess)
+		return repository.NewUserFavoritesRepository(userFavoritesDataAccess, usersDataAccess, dogsDataAccess)
+	})
+	c.ProvideSingleton("SavedSearchesRepository", func() any {
+		savedSearchesDataAccess := c.Resolve("SavedSearchesDataAccess", Singleton).(repository.SavedSearchesDataAccess)
+		usersDataAccess := c.Resolve("UsersDataAccess", Singleton).(repository.GetUserByIdDataAccess)
+		dogsDataAccess := c.Resolve("DogsDataAccess", Singleton).(repository.SavedSearchDogsDataAccess)
+		return repository.NewSavedSearchesRepository(savedSearchesDataAccess, usersDataAccess, dogsDataAccess)
+	})
+	c.ProvideSingleton("UserNotificationsRepository", func() any {
+		userNotificationsDataAccess := c.Resolve("UserNotificationsDataAccess", Singleton).(repository.UserNotificationsDataAccess)
+		usersDataAccess := c.Resolve("UsersDataAccess", Singleton).(repository.GetUserByIdDataAccess)
+		return repository.NewUserNotificationsRepository(userNotificationsDataAccess, usersDataAccess)
 	})
 	c.ProvideSingleton("UserWebhooksRepository", func() any {
 		userWebhooksDataAccess := c.Resolve("UserWebhooksDataAccess", Singleton).(repository.UserWebhooksDataAccess)
-		return repository.NewUserWebhooksRepository(userWebhooksDataAccess)
+		usersDataAccess := c.Resolve("UsersDataAccess", Singleton).(repository.GetUserByIdDataAccess)
+		return repository.NewUserWebhooksRepository(userWebhooksDataAccess, usersDataAccess)
 	})
 	c.ProvideSingleton("UsersRepository", func() any {
 		usersDataAcess := c.Resolve("UsersDataAccess", Singleton).(repository.UsersDataAccess)
@@ -84,20 +336,95 @@ func SetupContainer(config ContainerConfig) *Container {
 		return service.NewJwtService(config.JwtSigningKey)
 	})
 	c.ProvideSingleton("CryptographyService", func() any {
-		cryptoService, err := service.NewCryptographyService(config.CryptographySecretKey)
+		passwordHasher, err := service.NewPasswordHasher(config.PasswordHashing)
+		if err != nil {
+			fmt.Fprint(os.Stderr, "Failed to create password hasher: ", err.Error())
+			os.Exit(1)
+		}
+		cryptoService, err := service.NewCryptographyService(config.CryptographySecretKey, passwordHasher)
 		if err != nil {
 			fmt.Fprint(os.Stderr, "Failed to create cryptography service")
 			os.Exit(1)
 		}
 		return cryptoService
 	})
+	c.ProvideSingleton("PasswordPolicy", func() any {
+		return service.NewPasswordPolicy(config.PasswordPolicy)
+	})
+	c.ProvideSingleton("TotpService", func() any {
+		return service.NewTotpService("DogAdoptionApp")
+	})
 	c.ProvideSingleton("HateoasLinkGenerator", func() any {
 		return service.NewHateoasLinkGenerator(config.BasePath)
 	})
+	c.ProvideSingleton("Geocoder", func() any {
+		if config.Geocoding.HttpEndpoint != "" {
+			return geocoder.NewHttpGeocoder(config.Geocoding.HttpEndpoint, config.Geocoding.HttpApiKey, config.Geocoding.HttpUserAgent)
+		}
+		gazetteerGeocoder, err := geocoder.NewGazetteerGeocoder(config.Geocoding.GazetteerPath)
+		if err != nil {
+			fmt.Fprint(os.Stderr, "Failed to load gazetteer: ", err.Error())
+			os.Exit(1)
+		}
+		return gazetteerGeocoder
+	})
+	c.ProvideSingleton("BlobStore", func() any {
+		blobStore, err := blobstore.NewLocalBlobStore(config.Photos.StoragePath)
+		if err != nil {
+			fmt.Fprint(os.Stderr, "Failed to create blob store: ", err.Error())
+			os.Exit(1)
+		}
+		return blobStore
+	})
+	c.ProvideSingleton("Mailer", func() any {
+		if config.Mail.SmtpHost != "" {
+			return mailer.NewSmtpMailer(config.Mail.SmtpHost, config.Mail.SmtpPort, config.Mail.SmtpUsername,
+				config.Mail.SmtpPassword, config.Mail.From)
+		}
+		return mailer.NewOutboxMailer(config.Mail.OutboxDirectory, config.Mail.From)
+	})
+	c.ProvideSingleton("Notifier", func() any {
+		return notifier.NewLogNotifier(config.NotificationLogPath)
+	})
 	c.ProvideSingleton("WebhookDispatcher", func() any {
 		userWebhooksRepo := c.Resolve("UserWebhooksRepository", Singleton).(webhook.UserWebhooksRepository)
 		cryptoService := c.Resolve("CryptographyService", Singleton).(webhook.CryptographyService)
-		return webhook.NewWebhookDispatcher(userWebhooksRepo, cryptoService)
+		inbox := c.Resolve("UserNotificationInbox", Singleton).(webhook.NotificationInbox)
+		return webhook.NewWebhookDispatcher(userWebhooksRepo, cryptoService, inbox)
+	})
+
+	/// Admins
+	c.ProvideSingleton("AdminsDeleteService", func() any {
+		adminsRepo := c.Resolve("AdminsRepository", Singleton).(adminsservice.DeleteAdminsRepository)
+		return adminsservice.NewDeleteAdminsService(adminsRepo)
+	})
+	c.ProvideSingleton("AdminsGetByIdService", func() any {
+		adminsRepo := c.Resolve("AdminsRepository", Singleton).(adminsservice.GetAdminByIdRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(adminsservice.AdminLinkGenerator)
+		return adminsservice.NewGetAdminByIdService(adminsRepo, linkGenerator)
+	})
+	c.ProvideSingleton("AdminsGetService", func() any {
+		adminsRepo := c.Resolve("AdminsRepository", Singleton).(adminsservice.GetAdminsRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(adminsservice.GetAdminsLinkGenerator)
+		return adminsservice.NewGetAdminsService(adminsRepo, linkGenerator)
+	})
+	c.ProvideSingleton("AdminsPasswordChangeService", func() any {
+		passwordsRepo := c.Resolve("PasswordsRepository", Singleton).(passwordservice.ChangePasswordRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(passwordservice.ChangePasswordCryptographyService)
+		passwordPolicy := c.Resolve("PasswordPolicy", Singleton).(passwordservice.PasswordPolicyValidator)
+		return passwordservice.NewChangePasswordService(passwordsRepo, cryptoService, passwordPolicy, model.ADMIN)
+	})
+	c.ProvideSingleton("AdminsPostService", func() any {
+		adminsRepo := c.Resolve("AdminsRepository", Singleton).(adminsservice.PostAdminsRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(adminsservice.PostAdminsCryptographyService)
+		passwordPolicy := c.Resolve("PasswordPolicy", Singleton).(adminsservice.PostAdminsPasswordPolicy)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(adminsservice.AdminLinkGenerator)
+		return adminsservice.NewPostAdminsService(adminsRepo, cryptoService, passwordPolicy, linkGenerator)
+	})
+	c.ProvideSingleton("AdminsPutService", func() any {
+		adminsRepo := c.Resolve("AdminsRepository", Singleton).(adminsservice.PutAdminsRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(adminsservice.AdminLinkGenerator)
+		return adminsservice.NewPutAdminsService(adminsRepo, linkGenerator)
 	})
 
 	// Api
@@ -109,14 +436,113 @@ func SetupContainer(config ContainerConfig) *Container {
 	/// Auth
 	c.ProvideSingleton("AuthLoginService", func() any {
 		loginRepository := c.Resolve("LoginRepository", Singleton).(authservice.LoginRepository)
+		mfaRepository := c.Resolve("MfaRepository", Singleton).(authservice.LoginMfaRepository)
+		passwordsRepository := c.Resolve("PasswordsRepository", Singleton).(authservice.LoginPasswordRepository)
 		jwtGenerator := c.Resolve("JwtGenerator", Singleton).(authservice.JwtGenerator)
 		cryptoService := c.Resolve("CryptographyService", Singleton).(authservice.CryptographyService)
-		return authservice.NewLoginService(loginRepository, jwtGenerator, cryptoService)
+		return authservice.NewLoginService(loginRepository, mfaRepository, passwordsRepository, jwtGenerator, cryptoService)
+	})
+	//// Mfa
+	c.ProvideSingleton("MfaChallengeService", func() any {
+		mfaRepo := c.Resolve("MfaRepository", Singleton).(mfaservice.ChallengeMfaRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(mfaservice.SecondFactorCryptographyService)
+		totpService := c.Resolve("TotpService", Singleton).(mfaservice.TotpValidator)
+		jwtService := c.Resolve("JwtGenerator", Singleton).(mfaservice.ChallengeMfaJwtService)
+		return mfaservice.NewChallengeMfaService(mfaRepo, cryptoService, totpService, jwtService)
+	})
+	c.ProvideSingleton("MfaDisableService", func() any {
+		mfaRepo := c.Resolve("MfaRepository", Singleton).(mfaservice.DisableMfaRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(mfaservice.SecondFactorCryptographyService)
+		totpService := c.Resolve("TotpService", Singleton).(mfaservice.TotpValidator)
+		return mfaservice.NewDisableMfaService(mfaRepo, cryptoService, totpService)
+	})
+	c.ProvideSingleton("MfaEnrollService", func() any {
+		mfaRepo := c.Resolve("MfaRepository", Singleton).(mfaservice.EnrollMfaRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(mfaservice.EnrollMfaCryptographyService)
+		totpService := c.Resolve("TotpService", Singleton).(mfaservice.EnrollMfaTotpService)
+		return mfaservice.NewEnrollMfaService(mfaRepo, cryptoService, totpService)
 	})
+	c.ProvideSingleton("MfaPoliciesGetService", func() any {
+		mfaRepo := c.Resolve("MfaRepository", Singleton).(mfaservice.GetMfaPoliciesRepository)
+		return mfaservice.NewGetMfaPoliciesService(mfaRepo)
+	})
+	c.ProvideSingleton("MfaPolicyPutService", func() any {
+		mfaRepo := c.Resolve("MfaRepository", Singleton).(mfaservice.PutMfaPolicyRepository)
+		return mfaservice.NewPutMfaPolicyService(mfaRepo)
+	})
+	c.ProvideSingleton("MfaVerifyService", func() any {
+		mfaRepo := c.Resolve("MfaRepository", Singleton).(mfaservice.VerifyMfaRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(mfaservice.VerifyMfaCryptographyService)
+		totpService := c.Resolve("TotpService", Singleton).(mfaservice.TotpValidator)
+		return mfaservice.NewVerifyMfaService(mfaRepo, cryptoService, totpService)
+	})
+	//// Password
+	c.ProvideSingleton("PasswordForgotService", func() any {
+		passwordsRepo := c.Resolve("PasswordsRepository", Singleton).(passwordservice.ForgotPasswordRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(passwordservice.ForgotPasswordCryptographyService)
+		notifier := c.Resolve("Notifier", Singleton).(passwordservice.PasswordResetNotifier)
+		return passwordservice.NewForgotPasswordService(passwordsRepo, cryptoService, notifier)
+	})
+	c.ProvideSingleton("PasswordResetService", func() any {
+		passwordsRepo := c.Resolve("PasswordsRepository", Singleton).(passwordservice.ResetPasswordRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(passwordservice.ResetPasswordCryptographyService)
+		passwordPolicy := c.Resolve("PasswordPolicy", Singleton).(passwordservice.PasswordPolicyValidator)
+		return passwordservice.NewResetPasswordService(passwordsRepo, cryptoService, passwordPolicy)
+	})
+	c.ProvideSingleton("DogSheltersPasswordChangeService", func() any {
+		passwordsRepo := c.Resolve("PasswordsRepository", Singleton).(passwordservice.ChangePasswordRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(passwordservice.ChangePasswordCryptographyService)
+		passwordPolicy := c.Resolve("PasswordPolicy", Singleton).(passwordservice.PasswordPolicyValidator)
+		return passwordservice.NewChangePasswordService(passwordsRepo, cryptoService, passwordPolicy, model.DOGSHELTER)
+	})
+	c.ProvideSingleton("ShelterStaffPasswordChangeService", func() any {
+		passwordsRepo := c.Resolve("PasswordsRepository", Singleton).(passwordservice.ChangePasswordRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(passwordservice.ChangePasswordCryptographyService)
+		passwordPolicy := c.Resolve("PasswordPolicy", Singleton).(passwordservice.PasswordPolicyValidator)
+		return passwordservice.NewChangePasswordService(passwordsRepo, cryptoService, passwordPolicy, model.SHELTERSTAFF)
+	})
+	c.ProvideSingleton("UsersPasswordChangeService", func() any {
+		passwordsRepo := c.Resolve("PasswordsRepository", Singleton).(passwordservice.ChangePasswordRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(passwordservice.ChangePasswordCryptographyService)
+		passwordPolicy := c.Resolve("PasswordPolicy", Singleton).(passwordservice.PasswordPolicyValidator)
+		return passwordservice.NewChangePasswordService(passwordsRepo, cryptoService, passwordPolicy, model.USER)
+	})
+	/// Breeds
+	c.ProvideSingleton("BreedsDeleteService", func() any {
+		breedsRepo := c.Resolve("BreedsRepository", Singleton).(breedsservice.DeleteBreedRepository)
+		return breedsservice.NewDeleteBreedService(breedsRepo)
+	})
+	c.ProvideSingleton("BreedsGetByIdService", func() any {
+		breedsRepo := c.Resolve("BreedsRepository", Singleton).(breedsservice.GetBreedByIdRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(breedsservice.BreedLinkGenerator)
+		return breedsservice.NewGetBreedByIdService(breedsRepo, linkGenerator)
+	})
+	c.ProvideSingleton("BreedsGetService", func() any {
+		breedsRepo := c.Resolve("BreedsRepository", Singleton).(breedsservice.GetBreedsRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(breedsservice.GetBreedsLinkGenerator)
+		return breedsservice.NewGetBreedsService(breedsRepo, linkGenerator)
+	})
+	c.ProvideSingleton("BreedsMergeService", func() any {
+		breedsRepo := c.Resolve("BreedsRepository", Singleton).(breedsservice.MergeBreedRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(breedsservice.BreedLinkGenerator)
+		return breedsservice.NewMergeBreedService(breedsRepo, linkGenerator)
+	})
+	c.ProvideSingleton("BreedsPostService", func() any {
+		breedsRepo := c.Resolve("BreedsRepository", Singleton).(breedsservice.PostBreedRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(breedsservice.BreedLinkGenerator)
+		return breedsservice.NewPostBreedService(breedsRepo, linkGenerator)
+	})
+	c.ProvideSingleton("BreedsPutService", func() any {
+		breedsRepo := c.Resolve("BreedsRepository", Singleton).(breedsservice.PutBreedRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(breedsservice.BreedLinkGenerator)
+		return breedsservice.NewPutBreedService(breedsRepo, linkGenerator)
+	})
+
 	/// Dogs
 	c.ProvideSingleton("DogsDeleteService", func() any {
 		dogsRepo := c.Resolve("DogsRepository", Singleton).(dogsservice.DeleteDogRepository)
-		return dogsservice.NewDeleteDogService(dogsRepo)
+		photoFileStore := c.Resolve("BlobStore", Singleton).(dogsservice.DogPhotoFileStore)
+		return dogsservice.NewDeleteDogService(dogsRepo, photoFileStore)
 	})
 	c.ProvideSingleton("DogsGetByIdService", func() any {
 		dogsRepo := c.Resolve("DogsRepository", Singleton).(dogsservice.GetDogByIdRepository)
@@ -131,13 +557,103 @@ func SetupContainer(config ContainerConfig) *Container {
 	c.ProvideSingleton("DogsPostService", func() any {
 		dogsRepo := c.Resolve("DogsRepository", Singleton).(dogsservice.PostDogsRepository)
 		webhookDispatcher := c.Resolve("WebhookDispatcher", Singleton).(dogsservice.NewDogWebhookDispatcher)
+		breedResolver := c.Resolve("BreedsRepository", Singleton).(dogsservice.DogBreedResolver)
 		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(dogsservice.PostDogsLinkGenerator)
-		return dogsservice.NewPostDogService(dogsRepo, linkGenerator, webhookDispatcher)
+		searchMatcher := c.Resolve("UserSavedSearchAlertService", Singleton).(dogsservice.DogSavedSearchMatcher)
+		return dogsservice.NewPostDogService(dogsRepo, breedResolver, linkGenerator, webhookDispatcher, searchMatcher)
 	})
 	c.ProvideSingleton("DogsPutService", func() any {
 		dogsRepo := c.Resolve("DogsRepository", Singleton).(dogsservice.PutDogsRepository)
+		breedResolver := c.Resolve("BreedsRepository", Singleton).(dogsservice.DogBreedResolver)
 		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(dogsservice.PutDogsLinkGenerator)
-		return dogsservice.NewPutDogService(dogsRepo, linkGenerator)
+		statusNotifier := c.Resolve("UserFavoriteStatusNotifier", Singleton).(dogsservice.DogStatusChangeNotifier)
+		searchMatcher := c.Resolve("UserSavedSearchAlertService", Singleton).(dogsservice.DogSavedSearchMatcher)
+		return dogsservice.NewPutDogService(dogsRepo, breedResolver, linkGenerator, statusNotifier, searchMatcher)
+	})
+	c.ProvideSingleton("DogRecommendationScorer", func() any {
+		return dogsservice.NewWeightedRecommendationScorer(dogsservice.DefaultRecommendationWeights())
+	})
+	c.ProvideSingleton("DogsRecommendService", func() any {
+		dogsRepo := c.Resolve("DogsRepository", Singleton).(dogsservice.RecommendDogsRepository)
+		userRepo := c.Resolve("UsersRepository", Singleton).(dogsservice.RecommendDogsUserRepository)
+		geocoder := c.Resolve("Geocoder", Singleton).(dogsservice.RecommendDogsGeocoder)
+		scorer := c.Resolve("DogRecommendationScorer", Singleton).(dogsservice.DogRecommendationScorer)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(dogsservice.GetDogsLinkGenerator)
+		return dogsservice.NewRecommendDogsService(dogsRepo, userRepo, geocoder, scorer, linkGenerator)
+	})
+	c.ProvideSingleton("DogsStatusHistoryService", func() any {
+		dogsRepo := c.Resolve("DogsRepository", Singleton).(dogsservice.GetDogStatusHistoryRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(dogsservice.GetDogStatusHistoryLinkGenerator)
+		return dogsservice.NewGetDogStatusHistoryService(dogsRepo, linkGenerator)
+	})
+	//// Medical records
+	c.ProvideSingleton("DogMedicalRecordsDeleteService", func() any {
+		medicalRecordsRepo := c.Resolve("DogMedicalRecordsRepository", Singleton).(dogmedicalservice.DeleteDogMedicalRecordRepository)
+		return dogmedicalservice.NewDeleteDogMedicalRecordService(medicalRecordsRepo)
+	})
+	c.ProvideSingleton("DogMedicalRecordsGetService", func() any {
+		medicalRecordsRepo := c.Resolve("DogMedicalRecordsRepository", Singleton).(dogmedicalservice.GetDogMedicalRecordsRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(dogmedicalservice.DogMedicalRecordLinkGenerator)
+		return dogmedicalservice.NewGetDogMedicalRecordsService(medicalRecordsRepo, linkGenerator)
+	})
+	c.ProvideSingleton("DogMedicalRecordsPostService", func() any {
+		medicalRecordsRepo := c.Resolve("DogMedicalRecordsRepository", Singleton).(dogmedicalservice.PostDogMedicalRecordRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(dogmedicalservice.DogMedicalRecordLinkGenerator)
+		return dogmedicalservice.NewPostDogMedicalRecordService(medicalRecordsRepo, linkGenerator)
+	})
+	c.ProvideSingleton("DogMedicalRecordsPutService", func() any {
+		medicalRecordsRepo := c.Resolve("DogMedicalRecordsRepository", Singleton).(dogmedicalservice.PutDogMedicalRecordRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(dogmedicalservice.DogMedicalRecordLinkGenerator)
+		return dogmedicalservice.NewPutDogMedicalRecordService(medicalRecordsRepo, linkGenerator)
+	})
+	//// Photos
+	c.ProvideSingleton("DogPhotosDeleteService", func() any {
+		photosRepo := c.Resolve("DogPhotosRepository", Singleton).(dogphotoservice.DeleteDogPhotoRepository)
+		blobStore := c.Resolve("BlobStore", Singleton).(blobstore.BlobStore)
+		return dogphotoservice.NewDeleteDogPhotoService(photosRepo, blobStore)
+	})
+	c.ProvideSingleton("DogPhotosFileService", func() any {
+		photosRepo := c.Resolve("DogPhotosRepository", Singleton).(dogphotoservice.GetDogPhotoFileRepository)
+		blobStore := c.Resolve("BlobStore", Singleton).(blobstore.BlobStore)
+		return dogphotoservice.NewGetDogPhotoFileService(photosRepo, blobStore)
+	})
+	c.ProvideSingleton("DogPhotosGetService", func() any {
+		photosRepo := c.Resolve("DogPhotosRepository", Singleton).(dogphotoservice.GetDogPhotosRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(dogphotoservice.DogPhotoLinkGenerator)
+		return dogphotoservice.NewGetDogPhotosService(photosRepo, linkGenerator)
+	})
+	c.ProvideSingleton("DogPhotosUpdateService", func() any {
+		photosRepo := c.Resolve("DogPhotosRepository", Singleton).(dogphotoservice.UpdateDogPhotoRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(dogphotoservice.DogPhotoLinkGenerator)
+		return dogphotoservice.NewUpdateDogPhotoService(photosRepo, linkGenerator)
+	})
+	c.ProvideSingleton("DogPhotosUploadService", func() any {
+		photosRepo := c.Resolve("DogPhotosRepository", Singleton).(dogphotoservice.UploadDogPhotoRepository)
+		blobStore := c.Resolve("BlobStore", Singleton).(blobstore.BlobStore)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(dogphotoservice.UploadDogPhotoCryptographyService)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(dogphotoservice.DogPhotoLinkGenerator)
+		return dogphotoservice.NewUploadDogPhotoService(photosRepo, blobStore, cryptoService, linkGenerator,
+			dogphotoservice.PhotoUploadConfig{
+				MaxUploadBytes:  config.Photos.MaxUploadBytes,
+				MaxPhotosPerDog: config.Photos.MaxPhotosPerDog,
+			})
+	})
+	//// Transfers
+	c.ProvideSingleton("DogTransfersDecideService", func() any {
+		transfersRepo := c.Resolve("DogTransfersRepository", Singleton).(dogtransferservice.DecideDogTransferRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(dogtransferservice.DecideDogTransferLinkGenerator)
+		webhookDispatcher := c.Resolve("WebhookDispatcher", Singleton).(dogtransferservice.DogTransferredWebhookDispatcher)
+		return dogtransferservice.NewDecideDogTransferService(transfersRepo, linkGenerator, webhookDispatcher)
+	})
+	c.ProvideSingleton("DogTransfersGetService", func() any {
+		transfersRepo := c.Resolve("DogTransfersRepository", Singleton).(dogtransferservice.GetDogTransfersRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(dogtransferservice.DogTransferLinkGenerator)
+		return dogtransferservice.NewGetDogTransfersService(transfersRepo, linkGenerator)
+	})
+	c.ProvideSingleton("DogTransfersProposeService", func() any {
+		transfersRepo := c.Resolve("DogTransfersRepository", Singleton).(dogtransferservice.ProposeDogTransferRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(dogtransferservice.DogTransferLinkGenerator)
+		return dogtransferservice.NewProposeDogTransferService(transfersRepo, linkGenerator)
 	})
 	/// DogShelters
 	c.ProvideSingleton("DogSheltersDeleteService", func() any {
@@ -158,26 +674,306 @@ func SetupContainer(config ContainerConfig) *Container {
 		dogSheltersRepo := c.Resolve("DogSheltersRepository", Singleton).(dogsheltersservice.PostDogSheltersRepository)
 		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(dogsheltersservice.PostDogSheltersLinkGenerator)
 		cryptoService := c.Resolve("CryptographyService", Singleton).(dogsheltersservice.PostDogSheltersCryptographyService)
-		return dogsheltersservice.NewPostDogSheltersService(dogSheltersRepo, linkGenerator, cryptoService)
+		passwordPolicy := c.Resolve("PasswordPolicy", Singleton).(dogsheltersservice.PostDogSheltersPasswordPolicy)
+		geocoder := c.Resolve("Geocoder", Singleton).(dogsheltersservice.ShelterGeocoder)
+		return dogsheltersservice.NewPostDogSheltersService(dogSheltersRepo, linkGenerator, cryptoService, passwordPolicy, geocoder)
 	})
 	c.ProvideSingleton("DogSheltersPutService", func() any {
 		dogSheltersRepo := c.Resolve("DogSheltersRepository", Singleton).(dogsheltersservice.PutDogSheltersRepository)
 		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(dogsheltersservice.PutDogSheltersLinkGenerator)
-		return dogsheltersservice.NewPutDogSheltersService(dogSheltersRepo, linkGenerator)
+		geocoder := c.Resolve("Geocoder", Singleton).(dogsheltersservice.ShelterGeocoder)
+		return dogsheltersservice.NewPutDogSheltersService(dogSheltersRepo, linkGenerator, geocoder)
+	})
+	c.ProvideSingleton("DogSheltersRegisterService", func() any {
+		registrationsRepo := c.Resolve("ShelterRegistrationsRepository", Singleton).(dogsheltersservice.RegisterDogShelterRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(dogsheltersservice.ShelterRegistrationLinkGenerator)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(dogsheltersservice.PostDogSheltersCryptographyService)
+		passwordPolicy := c.Resolve("PasswordPolicy", Singleton).(dogsheltersservice.PostDogSheltersPasswordPolicy)
+		geocoder := c.Resolve("Geocoder", Singleton).(dogsheltersservice.ShelterGeocoder)
+		return dogsheltersservice.NewRegisterDogShelterService(registrationsRepo, linkGenerator, cryptoService, passwordPolicy, geocoder)
+	})
+	c.ProvideSingleton("DogSheltersRegistrationsService", func() any {
+		registrationsRepo := c.Resolve("ShelterRegistrationsRepository", Singleton).(dogsheltersservice.ShelterRegistrationsRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(dogsheltersservice.ShelterRegistrationsLinkGenerator)
+		notifier := c.Resolve("Notifier", Singleton).(dogsheltersservice.ShelterRegistrationNotifier)
+		return dogsheltersservice.NewShelterRegistrationsService(registrationsRepo, linkGenerator, notifier)
+	})
+	//// Staff
+	c.ProvideSingleton("ShelterStaffAcceptService", func() any {
+		staffRepo := c.Resolve("ShelterStaffRepository", Singleton).(shelterstaffservice.AcceptStaffInvitationRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(shelterstaffservice.AcceptStaffInvitationCryptographyService)
+		passwordPolicy := c.Resolve("PasswordPolicy", Singleton).(shelterstaffservice.AcceptStaffInvitationPasswordPolicy)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(shelterstaffservice.ShelterStaffLinkGenerator)
+		return shelterstaffservice.NewAcceptStaffInvitationService(staffRepo, cryptoService, passwordPolicy, linkGenerator)
+	})
+	c.ProvideSingleton("ShelterStaffDeleteService", func() any {
+		staffRepo := c.Resolve("ShelterStaffRepository", Singleton).(shelterstaffservice.DeleteShelterStaffRepository)
+		return shelterstaffservice.NewDeleteShelterStaffService(staffRepo)
+	})
+	c.ProvideSingleton("ShelterStaffGetService", func() any {
+		staffRepo := c.Resolve("ShelterStaffRepository", Singleton).(shelterstaffservice.GetShelterStaffRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(shelterstaffservice.ShelterStaffLinkGenerator)
+		return shelterstaffservice.NewGetShelterStaffService(staffRepo, linkGenerator)
+	})
+	c.ProvideSingleton("ShelterStaffInvitationsService", func() any {
+		staffRepo := c.Resolve("ShelterStaffRepository", Singleton).(shelterstaffservice.StaffInvitationsRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(shelterstaffservice.ShelterStaffLinkGenerator)
+		return shelterstaffservice.NewStaffInvitationsService(staffRepo, linkGenerator)
+	})
+	c.ProvideSingleton("ShelterStaffInviteService", func() any {
+		staffRepo := c.Resolve("ShelterStaffRepository", Singleton).(shelterstaffservice.InviteShelterStaffRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(shelterstaffservice.InviteShelterStaffCryptographyService)
+		mailer := c.Resolve("Mailer", Singleton).(shelterstaffservice.StaffInvitationMailer)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(shelterstaffservice.ShelterStaffLinkGenerator)
+		return shelterstaffservice.NewInviteShelterStaffService(staffRepo, cryptoService, mailer, linkGenerator)
+	})
+	c.ProvideSingleton("ShelterStaffMembershipService", func() any {
+		staffRepo := c.Resolve("ShelterStaffRepository", Singleton).(shelterstaffservice.ShelterMembershipRepository)
+		return shelterstaffservice.NewShelterMembershipService(staffRepo)
+	})
+	c.ProvideSingleton("ShelterStaffUpdateService", func() any {
+		staffRepo := c.Resolve("ShelterStaffRepository", Singleton).(shelterstaffservice.UpdateShelterStaffRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(shelterstaffservice.ShelterStaffLinkGenerator)
+		return shelterstaffservice.NewUpdateShelterStaffService(staffRepo, linkGenerator)
+	})
+
+	/// OAuth
+	c.ProvideSingleton("OAuthAuthorizeGetService", func() any {
+		oauthRepo := c.Resolve("OAuthRepository", Singleton).(oauthservice.GetAuthorizeRepository)
+		return oauthservice.NewGetAuthorizeService(oauthRepo)
+	})
+	c.ProvideSingleton("OAuthAuthorizePostService", func() any {
+		oauthRepo := c.Resolve("OAuthRepository", Singleton).(oauthservice.PostAuthorizeRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(oauthservice.PostAuthorizeCryptographyService)
+		return oauthservice.NewPostAuthorizeService(oauthRepo, cryptoService)
+	})
+	c.ProvideSingleton("OAuthClientsDeleteService", func() any {
+		oauthRepo := c.Resolve("OAuthRepository", Singleton).(oauthservice.DeleteOAuthClientRepository)
+		return oauthservice.NewDeleteOAuthClientService(oauthRepo)
+	})
+	c.ProvideSingleton("OAuthClientsGetService", func() any {
+		oauthRepo := c.Resolve("OAuthRepository", Singleton).(oauthservice.GetOAuthClientsRepository)
+		return oauthservice.NewGetOAuthClientsService(oauthRepo)
+	})
+	c.ProvideSingleton("OAuthClientsPostService", func() any {
+		oauthRepo := c.Resolve("OAuthRepository", Singleton).(oauthservice.PostOAuthClientRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(oauthservice.PostOAuthClientCryptographyService)
+		return oauthservice.NewPostOAuthClientService(oauthRepo, cryptoService)
+	})
+	c.ProvideSingleton("OAuthConsentsDeleteService", func() any {
+		oauthRepo := c.Resolve("OAuthRepository", Singleton).(oauthservice.DeleteOAuthConsentRepository)
+		return oauthservice.NewDeleteOAuthConsentService(oauthRepo)
+	})
+	c.ProvideSingleton("OAuthConsentsGetService", func() any {
+		oauthRepo := c.Resolve("OAuthRepository", Singleton).(oauthservice.GetOAuthConsentsRepository)
+		return oauthservice.NewGetOAuthConsentsService(oauthRepo)
+	})
+	c.ProvideSingleton("OAuthTokenService", func() any {
+		oauthRepo := c.Resolve("OAuthRepository", Singleton).(oauthservice.TokenRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(oauthservice.TokenCryptographyService)
+		jwtService := c.Resolve("JwtGenerator", Singleton).(oauthservice.TokenJwtService)
+		return oauthservice.NewTokenService(oauthRepo, cryptoService, jwtService, service.OAuthAccessTokenLifetime)
+	})
+	/// Reminders
+	c.ProvideSingleton("PostAdoptionFollowupReminderService", func() any {
+		remindersRepo := c.Resolve("RemindersRepository", Singleton).(reminderservice.PostAdoptionFollowupRepository)
+		notifier := c.Resolve("Notifier", Singleton).(notifier.Notifier)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(reminderservice.ReminderLinkGenerator)
+		return reminderservice.NewPostAdoptionFollowupReminderService(remindersRepo, notifier, linkGenerator,
+			config.Scheduler.FollowupDays)
+	})
+	c.ProvideSingleton("VaccinationDueReminderService", func() any {
+		remindersRepo := c.Resolve("RemindersRepository", Singleton).(reminderservice.VaccinationDueRepository)
+		notifier := c.Resolve("Notifier", Singleton).(notifier.Notifier)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(reminderservice.ReminderLinkGenerator)
+		return reminderservice.NewVaccinationDueReminderService(remindersRepo, notifier, linkGenerator,
+			config.Scheduler.VaccinationLeadDays)
+	})
+	c.ProvideSingleton("Scheduler", func() any {
+		jobStore := c.Resolve("ScheduledJobsDataAccess", Singleton).(scheduler.JobStore)
+		leaderLock := dataaccess.NewAdvisoryLock(config.DatabaseConnector, schedulerLockKey)
+		jobScheduler := scheduler.NewScheduler(jobStore, leaderLock, 0)
+
+		vaccinationDueService := c.Resolve("VaccinationDueReminderService", Singleton).(reminderservice.VaccinationDueReminderService)
+		err := jobScheduler.AddJob(string(model.REMINDER_VACCINATION_DUE),
+			withDefault(config.Scheduler.VaccinationDueCron, "0 8 * * *"), vaccinationDueService.SendReminders)
+		if err != nil {
+			fmt.Fprint(os.Stderr, "Failed to schedule vaccination reminders: ", err.Error())
+			os.Exit(1)
+		}
+		followupService := c.Resolve("PostAdoptionFollowupReminderService", Singleton).(reminderservice.PostAdoptionFollowupReminderService)
+		err = jobScheduler.AddJob(string(model.REMINDER_POST_ADOPTION_FOLLOWUP),
+			withDefault(config.Scheduler.FollowupCron, "0 9 * * *"), followupService.SendReminders)
+		if err != nil {
+			fmt.Fprint(os.Stderr, "Failed to schedule adoption follow-ups: ", err.Error())
+			os.Exit(1)
+		}
+		savedSearchAlertService := c.Resolve("UserSavedSearchAlertService", Singleton).(usersavedsearchservice.SavedSearchAlertService)
+		err = jobScheduler.AddJob(model.SAVED_SEARCH_DIGEST_JOB,
+			withDefault(config.Scheduler.SavedSearchDigestCron, "0 7 * * *"), savedSearchAlertService.SendDigests)
+		if err != nil {
+			fmt.Fprint(os.Stderr, "Failed to schedule saved search digests: ", err.Error())
+			os.Exit(1)
+		}
+		notificationInbox := c.Resolve("UserNotificationInbox", Singleton).(usernotificationservice.NotificationInbox)
+		err = jobScheduler.AddJob(model.NOTIFICATION_RETENTION_JOB,
+			withDefault(config.Scheduler.NotificationRetentionCron, "0 3 * * *"), notificationInbox.DeleteExpiredNotifications)
+		if err != nil {
+			fmt.Fprint(os.Stderr, "Failed to schedule the deletion of expired notifications: ", err.Error())
+			os.Exit(1)
+		}
+		return jobScheduler
 	})
 	/// Users
 	c.ProvideSingleton("UsersDeleteService", func() any {
 		userRepo := c.Resolve("UsersRepository", Singleton).(usersservice.DeleteUsersRepository)
 		return usersservice.NewDeleteUsersService(userRepo)
 	})
+	c.ProvideSingleton("UsersExportService", func() any {
+		exportsRepo := c.Resolve("UserExportsRepository", Singleton).(usersservice.ExportUsersRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(usersservice.ExportUsersLinkGenerator)
+		return usersservice.NewExportUsersService(exportsRepo, linkGenerator)
+	})
 	c.ProvideSingleton("UsersGetMeService", func() any {
 		userRepo := c.Resolve("UsersRepository", Singleton).(usersservice.GetUsersMeRepository)
-		return usersservice.NewGetUsersMeService(userRepo)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(usersservice.UserLinksGenerator)
+		return usersservice.NewGetUsersMeService(userRepo, linkGenerator)
+	})
+	c.ProvideSingleton("UsersGetService", func() any {
+		userRepo := c.Resolve("UsersRepository", Singleton).(usersservice.GetUsersRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(usersservice.GetUsersLinkGenerator)
+		return usersservice.NewGetUsersService(userRepo, linkGenerator)
+	})
+	c.ProvideSingleton("UsersGetByIdService", func() any {
+		userRepo := c.Resolve("UsersRepository", Singleton).(usersservice.GetUserByIdRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(usersservice.UserLinksGenerator)
+		return usersservice.NewGetUserByIdService(userRepo, linkGenerator)
+	})
+	c.ProvideSingleton("UsersStatusService", func() any {
+		userRepo := c.Resolve("UsersRepository", Singleton).(usersservice.UserStatusRepository)
+		return usersservice.NewUserStatusService(userRepo)
+	})
+	c.ProvideSingleton("UsersSuspensionService", func() any {
+		userRepo := c.Resolve("UsersRepository", Singleton).(usersservice.UserSuspensionRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(usersservice.UserLinksGenerator)
+		return usersservice.NewUserSuspensionService(userRepo, linkGenerator)
+	})
+	c.ProvideSingleton("UsersUpdateService", func() any {
+		userRepo := c.Resolve("UsersRepository", Singleton).(usersservice.UpdateUsersRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(usersservice.UserLinksGenerator)
+		verificationSender := c.Resolve("UserEmailVerificationSender", Singleton).(usersservice.UpdateUsersEmailVerificationSender)
+		return usersservice.NewUpdateUsersService(userRepo, linkGenerator, verificationSender)
 	})
 	c.ProvideSingleton("UsersPostService", func() any {
 		userRepo := c.Resolve("UsersRepository", Singleton).(usersservice.PostUsersRepository)
 		cryptoService := c.Resolve("CryptographyService", Singleton).(usersservice.PostUsersCryptographyService)
-		return usersservice.NewPostUsersService(userRepo, cryptoService)
+		passwordPolicy := c.Resolve("PasswordPolicy", Singleton).(usersservice.PostUsersPasswordPolicy)
+		verificationSender := c.Resolve("UserEmailVerificationSender", Singleton).(usersservice.PostUsersEmailVerificationSender)
+		return usersservice.NewPostUsersService(userRepo, cryptoService, passwordPolicy, verificationSender)
+	})
+	//// Api keys
+	c.ProvideSingleton("UserApiKeysDeleteService", func() any {
+		userApiKeysRepo := c.Resolve("UserApiKeysRepository", Singleton).(userapikeyservice.DeleteUserApiKeyRepository)
+		return userapikeyservice.NewDeleteUserApiKeyService(userApiKeysRepo)
+	})
+	c.ProvideSingleton("UserApiKeysGetService", func() any {
+		userApiKeysRepo := c.Resolve("UserApiKeysRepository", Singleton).(userapikeyservice.GetUserApiKeysRepository)
+		return userapikeyservice.NewGetUserApiKeysService(userApiKeysRepo)
+	})
+	c.ProvideSingleton("UserApiKeysPostService", func() any {
+		userApiKeysRepo := c.Resolve("UserApiKeysRepository", Singleton).(userapikeyservice.PostUserApiKeysRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(userapikeyservice.PostUserApiKeysCryptographyService)
+		return userapikeyservice.NewPostUserApiKeyService(userApiKeysRepo, cryptoService)
+	})
+	c.ProvideSingleton("UserApiKeysValidateService", func() any {
+		userApiKeysRepo := c.Resolve("UserApiKeysRepository", Singleton).(userapikeyservice.ValidateApiKeyRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(userapikeyservice.ValidateApiKeyCryptographyService)
+		return userapikeyservice.NewValidateApiKeyService(userApiKeysRepo, cryptoService)
+	})
+	//// Email verification
+	c.ProvideSingleton("UserEmailVerificationSender", func() any {
+		emailVerificationsRepo := c.Resolve("EmailVerificationsRepository", Singleton).(useremailverificationservice.EmailVerificationSenderRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(useremailverificationservice.EmailVerificationCryptographyService)
+		mailer := c.Resolve("Mailer", Singleton).(useremailverificationservice.EmailVerificationMailer)
+		return useremailverificationservice.NewEmailVerificationSender(emailVerificationsRepo, cryptoService, mailer)
+	})
+	c.ProvideSingleton("UserEmailVerificationResendService", func() any {
+		emailVerificationsRepo := c.Resolve("EmailVerificationsRepository", Singleton).(useremailverificationservice.ResendEmailVerificationRepository)
+		verificationSender := c.Resolve("UserEmailVerificationSender", Singleton).(useremailverificationservice.VerificationEmailSender)
+		return useremailverificationservice.NewResendEmailVerificationService(emailVerificationsRepo, verificationSender)
+	})
+	c.ProvideSingleton("UserEmailVerificationVerifyService", func() any {
+		emailVerificationsRepo := c.Resolve("EmailVerificationsRepository", Singleton).(useremailverificationservice.VerifyEmailRepository)
+		cryptoService := c.Resolve("CryptographyService", Singleton).(useremailverificationservice.VerifyEmailCryptographyService)
+		return useremailverificationservice.NewVerifyEmailService(emailVerificationsRepo, cryptoService)
+	})
+	//// Favorites
+	c.ProvideSingleton("UserFavoritesAddService", func() any {
+		userFavoritesRepo := c.Resolve("UserFavoritesRepository", Singleton).(userfavoriteservice.AddFavoriteRepository)
+		return userfavoriteservice.NewAddFavoriteService(userFavoritesRepo)
+	})
+	c.ProvideSingleton("UserFavoritesGetService", func() any {
+		userFavoritesRepo := c.Resolve("UserFavoritesRepository", Singleton).(userfavoriteservice.GetFavoritesRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(userfavoriteservice.GetFavoritesLinkGenerator)
+		return userfavoriteservice.NewGetFavoritesService(userFavoritesRepo, linkGenerator)
+	})
+	c.ProvideSingleton("UserFavoritesRemoveService", func() any {
+		userFavoritesRepo := c.Resolve("UserFavoritesRepository", Singleton).(userfavoriteservice.RemoveFavoriteRepository)
+		return userfavoriteservice.NewRemoveFavoriteService(userFavoritesRepo)
+	})
+	c.ProvideSingleton("UserFavoriteStatusNotifier", func() any {
+		userFavoritesRepo := c.Resolve("UserFavoritesRepository", Singleton).(userfavoriteservice.FavoriteStatusNotifierRepository)
+		webhookDispatcher := c.Resolve("WebhookDispatcher", Singleton).(userfavoriteservice.FavoriteStatusChangedWebhookDispatcher)
+		notifier := c.Resolve("Notifier", Singleton).(notifier.Notifier)
+		return userfavoriteservice.NewFavoriteStatusNotifier(userFavoritesRepo, webhookDispatcher, notifier)
+	})
+	//// Notifications
+	c.ProvideSingleton("UserNotificationInbox", func() any {
+		userNotificationsRepo := c.Resolve("UserNotificationsRepository", Singleton).(usernotificationservice.NotificationInboxRepository)
+		return usernotificationservice.NewNotificationInbox(userNotificationsRepo, config.Notifications.MaxPerUser,
+			config.Notifications.RetentionDays)
+	})
+	c.ProvideSingleton("UserNotificationsGetService", func() any {
+		userNotificationsRepo := c.Resolve("UserNotificationsRepository", Singleton).(usernotificationservice.GetNotificationsRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(usernotificationservice.GetNotificationsLinkGenerator)
+		return usernotificationservice.NewGetNotificationsService(userNotificationsRepo, linkGenerator)
+	})
+	c.ProvideSingleton("UserNotificationsReadService", func() any {
+		userNotificationsRepo := c.Resolve("UserNotificationsRepository", Singleton).(usernotificationservice.ReadNotificationsRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(usernotificationservice.NotificationLinkGenerator)
+		return usernotificationservice.NewReadNotificationsService(userNotificationsRepo, linkGenerator)
+	})
+	//// Saved searches
+	c.ProvideSingleton("UserSavedSearchesDeleteService", func() any {
+		savedSearchesRepo := c.Resolve("SavedSearchesRepository", Singleton).(usersavedsearchservice.DeleteSavedSearchRepository)
+		return usersavedsearchservice.NewDeleteSavedSearchService(savedSearchesRepo)
+	})
+	c.ProvideSingleton("UserSavedSearchesGetService", func() any {
+		savedSearchesRepo := c.Resolve("SavedSearchesRepository", Singleton).(usersavedsearchservice.GetSavedSearchesRepository)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(usersavedsearchservice.SavedSearchLinkGenerator)
+		return usersavedsearchservice.NewGetSavedSearchesService(savedSearchesRepo, linkGenerator)
+	})
+	c.ProvideSingleton("UserSavedSearchesPostService", func() any {
+		savedSearchesRepo := c.Resolve("SavedSearchesRepository", Singleton).(usersavedsearchservice.PostSavedSearchRepository)
+		queryParser := c.Resolve("QueryParamsMiddleware", Transient).(usersavedsearchservice.SavedSearchQueryParser)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(usersavedsearchservice.SavedSearchLinkGenerator)
+		return usersavedsearchservice.NewPostSavedSearchService(savedSearchesRepo, queryParser, linkGenerator)
+	})
+	c.ProvideSingleton("UserSavedSearchesPutService", func() any {
+		savedSearchesRepo := c.Resolve("SavedSearchesRepository", Singleton).(usersavedsearchservice.PutSavedSearchRepository)
+		queryParser := c.Resolve("QueryParamsMiddleware", Transient).(usersavedsearchservice.SavedSearchQueryParser)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(usersavedsearchservice.SavedSearchLinkGenerator)
+		return usersavedsearchservice.NewPutSavedSearchService(savedSearchesRepo, queryParser, linkGenerator)
+	})
+	c.ProvideSingleton("UserSavedSearchAlertService", func() any {
+		savedSearchesRepo := c.Resolve("SavedSearchesRepository", Singleton).(usersavedsearchservice.SavedSearchAlertRepository)
+		queryParser := c.Resolve("QueryParamsMiddleware", Transient).(usersavedsearchservice.SavedSearchQueryParser)
+		webhookDispatcher := c.Resolve("WebhookDispatcher", Singleton).(usersavedsearchservice.SavedSearchMatchWebhookDispatcher)
+		inbox := c.Resolve("UserNotificationInbox", Singleton).(usersavedsearchservice.SavedSearchMatchInbox)
+		notifier := c.Resolve("Notifier", Singleton).(notifier.Notifier)
+		linkGenerator := c.Resolve("HateoasLinkGenerator", Singleton).(usersavedsearchservice.SavedSearchAlertLinkGenerator)
+		return usersavedsearchservice.NewSavedSearchAlertService(savedSearchesRepo, queryParser, webhookDispatcher,
+			inbox, notifier, linkGenerator)
 	})
 	//// Webhooks
 	c.ProvideSingleton("UserWebhooksDataValidator", func() any {
@@ -209,6 +1005,32 @@ func SetupContainer(config ContainerConfig) *Container {
 		return handlers.NewRequestBodyValidator()
 	})
 
+	/// Admin
+	c.ProvideTransient("AdminDeleteHandler", func() any {
+		service := c.Resolve("AdminsDeleteService", Singleton).(adminhandler.DeleteAdminService)
+		return adminhandler.NewDeleteAdminHandler(service)
+	})
+	c.ProvideTransient("AdminGetByIdHandler", func() any {
+		service := c.Resolve("AdminsGetByIdService", Singleton).(adminhandler.GetAdminByIdService)
+		return adminhandler.NewGetAdminByIdHandler(service)
+	})
+	c.ProvideTransient("AdminGetHandler", func() any {
+		service := c.Resolve("AdminsGetService", Singleton).(adminhandler.GetAdminsService)
+		return adminhandler.NewGetAdminsHandler(service)
+	})
+	c.ProvideTransient("AdminPasswordHandler", func() any {
+		service := c.Resolve("AdminsPasswordChangeService", Singleton).(adminhandler.ChangeAdminPasswordService)
+		return adminhandler.NewChangeAdminPasswordHandler(service)
+	})
+	c.ProvideTransient("AdminPostHandler", func() any {
+		service := c.Resolve("AdminsPostService", Singleton).(adminhandler.PostAdminService)
+		return adminhandler.NewPostAdminHandler(service)
+	})
+	c.ProvideTransient("AdminPutHandler", func() any {
+		service := c.Resolve("AdminsPutService", Singleton).(adminhandler.PutAdminService)
+		return adminhandler.NewPutAdminHandler(service)
+	})
+
 	/// Api
 	c.ProvideTransient("ApiHandler", func() any {
 		apiService := c.Resolve("ApiService", Singleton).(apihandler.ApiService)
@@ -221,6 +1043,65 @@ func SetupContainer(config ContainerConfig) *Container {
 		reqBodyValidator := c.Resolve("RequestBodyValidator", Singleton).(authhandler.RequestBodyValidator)
 		return authhandler.NewLoginHandler(authService, reqBodyValidator)
 	})
+	//// Mfa
+	c.ProvideTransient("MfaChallengeHandler", func() any {
+		service := c.Resolve("MfaChallengeService", Singleton).(mfahandler.ChallengeMfaService)
+		return mfahandler.NewChallengeMfaHandler(service)
+	})
+	c.ProvideTransient("MfaDisableHandler", func() any {
+		service := c.Resolve("MfaDisableService", Singleton).(mfahandler.DisableMfaService)
+		return mfahandler.NewDisableMfaHandler(service)
+	})
+	c.ProvideTransient("MfaEnrollHandler", func() any {
+		service := c.Resolve("MfaEnrollService", Singleton).(mfahandler.EnrollMfaService)
+		return mfahandler.NewEnrollMfaHandler(service)
+	})
+	c.ProvideTransient("MfaPoliciesGetHandler", func() any {
+		service := c.Resolve("MfaPoliciesGetService", Singleton).(mfahandler.GetMfaPoliciesService)
+		return mfahandler.NewGetMfaPoliciesHandler(service)
+	})
+	c.ProvideTransient("MfaPolicyPutHandler", func() any {
+		service := c.Resolve("MfaPolicyPutService", Singleton).(mfahandler.PutMfaPolicyService)
+		return mfahandler.NewPutMfaPolicyHandler(service)
+	})
+	c.ProvideTransient("MfaVerifyHandler", func() any {
+		service := c.Resolve("MfaVerifyService", Singleton).(mfahandler.VerifyMfaService)
+		return mfahandler.NewVerifyMfaHandler(service)
+	})
+	c.ProvideTransient("PasswordForgotHandler", func() any {
+		service := c.Resolve("PasswordForgotService", Singleton).(authhandler.ForgotPasswordService)
+		return authhandler.NewForgotPasswordHandler(service)
+	})
+	c.ProvideTransient("PasswordResetHandler", func() any {
+		service := c.Resolve("PasswordResetService", Singleton).(authhandler.ResetPasswordService)
+		return authhandler.NewResetPasswordHandler(service)
+	})
+	/// Breed
+	c.ProvideTransient("BreedDeleteHandler", func() any {
+		service := c.Resolve("BreedsDeleteService", Singleton).(breedhandler.DeleteBreedService)
+		return breedhandler.NewDeleteBreedHandler(service)
+	})
+	c.ProvideTransient("BreedGetByIdHandler", func() any {
+		service := c.Resolve("BreedsGetByIdService", Singleton).(breedhandler.GetBreedByIdService)
+		return breedhandler.NewGetBreedByIdHandler(service)
+	})
+	c.ProvideTransient("BreedGetHandler", func() any {
+		service := c.Resolve("BreedsGetService", Singleton).(breedhandler.GetBreedsService)
+		return breedhandler.NewGetBreedsHandler(service)
+	})
+	c.ProvideTransient("BreedMergeHandler", func() any {
+		service := c.Resolve("BreedsMergeService", Singleton).(breedhandler.MergeBreedService)
+		return breedhandler.NewMergeBreedHandler(service)
+	})
+	c.ProvideTransient("BreedPostHandler", func() any {
+		service := c.Resolve("BreedsPostService", Singleton).(breedhandler.PostBreedService)
+		return breedhandler.NewPostBreedHandler(service)
+	})
+	c.ProvideTransient("BreedPutHandler", func() any {
+		service := c.Resolve("BreedsPutService", Singleton).(breedhandler.PutBreedService)
+		return breedhandler.NewPutBreedHandler(service)
+	})
+
 	/// Dog
 	c.ProvideTransient("DogDeleteHandler", func() any {
 		service := c.Resolve("DogsDeleteService", Singleton).(doghandler.DeleteDogService)
@@ -242,6 +1123,79 @@ func SetupContainer(config ContainerConfig) *Container {
 		service := c.Resolve("DogsPutService", Singleton).(doghandler.PutDogService)
 		return doghandler.NewPutDogHandler(service)
 	})
+	c.ProvideTransient("DogStatusHistoryHandler", func() any {
+		service := c.Resolve("DogsStatusHistoryService", Singleton).(doghandler.GetDogStatusHistoryService)
+		return doghandler.NewGetDogStatusHistoryHandler(service)
+	})
+	//// Transfers
+	c.ProvideTransient("DogMedicalRecordDeleteHandler", func() any {
+		service := c.Resolve("DogMedicalRecordsDeleteService", Singleton).(dogmedicalhandler.DeleteDogMedicalRecordService)
+		return dogmedicalhandler.NewDeleteDogMedicalRecordHandler(service)
+	})
+	c.ProvideTransient("DogMedicalRecordGetByIdHandler", func() any {
+		service := c.Resolve("DogMedicalRecordsGetService", Singleton).(dogmedicalhandler.GetDogMedicalRecordService)
+		return dogmedicalhandler.NewGetDogMedicalRecordHandler(service)
+	})
+	c.ProvideTransient("DogMedicalRecordGetHandler", func() any {
+		service := c.Resolve("DogMedicalRecordsGetService", Singleton).(dogmedicalhandler.GetDogMedicalRecordsService)
+		return dogmedicalhandler.NewGetDogMedicalRecordsHandler(service)
+	})
+	c.ProvideTransient("DogMedicalRecordPostHandler", func() any {
+		service := c.Resolve("DogMedicalRecordsPostService", Singleton).(dogmedicalhandler.PostDogMedicalRecordService)
+		return dogmedicalhandler.NewPostDogMedicalRecordHandler(service)
+	})
+	c.ProvideTransient("DogMedicalRecordPutHandler", func() any {
+		service := c.Resolve("DogMedicalRecordsPutService", Singleton).(dogmedicalhandler.PutDogMedicalRecordService)
+		return dogmedicalhandler.NewPutDogMedicalRecordHandler(service)
+	})
+	c.ProvideTransient("DogPhotoDeleteHandler", func() any {
+		service := c.Resolve("DogPhotosDeleteService", Singleton).(dogphotohandler.DeleteDogPhotoService)
+		return dogphotohandler.NewDeleteDogPhotoHandler(service)
+	})
+	c.ProvideTransient("DogPhotoFileHandler", func() any {
+		service := c.Resolve("DogPhotosFileService", Singleton).(dogphotohandler.GetDogPhotoFileService)
+		return dogphotohandler.NewGetDogPhotoFileHandler(service)
+	})
+	c.ProvideTransient("DogPhotoGetByIdHandler", func() any {
+		service := c.Resolve("DogPhotosGetService", Singleton).(dogphotohandler.GetDogPhotoService)
+		return dogphotohandler.NewGetDogPhotoHandler(service)
+	})
+	c.ProvideTransient("DogPhotoGetHandler", func() any {
+		service := c.Resolve("DogPhotosGetService", Singleton).(dogphotohandler.GetDogPhotosService)
+		return dogphotohandler.NewGetDogPhotosHandler(service)
+	})
+	c.ProvideTransient("DogPhotoPostHandler", func() any {
+		service := c.Resolve("DogPhotosUploadService", Singleton).(dogphotohandler.UploadDogPhotoService)
+		return dogphotohandler.NewUploadDogPhotoHandler(service)
+	})
+	c.ProvideTransient("DogPhotoPutHandler", func() any {
+		service := c.Resolve("DogPhotosUpdateService", Singleton).(dogphotohandler.UpdateDogPhotoService)
+		return dogphotohandler.NewUpdateDogPhotoHandler(service)
+	})
+	c.ProvideTransient("DogTransferAcceptHandler", func() any {
+		service := c.Resolve("DogTransfersDecideService", Singleton).(dogtransferhandler.AcceptDogTransferService)
+		return dogtransferhandler.NewAcceptDogTransferHandler(service)
+	})
+	c.ProvideTransient("DogTransferCancelHandler", func() any {
+		service := c.Resolve("DogTransfersDecideService", Singleton).(dogtransferhandler.CancelDogTransferService)
+		return dogtransferhandler.NewCancelDogTransferHandler(service)
+	})
+	c.ProvideTransient("DogTransferDeclineHandler", func() any {
+		service := c.Resolve("DogTransfersDecideService", Singleton).(dogtransferhandler.DeclineDogTransferService)
+		return dogtransferhandler.NewDeclineDogTransferHandler(service)
+	})
+	c.ProvideTransient("DogTransferGetByIdHandler", func() any {
+		service := c.Resolve("DogTransfersGetService", Singleton).(dogtransferhandler.GetDogTransferByIdService)
+		return dogtransferhandler.NewGetDogTransferByIdHandler(service)
+	})
+	c.ProvideTransient("DogTransferGetHandler", func() any {
+		service := c.Resolve("DogTransfersGetService", Singleton).(dogtransferhandler.GetDogTransfersService)
+		return dogtransferhandler.NewGetDogTransfersHandler(service)
+	})
+	c.ProvideTransient("DogTransferPostHandler", func() any {
+		service := c.Resolve("DogTransfersProposeService", Singleton).(dogtransferhandler.ProposeDogTransferService)
+		return dogtransferhandler.NewProposeDogTransferHandler(service)
+	})
 	/// DogShelter
 	c.ProvideTransient("DogShelterDeleteHandler", func() any {
 		service := c.Resolve("DogSheltersDeleteService", Singleton).(dogshelterhandler.DeleteDogShelterService)
@@ -255,6 +1209,10 @@ func SetupContainer(config ContainerConfig) *Container {
 		service := c.Resolve("DogSheltersGetService", Singleton).(dogshelterhandler.GetDogShelterService)
 		return dogshelterhandler.NewGetDogShelterHandler(service)
 	})
+	c.ProvideTransient("DogShelterPasswordHandler", func() any {
+		service := c.Resolve("DogSheltersPasswordChangeService", Singleton).(dogshelterhandler.ChangeDogShelterPasswordService)
+		return dogshelterhandler.NewChangeDogShelterPasswordHandler(service)
+	})
 	c.ProvideTransient("DogShelterPostHandler", func() any {
 		service := c.Resolve("DogSheltersPostService", Singleton).(dogshelterhandler.PostDogShelterService)
 		return dogshelterhandler.NewPostShelterHandler(service)
@@ -263,20 +1221,187 @@ func SetupContainer(config ContainerConfig) *Container {
 		service := c.Resolve("DogSheltersPutService", Singleton).(dogshelterhandler.PutDogShelterService)
 		return dogshelterhandler.NewPutDogShelterHandler(service)
 	})
+	c.ProvideTransient("DogShelterRegisterHandler", func() any {
+		service := c.Resolve("DogSheltersRegisterService", Singleton).(dogshelterhandler.RegisterDogShelterService)
+		return dogshelterhandler.NewRegisterDogShelterHandler(service)
+	})
+	c.ProvideTransient("ShelterRegistrationApproveHandler", func() any {
+		service := c.Resolve("DogSheltersRegistrationsService", Singleton).(dogshelterhandler.ApproveShelterRegistrationService)
+		return dogshelterhandler.NewApproveShelterRegistrationHandler(service)
+	})
+	c.ProvideTransient("ShelterRegistrationGetByIdHandler", func() any {
+		service := c.Resolve("DogSheltersRegistrationsService", Singleton).(dogshelterhandler.GetShelterRegistrationByIdService)
+		return dogshelterhandler.NewGetShelterRegistrationByIdHandler(service)
+	})
+	c.ProvideTransient("ShelterRegistrationRejectHandler", func() any {
+		service := c.Resolve("DogSheltersRegistrationsService", Singleton).(dogshelterhandler.RejectShelterRegistrationService)
+		return dogshelterhandler.NewRejectShelterRegistrationHandler(service)
+	})
+	c.ProvideTransient("ShelterRegistrationsGetHandler", func() any {
+		service := c.Resolve("DogSheltersRegistrationsService", Singleton).(dogshelterhandler.GetShelterRegistrationsService)
+		return dogshelterhandler.NewGetShelterRegistrationsHandler(service)
+	})
+	//// Staff
+	c.ProvideTransient("ShelterStaffAcceptHandler", func() any {
+		service := c.Resolve("ShelterStaffAcceptService", Singleton).(shelterstaffhandler.AcceptStaffInvitationService)
+		return shelterstaffhandler.NewAcceptStaffInvitationHandler(service)
+	})
+	c.ProvideTransient("ShelterStaffDeleteHandler", func() any {
+		service := c.Resolve("ShelterStaffDeleteService", Singleton).(shelterstaffhandler.DeleteShelterStaffService)
+		return shelterstaffhandler.NewDeleteShelterStaffHandler(service)
+	})
+	c.ProvideTransient("ShelterStaffGetHandler", func() any {
+		service := c.Resolve("ShelterStaffGetService", Singleton).(shelterstaffhandler.GetShelterStaffService)
+		return shelterstaffhandler.NewGetShelterStaffHandler(service)
+	})
+	c.ProvideTransient("ShelterStaffInvitationDeleteHandler", func() any {
+		service := c.Resolve("ShelterStaffInvitationsService", Singleton).(shelterstaffhandler.RevokeStaffInvitationService)
+		return shelterstaffhandler.NewDeleteStaffInvitationHandler(service)
+	})
+	c.ProvideTransient("ShelterStaffInvitationsGetHandler", func() any {
+		service := c.Resolve("ShelterStaffInvitationsService", Singleton).(shelterstaffhandler.GetStaffInvitationsService)
+		return shelterstaffhandler.NewGetStaffInvitationsHandler(service)
+	})
+	c.ProvideTransient("ShelterStaffInviteHandler", func() any {
+		service := c.Resolve("ShelterStaffInviteService", Singleton).(shelterstaffhandler.InviteShelterStaffService)
+		return shelterstaffhandler.NewInviteShelterStaffHandler(service)
+	})
+	c.ProvideTransient("ShelterStaffPasswordChangeHandler", func() any {
+		service := c.Resolve("ShelterStaffPasswordChangeService", Singleton).(shelterstaffhandler.ChangeShelterStaffPasswordService)
+		return shelterstaffhandler.NewChangeShelterStaffPasswordHandler(service)
+	})
+	c.ProvideTransient("ShelterStaffPutHandler", func() any {
+		service := c.Resolve("ShelterStaffUpdateService", Singleton).(shelterstaffhandler.UpdateShelterStaffService)
+		return shelterstaffhandler.NewPutShelterStaffHandler(service)
+	})
+
 	/// Middleware
 	c.ProvideTransient("AuthMiddleware", func() any {
 		jwtGenerator := c.Resolve("JwtGenerator", Singleton).(middleware.JwtService)
-		return middleware.NewAuthMiddleware(jwtGenerator)
+		apiKeyService := c.Resolve("UserApiKeysValidateService", Singleton).(middleware.ApiKeyService)
+		userStatusService := c.Resolve("UsersStatusService", Singleton).(middleware.UserStatusService)
+		membershipService := c.Resolve("ShelterStaffMembershipService", Singleton).(middleware.ShelterMembershipService)
+		return middleware.NewAuthMiddleware(jwtGenerator, apiKeyService, userStatusService, membershipService)
 	})
 	c.ProvideTransient("QueryParamsMiddleware", func() any {
 		return middleware.NewQueryParamsValidator()
 	})
+	/// OAuth
+	c.ProvideTransient("OAuthAuthorizeGetHandler", func() any {
+		service := c.Resolve("OAuthAuthorizeGetService", Singleton).(oauthhandler.GetAuthorizeService)
+		return oauthhandler.NewGetAuthorizeHandler(service)
+	})
+	c.ProvideTransient("OAuthAuthorizePostHandler", func() any {
+		service := c.Resolve("OAuthAuthorizePostService", Singleton).(oauthhandler.PostAuthorizeService)
+		return oauthhandler.NewPostAuthorizeHandler(service)
+	})
+	c.ProvideTransient("OAuthClientDeleteHandler", func() any {
+		service := c.Resolve("OAuthClientsDeleteService", Singleton).(oauthhandler.DeleteOAuthClientService)
+		return oauthhandler.NewDeleteOAuthClientHandler(service)
+	})
+	c.ProvideTransient("OAuthClientGetHandler", func() any {
+		service := c.Resolve("OAuthClientsGetService", Singleton).(oauthhandler.GetOAuthClientsService)
+		return oauthhandler.NewGetOAuthClientsHandler(service)
+	})
+	c.ProvideTransient("OAuthClientPostHandler", func() any {
+		service := c.Resolve("OAuthClientsPostService", Singleton).(oauthhandler.PostOAuthClientService)
+		return oauthhandler.NewPostOAuthClientHandler(service)
+	})
+	c.ProvideTransient("OAuthConsentDeleteHandler", func() any {
+		service := c.Resolve("OAuthConsentsDeleteService", Singleton).(oauthhandler.DeleteOAuthConsentService)
+		return oauthhandler.NewDeleteOAuthConsentHandler(service)
+	})
+	c.ProvideTransient("OAuthConsentGetHandler", func() any {
+		service := c.Resolve("OAuthConsentsGetService", Singleton).(oauthhandler.GetOAuthConsentsService)
+		return oauthhandler.NewGetOAuthConsentsHandler(service)
+	})
+	c.ProvideTransient("OAuthTokenHandler", func() any {
+		service := c.Resolve("OAuthTokenService", Singleton).(oauthhandler.TokenService)
+		return oauthhandler.NewTokenHandler(service)
+	})
 	/// User
 	//// Me
 	c.ProvideTransient("UserGetMeHandler", func() any {
 		userMeService := c.Resolve("UsersGetMeService", Singleton).(usermehandler.GetUserMeService)
 		return usermehandler.NewGetUserMeHandler(userMeService)
 	})
+	c.ProvideTransient("UserGetMeRecommendationsHandler", func() any {
+		service := c.Resolve("DogsRecommendService", Singleton).(usermehandler.GetUserMeRecommendationsService)
+		return usermehandler.NewGetUserMeRecommendationsHandler(service)
+	})
+	//// Api key
+	c.ProvideTransient("UserApiKeyDeleteHandler", func() any {
+		service := c.Resolve("UserApiKeysDeleteService", Singleton).(userapikeyhandler.DeleteUserApiKeyService)
+		return userapikeyhandler.NewDeleteUserApiKeyHandler(service)
+	})
+	c.ProvideTransient("UserApiKeyGetHandler", func() any {
+		service := c.Resolve("UserApiKeysGetService", Singleton).(userapikeyhandler.GetUserApiKeysService)
+		return userapikeyhandler.NewGetUserApiKeysHandler(service)
+	})
+	c.ProvideTransient("UserApiKeyPostHandler", func() any {
+		service := c.Resolve("UserApiKeysPostService", Singleton).(userapikeyhandler.PostUserApiKeyService)
+		return userapikeyhandler.NewPostUserApiKeyHandler(service)
+	})
+	//// Email verification
+	c.ProvideTransient("UserEmailVerificationResendHandler", func() any {
+		service := c.Resolve("UserEmailVerificationResendService", Singleton).(useremailverificationhandler.ResendEmailVerificationService)
+		return useremailverificationhandler.NewResendEmailVerificationHandler(service)
+	})
+	c.ProvideTransient("UserEmailVerificationVerifyHandler", func() any {
+		service := c.Resolve("UserEmailVerificationVerifyService", Singleton).(useremailverificationhandler.VerifyEmailService)
+		return useremailverificationhandler.NewVerifyEmailHandler(service)
+	})
+	//// Favorite
+	c.ProvideTransient("UserFavoriteDeleteHandler", func() any {
+		service := c.Resolve("UserFavoritesRemoveService", Singleton).(userfavoritehandler.RemoveFavoriteService)
+		return userfavoritehandler.NewDeleteFavoriteHandler(service)
+	})
+	c.ProvideTransient("UserFavoriteGetHandler", func() any {
+		service := c.Resolve("UserFavoritesGetService", Singleton).(userfavoritehandler.GetFavoritesService)
+		return userfavoritehandler.NewGetFavoritesHandler(service)
+	})
+	c.ProvideTransient("UserFavoritePutHandler", func() any {
+		service := c.Resolve("UserFavoritesAddService", Singleton).(userfavoritehandler.AddFavoriteService)
+		return userfavoritehandler.NewPutFavoriteHandler(service)
+	})
+	//// Notification
+	c.ProvideTransient("UserNotificationGetHandler", func() any {
+		service := c.Resolve("UserNotificationsGetService", Singleton).(usernotificationhandler.GetNotificationsService)
+		return usernotificationhandler.NewGetNotificationsHandler(service)
+	})
+	c.ProvideTransient("UserNotificationReadAllHandler", func() any {
+		service := c.Resolve("UserNotificationsReadService", Singleton).(usernotificationhandler.ReadAllNotificationsService)
+		return usernotificationhandler.NewReadAllNotificationsHandler(service)
+	})
+	c.ProvideTransient("UserNotificationReadHandler", func() any {
+		service := c.Resolve("UserNotificationsReadService", Singleton).(usernotificationhandler.ReadNotificationService)
+		return usernotificationhandler.NewReadNotificationHandler(service)
+	})
+	c.ProvideTransient("UserNotificationUnreadCountHandler", func() any {
+		service := c.Resolve("UserNotificationsGetService", Singleton).(usernotificationhandler.GetUnreadCountService)
+		return usernotificationhandler.NewGetUnreadCountHandler(service)
+	})
+	//// Saved search
+	c.ProvideTransient("UserSavedSearchDeleteHandler", func() any {
+		service := c.Resolve("UserSavedSearchesDeleteService", Singleton).(usersavedsearchhandler.DeleteSavedSearchService)
+		return usersavedsearchhandler.NewDeleteSavedSearchHandler(service)
+	})
+	c.ProvideTransient("UserSavedSearchGetByIdHandler", func() any {
+		service := c.Resolve("UserSavedSearchesGetService", Singleton).(usersavedsearchhandler.GetSavedSearchService)
+		return usersavedsearchhandler.NewGetSavedSearchByIdHandler(service)
+	})
+	c.ProvideTransient("UserSavedSearchGetHandler", func() any {
+		service := c.Resolve("UserSavedSearchesGetService", Singleton).(usersavedsearchhandler.GetSavedSearchesService)
+		return usersavedsearchhandler.NewGetSavedSearchesHandler(service)
+	})
+	c.ProvideTransient("UserSavedSearchPostHandler", func() any {
+		service := c.Resolve("UserSavedSearchesPostService", Singleton).(usersavedsearchhandler.PostSavedSearchService)
+		return usersavedsearchhandler.NewPostSavedSearchHandler(service)
+	})
+	c.ProvideTransient("UserSavedSearchPutHandler", func() any {
+		service := c.Resolve("UserSavedSearchesPutService", Singleton).(usersavedsearchhandler.PutSavedSearchService)
+		return usersavedsearchhandler.NewPutSavedSearchHandler(service)
+	})
 	//// Webhook
 	c.ProvideTransient("UserWebhookDeleteHandler", func() any {
 		service := c.Resolve("UserWebhooksDeleteService", Singleton).(userwebhookhandler.DeleteUserWebhookService)
@@ -299,6 +1424,38 @@ func SetupContainer(config ContainerConfig) *Container {
 		service := c.Resolve("UsersDeleteService", Singleton).(userhandler.DeleteUserService)
 		return userhandler.NewDeleteUserHandler(service)
 	})
+	c.ProvideTransient("UserExportHandler", func() any {
+		service := c.Resolve("UsersExportService", Singleton).(userhandler.ExportUserService)
+		return userhandler.NewExportUserHandler(service)
+	})
+	c.ProvideTransient("UserGetHandler", func() any {
+		service := c.Resolve("UsersGetService", Singleton).(userhandler.GetUsersService)
+		return userhandler.NewGetUsersHandler(service)
+	})
+	c.ProvideTransient("UserGetByIdHandler", func() any {
+		service := c.Resolve("UsersGetByIdService", Singleton).(userhandler.GetUserByIdService)
+		return userhandler.NewGetUserByIdHandler(service)
+	})
+	c.ProvideTransient("UserPatchHandler", func() any {
+		service := c.Resolve("UsersUpdateService", Singleton).(userhandler.PatchUserService)
+		return userhandler.NewPatchUserHandler(service)
+	})
+	c.ProvideTransient("UserSuspendHandler", func() any {
+		service := c.Resolve("UsersSuspensionService", Singleton).(userhandler.SuspendUserService)
+		return userhandler.NewSuspendUserHandler(service)
+	})
+	c.ProvideTransient("UserUnsuspendHandler", func() any {
+		service := c.Resolve("UsersSuspensionService", Singleton).(userhandler.UnsuspendUserService)
+		return userhandler.NewUnsuspendUserHandler(service)
+	})
+	c.ProvideTransient("UserPutHandler", func() any {
+		service := c.Resolve("UsersUpdateService", Singleton).(userhandler.PutUserService)
+		return userhandler.NewPutUserHandler(service)
+	})
+	c.ProvideTransient("UserPasswordHandler", func() any {
+		service := c.Resolve("UsersPasswordChangeService", Singleton).(userhandler.ChangeUserPasswordService)
+		return userhandler.NewChangeUserPasswordHandler(service)
+	})
 	c.ProvideTransient("UserPostHandler", func() any {
 		service := c.Resolve("UsersPostService", Singleton).(userhandler.PostUserService)
 		return userhandler.NewPostUserHandler(service)
@@ -1,10 +1,13 @@
 package dataaccess
 
 import (
+	"1dv027/aad/internal/dto"
+	admindto "1dv027/aad/internal/dto/admin"
 	customerrors "1dv027/aad/internal/errors"
 	"1dv027/aad/internal/model"
 	"context"
 	"errors"
+	"slices"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -22,9 +25,8 @@ func NewAdminsDataAccess(dbPool *pgxpool.Pool) AdminsDataAccess {
 
 func (a AdminsDataAccess) GetAdminByUsername(ctx context.Context, username string) (model.Admin, error) {
 	emptyModel := model.Admin{}
-	var admin model.Admin
 	query := `SELECT * FROM Admins WHERE username = $1;`
-	err := a.dbPool.QueryRow(ctx, query, username).Scan(&admin.Id, &admin.Username, &admin.Password)
+	admin, err := scanAdmin(a.dbPool.QueryRow(ctx, query, username))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return emptyModel, &customerrors.AdminNotFoundError{}
@@ -34,3 +36,127 @@ func (a AdminsDataAccess) GetAdminByUsername(ctx context.Context, username strin
 
 	return admin, nil
 }
+
+func (a AdminsDataAccess) GetAdminById(ctx context.Context, adminId int) (model.Admin, error) {
+	emptyModel := model.Admin{}
+	query := `SELECT * FROM Admins WHERE id = $1;`
+	admin, err := scanAdmin(a.dbPool.QueryRow(ctx, query, adminId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return emptyModel, &customerrors.AdminNotFoundError{}
+		}
+		return emptyModel, &customerrors.DatabaseError{}
+	}
+	return admin, nil
+}
+
+func (a AdminsDataAccess) GetAdmins(ctx context.Context, queryParams dto.QueryParams) (admindto.GetAdminsQueryResponseDTO, error) {
+	emptyDto := admindto.GetAdminsQueryResponseDTO{}
+	qb := NewQueryBuilder("SELECT * FROM Admins")
+	qb.withOrderBy("id")
+	paginationParams := queryParams.Pagination
+	if paginationParams != nil {
+		if paginationParams.Limit != nil {
+			qb.withLimit(*paginationParams.Limit)
+		}
+		if paginationParams.Page != nil {
+			qb.withPage(*paginationParams.Page)
+		}
+	}
+
+	query, filterValues := qb.build()
+	rows, err := a.dbPool.Query(ctx, query, filterValues...)
+	if err != nil {
+		return emptyDto, &customerrors.DatabaseError{}
+	}
+	admins, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.Admin, error) {
+		return scanAdmin(row)
+	})
+	if err != nil {
+		return emptyDto, &customerrors.DatabaseError{Message: "getAdmins had a database error"}
+	}
+
+	var totalCount int
+	countQuery, _ := qb.buildForTotalCount()
+	err = a.dbPool.QueryRow(ctx, countQuery, filterValues...).Scan(&totalCount)
+	if err != nil {
+		return emptyDto, &customerrors.DatabaseError{}
+	}
+
+	return admindto.GetAdminsQueryResponseDTO{
+		Admins:               admins,
+		TotalAmountAvailable: totalCount,
+	}, nil
+}
+
+func (a AdminsDataAccess) CreateAdmin(ctx context.Context, username, passwordHash string) (int, error) {
+	query := `INSERT INTO Admins (username, password) VALUES ($1, $2) RETURNING id`
+	var id int
+	err := a.dbPool.QueryRow(ctx, query, username, passwordHash).Scan(&id)
+	if err != nil {
+		return 0, &customerrors.DatabaseError{}
+	}
+	return id, nil
+}
+
+func (a AdminsDataAccess) UpdateAdminUsername(ctx context.Context, adminId int, username string) error {
+	query := `UPDATE Admins SET username = $1 WHERE id = $2`
+	result, err := a.dbPool.Exec(ctx, query, username, adminId)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.AdminNotFoundError{}
+	}
+	return nil
+}
+
+// DeleteAdmin deletes the admin together with its MFA enrollment and password reset tokens.
+// The admin rows are locked so that two concurrent deletes can not remove the last admin.
+func (a AdminsDataAccess) DeleteAdmin(ctx context.Context, adminId int) error {
+	tx, err := a.dbPool.Begin(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `SELECT id FROM Admins FOR UPDATE`)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	adminIds, err := pgx.CollectRows(rows, pgx.RowTo[int])
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	if !slices.Contains(adminIds, adminId) {
+		return &customerrors.AdminNotFoundError{}
+	}
+	if len(adminIds) == 1 {
+		return &customerrors.InvalidAdminDataError{Message: "the last admin can not be deleted"}
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM Admins WHERE id = $1`, adminId)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	_, err = tx.Exec(ctx, `DELETE FROM MfaEnrollments WHERE account_id = $1 AND account_role = $2`, adminId, string(model.ADMIN))
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	_, err = tx.Exec(ctx, `DELETE FROM PasswordResetTokens WHERE account_id = $1 AND account_role = $2`, adminId, string(model.ADMIN))
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	return nil
+}
+
+func scanAdmin(row pgx.Row) (model.Admin, error) {
+	var admin model.Admin
+	err := row.Scan(&admin.Id, &admin.Username, &admin.Password)
+	return admin, err
+}
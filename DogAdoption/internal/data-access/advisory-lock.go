@@ -0,0 +1,73 @@
+package dataaccess
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AdvisoryLock is a session level Postgres advisory lock. The session is kept on a connection taken
+// out of the pool, and the lock is released by Postgres if the connection is lost.
+type AdvisoryLock struct {
+	dbPool *pgxpool.Pool
+	key    int64
+	state  *advisoryLockState
+}
+
+type advisoryLockState struct {
+	mutex sync.Mutex
+	conn  *pgxpool.Conn
+}
+
+func NewAdvisoryLock(dbPool *pgxpool.Pool, key int64) AdvisoryLock {
+	return AdvisoryLock{
+		dbPool: dbPool,
+		key:    key,
+		state:  &advisoryLockState{},
+	}
+}
+
+// TryAcquire reports if the lock is held. A held lock is checked by pinging its connection, and a lost
+// connection is given up before trying to acquire the lock again.
+func (a AdvisoryLock) TryAcquire(ctx context.Context) (bool, error) {
+	a.state.mutex.Lock()
+	defer a.state.mutex.Unlock()
+	if a.state.conn != nil {
+		if a.state.conn.Ping(ctx) == nil {
+			return true, nil
+		}
+		a.state.conn.Release()
+		a.state.conn = nil
+	}
+
+	conn, err := a.dbPool.Acquire(ctx)
+	if err != nil {
+		return false, &customerrors.DatabaseError{}
+	}
+	var isAcquired bool
+	err = conn.QueryRow(ctx, `SELECT pg_try_advisory_lock($1)`, a.key).Scan(&isAcquired)
+	if err != nil {
+		conn.Release()
+		return false, &customerrors.DatabaseError{}
+	}
+	if !isAcquired {
+		conn.Release()
+		return false, nil
+	}
+	a.state.conn = conn
+	return true, nil
+}
+
+func (a AdvisoryLock) Release(ctx context.Context) {
+	a.state.mutex.Lock()
+	defer a.state.mutex.Unlock()
+	if a.state.conn == nil {
+		return
+	}
+	// Closing the session would also release the lock, but the connection goes back to the pool.
+	_, _ = a.state.conn.Exec(ctx, `SELECT pg_advisory_unlock($1)`, a.key)
+	a.state.conn.Release()
+	a.state.conn = nil
+}
@@ -0,0 +1,331 @@
+package dataaccess
+
+import (
+	dto "1dv027/aad/internal/dto"
+	breeddto "1dv027/aad/internal/dto/breed"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type BreedsDataAccess struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewBreedsDataAccess(dbPool *pgxpool.Pool) BreedsDataAccess {
+	return BreedsDataAccess{
+		dbPool: dbPool,
+	}
+}
+
+// GetBreeds returns the breeds in alphabetical order.
+func (b BreedsDataAccess) GetBreeds(ctx context.Context, queryParams dto.QueryParams) (breeddto.GetBreedsQueryResponseDTO, error) {
+	emptyDto := breeddto.GetBreedsQueryResponseDTO{}
+	qb := NewQueryBuilder("SELECT * FROM Breeds")
+	breedsFilter := queryParams.BreedsFilter
+	if breedsFilter != nil {
+		if breedsFilter.Search != nil {
+			escapedSearch := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(*breedsFilter.Search)
+			qb.withConditionParam(`(name ILIKE %[1]s OR EXISTS (SELECT 1 FROM BreedAliases
+			WHERE BreedAliases.breed_id = Breeds.id AND alias ILIKE %[1]s))`, escapedSearch+"%")
+		}
+		if breedsFilter.SizeGroup != nil {
+			qb.withFilterParam("size_group", *breedsFilter.SizeGroup)
+		}
+		if breedsFilter.FciGroup != nil {
+			qb.withFilterParam("fci_group", fmt.Sprintf("%d", *breedsFilter.FciGroup))
+		}
+	}
+	qb.withOrderBy("name, id")
+	paginationParams := queryParams.Pagination
+	if paginationParams != nil {
+		if paginationParams.Limit != nil {
+			qb.withLimit(*paginationParams.Limit)
+		}
+		if paginationParams.Page != nil {
+			qb.withPage(*paginationParams.Page)
+		}
+	}
+
+	selectQuery, filterValues := qb.build()
+	rows, err := b.dbPool.Query(ctx, selectQuery, filterValues...)
+	if err != nil {
+		return emptyDto, &customerrors.DatabaseError{}
+	}
+	breeds, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.Breed, error) {
+		return scanBreed(row)
+	})
+	if err != nil {
+		return emptyDto, &customerrors.DatabaseError{Message: "getBreeds had a database error"}
+	}
+	err = b.loadAliases(ctx, breeds)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	countQuery, _ := qb.buildForTotalCount()
+	var totalCount int
+	err = b.dbPool.QueryRow(ctx, countQuery, filterValues...).Scan(&totalCount)
+	if err != nil {
+		return emptyDto, &customerrors.DatabaseError{}
+	}
+
+	return breeddto.GetBreedsQueryResponseDTO{
+		Breeds:               breeds,
+		TotalAmountAvailable: totalCount,
+	}, nil
+}
+
+func (b BreedsDataAccess) GetBreedById(ctx context.Context, breedId int) (model.Breed, error) {
+	return b.getBreed(ctx, `SELECT * FROM Breeds WHERE id = $1`, breedId)
+}
+
+// GetBreedByName returns the breed with the name or alias, ignoring case.
+func (b BreedsDataAccess) GetBreedByName(ctx context.Context, name string) (model.Breed, error) {
+	return b.getBreed(ctx, `SELECT * FROM Breeds WHERE lower(name) = lower($1)
+	OR id = (SELECT breed_id FROM BreedAliases WHERE lower(alias) = lower($1))`, name)
+}
+
+func (b BreedsDataAccess) getBreed(ctx context.Context, query string, value any) (model.Breed, error) {
+	breed, err := scanBreed(b.dbPool.QueryRow(ctx, query, value))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Breed{}, &customerrors.BreedNotFoundError{}
+		}
+		return model.Breed{}, &customerrors.DatabaseError{}
+	}
+	breeds := []model.Breed{breed}
+	err = b.loadAliases(ctx, breeds)
+	if err != nil {
+		return model.Breed{}, err
+	}
+	return breeds[0], nil
+}
+
+func (b BreedsDataAccess) CreateBreed(ctx context.Context, breed model.Breed) (int, error) {
+	tx, err := b.dbPool.Begin(ctx)
+	if err != nil {
+		return 0, &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
+	err = b.checkNamesAvailable(ctx, tx, 0, append([]string{breed.Name}, breed.Aliases...))
+	if err != nil {
+		return 0, err
+	}
+	var id int
+	err = tx.QueryRow(ctx, `INSERT INTO Breeds (name, size_group, fci_group) VALUES ($1, $2, $3) RETURNING id`,
+		breed.Name, breed.SizeGroup, breed.FciGroup).Scan(&id)
+	if err != nil {
+		return 0, breedWriteError(err, "could not create breed")
+	}
+	err = b.insertAliases(ctx, tx, id, breed.Aliases)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, &customerrors.DatabaseError{}
+	}
+	return id, nil
+}
+
+// UpdateBreed replaces the breed and its aliases. Dogs of a renamed breed get the new name.
+func (b BreedsDataAccess) UpdateBreed(ctx context.Context, breed model.Breed) error {
+	tx, err := b.dbPool.Begin(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
+	err = b.checkNamesAvailable(ctx, tx, breed.Id, append([]string{breed.Name}, breed.Aliases...))
+	if err != nil {
+		return err
+	}
+	result, err := tx.Exec(ctx, `UPDATE Breeds SET name = $1, size_group = $2, fci_group = $3 WHERE id = $4`,
+		breed.Name, breed.SizeGroup, breed.FciGroup, breed.Id)
+	if err != nil {
+		return breedWriteError(err, "could not update breed")
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.BreedNotFoundError{}
+	}
+	_, err = tx.Exec(ctx, `DELETE FROM BreedAliases WHERE breed_id = $1`, breed.Id)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not update breed aliases"}
+	}
+	err = b.insertAliases(ctx, tx, breed.Id, breed.Aliases)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `UPDATE Dogs SET breed = $1 WHERE primary_breed_id = $2 AND breed <> $1`, breed.Name, breed.Id)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not rename the breed of dogs"}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	return nil
+}
+
+// DeleteBreed deletes a breed that no dog references.
+func (b BreedsDataAccess) DeleteBreed(ctx context.Context, breedId int) error {
+	result, err := b.dbPool.Exec(ctx, `DELETE FROM Breeds WHERE id = $1`, breedId)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return &customerrors.BreedConflictError{Message: "the breed is the breed of dogs, merge it into another breed instead"}
+		}
+		return &customerrors.DatabaseError{}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.BreedNotFoundError{}
+	}
+	return nil
+}
+
+// MergeBreeds moves the dogs of the source breed to the target breed, makes the name and aliases of the
+// source aliases of the target, and deletes the source.
+func (b BreedsDataAccess) MergeBreeds(ctx context.Context, targetBreedId, sourceBreedId int) error {
+	tx, err := b.dbPool.Begin(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
+	var targetName, sourceName string
+	err = tx.QueryRow(ctx, `SELECT name FROM Breeds WHERE id = $1 FOR UPDATE`, targetBreedId).Scan(&targetName)
+	if err == nil {
+		err = tx.QueryRow(ctx, `SELECT name FROM Breeds WHERE id = $1 FOR UPDATE`, sourceBreedId).Scan(&sourceName)
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &customerrors.BreedNotFoundError{}
+		}
+		return &customerrors.DatabaseError{}
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE Dogs SET primary_breed_id = $1, breed = $2 WHERE primary_breed_id = $3`,
+		targetBreedId, targetName, sourceBreedId)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not merge breeds"}
+	}
+	queries := []string{
+		`UPDATE Dogs SET secondary_breed_id = $1 WHERE secondary_breed_id = $2`,
+		`UPDATE BreedAliases SET breed_id = $1 WHERE breed_id = $2`,
+	}
+	for _, query := range queries {
+		_, err = tx.Exec(ctx, query, targetBreedId, sourceBreedId)
+		if err != nil {
+			return &customerrors.DatabaseError{Message: "could not merge breeds"}
+		}
+	}
+	// A mixed breed of the two merged breeds is a single breed dog.
+	_, err = tx.Exec(ctx, `UPDATE Dogs SET secondary_breed_id = NULL WHERE primary_breed_id = $1 AND secondary_breed_id = $1`,
+		targetBreedId)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not merge breeds"}
+	}
+	_, err = tx.Exec(ctx, `DELETE FROM Breeds WHERE id = $1`, sourceBreedId)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not merge breeds"}
+	}
+	err = b.insertAliases(ctx, tx, targetBreedId, []string{sourceName})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	return nil
+}
+
+// checkNamesAvailable returns a conflict when a name is the name or an alias of another breed, since
+// names and aliases share one namespace.
+func (b BreedsDataAccess) checkNamesAvailable(ctx context.Context, tx pgx.Tx, breedId int, names []string) error {
+	lowerNames := make([]string, 0, len(names))
+	for _, name := range names {
+		lowerNames = append(lowerNames, strings.ToLower(name))
+	}
+	var takenName string
+	err := tx.QueryRow(ctx, `SELECT name FROM Breeds WHERE lower(name) = ANY($1) AND id <> $2
+	UNION ALL SELECT alias FROM BreedAliases WHERE lower(alias) = ANY($1) AND breed_id <> $2
+	LIMIT 1`, lowerNames, breedId).Scan(&takenName)
+	if err == nil {
+		return &customerrors.BreedConflictError{Message: fmt.Sprintf("%s is already the name or an alias of another breed", takenName)}
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return &customerrors.DatabaseError{}
+	}
+	return nil
+}
+
+func (b BreedsDataAccess) insertAliases(ctx context.Context, tx pgx.Tx, breedId int, aliases []string) error {
+	for _, alias := range aliases {
+		_, err := tx.Exec(ctx, `INSERT INTO BreedAliases (breed_id, alias) VALUES ($1, $2)`, breedId, alias)
+		if err != nil {
+			return breedWriteError(err, "could not add breed alias")
+		}
+	}
+	return nil
+}
+
+// loadAliases sets the aliases of the breeds with one query.
+func (b BreedsDataAccess) loadAliases(ctx context.Context, breeds []model.Breed) error {
+	if len(breeds) == 0 {
+		return nil
+	}
+	breedIndexes := make(map[int]int, len(breeds))
+	breedIds := make([]int, 0, len(breeds))
+	for i, breed := range breeds {
+		breedIndexes[breed.Id] = i
+		breedIds = append(breedIds, breed.Id)
+	}
+	rows, err := b.dbPool.Query(ctx, `SELECT breed_id, alias FROM BreedAliases WHERE breed_id = ANY($1) ORDER BY alias`, breedIds)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	var breedId int
+	var alias string
+	_, err = pgx.ForEachRow(rows, []any{&breedId, &alias}, func() error {
+		breed := &breeds[breedIndexes[breedId]]
+		breed.Aliases = append(breed.Aliases, alias)
+		return nil
+	})
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not load breed aliases"}
+	}
+	return nil
+}
+
+func breedWriteError(err error, message string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return &customerrors.BreedConflictError{Message: "the name or an alias is already used by another breed"}
+	}
+	return &customerrors.DatabaseError{Message: message}
+}
+
+func scanBreed(row pgx.Row) (model.Breed, error) {
+	var breed model.Breed
+	err := row.Scan(
+		&breed.Id,
+		&breed.Name,
+		&breed.SizeGroup,
+		&breed.FciGroup,
+		&breed.CreatedAt,
+	)
+	return breed, err
+}
@@ -0,0 +1,101 @@
+package dataaccess
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type DogMedicalRecordsDataAccess struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewDogMedicalRecordsDataAccess(dbPool *pgxpool.Pool) DogMedicalRecordsDataAccess {
+	return DogMedicalRecordsDataAccess{
+		dbPool: dbPool,
+	}
+}
+
+func (d DogMedicalRecordsDataAccess) CreateDogMedicalRecord(ctx context.Context, record model.DogMedicalRecord) (model.DogMedicalRecord, error) {
+	query := `INSERT INTO DogMedicalRecords (dog_id, record_type, title, record_date, next_due_on, notes)
+	VALUES ($1, $2, $3, $4, $5, $6) RETURNING *`
+	createdRecord, err := scanDogMedicalRecord(d.dbPool.QueryRow(ctx, query, record.DogId, record.RecordType,
+		record.Title, record.RecordDate, record.NextDueOn, record.Notes))
+	if err != nil {
+		return model.DogMedicalRecord{}, &customerrors.DatabaseError{Message: "could not create medical record"}
+	}
+	return createdRecord, nil
+}
+
+// GetDogMedicalRecords returns the medical history of the dog, the most recent records first.
+func (d DogMedicalRecordsDataAccess) GetDogMedicalRecords(ctx context.Context, dogId int) ([]model.DogMedicalRecord, error) {
+	rows, err := d.dbPool.Query(ctx, `SELECT * FROM DogMedicalRecords WHERE dog_id = $1
+	ORDER BY record_date DESC, id DESC`, dogId)
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	records, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.DogMedicalRecord, error) {
+		return scanDogMedicalRecord(row)
+	})
+	if err != nil {
+		return nil, &customerrors.DatabaseError{Message: "getDogMedicalRecords had a database error"}
+	}
+	return records, nil
+}
+
+func (d DogMedicalRecordsDataAccess) GetDogMedicalRecordById(ctx context.Context, dogId, recordId int) (model.DogMedicalRecord, error) {
+	record, err := scanDogMedicalRecord(d.dbPool.QueryRow(ctx,
+		`SELECT * FROM DogMedicalRecords WHERE id = $1 AND dog_id = $2`, recordId, dogId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.DogMedicalRecord{}, &customerrors.MedicalRecordNotFoundError{}
+		}
+		return model.DogMedicalRecord{}, &customerrors.DatabaseError{}
+	}
+	return record, nil
+}
+
+func (d DogMedicalRecordsDataAccess) UpdateDogMedicalRecord(ctx context.Context, record model.DogMedicalRecord) error {
+	query := `UPDATE DogMedicalRecords SET record_type = $1, title = $2, record_date = $3, next_due_on = $4,
+	notes = $5, updated_at = now() WHERE id = $6 AND dog_id = $7`
+	result, err := d.dbPool.Exec(ctx, query, record.RecordType, record.Title, record.RecordDate, record.NextDueOn,
+		record.Notes, record.Id, record.DogId)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not update medical record"}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.MedicalRecordNotFoundError{}
+	}
+	return nil
+}
+
+func (d DogMedicalRecordsDataAccess) DeleteDogMedicalRecord(ctx context.Context, dogId, recordId int) error {
+	result, err := d.dbPool.Exec(ctx, `DELETE FROM DogMedicalRecords WHERE id = $1 AND dog_id = $2`, recordId, dogId)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.MedicalRecordNotFoundError{}
+	}
+	return nil
+}
+
+func scanDogMedicalRecord(row pgx.Row) (model.DogMedicalRecord, error) {
+	var record model.DogMedicalRecord
+	err := row.Scan(
+		&record.Id,
+		&record.DogId,
+		&record.RecordType,
+		&record.Title,
+		&record.RecordDate,
+		&record.NextDueOn,
+		&record.Notes,
+		&record.CreatedAt,
+		&record.UpdatedAt,
+	)
+	return record, err
+}
@@ -0,0 +1,208 @@
+package dataaccess
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type DogPhotosDataAccess struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewDogPhotosDataAccess(dbPool *pgxpool.Pool) DogPhotosDataAccess {
+	return DogPhotosDataAccess{
+		dbPool: dbPool,
+	}
+}
+
+// CreateDogPhoto adds the photo last in the gallery. The first photo of a dog becomes its primary photo.
+func (d DogPhotosDataAccess) CreateDogPhoto(ctx context.Context, photo model.DogPhoto, makePrimary bool) (model.DogPhoto, error) {
+	tx, err := d.dbPool.Begin(ctx)
+	if err != nil {
+		return model.DogPhoto{}, &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
+	photoIds, err := d.lockGallery(ctx, tx, photo.DogId)
+	if err != nil {
+		return model.DogPhoto{}, err
+	}
+	makePrimary = makePrimary || len(photoIds) == 0
+	if makePrimary {
+		_, err = tx.Exec(ctx, `UPDATE DogPhotos SET is_primary = false WHERE dog_id = $1 AND is_primary`, photo.DogId)
+		if err != nil {
+			return model.DogPhoto{}, &customerrors.DatabaseError{}
+		}
+	}
+
+	query := `INSERT INTO DogPhotos (dog_id, position, is_primary, content_type, width, height, size_bytes, storage_key)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *`
+	createdPhoto, err := scanDogPhoto(tx.QueryRow(ctx, query, photo.DogId, len(photoIds)+1, makePrimary,
+		photo.ContentType, photo.Width, photo.Height, photo.SizeBytes, photo.StorageKey))
+	if err != nil {
+		return model.DogPhoto{}, &customerrors.DatabaseError{Message: "could not create dog photo"}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return model.DogPhoto{}, &customerrors.DatabaseError{}
+	}
+	return createdPhoto, nil
+}
+
+func (d DogPhotosDataAccess) GetDogPhotos(ctx context.Context, dogId int) ([]model.DogPhoto, error) {
+	rows, err := d.dbPool.Query(ctx, `SELECT * FROM DogPhotos WHERE dog_id = $1 ORDER BY position`, dogId)
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	photos, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.DogPhoto, error) {
+		return scanDogPhoto(row)
+	})
+	if err != nil {
+		return nil, &customerrors.DatabaseError{Message: "getDogPhotos had a database error"}
+	}
+	return photos, nil
+}
+
+func (d DogPhotosDataAccess) GetDogPhotoById(ctx context.Context, dogId, photoId int) (model.DogPhoto, error) {
+	photo, err := scanDogPhoto(d.dbPool.QueryRow(ctx, `SELECT * FROM DogPhotos WHERE id = $1 AND dog_id = $2`, photoId, dogId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.DogPhoto{}, &customerrors.DogPhotoNotFoundError{}
+		}
+		return model.DogPhoto{}, &customerrors.DatabaseError{}
+	}
+	return photo, nil
+}
+
+// UpdateDogPhoto moves the photo to the position in the gallery, and makes it the primary photo.
+// Positions past the end of the gallery move the photo last.
+func (d DogPhotosDataAccess) UpdateDogPhoto(ctx context.Context, dogId, photoId int, position *int, makePrimary bool) error {
+	tx, err := d.dbPool.Begin(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
+	photoIds, err := d.lockGallery(ctx, tx, dogId)
+	if err != nil {
+		return err
+	}
+	index := slices.Index(photoIds, photoId)
+	if index == -1 {
+		return &customerrors.DogPhotoNotFoundError{}
+	}
+	if position != nil {
+		photoIds = slices.Delete(photoIds, index, index+1)
+		photoIds = slices.Insert(photoIds, min(*position, len(photoIds)+1)-1, photoId)
+		err = d.savePositions(ctx, tx, photoIds)
+		if err != nil {
+			return err
+		}
+	}
+	if makePrimary {
+		_, err = tx.Exec(ctx, `UPDATE DogPhotos SET is_primary = (id = $2) WHERE dog_id = $1`, dogId, photoId)
+		if err != nil {
+			return &customerrors.DatabaseError{}
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	return nil
+}
+
+// DeleteDogPhoto removes the photo from the gallery and returns it, so that its files can be removed.
+// The first remaining photo becomes primary when the primary photo is deleted.
+func (d DogPhotosDataAccess) DeleteDogPhoto(ctx context.Context, dogId, photoId int) (model.DogPhoto, error) {
+	tx, err := d.dbPool.Begin(ctx)
+	if err != nil {
+		return model.DogPhoto{}, &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
+	photoIds, err := d.lockGallery(ctx, tx, dogId)
+	if err != nil {
+		return model.DogPhoto{}, err
+	}
+	deletedPhoto, err := scanDogPhoto(tx.QueryRow(ctx, `DELETE FROM DogPhotos WHERE id = $1 AND dog_id = $2 RETURNING *`, photoId, dogId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.DogPhoto{}, &customerrors.DogPhotoNotFoundError{}
+		}
+		return model.DogPhoto{}, &customerrors.DatabaseError{}
+	}
+	photoIds = slices.DeleteFunc(photoIds, func(id int) bool { return id == photoId })
+	err = d.savePositions(ctx, tx, photoIds)
+	if err != nil {
+		return model.DogPhoto{}, err
+	}
+	if deletedPhoto.IsPrimary && len(photoIds) > 0 {
+		_, err = tx.Exec(ctx, `UPDATE DogPhotos SET is_primary = true WHERE id = $1`, photoIds[0])
+		if err != nil {
+			return model.DogPhoto{}, &customerrors.DatabaseError{}
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return model.DogPhoto{}, &customerrors.DatabaseError{}
+	}
+	return deletedPhoto, nil
+}
+
+// lockGallery locks the dog, so that concurrent changes to its gallery are serialized, and returns
+// the ids of its photos in gallery order.
+func (d DogPhotosDataAccess) lockGallery(ctx context.Context, tx pgx.Tx, dogId int) ([]int, error) {
+	var lockedId int
+	err := tx.QueryRow(ctx, `SELECT id FROM Dogs WHERE id = $1 FOR UPDATE`, dogId).Scan(&lockedId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &customerrors.DogNotFoundError{}
+		}
+		return nil, &customerrors.DatabaseError{}
+	}
+	rows, err := tx.Query(ctx, `SELECT id FROM DogPhotos WHERE dog_id = $1 ORDER BY position`, dogId)
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	photoIds, err := pgx.CollectRows(rows, pgx.RowTo[int])
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	return photoIds, nil
+}
+
+func (d DogPhotosDataAccess) savePositions(ctx context.Context, tx pgx.Tx, photoIds []int) error {
+	_, err := tx.Exec(ctx, `UPDATE DogPhotos SET position = ordered.position
+	FROM unnest($1::integer[]) WITH ORDINALITY AS ordered(id, position) WHERE DogPhotos.id = ordered.id`, photoIds)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not reorder dog photos"}
+	}
+	return nil
+}
+
+func scanDogPhoto(row pgx.Row) (model.DogPhoto, error) {
+	var photo model.DogPhoto
+	err := row.Scan(
+		&photo.Id,
+		&photo.DogId,
+		&photo.Position,
+		&photo.IsPrimary,
+		&photo.ContentType,
+		&photo.Width,
+		&photo.Height,
+		&photo.SizeBytes,
+		&photo.StorageKey,
+		&photo.CreatedAt,
+	)
+	return photo, err
+}
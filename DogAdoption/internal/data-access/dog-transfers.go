@@ -0,0 +1,145 @@
+package dataaccess
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type DogTransfersDataAccess struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewDogTransfersDataAccess(dbPool *pgxpool.Pool) DogTransfersDataAccess {
+	return DogTransfersDataAccess{
+		dbPool: dbPool,
+	}
+}
+
+func (d DogTransfersDataAccess) CreateDogTransfer(ctx context.Context, transfer model.DogTransfer) (model.DogTransfer, error) {
+	query := `INSERT INTO DogTransfers (dog_id, from_shelter_id, to_shelter_id, note)
+	VALUES ($1, $2, $3, $4) RETURNING *`
+	createdTransfer, err := scanDogTransfer(d.dbPool.QueryRow(ctx, query,
+		transfer.DogId, transfer.FromShelterId, transfer.ToShelterId, transfer.Note))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return model.DogTransfer{}, &customerrors.DogTransferConflictError{Message: "the dog already has a pending transfer"}
+		}
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return model.DogTransfer{}, &customerrors.InvalidDogTransferDataError{Message: "to_shelter_id does not belong to a dog shelter"}
+		}
+		return model.DogTransfer{}, &customerrors.DatabaseError{Message: "could not create dog transfer"}
+	}
+	return createdTransfer, nil
+}
+
+func (d DogTransfersDataAccess) GetDogTransferById(ctx context.Context, dogId, transferId int) (model.DogTransfer, error) {
+	query := `SELECT * FROM DogTransfers WHERE id = $1 AND dog_id = $2`
+	transfer, err := scanDogTransfer(d.dbPool.QueryRow(ctx, query, transferId, dogId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.DogTransfer{}, &customerrors.DogTransferNotFoundError{}
+		}
+		return model.DogTransfer{}, &customerrors.DatabaseError{}
+	}
+	return transfer, nil
+}
+
+// GetDogTransfers returns the transfer history of the dog, oldest first.
+func (d DogTransfersDataAccess) GetDogTransfers(ctx context.Context, dogId int) ([]model.DogTransfer, error) {
+	query := `SELECT * FROM DogTransfers WHERE dog_id = $1 ORDER BY created_at, id`
+	rows, err := d.dbPool.Query(ctx, query, dogId)
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	transfers, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.DogTransfer, error) {
+		return scanDogTransfer(row)
+	})
+	if err != nil {
+		return nil, &customerrors.DatabaseError{Message: "getDogTransfers had a database error"}
+	}
+	return transfers, nil
+}
+
+// AcceptDogTransfer accepts a pending transfer and moves the dog to the destination shelter in the same
+// transaction. The move only succeeds if the dog is still at the shelter that proposed the transfer.
+func (d DogTransfersDataAccess) AcceptDogTransfer(ctx context.Context, dogId, transferId int) error {
+	tx, err := d.dbPool.Begin(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
+	query := `UPDATE DogTransfers SET status = $1, decided_at = now()
+	WHERE id = $2 AND dog_id = $3 AND status = $4 RETURNING *`
+	transfer, err := scanDogTransfer(tx.QueryRow(ctx, query,
+		string(model.TRANSFER_ACCEPTED), transferId, dogId, string(model.TRANSFER_PENDING)))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return d.undecidableTransferError(ctx, dogId, transferId)
+		}
+		return &customerrors.DatabaseError{}
+	}
+
+	result, err := tx.Exec(ctx, `UPDATE Dogs SET shelter_id = $1 WHERE id = $2 AND shelter_id = $3`,
+		transfer.ToShelterId, dogId, transfer.FromShelterId)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.DogTransferConflictError{Message: "the dog is no longer at the shelter that proposed the transfer"}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	return nil
+}
+
+// CloseDogTransfer declines or cancels a pending transfer.
+func (d DogTransfersDataAccess) CloseDogTransfer(ctx context.Context, dogId, transferId int,
+	status model.DogTransferStatus, reason *string) error {
+	query := `UPDATE DogTransfers SET status = $1, decision_reason = $2, decided_at = now()
+	WHERE id = $3 AND dog_id = $4 AND status = $5`
+	result, err := d.dbPool.Exec(ctx, query, string(status), reason, transferId, dogId, string(model.TRANSFER_PENDING))
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	if result.RowsAffected() == 0 {
+		return d.undecidableTransferError(ctx, dogId, transferId)
+	}
+	return nil
+}
+
+// undecidableTransferError tells a missing transfer apart from one that is no longer pending.
+func (d DogTransfersDataAccess) undecidableTransferError(ctx context.Context, dogId, transferId int) error {
+	transfer, err := d.GetDogTransferById(ctx, dogId, transferId)
+	if err != nil {
+		return err
+	}
+	return &customerrors.DogTransferConflictError{Message: fmt.Sprintf("the transfer has already been %s", transfer.Status)}
+}
+
+func scanDogTransfer(row pgx.Row) (model.DogTransfer, error) {
+	var transfer model.DogTransfer
+	err := row.Scan(
+		&transfer.Id,
+		&transfer.DogId,
+		&transfer.FromShelterId,
+		&transfer.ToShelterId,
+		&transfer.Status,
+		&transfer.Note,
+		&transfer.DecisionReason,
+		&transfer.CreatedAt,
+		&transfer.DecidedAt,
+	)
+	return transfer, err
+}
@@ -6,9 +6,10 @@ import (
 	customerrors "1dv027/aad/internal/errors"
 	"1dv027/aad/internal/model"
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
-	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -18,6 +19,15 @@ type DogQueries struct {
 	dogsQuery       string
 	totalCountQuery string
 	filterValues    []any
+	withDistance    bool
+}
+
+// goodWithColumns are the columns of the groups of the good-with filter.
+var goodWithColumns = map[string]string{
+	model.GOOD_WITH_CHILDREN:      "good_with_children",
+	model.GOOD_WITH_DOGS:          "good_with_dogs",
+	model.GOOD_WITH_CATS:          "good_with_cats",
+	model.GOOD_WITH_SMALL_ANIMALS: "good_with_small_animals",
 }
 
 type DogsDataAccess struct {
@@ -39,6 +49,9 @@ func (d DogsDataAccess) GetDogs(ctx context.Context, queryParams dto.QueryParams
 	}
 
 	dogs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.Dog, error) {
+		if queries.withDistance {
+			return d.dogDistanceScanner(row)
+		}
 		dog, err := d.dogScanner(row)
 		return dog, err
 	})
@@ -46,6 +59,14 @@ func (d DogsDataAccess) GetDogs(ctx context.Context, queryParams dto.QueryParams
 	if err != nil {
 		return emptyDto, &customerrors.DatabaseError{}
 	}
+	err = d.loadPrimaryPhotos(ctx, dogs)
+	if err != nil {
+		return emptyDto, err
+	}
+	err = d.loadFavoritedCounts(ctx, dogs)
+	if err != nil {
+		return emptyDto, err
+	}
 
 	var totalCount int
 	err = d.dbPool.QueryRow(ctx, queries.totalCountQuery, queries.filterValues...).Scan(&totalCount)
@@ -61,6 +82,48 @@ func (d DogsDataAccess) GetDogs(ctx context.Context, queryParams dto.QueryParams
 	return dogQueryResult, nil
 }
 
+// GetSearchesMatchingDog returns the keys of the searches that find the dog. All searches are evaluated in a
+// single query, each one as an EXISTS over its filters and the id of the dog.
+func (d DogsDataAccess) GetSearchesMatchingDog(ctx context.Context, dogId int, searches map[int]dto.QueryParams) ([]int, error) {
+	if len(searches) == 0 {
+		return []int{}, nil
+	}
+	searchIds := make([]int, 0, len(searches))
+	for searchId := range searches {
+		searchIds = append(searchIds, searchId)
+	}
+	slices.Sort(searchIds)
+
+	var filterValues []any
+	searchQueries := make([]string, 0, len(searchIds))
+	for _, searchId := range searchIds {
+		searchParams := searches[searchId]
+		dogsFilter := dto.DogsFilterParams{}
+		if searchParams.DogsFilter != nil {
+			dogsFilter = *searchParams.DogsFilter
+		}
+		dogsFilter.DogId = &dogId
+		searchParams.DogsFilter = &dogsFilter
+		searchParams.Pagination = nil
+
+		qb := d.createQueryBuilder(searchParams, filterValues)
+		var searchQuery string
+		searchQuery, filterValues = qb.build()
+		searchQueries = append(searchQueries, fmt.Sprintf("SELECT %d AS search_id WHERE EXISTS (%s)",
+			searchId, strings.TrimSuffix(searchQuery, ";")))
+	}
+
+	rows, err := d.dbPool.Query(ctx, strings.Join(searchQueries, " UNION ALL "), filterValues...)
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	matchingIds, err := pgx.CollectRows(rows, pgx.RowTo[int])
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	return matchingIds, nil
+}
+
 func (d DogsDataAccess) GetDogById(ctx context.Context, dogId int) (model.Dog, error) {
 	emptyModel := model.Dog{}
 	query := `SELECT * FROM Dogs WHERE id = $1`
@@ -80,9 +143,70 @@ func (d DogsDataAccess) GetDogById(ctx context.Context, dogId int) (model.Dog, e
 	if len(dogData) == 0 {
 		return emptyModel, &customerrors.DogNotFoundError{}
 	}
+	err = d.loadPrimaryPhotos(ctx, dogData)
+	if err != nil {
+		return emptyModel, err
+	}
+	err = d.loadFavoritedCounts(ctx, dogData)
+	if err != nil {
+		return emptyModel, err
+	}
 	return dogData[0], nil
 }
 
+// loadPrimaryPhotos sets the primary photo of the dogs with one query.
+func (d DogsDataAccess) loadPrimaryPhotos(ctx context.Context, dogs []model.Dog) error {
+	if len(dogs) == 0 {
+		return nil
+	}
+	dogIndexes := make(map[int]int, len(dogs))
+	dogIds := make([]int, 0, len(dogs))
+	for i, dog := range dogs {
+		dogIndexes[dog.Id] = i
+		dogIds = append(dogIds, dog.Id)
+	}
+	rows, err := d.dbPool.Query(ctx, `SELECT dog_id, id FROM DogPhotos WHERE dog_id = ANY($1) AND is_primary`, dogIds)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	var dogId, photoId int
+	_, err = pgx.ForEachRow(rows, []any{&dogId, &photoId}, func() error {
+		primaryPhotoId := photoId
+		dogs[dogIndexes[dogId]].PrimaryPhotoId = &primaryPhotoId
+		return nil
+	})
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not load primary dog photos"}
+	}
+	return nil
+}
+
+// loadFavoritedCounts sets how many users have each of the dogs as a favorite with one query.
+func (d DogsDataAccess) loadFavoritedCounts(ctx context.Context, dogs []model.Dog) error {
+	if len(dogs) == 0 {
+		return nil
+	}
+	dogIndexes := make(map[int]int, len(dogs))
+	dogIds := make([]int, 0, len(dogs))
+	for i, dog := range dogs {
+		dogIndexes[dog.Id] = i
+		dogIds = append(dogIds, dog.Id)
+	}
+	rows, err := d.dbPool.Query(ctx, `SELECT dog_id, count(*) FROM UserFavorites WHERE dog_id = ANY($1) GROUP BY dog_id`, dogIds)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	var dogId, favoritedCount int
+	_, err = pgx.ForEachRow(rows, []any{&dogId, &favoritedCount}, func() error {
+		dogs[dogIndexes[dogId]].FavoritedCount = favoritedCount
+		return nil
+	})
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not load favorited counts"}
+	}
+	return nil
+}
+
 func (d DogsDataAccess) DeleteDog(ctx context.Context, dogId int) error {
 	query := `DELETE FROM Dogs WHERE id = $1`
 	result, err := d.dbPool.Exec(ctx, query, dogId)
@@ -97,34 +221,57 @@ func (d DogsDataAccess) DeleteDog(ctx context.Context, dogId int) error {
 	}
 }
 
+// UpdateDog updates the dog, and records a status change in the status history. The status is read
+// with a row lock, so that concurrent changes cannot skip the transition graph.
 func (d DogsDataAccess) UpdateDog(ctx context.Context, dogId int, dogData dogdto.UpdateDogDTO) error {
-	query := d.createUpdateDogQuery(dogId, dogData)
-	result, err := d.dbPool.Exec(ctx, query)
+	tx, err := d.dbPool.Begin(ctx)
 	if err != nil {
 		return &customerrors.DatabaseError{}
 	}
-	rowsAffected := result.RowsAffected()
-	if rowsAffected == 0 {
-		return &customerrors.DogNotFoundError{}
+	defer tx.Rollback(ctx)
+
+	var currentStatus model.DogStatus
+	err = tx.QueryRow(ctx, `SELECT status FROM Dogs WHERE id = $1 FOR UPDATE`, dogId).Scan(&currentStatus)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &customerrors.DogNotFoundError{}
+		}
+		return &customerrors.DatabaseError{}
+	}
+	statusChanged := dogData.Status != nil && model.DogStatus(*dogData.Status) != currentStatus
+	if statusChanged && !currentStatus.CanTransitionTo(model.DogStatus(*dogData.Status)) {
+		return &customerrors.InvalidDogStatusTransitionError{
+			Message: fmt.Sprintf("a dog cannot go from %s to %s", currentStatus, *dogData.Status),
+		}
+	}
+
+	query, values := d.createUpdateDogQuery(dogId, dogData, statusChanged)
+	_, err = tx.Exec(ctx, query, values...)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	if statusChanged {
+		err = d.insertStatusChange(ctx, tx, dogId, &currentStatus, model.DogStatus(*dogData.Status), dogData.StatusNote)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
 	}
 	return nil
 }
 
-func (d DogsDataAccess) createUpdateDogQuery(dogId int, dogData dogdto.UpdateDogDTO) string {
+func (d DogsDataAccess) createUpdateDogQuery(dogId int, dogData dogdto.UpdateDogDTO, statusChanged bool) (string, []any) {
 	query := `UPDATE Dogs SET `
 	updates := []string{}
+	values := []any{}
 
-	addUpdate := func(field string, value interface{}) {
-		switch v := value.(type) {
-		case string:
-			updates = append(updates, fmt.Sprintf("%s = '%s'", field, v))
-		case int, int64:
-			updates = append(updates, fmt.Sprintf("%s = %d", field, v))
-		case bool:
-			updates = append(updates, fmt.Sprintf("%s = %t", field, v))
-		case *time.Time:
-			updates = append(updates, fmt.Sprintf("%s = '%s'", field, v.Format("2006-01-02 15:04:05")))
-		}
+	addUpdate := func(field string, value any) {
+		values = append(values, value)
+		updates = append(updates, fmt.Sprintf("%s = $%d", field, len(values)))
 	}
 
 	if dogData.Name != nil {
@@ -139,6 +286,17 @@ func (d DogsDataAccess) createUpdateDogQuery(dogId int, dogData dogdto.UpdateDog
 	if dogData.Breed != nil {
 		addUpdate("breed", *dogData.Breed)
 	}
+	if dogData.PrimaryBreedId != nil {
+		addUpdate("primary_breed_id", *dogData.PrimaryBreedId)
+	}
+	// A secondary breed id of 0 makes the dog a single breed dog.
+	if dogData.SecondaryBreedId != nil {
+		if *dogData.SecondaryBreedId == 0 {
+			addUpdate("secondary_breed_id", nil)
+		} else {
+			addUpdate("secondary_breed_id", *dogData.SecondaryBreedId)
+		}
+	}
 	if dogData.IsNeutered != nil {
 		addUpdate("is_neutered", *dogData.IsNeutered)
 	}
@@ -148,27 +306,68 @@ func (d DogsDataAccess) createUpdateDogQuery(dogId int, dogData dogdto.UpdateDog
 	if dogData.AdoptionFee != nil {
 		addUpdate("adoption_fee", *dogData.AdoptionFee)
 	}
-	if dogData.IsAdopted != nil {
-		addUpdate("is_adopted", *dogData.IsAdopted)
-	}
 	if dogData.FriendlyWith != nil {
 		addUpdate("friendly_with", *dogData.FriendlyWith)
 	}
+	if dogData.GoodWith != nil {
+		goodWithValues := map[string]*string{
+			model.GOOD_WITH_CHILDREN:      dogData.GoodWith.Children,
+			model.GOOD_WITH_DOGS:          dogData.GoodWith.Dogs,
+			model.GOOD_WITH_CATS:          dogData.GoodWith.Cats,
+			model.GOOD_WITH_SMALL_ANIMALS: dogData.GoodWith.SmallAnimals,
+		}
+		for _, group := range model.CompatibilityGroups {
+			if goodWithValues[group] != nil {
+				addUpdate(goodWithColumns[group], *goodWithValues[group])
+			}
+		}
+	}
+	// An empty energy level or size removes it.
+	if dogData.EnergyLevel != nil {
+		if *dogData.EnergyLevel == "" {
+			addUpdate("energy_level", nil)
+		} else {
+			addUpdate("energy_level", *dogData.EnergyLevel)
+		}
+	}
+	if dogData.Size != nil {
+		if *dogData.Size == "" {
+			addUpdate("size", nil)
+		} else {
+			addUpdate("size", *dogData.Size)
+		}
+	}
 	if dogData.Gender != nil {
 		addUpdate("gender", *dogData.Gender)
 	}
+	if statusChanged {
+		addUpdate("status", *dogData.Status)
+		updates = append(updates, "status_changed_at = now()")
+	}
+	if len(updates) == 0 {
+		updates = append(updates, "id = id")
+	}
+	values = append(values, dogId)
 	query += strings.Join(updates, ", ")
-	query += fmt.Sprintf(" WHERE id = %d;", dogId)
+	query += fmt.Sprintf(" WHERE id = $%d;", len(values))
 
-	return query
+	return query, values
 }
 
 func (d DogsDataAccess) CreateDog(ctx context.Context, dogData dogdto.NewDogDTO) (int, error) {
+	tx, err := d.dbPool.Begin(ctx)
+	if err != nil {
+		return 0, &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
 	query := `INSERT INTO Dogs 
-	(name, description, birth_date, breed, is_neutered, shelter_id, image_url, adoption_fee, is_adopted, friendly_with, gender)
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING id`
+	(name, description, birth_date, breed, is_neutered, shelter_id, image_url, adoption_fee, status, friendly_with, gender,
+	primary_breed_id, secondary_breed_id, good_with_children, good_with_dogs, good_with_cats, good_with_small_animals,
+	energy_level, size)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19) RETURNING id`
 	var id int
-	err := d.dbPool.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		*dogData.Name,
 		*dogData.Description,
 		*dogData.BirthDate,
@@ -177,36 +376,65 @@ func (d DogsDataAccess) CreateDog(ctx context.Context, dogData dogdto.NewDogDTO)
 		*dogData.ShelterId,
 		*dogData.ImageUrl,
 		*dogData.AdoptionFee,
-		*dogData.IsAdopted,
+		*dogData.Status,
 		*dogData.FriendlyWith,
 		*dogData.Gender,
+		dogData.PrimaryBreedId,
+		dogData.SecondaryBreedId,
+		*dogData.GoodWith.Children,
+		*dogData.GoodWith.Dogs,
+		*dogData.GoodWith.Cats,
+		*dogData.GoodWith.SmallAnimals,
+		dogData.EnergyLevel,
+		dogData.Size,
 	).Scan(&id)
 	if err != nil {
 		return 0, &customerrors.DatabaseError{}
 	}
+	err = d.insertStatusChange(ctx, tx, id, nil, model.DogStatus(*dogData.Status), dogData.StatusNote)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, &customerrors.DatabaseError{}
+	}
 	return id, nil
 }
 
-func (d DogsDataAccess) createQuery(queryParams dto.QueryParams) DogQueries {
-	qb := NewQueryBuilder("SELECT * FROM Dogs")
-
-	dogFilters := queryParams.DogsFilter
-	if dogFilters.Breed != nil {
-		qb.withFilterParam("breed", *dogFilters.Breed)
-	}
-	if dogFilters.Gender != nil {
-		qb.withFilterParam("gender", *dogFilters.Gender)
+// GetDogStatusHistory returns the status changes of the dog, oldest first.
+func (d DogsDataAccess) GetDogStatusHistory(ctx context.Context, dogId int) ([]model.DogStatusChange, error) {
+	query := `SELECT id, dog_id, from_status, to_status, note, changed_at FROM DogStatusHistory
+	WHERE dog_id = $1 ORDER BY changed_at, id`
+	rows, err := d.dbPool.Query(ctx, query, dogId)
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
 	}
-	if dogFilters.IsAdopted != nil {
-		qb.withFilterParam("is_adopted", *dogFilters.IsAdopted)
+	history, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.DogStatusChange, error) {
+		var change model.DogStatusChange
+		err := row.Scan(&change.Id, &change.DogId, &change.FromStatus, &change.ToStatus, &change.Note, &change.ChangedAt)
+		return change, err
+	})
+	if err != nil {
+		return nil, &customerrors.DatabaseError{Message: "getDogStatusHistory had a database error"}
 	}
+	return history, nil
+}
 
-	if dogFilters.IsNeutered != nil {
-		qb.withFilterParam("is_neutered", *dogFilters.IsNeutered)
-	}
-	if dogFilters.ShelterId != nil {
-		qb.withFilterParam("shelter_id", fmt.Sprintf("%d", *dogFilters.ShelterId))
+func (d DogsDataAccess) insertStatusChange(ctx context.Context, tx pgx.Tx, dogId int,
+	fromStatus *model.DogStatus, toStatus model.DogStatus, note *string) error {
+	_, err := tx.Exec(ctx, `INSERT INTO DogStatusHistory (dog_id, from_status, to_status, note) VALUES ($1, $2, $3, $4)`,
+		dogId, fromStatus, toStatus, note)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not record dog status change"}
 	}
+	return nil
+}
+
+func (d DogsDataAccess) createQuery(queryParams dto.QueryParams) DogQueries {
+	qb := d.createQueryBuilder(queryParams, nil)
+	geoFilter := queryParams.GeoFilter
 
 	paginationParams := queryParams.Pagination
 	if paginationParams != nil {
@@ -224,13 +452,102 @@ func (d DogsDataAccess) createQuery(queryParams dto.QueryParams) DogQueries {
 		dogsQuery:       selectQuery,
 		totalCountQuery: countQuery,
 		filterValues:    filterValues,
+		withDistance:    geoFilter != nil,
 	}
 	return queries
 }
 
+// createQueryBuilder adds the dog and distance filters. The parameters are numbered after the given values,
+// so that the queries of several searches can share one list of parameters.
+func (d DogsDataAccess) createQueryBuilder(queryParams dto.QueryParams, values []any) queryBuilder {
+	qb := NewQueryBuilderWithParams("SELECT * FROM Dogs", values...)
+	geoFilter := queryParams.GeoFilter
+	if geoFilter != nil {
+		// The distance of a dog is the distance of its shelter.
+		latitudeParam := fmt.Sprintf("$%d", len(values)+1)
+		longitudeParam := fmt.Sprintf("$%d", len(values)+2)
+		qb = NewQueryBuilderWithParams(fmt.Sprintf(`SELECT * FROM (SELECT Dogs.*, %s AS distance_km
+		FROM Dogs JOIN DogShelters ON DogShelters.id = Dogs.shelter_id) AS Dogs`,
+			haversineDistance("DogShelters.latitude", "DogShelters.longitude", latitudeParam, longitudeParam)),
+			append(values, geoFilter.Latitude, geoFilter.Longitude)...)
+		withDistance(&qb, *geoFilter)
+	}
+
+	dogFilters := queryParams.DogsFilter
+	// A breed matches the primary or secondary breed of a dog by its name or any of its aliases.
+	if dogFilters.Breed != nil {
+		qb.withConditionParam(`EXISTS (SELECT 1 FROM Breeds LEFT JOIN BreedAliases ON BreedAliases.breed_id = Breeds.id
+		WHERE Breeds.id IN (Dogs.primary_breed_id, Dogs.secondary_breed_id)
+		AND (lower(Breeds.name) = lower(%[1]s) OR lower(BreedAliases.alias) = lower(%[1]s)))`, *dogFilters.Breed)
+	}
+	if dogFilters.BreedId != nil {
+		qb.withConditionParam("(primary_breed_id = %[1]s OR secondary_breed_id = %[1]s)", *dogFilters.BreedId)
+	}
+	if dogFilters.Gender != nil {
+		qb.withFilterParam("gender", *dogFilters.Gender)
+	}
+	if len(dogFilters.Statuses) > 0 {
+		qb.withAnyParam("status", dogFilters.Statuses)
+	}
+	// is-adopted is kept for clients written before the status lifecycle.
+	if dogFilters.IsAdopted != nil {
+		if *dogFilters.IsAdopted == "true" {
+			qb.withFilterParam("status", string(model.DOG_ADOPTED))
+		} else {
+			qb.withCondition(fmt.Sprintf("status <> '%s'", model.DOG_ADOPTED))
+		}
+	}
+
+	if dogFilters.IsNeutered != nil {
+		qb.withFilterParam("is_neutered", *dogFilters.IsNeutered)
+	}
+	// Dogs match good-with when they are known to be good with every given group.
+	for _, group := range dogFilters.GoodWith {
+		qb.withFilterParam(goodWithColumns[group], string(model.COMPATIBILITY_YES))
+	}
+	if len(dogFilters.EnergyLevels) > 0 {
+		qb.withAnyParam("energy_level", dogFilters.EnergyLevels)
+	}
+	if len(dogFilters.Sizes) > 0 {
+		qb.withAnyParam("size", dogFilters.Sizes)
+	}
+	// A dog is vaccinated while it has a vaccination that is not overdue.
+	if dogFilters.IsVaccinated != nil {
+		vaccinatedCondition := `EXISTS (SELECT 1 FROM DogMedicalRecords WHERE DogMedicalRecords.dog_id = Dogs.id
+		AND record_type = 'vaccination' AND (next_due_on IS NULL OR next_due_on >= CURRENT_DATE))`
+		if !*dogFilters.IsVaccinated {
+			vaccinatedCondition = "NOT " + vaccinatedCondition
+		}
+		qb.withCondition(vaccinatedCondition)
+	}
+	if dogFilters.ShelterId != nil {
+		qb.withFilterParam("shelter_id", fmt.Sprintf("%d", *dogFilters.ShelterId))
+	}
+	if dogFilters.FavoritedBy != nil {
+		qb.withConditionParam("EXISTS (SELECT 1 FROM UserFavorites WHERE UserFavorites.dog_id = Dogs.id AND UserFavorites.user_id = %[1]s)",
+			*dogFilters.FavoritedBy)
+	}
+	if dogFilters.DogId != nil {
+		qb.withConditionParam("id = %[1]s", *dogFilters.DogId)
+	}
+	return qb
+}
+
 func (d *DogsDataAccess) dogScanner(row pgx.CollectableRow) (model.Dog, error) {
 	var dog model.Dog
-	err := row.Scan(
+	err := row.Scan(d.dogScanDestinations(&dog)...)
+	return dog, err
+}
+
+// dogDistanceScanner scans a dog followed by the distance_km column of a distance search.
+func (d *DogsDataAccess) dogDistanceScanner(row pgx.CollectableRow) (model.Dog, error) {
+	var dog model.Dog
+	err := row.Scan(append(d.dogScanDestinations(&dog), &dog.DistanceKm)...)
+	return dog, err
+}
+
+func (d *DogsDataAccess) dogScanDestinations(dog *model.Dog) []any {
+	return []any{
 		&dog.Id,
 		&dog.Name,
 		&dog.Description,
@@ -240,9 +557,17 @@ func (d *DogsDataAccess) dogScanner(row pgx.CollectableRow) (model.Dog, error) {
 		&dog.ShelterId,
 		&dog.ImageUrl,
 		&dog.AdoptionFee,
-		&dog.IsAdopted,
 		&dog.FriendlyWith,
 		&dog.Gender,
-	)
-	return dog, err
+		&dog.Status,
+		&dog.StatusChangedAt,
+		&dog.PrimaryBreedId,
+		&dog.SecondaryBreedId,
+		&dog.Compatibility.Children,
+		&dog.Compatibility.Dogs,
+		&dog.Compatibility.Cats,
+		&dog.Compatibility.SmallAnimals,
+		&dog.EnergyLevel,
+		&dog.Size,
+	}
 }
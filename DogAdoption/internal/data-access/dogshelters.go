@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -18,6 +19,7 @@ type DogShelterQueries struct {
 	dogShelterQuery string
 	totalCountQuery string
 	filterValues    []any
+	withDistance    bool
 }
 
 type DogSheltersDataAccess struct {
@@ -32,18 +34,8 @@ func NewDogSheltersDataAccess(dbPool *pgxpool.Pool) DogSheltersDataAccess {
 
 func (d DogSheltersDataAccess) GetDogShelterByUsername(ctx context.Context, username string) (model.DogShelter, error) {
 	emptyModel := model.DogShelter{}
-	var dogShelter model.DogShelter
 	query := `SELECT * FROM DogShelters WHERE username = $1;`
-	err := d.dbPool.QueryRow(ctx, query, username).Scan(
-		&dogShelter.Id,
-		&dogShelter.Name,
-		&dogShelter.Website,
-		&dogShelter.Country,
-		&dogShelter.City,
-		&dogShelter.Address,
-		&dogShelter.Username,
-		&dogShelter.Password,
-	)
+	dogShelter, err := d.dogShelterScanner(d.dbPool.QueryRow(ctx, query, username))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return emptyModel, &customerrors.DogShelterNotFoundError{}
@@ -62,6 +54,9 @@ func (d DogSheltersDataAccess) GetDogShelters(ctx context.Context, queryParams d
 	}
 
 	shelters, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.DogShelter, error) {
+		if queries.withDistance {
+			return d.dogShelterDistanceScanner(row)
+		}
 		shelter, err := d.dogShelterScanner(row)
 		return shelter, err
 	})
@@ -69,6 +64,10 @@ func (d DogSheltersDataAccess) GetDogShelters(ctx context.Context, queryParams d
 	if err != nil {
 		return emptyDto, &customerrors.DatabaseError{Message: "getDogShelters had a database error"}
 	}
+	err = d.loadShelterDetails(ctx, shelters)
+	if err != nil {
+		return emptyDto, err
+	}
 
 	var totalCount int
 	err = d.dbPool.QueryRow(ctx, queries.totalCountQuery, queries.filterValues...).Scan(&totalCount)
@@ -103,6 +102,10 @@ func (d DogSheltersDataAccess) GetDogShelterById(ctx context.Context, shelterId
 	if len(dogShelter) == 0 {
 		return emptyModel, &customerrors.DogShelterNotFoundError{}
 	}
+	err = d.loadShelterDetails(ctx, dogShelter)
+	if err != nil {
+		return emptyModel, err
+	}
 	return dogShelter[0], nil
 }
 
@@ -120,10 +123,17 @@ func (d DogSheltersDataAccess) DeleteDogShelter(ctx context.Context, shelterId i
 	}
 }
 
+// UpdateDogShelter updates the set fields and replaces the opening hours and exceptions if they are set.
 func (d DogSheltersDataAccess) UpdateDogShelter(ctx context.Context,
 	shelterId int, dogShelterData dogshelterdto.UpdateDogShelterDTO) error {
-	query := d.createUpdateDogShelterQuery(shelterId, dogShelterData)
-	result, err := d.dbPool.Exec(ctx, query)
+	tx, err := d.dbPool.Begin(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
+	query, values := d.createUpdateDogShelterQuery(shelterId, dogShelterData)
+	result, err := tx.Exec(ctx, query, values...)
 	if err != nil {
 		return &customerrors.DatabaseError{}
 	}
@@ -131,15 +141,32 @@ func (d DogSheltersDataAccess) UpdateDogShelter(ctx context.Context,
 	if rowsAffected == 0 {
 		return &customerrors.DogShelterNotFoundError{}
 	}
+	err = d.replaceOpeningHours(ctx, tx, shelterId, dogShelterData.ShelterDetailsDTO)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
 	return nil
 }
 
 func (d DogSheltersDataAccess) CreateDogShelter(ctx context.Context, newShelter dogshelterdto.NewDogShelterDTO) (int, error) {
+	tx, err := d.dbPool.Begin(ctx)
+	if err != nil {
+		return 0, &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
 	query := `INSERT INTO DogShelters 
-	(name, website, country, city, address, username, password)
-	VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`
+	(name, website, country, city, address, username, password, phone, email, capacity, timezone,
+	latitude, longitude, geocode_accuracy)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, COALESCE($11, 'UTC'), $12, $13, $14) RETURNING id`
+	latitude, longitude, accuracy := locationValues(newShelter.ShelterDetailsDTO)
 	var id int
-	err := d.dbPool.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		*newShelter.Name,
 		*newShelter.Website,
 		*newShelter.Country,
@@ -147,15 +174,159 @@ func (d DogSheltersDataAccess) CreateDogShelter(ctx context.Context, newShelter
 		*newShelter.Address,
 		*newShelter.Username,
 		*newShelter.Password,
+		newShelter.Phone,
+		newShelter.Email,
+		newShelter.Capacity,
+		newShelter.Timezone,
+		latitude,
+		longitude,
+		accuracy,
 	).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return 0, &customerrors.UsernameTakenError{Message: "username is already taken. try another one!"}
+		}
+		return 0, &customerrors.DatabaseError{}
+	}
+	err = d.replaceOpeningHours(ctx, tx, id, newShelter.ShelterDetailsDTO)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, &customerrors.DatabaseError{}
+	}
+	return id, nil
+}
+
+// CreateDogShelterRegistration stores a self-registered shelter that can not log in until an admin approves it.
+func (d DogSheltersDataAccess) CreateDogShelterRegistration(ctx context.Context, registration dogshelterdto.NewDogShelterRegistrationDTO) (int, error) {
+	tx, err := d.dbPool.Begin(ctx)
+	if err != nil {
+		return 0, &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
+	query := `INSERT INTO DogShelters 
+	(name, website, country, city, address, username, password, status, contact_email, phone, email, capacity, timezone,
+	latitude, longitude, geocode_accuracy)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, COALESCE($13, 'UTC'), $14, $15, $16) RETURNING id`
+	latitude, longitude, accuracy := locationValues(registration.ShelterDetailsDTO)
+	var id int
+	err = tx.QueryRow(ctx, query,
+		*registration.Name,
+		*registration.Website,
+		*registration.Country,
+		*registration.City,
+		*registration.Address,
+		*registration.Username,
+		*registration.Password,
+		string(model.SHELTER_PENDING),
+		*registration.ContactEmail,
+		registration.Phone,
+		registration.Email,
+		registration.Capacity,
+		registration.Timezone,
+		latitude,
+		longitude,
+		accuracy,
+	).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return 0, &customerrors.UsernameTakenError{Message: "username is already taken. try another one!"}
+		}
+		return 0, &customerrors.DatabaseError{}
+	}
+	err = d.replaceOpeningHours(ctx, tx, id, registration.ShelterDetailsDTO)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit(ctx)
 	if err != nil {
 		return 0, &customerrors.DatabaseError{}
 	}
 	return id, nil
 }
 
+func (d DogSheltersDataAccess) GetDogShelterRegistrations(ctx context.Context, queryParams dto.QueryParams) (dogshelterdto.GetDogSheltersQueryResponseDTO, error) {
+	emptyDto := dogshelterdto.GetDogSheltersQueryResponseDTO{}
+	qb := NewQueryBuilder("SELECT * FROM DogShelters")
+	status := string(model.SHELTER_PENDING)
+	if queryParams.ShelterRegistrationFilter != nil && queryParams.ShelterRegistrationFilter.Status != nil {
+		status = *queryParams.ShelterRegistrationFilter.Status
+	}
+	qb.withFilterParam("status", status)
+	qb.withOrderBy("registered_at, id")
+	paginationParams := queryParams.Pagination
+	if paginationParams != nil {
+		if paginationParams.Limit != nil {
+			qb.withLimit(*paginationParams.Limit)
+		}
+		if paginationParams.Page != nil {
+			qb.withPage(*paginationParams.Page)
+		}
+	}
+
+	query, filterValues := qb.build()
+	rows, err := d.dbPool.Query(ctx, query, filterValues...)
+	if err != nil {
+		return emptyDto, &customerrors.DatabaseError{}
+	}
+	shelters, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.DogShelter, error) {
+		return d.dogShelterScanner(row)
+	})
+	if err != nil {
+		return emptyDto, &customerrors.DatabaseError{Message: "getDogShelterRegistrations had a database error"}
+	}
+
+	var totalCount int
+	countQuery, _ := qb.buildForTotalCount()
+	err = d.dbPool.QueryRow(ctx, countQuery, filterValues...).Scan(&totalCount)
+	if err != nil {
+		return emptyDto, &customerrors.DatabaseError{}
+	}
+
+	return dogshelterdto.GetDogSheltersQueryResponseDTO{
+		DogShelters:          shelters,
+		TotalAmountAvailable: totalCount,
+	}, nil
+}
+
+// ReviewDogShelterRegistration records the admin decision on a pending registration. Only pending
+// registrations can be reviewed, so that two admins deciding at the same time can not both succeed.
+func (d DogSheltersDataAccess) ReviewDogShelterRegistration(ctx context.Context, shelterId int, status model.DogShelterStatus, reason *string) error {
+	query := `UPDATE DogShelters SET status = $1, review_reason = $2, reviewed_at = now()
+	WHERE id = $3 AND status = $4`
+	result, err := d.dbPool.Exec(ctx, query, string(status), reason, shelterId, string(model.SHELTER_PENDING))
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	if result.RowsAffected() == 0 {
+		shelter, err := d.GetDogShelterById(ctx, shelterId)
+		if err != nil {
+			return err
+		}
+		return &customerrors.ShelterRegistrationReviewedError{
+			Message: fmt.Sprintf("the registration has already been %s", shelter.Status),
+		}
+	}
+	return nil
+}
+
 func (d DogSheltersDataAccess) createQuery(queryParams dto.QueryParams) DogShelterQueries {
 	qb := NewQueryBuilder("SELECT * FROM DogShelters")
+	geoFilter := queryParams.GeoFilter
+	if geoFilter != nil {
+		qb = NewQueryBuilderWithParams(fmt.Sprintf("SELECT * FROM (SELECT DogShelters.*, %s AS distance_km FROM DogShelters) AS DogShelters",
+			haversineDistance("DogShelters.latitude", "DogShelters.longitude", "$1", "$2")),
+			geoFilter.Latitude, geoFilter.Longitude)
+		withDistance(&qb, *geoFilter)
+	}
+	qb.withCondition("status = 'approved'")
 
 	dogShelterFilters := queryParams.DogShelterFilter
 
@@ -168,6 +339,13 @@ func (d DogSheltersDataAccess) createQuery(queryParams dto.QueryParams) DogShelt
 	if dogShelterFilters.Name != nil {
 		qb.withFilterParam("name", *dogShelterFilters.Name)
 	}
+	if dogShelterFilters.OpenNow != nil {
+		if *dogShelterFilters.OpenNow {
+			qb.withCondition(openNowCondition)
+		} else {
+			qb.withCondition("NOT " + openNowCondition)
+		}
+	}
 
 	paginationParams := queryParams.Pagination
 	if paginationParams != nil {
@@ -185,13 +363,26 @@ func (d DogSheltersDataAccess) createQuery(queryParams dto.QueryParams) DogShelt
 		dogShelterQuery: selectQuery,
 		totalCountQuery: countQuery,
 		filterValues:    filterValues,
+		withDistance:    geoFilter != nil,
 	}
 	return queries
 }
 
-func (d DogSheltersDataAccess) dogShelterScanner(row pgx.CollectableRow) (model.DogShelter, error) {
+func (d DogSheltersDataAccess) dogShelterScanner(row pgx.Row) (model.DogShelter, error) {
 	var dogShelter model.DogShelter
-	err := row.Scan(
+	err := row.Scan(d.dogShelterScanDestinations(&dogShelter)...)
+	return dogShelter, err
+}
+
+// dogShelterDistanceScanner scans a shelter followed by the distance_km column of a distance search.
+func (d DogSheltersDataAccess) dogShelterDistanceScanner(row pgx.Row) (model.DogShelter, error) {
+	var dogShelter model.DogShelter
+	err := row.Scan(append(d.dogShelterScanDestinations(&dogShelter), &dogShelter.DistanceKm)...)
+	return dogShelter, err
+}
+
+func (d DogSheltersDataAccess) dogShelterScanDestinations(dogShelter *model.DogShelter) []any {
+	return []any{
 		&dogShelter.Id,
 		&dogShelter.Name,
 		&dogShelter.Website,
@@ -200,21 +391,31 @@ func (d DogSheltersDataAccess) dogShelterScanner(row pgx.CollectableRow) (model.
 		&dogShelter.Address,
 		&dogShelter.Username,
 		&dogShelter.Password,
-	)
-	return dogShelter, err
+		&dogShelter.Status,
+		&dogShelter.ContactEmail,
+		&dogShelter.RegisteredAt,
+		&dogShelter.ReviewedAt,
+		&dogShelter.ReviewReason,
+		&dogShelter.Phone,
+		&dogShelter.Email,
+		&dogShelter.Capacity,
+		&dogShelter.Timezone,
+		&dogShelter.Latitude,
+		&dogShelter.Longitude,
+		&dogShelter.GeocodeAccuracy,
+	}
 }
 
-func (d DogSheltersDataAccess) createUpdateDogShelterQuery(dogShelterId int, dogShelter dogshelterdto.UpdateDogShelterDTO) string {
+// createUpdateDogShelterQuery creates an update of the set columns. Without set columns the query
+// only matches the shelter, so that a missing shelter is still detected.
+func (d DogSheltersDataAccess) createUpdateDogShelterQuery(dogShelterId int, dogShelter dogshelterdto.UpdateDogShelterDTO) (string, []any) {
 	query := `UPDATE DogShelters SET `
 	updates := []string{}
+	values := []any{}
 
-	addUpdate := func(field string, value interface{}) {
-		switch v := value.(type) {
-		case string:
-			updates = append(updates, fmt.Sprintf("%s = '%s'", field, v))
-		case int, int64:
-			updates = append(updates, fmt.Sprintf("%s = %d", field, v))
-		}
+	addUpdate := func(field string, value any) {
+		values = append(values, value)
+		updates = append(updates, fmt.Sprintf("%s = $%d", field, len(values)))
 	}
 
 	if dogShelter.Name != nil {
@@ -224,7 +425,7 @@ func (d DogSheltersDataAccess) createUpdateDogShelterQuery(dogShelterId int, dog
 		addUpdate("website", *dogShelter.Website)
 	}
 	if dogShelter.Country != nil {
-		addUpdate("country", dogShelter.Country)
+		addUpdate("country", *dogShelter.Country)
 	}
 	if dogShelter.City != nil {
 		addUpdate("city", *dogShelter.City)
@@ -232,9 +433,38 @@ func (d DogSheltersDataAccess) createUpdateDogShelterQuery(dogShelterId int, dog
 	if dogShelter.Address != nil {
 		addUpdate("address", *dogShelter.Address)
 	}
+	if dogShelter.Phone != nil {
+		addUpdate("phone", *dogShelter.Phone)
+	}
+	if dogShelter.Email != nil {
+		addUpdate("email", *dogShelter.Email)
+	}
+	if dogShelter.Capacity != nil {
+		addUpdate("capacity", *dogShelter.Capacity)
+	}
+	if dogShelter.Timezone != nil {
+		addUpdate("timezone", *dogShelter.Timezone)
+	}
+	if dogShelter.Location != nil {
+		addUpdate("latitude", dogShelter.Location.Latitude)
+		addUpdate("longitude", dogShelter.Location.Longitude)
+		addUpdate("geocode_accuracy", dogShelter.Location.Accuracy)
+	}
+	if len(updates) == 0 {
+		updates = append(updates, "id = id")
+	}
 
+	values = append(values, dogShelterId)
 	query += strings.Join(updates, ", ")
-	query += fmt.Sprintf(" WHERE id = %d;", dogShelterId)
+	query += fmt.Sprintf(" WHERE id = $%d;", len(values))
 
-	return query
+	return query, values
+}
+
+// locationValues returns the resolved location of a new shelter, which is stored without coordinates if it is not resolved.
+func locationValues(details dogshelterdto.ShelterDetailsDTO) (*float64, *float64, *string) {
+	if details.Location == nil {
+		return nil, nil, nil
+	}
+	return details.Location.Latitude, details.Location.Longitude, details.Location.Accuracy
 }
@@ -0,0 +1,91 @@
+package dataaccess
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type EmailVerificationsDataAccess struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewEmailVerificationsDataAccess(dbPool *pgxpool.Pool) EmailVerificationsDataAccess {
+	return EmailVerificationsDataAccess{
+		dbPool: dbPool,
+	}
+}
+
+// CreateEmailVerificationToken stores a new token and invalidates the earlier tokens of the user,
+// so that only the latest sent verification email can be used.
+func (e EmailVerificationsDataAccess) CreateEmailVerificationToken(ctx context.Context, token model.EmailVerificationToken) error {
+	tx, err := e.dbPool.Begin(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `UPDATE EmailVerificationTokens SET used_at = NOW() WHERE user_id = $1 AND used_at IS NULL`, token.UserId)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not invalidate email verification tokens"}
+	}
+
+	query := `INSERT INTO EmailVerificationTokens (token_hash, user_id, email, expires_at) VALUES ($1, $2, $3, $4)`
+	_, err = tx.Exec(ctx, query, token.TokenHash, token.UserId, token.Email, token.ExpiresAt)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not create email verification token"}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	return nil
+}
+
+// VerifyEmail consumes an unused and unexpired token, and marks the email of the user as verified
+// if it is still the email the token was sent to.
+func (e EmailVerificationsDataAccess) VerifyEmail(ctx context.Context, tokenHash string) (model.EmailVerificationToken, error) {
+	emptyModel := model.EmailVerificationToken{}
+	tx, err := e.dbPool.Begin(ctx)
+	if err != nil {
+		return emptyModel, &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
+	query := `UPDATE EmailVerificationTokens SET used_at = NOW()
+	WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW() RETURNING *`
+	var token model.EmailVerificationToken
+	err = tx.QueryRow(ctx, query, tokenHash).Scan(
+		&token.TokenHash,
+		&token.UserId,
+		&token.Email,
+		&token.ExpiresAt,
+		&token.UsedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return emptyModel, &customerrors.EmailVerificationTokenNotFoundError{}
+		}
+		return emptyModel, &customerrors.DatabaseError{}
+	}
+
+	result, err := tx.Exec(ctx, `UPDATE Users SET email_status = $1 WHERE id = $2 AND email = $3`,
+		model.EMAIL_VERIFIED, token.UserId, token.Email)
+	if err != nil {
+		return emptyModel, &customerrors.DatabaseError{Message: "could not verify email"}
+	}
+	if result.RowsAffected() == 0 {
+		return emptyModel, &customerrors.EmailVerificationTokenNotFoundError{}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return emptyModel, &customerrors.DatabaseError{}
+	}
+	return token, nil
+}
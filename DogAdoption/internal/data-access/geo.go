@@ -0,0 +1,31 @@
+package dataaccess
+
+import (
+	"1dv027/aad/internal/dto"
+	"fmt"
+	"strconv"
+)
+
+const earthRadiusKm = 6371.0
+
+// haversineDistance returns an SQL expression for the great-circle distance in kilometers between the coordinate
+// columns and the point given by the latitude and longitude parameters, such as $1 and $2.
+func haversineDistance(latitudeColumn, longitudeColumn, latitudeParam, longitudeParam string) string {
+	latitude := latitudeParam + "::double precision"
+	longitude := longitudeParam + "::double precision"
+	return fmt.Sprintf(`(%s * 2 * asin(sqrt(least(1,
+		power(sin(radians(%s - %s) / 2), 2) +
+		cos(radians(%s)) * cos(radians(%s)) * power(sin(radians(%s - %s) / 2), 2)))))`,
+		strconv.FormatFloat(earthRadiusKm, 'f', -1, 64),
+		latitudeColumn, latitude,
+		latitude, latitudeColumn,
+		longitudeColumn, longitude)
+}
+
+// withDistance adds the radius filter of the geo filter and sorts by distance. Rows without coordinates come last.
+func withDistance(qb *queryBuilder, geoFilter dto.GeoFilterParams) {
+	if geoFilter.RadiusKm != nil {
+		qb.withMaxParam("distance_km", *geoFilter.RadiusKm)
+	}
+	qb.withOrderBy("distance_km NULLS LAST, id")
+}
@@ -0,0 +1,133 @@
+package dataaccess
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type MfaDataAccess struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewMfaDataAccess(dbPool *pgxpool.Pool) MfaDataAccess {
+	return MfaDataAccess{
+		dbPool: dbPool,
+	}
+}
+
+func (m MfaDataAccess) GetMfaEnrollment(ctx context.Context, accountId int, role model.UserRole) (model.MfaEnrollment, error) {
+	emptyModel := model.MfaEnrollment{}
+	query := `SELECT id, account_id, account_role, secret, is_enabled, recovery_codes, last_used_step
+	FROM MfaEnrollments WHERE account_id = $1 AND account_role = $2`
+	var enrollment model.MfaEnrollment
+	err := m.dbPool.QueryRow(ctx, query, accountId, role).Scan(
+		&enrollment.Id,
+		&enrollment.AccountId,
+		&enrollment.AccountRole,
+		&enrollment.Secret,
+		&enrollment.IsEnabled,
+		&enrollment.RecoveryCodes,
+		&enrollment.LastUsedStep,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return emptyModel, &customerrors.MfaEnrollmentNotFoundError{}
+		}
+		return emptyModel, &customerrors.DatabaseError{}
+	}
+	return enrollment, nil
+}
+
+func (m MfaDataAccess) UpsertMfaEnrollment(ctx context.Context, accountId int, role model.UserRole, secret string, recoveryCodes []string) error {
+	query := `INSERT INTO MfaEnrollments (account_id, account_role, secret, is_enabled, recovery_codes, last_used_step)
+	VALUES ($1, $2, $3, FALSE, $4, 0)
+	ON CONFLICT (account_id, account_role)
+	DO UPDATE SET secret = EXCLUDED.secret, is_enabled = FALSE, recovery_codes = EXCLUDED.recovery_codes, last_used_step = 0`
+	_, err := m.dbPool.Exec(ctx, query, accountId, role, secret, recoveryCodes)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not store mfa enrollment"}
+	}
+	return nil
+}
+
+func (m MfaDataAccess) EnableMfaEnrollment(ctx context.Context, accountId int, role model.UserRole, usedStep int64) error {
+	query := `UPDATE MfaEnrollments SET is_enabled = TRUE, last_used_step = $3 WHERE account_id = $1 AND account_role = $2`
+	result, err := m.dbPool.Exec(ctx, query, accountId, role, usedStep)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.MfaEnrollmentNotFoundError{}
+	}
+	return nil
+}
+
+// UpdateMfaLastUsedStep only moves the last used step forward, so a code can only be used once even when it
+// is sent in several requests at the same time.
+func (m MfaDataAccess) UpdateMfaLastUsedStep(ctx context.Context, accountId int, role model.UserRole, usedStep int64) error {
+	query := `UPDATE MfaEnrollments SET last_used_step = $3
+	WHERE account_id = $1 AND account_role = $2 AND last_used_step < $3`
+	result, err := m.dbPool.Exec(ctx, query, accountId, role, usedStep)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.InvalidMfaCodeError{Message: "invalid mfa code"}
+	}
+	return nil
+}
+
+// ConsumeMfaRecoveryCode removes the hashed recovery code in a single update, so a code can only be used once
+// even when it is sent in several requests at the same time.
+func (m MfaDataAccess) ConsumeMfaRecoveryCode(ctx context.Context, accountId int, role model.UserRole, hashedCode string) error {
+	query := `UPDATE MfaEnrollments SET recovery_codes = array_remove(recovery_codes, $3)
+	WHERE account_id = $1 AND account_role = $2 AND $3 = ANY(recovery_codes)`
+	result, err := m.dbPool.Exec(ctx, query, accountId, role, hashedCode)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.InvalidMfaCodeError{Message: "invalid recovery code"}
+	}
+	return nil
+}
+
+func (m MfaDataAccess) DeleteMfaEnrollment(ctx context.Context, accountId int, role model.UserRole) error {
+	query := `DELETE FROM MfaEnrollments WHERE account_id = $1 AND account_role = $2`
+	result, err := m.dbPool.Exec(ctx, query, accountId, role)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.MfaEnrollmentNotFoundError{}
+	}
+	return nil
+}
+
+func (m MfaDataAccess) GetMfaPolicy(ctx context.Context, role model.UserRole) (model.MfaPolicy, error) {
+	query := `SELECT role, is_required FROM MfaPolicies WHERE role = $1`
+	var policy model.MfaPolicy
+	err := m.dbPool.QueryRow(ctx, query, role).Scan(&policy.Role, &policy.IsRequired)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.MfaPolicy{Role: role, IsRequired: false}, nil
+		}
+		return model.MfaPolicy{}, &customerrors.DatabaseError{}
+	}
+	return policy, nil
+}
+
+func (m MfaDataAccess) UpsertMfaPolicy(ctx context.Context, policy model.MfaPolicy) error {
+	query := `INSERT INTO MfaPolicies (role, is_required) VALUES ($1, $2)
+	ON CONFLICT (role) DO UPDATE SET is_required = EXCLUDED.is_required`
+	_, err := m.dbPool.Exec(ctx, query, policy.Role, policy.IsRequired)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not store mfa policy"}
+	}
+	return nil
+}
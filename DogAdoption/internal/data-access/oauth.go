@@ -0,0 +1,252 @@
+package dataaccess
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type OAuthDataAccess struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewOAuthDataAccess(dbPool *pgxpool.Pool) OAuthDataAccess {
+	return OAuthDataAccess{
+		dbPool: dbPool,
+	}
+}
+
+func (o OAuthDataAccess) CreateClient(ctx context.Context, client model.OAuthClient) (model.OAuthClient, error) {
+	query := `INSERT INTO OAuthClients (client_id, client_secret_hash, name, redirect_uris, scopes, grant_types, is_confidential, owner_user_id)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *`
+	createdClient, err := o.scanClient(o.dbPool.QueryRow(ctx, query, client.ClientId, client.ClientSecretHash, client.Name,
+		client.RedirectUris, client.Scopes, client.GrantTypes, client.IsConfidential, client.OwnerUserId))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return model.OAuthClient{}, &customerrors.InvalidOAuthClientDataError{Message: "owner_user_id does not belong to a registered user"}
+		}
+		return model.OAuthClient{}, &customerrors.DatabaseError{Message: "could not create oauth client"}
+	}
+	return createdClient, nil
+}
+
+func (o OAuthDataAccess) GetClients(ctx context.Context) ([]model.OAuthClient, error) {
+	query := `SELECT * FROM OAuthClients ORDER BY id`
+	clients := []model.OAuthClient{}
+
+	rows, err := o.dbPool.Query(ctx, query)
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		client, err := o.scanClient(rows)
+		if err != nil {
+			return nil, &customerrors.DatabaseError{}
+		}
+		clients = append(clients, client)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	return clients, nil
+}
+
+func (o OAuthDataAccess) GetClient(ctx context.Context, clientId string) (model.OAuthClient, error) {
+	query := `SELECT * FROM OAuthClients WHERE client_id = $1`
+	client, err := o.scanClient(o.dbPool.QueryRow(ctx, query, clientId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.OAuthClient{}, &customerrors.OAuthClientNotFoundError{}
+		}
+		return model.OAuthClient{}, &customerrors.DatabaseError{}
+	}
+	return client, nil
+}
+
+func (o OAuthDataAccess) DeleteClient(ctx context.Context, clientId string) error {
+	query := `DELETE FROM OAuthClients WHERE client_id = $1`
+	result, err := o.dbPool.Exec(ctx, query, clientId)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "something went wrong trying to delete oauth client"}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.OAuthClientNotFoundError{Message: "no oauth client was deleted"}
+	}
+	return nil
+}
+
+func (o OAuthDataAccess) GetConsent(ctx context.Context, userId int, clientId string) (model.OAuthConsent, error) {
+	query := `SELECT * FROM OAuthConsents WHERE user_id = $1 AND client_id = $2`
+	consent, err := o.scanConsent(o.dbPool.QueryRow(ctx, query, userId, clientId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.OAuthConsent{}, &customerrors.OAuthConsentNotFoundError{}
+		}
+		return model.OAuthConsent{}, &customerrors.DatabaseError{}
+	}
+	return consent, nil
+}
+
+func (o OAuthDataAccess) GetUserConsents(ctx context.Context, userId int) ([]model.OAuthConsent, error) {
+	query := `SELECT * FROM OAuthConsents WHERE user_id = $1 ORDER BY id`
+	consents := []model.OAuthConsent{}
+
+	rows, err := o.dbPool.Query(ctx, query, userId)
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		consent, err := o.scanConsent(rows)
+		if err != nil {
+			return nil, &customerrors.DatabaseError{}
+		}
+		consents = append(consents, consent)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	return consents, nil
+}
+
+func (o OAuthDataAccess) UpsertConsent(ctx context.Context, userId int, clientId string, scopes []string) error {
+	query := `INSERT INTO OAuthConsents (user_id, client_id, scopes) VALUES ($1, $2, $3)
+	ON CONFLICT (user_id, client_id) DO UPDATE SET scopes = EXCLUDED.scopes, granted_at = NOW()`
+	_, err := o.dbPool.Exec(ctx, query, userId, clientId, scopes)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not save oauth consent"}
+	}
+	return nil
+}
+
+func (o OAuthDataAccess) DeleteConsent(ctx context.Context, userId int, clientId string) error {
+	query := `DELETE FROM OAuthConsents WHERE user_id = $1 AND client_id = $2`
+	result, err := o.dbPool.Exec(ctx, query, userId, clientId)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "something went wrong trying to delete oauth consent"}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.OAuthConsentNotFoundError{Message: "no oauth consent was deleted"}
+	}
+	return nil
+}
+
+func (o OAuthDataAccess) CreateAuthorizationCode(ctx context.Context, code model.OAuthAuthorizationCode) error {
+	query := `INSERT INTO OAuthAuthorizationCodes (code_hash, client_id, user_id, redirect_uri, redirect_uri_supplied,
+	scopes, code_challenge, expires_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	_, err := o.dbPool.Exec(ctx, query, code.CodeHash, code.ClientId, code.UserId, code.RedirectUri, code.RedirectUriSupplied,
+		code.Scopes, code.CodeChallenge, code.ExpiresAt)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not create authorization code"}
+	}
+	return nil
+}
+
+// ConsumeAuthorizationCode marks an unused and unexpired code of the client as used and returns it,
+// so that a code can only be exchanged once, and only by the client it was issued to.
+func (o OAuthDataAccess) ConsumeAuthorizationCode(ctx context.Context, codeHash string, clientId string) (model.OAuthAuthorizationCode, error) {
+	query := `UPDATE OAuthAuthorizationCodes SET is_used = TRUE
+	WHERE code_hash = $1 AND client_id = $2 AND is_used = FALSE AND expires_at > NOW()
+	RETURNING code_hash, client_id, user_id, redirect_uri, redirect_uri_supplied, scopes, code_challenge, expires_at, is_used`
+	var code model.OAuthAuthorizationCode
+	err := o.dbPool.QueryRow(ctx, query, codeHash, clientId).Scan(
+		&code.CodeHash,
+		&code.ClientId,
+		&code.UserId,
+		&code.RedirectUri,
+		&code.RedirectUriSupplied,
+		&code.Scopes,
+		&code.CodeChallenge,
+		&code.ExpiresAt,
+		&code.IsUsed,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.OAuthAuthorizationCode{}, &customerrors.OAuthCodeNotFoundError{}
+		}
+		return model.OAuthAuthorizationCode{}, &customerrors.DatabaseError{}
+	}
+	return code, nil
+}
+
+func (o OAuthDataAccess) CreateRefreshToken(ctx context.Context, token model.OAuthRefreshToken) error {
+	query := `INSERT INTO OAuthRefreshTokens (token_hash, client_id, user_id, scopes, expires_at) VALUES ($1, $2, $3, $4, $5)`
+	_, err := o.dbPool.Exec(ctx, query, token.TokenHash, token.ClientId, token.UserId, token.Scopes, token.ExpiresAt)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not create refresh token"}
+	}
+	return nil
+}
+
+// ConsumeRefreshToken revokes an active refresh token of the client and returns it, so that
+// refresh tokens are rotated on every use. A token presented by another client is left untouched.
+func (o OAuthDataAccess) ConsumeRefreshToken(ctx context.Context, tokenHash string, clientId string) (model.OAuthRefreshToken, error) {
+	query := `UPDATE OAuthRefreshTokens SET revoked_at = NOW()
+	WHERE token_hash = $1 AND client_id = $2 AND revoked_at IS NULL AND expires_at > NOW() RETURNING *`
+	var token model.OAuthRefreshToken
+	err := o.dbPool.QueryRow(ctx, query, tokenHash, clientId).Scan(
+		&token.TokenHash,
+		&token.ClientId,
+		&token.UserId,
+		&token.Scopes,
+		&token.ExpiresAt,
+		&token.RevokedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.OAuthRefreshToken{}, &customerrors.OAuthRefreshTokenNotFoundError{}
+		}
+		return model.OAuthRefreshToken{}, &customerrors.DatabaseError{}
+	}
+	return token, nil
+}
+
+func (o OAuthDataAccess) RevokeRefreshTokens(ctx context.Context, userId int, clientId string) error {
+	query := `UPDATE OAuthRefreshTokens SET revoked_at = NOW() WHERE user_id = $1 AND client_id = $2 AND revoked_at IS NULL`
+	_, err := o.dbPool.Exec(ctx, query, userId, clientId)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not revoke refresh tokens"}
+	}
+	return nil
+}
+
+func (o OAuthDataAccess) scanClient(row pgx.Row) (model.OAuthClient, error) {
+	var client model.OAuthClient
+	err := row.Scan(
+		&client.Id,
+		&client.ClientId,
+		&client.ClientSecretHash,
+		&client.Name,
+		&client.RedirectUris,
+		&client.Scopes,
+		&client.GrantTypes,
+		&client.IsConfidential,
+		&client.OwnerUserId,
+		&client.CreatedAt,
+	)
+	return client, err
+}
+
+func (o OAuthDataAccess) scanConsent(row pgx.Row) (model.OAuthConsent, error) {
+	var consent model.OAuthConsent
+	err := row.Scan(
+		&consent.Id,
+		&consent.UserId,
+		&consent.ClientId,
+		&consent.Scopes,
+		&consent.GrantedAt,
+	)
+	return consent, err
+}
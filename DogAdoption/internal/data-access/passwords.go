@@ -0,0 +1,171 @@
+package dataaccess
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PasswordsDataAccess struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewPasswordsDataAccess(dbPool *pgxpool.Pool) PasswordsDataAccess {
+	return PasswordsDataAccess{
+		dbPool: dbPool,
+	}
+}
+
+func (p PasswordsDataAccess) GetPasswordHash(ctx context.Context, accountId int, role model.UserRole) (string, error) {
+	table, notFoundError, err := p.getAccountTable(role)
+	if err != nil {
+		return "", err
+	}
+	query := fmt.Sprintf(`SELECT password FROM %s WHERE id = $1`, table)
+	var passwordHash string
+	err = p.dbPool.QueryRow(ctx, query, accountId).Scan(&passwordHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", notFoundError
+		}
+		return "", &customerrors.DatabaseError{}
+	}
+	return passwordHash, nil
+}
+
+// UpdatePassword sets the new password hash, and in the same transaction invalidates outstanding
+// password reset tokens and revokes the oauth refresh tokens issued for the account.
+func (p PasswordsDataAccess) UpdatePassword(ctx context.Context, accountId int, role model.UserRole, passwordHash string) error {
+	table, notFoundError, err := p.getAccountTable(role)
+	if err != nil {
+		return err
+	}
+
+	tx, err := p.dbPool.Begin(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, fmt.Sprintf(`UPDATE %s SET password = $1 WHERE id = $2`, table), passwordHash, accountId)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not update password"}
+	}
+	if result.RowsAffected() == 0 {
+		return notFoundError
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE PasswordResetTokens SET used_at = NOW()
+	WHERE account_id = $1 AND account_role = $2 AND used_at IS NULL`, accountId, role)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not invalidate password reset tokens"}
+	}
+
+	if role == model.USER {
+		_, err = tx.Exec(ctx, `UPDATE OAuthRefreshTokens SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL`, accountId)
+		if err != nil {
+			return &customerrors.DatabaseError{Message: "could not revoke refresh tokens"}
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	return nil
+}
+
+func (p PasswordsDataAccess) GetAccountUsername(ctx context.Context, accountId int, role model.UserRole) (string, error) {
+	table, notFoundError, err := p.getAccountTable(role)
+	if err != nil {
+		return "", err
+	}
+	query := fmt.Sprintf(`SELECT username FROM %s WHERE id = $1`, table)
+	var username string
+	err = p.dbPool.QueryRow(ctx, query, accountId).Scan(&username)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", notFoundError
+		}
+		return "", &customerrors.DatabaseError{}
+	}
+	return username, nil
+}
+
+// UpdatePasswordHash replaces the stored hash of an unchanged password, such as when it is
+// rehashed with stronger parameters on login, so no tokens are invalidated.
+func (p PasswordsDataAccess) UpdatePasswordHash(ctx context.Context, accountId int, role model.UserRole, passwordHash string) error {
+	table, notFoundError, err := p.getAccountTable(role)
+	if err != nil {
+		return err
+	}
+	result, err := p.dbPool.Exec(ctx, fmt.Sprintf(`UPDATE %s SET password = $1 WHERE id = $2`, table), passwordHash, accountId)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not update password hash"}
+	}
+	if result.RowsAffected() == 0 {
+		return notFoundError
+	}
+	return nil
+}
+
+func (p PasswordsDataAccess) CreatePasswordResetToken(ctx context.Context, token model.PasswordResetToken) error {
+	query := `INSERT INTO PasswordResetTokens (token_hash, account_id, account_role, expires_at) VALUES ($1, $2, $3, $4)`
+	_, err := p.dbPool.Exec(ctx, query, token.TokenHash, token.AccountId, token.AccountRole, token.ExpiresAt)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not create password reset token"}
+	}
+	return nil
+}
+
+// GetPasswordResetToken returns an unused and unexpired token without consuming it.
+func (p PasswordsDataAccess) GetPasswordResetToken(ctx context.Context, tokenHash string) (model.PasswordResetToken, error) {
+	query := `SELECT * FROM PasswordResetTokens WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW()`
+	return scanPasswordResetToken(p.dbPool.QueryRow(ctx, query, tokenHash))
+}
+
+// ConsumePasswordResetToken marks an unused and unexpired token as used and returns it,
+// so that a token can only be used once.
+func (p PasswordsDataAccess) ConsumePasswordResetToken(ctx context.Context, tokenHash string) (model.PasswordResetToken, error) {
+	query := `UPDATE PasswordResetTokens SET used_at = NOW()
+	WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW() RETURNING *`
+	return scanPasswordResetToken(p.dbPool.QueryRow(ctx, query, tokenHash))
+}
+
+func scanPasswordResetToken(row pgx.Row) (model.PasswordResetToken, error) {
+	var token model.PasswordResetToken
+	err := row.Scan(
+		&token.TokenHash,
+		&token.AccountId,
+		&token.AccountRole,
+		&token.ExpiresAt,
+		&token.UsedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.PasswordResetToken{}, &customerrors.PasswordResetTokenNotFoundError{}
+		}
+		return model.PasswordResetToken{}, &customerrors.DatabaseError{}
+	}
+	return token, nil
+}
+
+func (p PasswordsDataAccess) getAccountTable(role model.UserRole) (string, error, error) {
+	switch role {
+	case model.USER:
+		return "Users", &customerrors.UserNotFoundError{}, nil
+	case model.DOGSHELTER:
+		return "DogShelters", &customerrors.DogShelterNotFoundError{}, nil
+	case model.ADMIN:
+		return "Admins", &customerrors.AdminNotFoundError{}, nil
+	case model.SHELTERSTAFF:
+		return "ShelterStaff", &customerrors.ShelterStaffNotFoundError{}, nil
+	default:
+		return "", nil, &customerrors.UnauthorizedError{Message: "user role not recognized"}
+	}
+}
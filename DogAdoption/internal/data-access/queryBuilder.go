@@ -9,6 +9,7 @@ type queryBuilder struct {
 	baseQuery    string
 	queryFilters []string
 	filterValues []any
+	orderBy      string
 	limit        int
 	offset       int
 }
@@ -19,12 +20,59 @@ func NewQueryBuilder(baseQuery string) queryBuilder {
 	}
 }
 
+// NewQueryBuilderWithParams creates a builder for a base query that references the values as $1 to $n.
+// The parameters of the filters are numbered after them.
+func NewQueryBuilderWithParams(baseQuery string, values ...any) queryBuilder {
+	return queryBuilder{
+		baseQuery:    baseQuery,
+		filterValues: values,
+	}
+}
+
 func (q *queryBuilder) withFilterParam(key, value string) {
-	filterIndex := len(q.queryFilters) + 1
+	filterIndex := len(q.filterValues) + 1
 	q.queryFilters = append(q.queryFilters, fmt.Sprintf("%s=$%d", key, filterIndex))
 	q.filterValues = append(q.filterValues, value)
 }
 
+// withPrefixFilterParam matches values starting with the given prefix, ignoring case.
+func (q *queryBuilder) withPrefixFilterParam(key, prefix string) {
+	filterIndex := len(q.filterValues) + 1
+	q.queryFilters = append(q.queryFilters, fmt.Sprintf("%s ILIKE $%d", key, filterIndex))
+	escapedPrefix := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(prefix)
+	q.filterValues = append(q.filterValues, escapedPrefix+"%")
+}
+
+// withMaxParam matches values that are at most the given value.
+func (q *queryBuilder) withMaxParam(key string, value any) {
+	filterIndex := len(q.filterValues) + 1
+	q.queryFilters = append(q.queryFilters, fmt.Sprintf("%s <= $%d", key, filterIndex))
+	q.filterValues = append(q.filterValues, value)
+}
+
+// withAnyParam matches any of the given values.
+func (q *queryBuilder) withAnyParam(key string, values []string) {
+	filterIndex := len(q.filterValues) + 1
+	q.queryFilters = append(q.queryFilters, fmt.Sprintf("%s = ANY($%d)", key, filterIndex))
+	q.filterValues = append(q.filterValues, values)
+}
+
+// withCondition adds a condition without parameters.
+func (q *queryBuilder) withCondition(condition string) {
+	q.queryFilters = append(q.queryFilters, condition)
+}
+
+// withConditionParam adds a condition with one parameter, referenced as %[1]s in the condition.
+func (q *queryBuilder) withConditionParam(condition string, value any) {
+	filterIndex := len(q.filterValues) + 1
+	q.queryFilters = append(q.queryFilters, fmt.Sprintf(condition, fmt.Sprintf("$%d", filterIndex)))
+	q.filterValues = append(q.filterValues, value)
+}
+
+func (q *queryBuilder) withOrderBy(orderBy string) {
+	q.orderBy = orderBy
+}
+
 func (q *queryBuilder) withLimit(limit int) {
 	q.limit = limit
 }
@@ -40,6 +88,10 @@ func (q *queryBuilder) build() (string, []any) {
 		finalQuery += " WHERE " + strings.Join(q.queryFilters, " AND ")
 	}
 
+	if q.orderBy != "" {
+		finalQuery += " ORDER BY " + q.orderBy
+	}
+
 	if q.limit > 0 {
 		finalQuery += fmt.Sprintf(" LIMIT %d OFFSET %d", q.limit, q.offset)
 	}
@@ -0,0 +1,89 @@
+package dataaccess
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RemindersDataAccess struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewRemindersDataAccess(dbPool *pgxpool.Pool) RemindersDataAccess {
+	return RemindersDataAccess{
+		dbPool: dbPool,
+	}
+}
+
+// GetDueVaccinations returns the vaccinations whose next dose is due within the period, of dogs that
+// are still cared for by a shelter.
+func (r RemindersDataAccess) GetDueVaccinations(ctx context.Context, from, to time.Time) ([]model.VaccinationDueReminder, error) {
+	query := `SELECT DogMedicalRecords.*, Dogs.name, DogShelters.id, DogShelters.name, DogShelters.username,
+	DogShelters.email, DogShelters.contact_email
+	FROM DogMedicalRecords
+	JOIN Dogs ON Dogs.id = DogMedicalRecords.dog_id
+	JOIN DogShelters ON DogShelters.id = Dogs.shelter_id
+	WHERE DogMedicalRecords.record_type = $1 AND DogMedicalRecords.next_due_on BETWEEN $2 AND $3
+	AND Dogs.status <> ALL($4)
+	ORDER BY DogMedicalRecords.next_due_on`
+	rows, err := r.dbPool.Query(ctx, query, model.MEDICAL_VACCINATION, from, to,
+		[]string{string(model.DOG_ADOPTED), string(model.DOG_DECEASED)})
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	reminders, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.VaccinationDueReminder, error) {
+		var reminder model.VaccinationDueReminder
+		record := &reminder.Vaccination
+		shelter := &reminder.Shelter
+		err := row.Scan(&record.Id, &record.DogId, &record.RecordType, &record.Title, &record.RecordDate,
+			&record.NextDueOn, &record.Notes, &record.CreatedAt, &record.UpdatedAt, &reminder.DogName,
+			&shelter.Id, &shelter.Name, &shelter.Username, &shelter.Email, &shelter.ContactEmail)
+		return reminder, err
+	})
+	if err != nil {
+		return nil, &customerrors.DatabaseError{Message: "getDueVaccinations had a database error"}
+	}
+	return reminders, nil
+}
+
+// GetAdoptionsForFollowup returns the adoptions made within the period, of dogs that are still adopted.
+func (r RemindersDataAccess) GetAdoptionsForFollowup(ctx context.Context, from, to time.Time) ([]model.PostAdoptionFollowupReminder, error) {
+	query := `SELECT DogStatusHistory.id, Dogs.id, Dogs.name, DogStatusHistory.changed_at, DogShelters.id,
+	DogShelters.name, DogShelters.username, DogShelters.email, DogShelters.contact_email
+	FROM DogStatusHistory
+	JOIN Dogs ON Dogs.id = DogStatusHistory.dog_id
+	JOIN DogShelters ON DogShelters.id = Dogs.shelter_id
+	WHERE DogStatusHistory.to_status = $1 AND Dogs.status = $1
+	AND DogStatusHistory.changed_at >= $2 AND DogStatusHistory.changed_at < $3
+	ORDER BY DogStatusHistory.changed_at`
+	rows, err := r.dbPool.Query(ctx, query, model.DOG_ADOPTED, from, to)
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	reminders, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.PostAdoptionFollowupReminder, error) {
+		var reminder model.PostAdoptionFollowupReminder
+		shelter := &reminder.Shelter
+		err := row.Scan(&reminder.StatusChangeId, &reminder.DogId, &reminder.DogName, &reminder.AdoptedAt,
+			&shelter.Id, &shelter.Name, &shelter.Username, &shelter.Email, &shelter.ContactEmail)
+		return reminder, err
+	})
+	if err != nil {
+		return nil, &customerrors.DatabaseError{Message: "getAdoptionsForFollowup had a database error"}
+	}
+	return reminders, nil
+}
+
+// MarkReminderSent records the reminder, and reports false if it was already sent.
+func (r RemindersDataAccess) MarkReminderSent(ctx context.Context, kind model.ReminderKind, referenceId int, dueOn time.Time) (bool, error) {
+	result, err := r.dbPool.Exec(ctx, `INSERT INTO SentReminders (kind, reference_id, due_on) VALUES ($1, $2, $3)
+	ON CONFLICT DO NOTHING`, kind, referenceId, dueOn)
+	if err != nil {
+		return false, &customerrors.DatabaseError{Message: "could not record reminder"}
+	}
+	return result.RowsAffected() == 1, nil
+}
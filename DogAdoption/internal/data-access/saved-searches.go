@@ -0,0 +1,165 @@
+package dataaccess
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SavedSearchesDataAccess struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewSavedSearchesDataAccess(dbPool *pgxpool.Pool) SavedSearchesDataAccess {
+	return SavedSearchesDataAccess{
+		dbPool: dbPool,
+	}
+}
+
+func (s SavedSearchesDataAccess) CreateSavedSearch(ctx context.Context, savedSearch model.SavedSearch) (model.SavedSearch, error) {
+	query := `INSERT INTO SavedSearches (user_id, name, query, channel, frequency) VALUES ($1, $2, $3, $4, $5) RETURNING *`
+	created, err := s.scanSavedSearch(s.dbPool.QueryRow(ctx, query, savedSearch.UserId, savedSearch.Name,
+		savedSearch.Query, savedSearch.Channel, savedSearch.Frequency))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return model.SavedSearch{}, &customerrors.UserNotFoundError{Message: "No registered user for the saved search"}
+		}
+		return model.SavedSearch{}, &customerrors.DatabaseError{Message: "could not create saved search"}
+	}
+	return created, nil
+}
+
+func (s SavedSearchesDataAccess) GetUserSavedSearches(ctx context.Context, userId int) ([]model.SavedSearch, error) {
+	query := `SELECT * FROM SavedSearches WHERE user_id = $1 ORDER BY id`
+	return s.querySavedSearches(ctx, query, userId)
+}
+
+func (s SavedSearchesDataAccess) GetUserSavedSearch(ctx context.Context, userId int, searchId int) (model.SavedSearch, error) {
+	query := `SELECT * FROM SavedSearches WHERE user_id = $1 AND id = $2`
+	savedSearch, err := s.scanSavedSearch(s.dbPool.QueryRow(ctx, query, userId, searchId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.SavedSearch{}, &customerrors.SavedSearchNotFoundError{}
+		}
+		return model.SavedSearch{}, &customerrors.DatabaseError{}
+	}
+	return savedSearch, nil
+}
+
+// GetActiveSavedSearches returns the saved searches of all active users, which new and updated dogs are
+// matched against.
+func (s SavedSearchesDataAccess) GetActiveSavedSearches(ctx context.Context) ([]model.SavedSearch, error) {
+	query := `SELECT SavedSearches.* FROM SavedSearches JOIN Users ON Users.id = SavedSearches.user_id
+	WHERE Users.status = 'active' ORDER BY SavedSearches.id`
+	return s.querySavedSearches(ctx, query)
+}
+
+func (s SavedSearchesDataAccess) UpdateSavedSearch(ctx context.Context, savedSearch model.SavedSearch) (model.SavedSearch, error) {
+	query := `UPDATE SavedSearches SET name = $3, query = $4, channel = $5, frequency = $6, updated_at = now()
+	WHERE user_id = $1 AND id = $2 RETURNING *`
+	updated, err := s.scanSavedSearch(s.dbPool.QueryRow(ctx, query, savedSearch.UserId, savedSearch.Id,
+		savedSearch.Name, savedSearch.Query, savedSearch.Channel, savedSearch.Frequency))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.SavedSearch{}, &customerrors.SavedSearchNotFoundError{}
+		}
+		return model.SavedSearch{}, &customerrors.DatabaseError{Message: "could not update saved search"}
+	}
+	return updated, nil
+}
+
+func (s SavedSearchesDataAccess) DeleteSavedSearch(ctx context.Context, userId int, searchId int) error {
+	query := `DELETE FROM SavedSearches WHERE user_id = $1 AND id = $2`
+	result, err := s.dbPool.Exec(ctx, query, userId, searchId)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not delete saved search"}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.SavedSearchNotFoundError{}
+	}
+	return nil
+}
+
+// AddSavedSearchMatch records that the dog matched the saved search, and reports if it had not matched
+// before, so that users are only told about a dog once per search.
+func (s SavedSearchesDataAccess) AddSavedSearchMatch(ctx context.Context, searchId int, dogId int) (bool, error) {
+	query := `INSERT INTO SavedSearchMatches (saved_search_id, dog_id) VALUES ($1, $2)
+	ON CONFLICT (saved_search_id, dog_id) DO NOTHING`
+	result, err := s.dbPool.Exec(ctx, query, searchId, dogId)
+	if err != nil {
+		return false, &customerrors.DatabaseError{Message: "could not record saved search match"}
+	}
+	return result.RowsAffected() == 1, nil
+}
+
+func (s SavedSearchesDataAccess) MarkSavedSearchMatchesNotified(ctx context.Context, searchId int, dogIds []int) error {
+	query := `UPDATE SavedSearchMatches SET notified_at = now()
+	WHERE saved_search_id = $1 AND dog_id = ANY($2) AND notified_at IS NULL`
+	_, err := s.dbPool.Exec(ctx, query, searchId, dogIds)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not mark saved search matches as notified"}
+	}
+	return nil
+}
+
+// GetPendingSavedSearchDigests returns the saved searches of active users with the matches that have not
+// been notified, oldest match first.
+func (s SavedSearchesDataAccess) GetPendingSavedSearchDigests(ctx context.Context) ([]model.SavedSearchDigest, error) {
+	query := `SELECT SavedSearches.*, array_agg(SavedSearchMatches.dog_id ORDER BY SavedSearchMatches.matched_at)
+	FROM SavedSearches
+	JOIN SavedSearchMatches ON SavedSearchMatches.saved_search_id = SavedSearches.id
+	JOIN Users ON Users.id = SavedSearches.user_id
+	WHERE SavedSearchMatches.notified_at IS NULL AND Users.status = 'active'
+	GROUP BY SavedSearches.id
+	ORDER BY SavedSearches.id`
+	rows, err := s.dbPool.Query(ctx, query)
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	digests, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.SavedSearchDigest, error) {
+		var digest model.SavedSearchDigest
+		savedSearch := &digest.SavedSearch
+		err := row.Scan(&savedSearch.Id, &savedSearch.UserId, &savedSearch.Name, &savedSearch.Query,
+			&savedSearch.Channel, &savedSearch.Frequency, &savedSearch.CreatedAt, &savedSearch.UpdatedAt, &digest.DogIds)
+		return digest, err
+	})
+	if err != nil {
+		return nil, &customerrors.DatabaseError{Message: "getPendingSavedSearchDigests had a database error"}
+	}
+	return digests, nil
+}
+
+func (s SavedSearchesDataAccess) querySavedSearches(ctx context.Context, query string, args ...any) ([]model.SavedSearch, error) {
+	rows, err := s.dbPool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	savedSearches, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.SavedSearch, error) {
+		return s.scanSavedSearch(row)
+	})
+	if err != nil {
+		return nil, &customerrors.DatabaseError{Message: "could not load saved searches"}
+	}
+	return savedSearches, nil
+}
+
+func (s SavedSearchesDataAccess) scanSavedSearch(row pgx.Row) (model.SavedSearch, error) {
+	var savedSearch model.SavedSearch
+	err := row.Scan(
+		&savedSearch.Id,
+		&savedSearch.UserId,
+		&savedSearch.Name,
+		&savedSearch.Query,
+		&savedSearch.Channel,
+		&savedSearch.Frequency,
+		&savedSearch.CreatedAt,
+		&savedSearch.UpdatedAt,
+	)
+	return savedSearch, err
+}
@@ -0,0 +1,58 @@
+package dataaccess
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ScheduledJobsDataAccess struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewScheduledJobsDataAccess(dbPool *pgxpool.Pool) ScheduledJobsDataAccess {
+	return ScheduledJobsDataAccess{
+		dbPool: dbPool,
+	}
+}
+
+// EnsureScheduledJob registers the job. The next run of a registered job is only moved when its
+// schedule changed, so that restarts do not skip due runs.
+func (s ScheduledJobsDataAccess) EnsureScheduledJob(ctx context.Context, name, schedule string, nextRunAt time.Time) error {
+	query := `INSERT INTO ScheduledJobs (name, schedule, next_run_at) VALUES ($1, $2, $3)
+	ON CONFLICT (name) DO UPDATE SET schedule = EXCLUDED.schedule, next_run_at = EXCLUDED.next_run_at
+	WHERE ScheduledJobs.schedule <> EXCLUDED.schedule`
+	_, err := s.dbPool.Exec(ctx, query, name, schedule, nextRunAt)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not register scheduled job"}
+	}
+	return nil
+}
+
+// ClaimDueScheduledJob moves the next run of the job forward if it is due. Only one caller can claim
+// a run, since the update is conditional on the run still being due.
+func (s ScheduledJobsDataAccess) ClaimDueScheduledJob(ctx context.Context, name string, now, nextRunAt time.Time) (bool, error) {
+	query := `UPDATE ScheduledJobs SET next_run_at = $3, last_started_at = $2
+	WHERE name = $1 AND next_run_at <= $2`
+	result, err := s.dbPool.Exec(ctx, query, name, now, nextRunAt)
+	if err != nil {
+		return false, &customerrors.DatabaseError{Message: "could not claim scheduled job"}
+	}
+	return result.RowsAffected() == 1, nil
+}
+
+func (s ScheduledJobsDataAccess) RecordScheduledJobRun(ctx context.Context, name string, finishedAt time.Time, runErr error) error {
+	var lastError *string
+	if runErr != nil {
+		errMessage := runErr.Error()
+		lastError = &errMessage
+	}
+	_, err := s.dbPool.Exec(ctx, `UPDATE ScheduledJobs SET last_finished_at = $2, last_error = $3 WHERE name = $1`,
+		name, finishedAt, lastError)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not record scheduled job run"}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package dataaccess
+
+import (
+	dogshelterdto "1dv027/aad/internal/dto/dog-shelter"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// openNowCondition matches shelters that are open at the current time in their own timezone. An exception
+// for the local date takes precedence over the weekly opening hours, and an exception without times is closed.
+const openNowCondition = `(CASE WHEN EXISTS (SELECT 1 FROM ShelterOpeningHoursExceptions e
+		WHERE e.shelter_id = DogShelters.id AND e.date = (now() AT TIME ZONE DogShelters.timezone)::date)
+	THEN EXISTS (SELECT 1 FROM ShelterOpeningHoursExceptions e
+		WHERE e.shelter_id = DogShelters.id AND e.date = (now() AT TIME ZONE DogShelters.timezone)::date
+		AND (now() AT TIME ZONE DogShelters.timezone)::time >= e.opens_at
+		AND (now() AT TIME ZONE DogShelters.timezone)::time < e.closes_at)
+	ELSE EXISTS (SELECT 1 FROM ShelterOpeningHours h
+		WHERE h.shelter_id = DogShelters.id
+		AND h.weekday = EXTRACT(DOW FROM now() AT TIME ZONE DogShelters.timezone)
+		AND (now() AT TIME ZONE DogShelters.timezone)::time >= h.opens_at
+		AND (now() AT TIME ZONE DogShelters.timezone)::time < h.closes_at)
+	END)`
+
+// replaceOpeningHours replaces the opening hours and the exceptions of the shelter that are set in details.
+func (d DogSheltersDataAccess) replaceOpeningHours(ctx context.Context, tx pgx.Tx, shelterId int, details dogshelterdto.ShelterDetailsDTO) error {
+	if details.OpeningHours != nil {
+		_, err := tx.Exec(ctx, `DELETE FROM ShelterOpeningHours WHERE shelter_id = $1`, shelterId)
+		if err != nil {
+			return &customerrors.DatabaseError{}
+		}
+		for _, hours := range *details.OpeningHours {
+			_, err = tx.Exec(ctx, `INSERT INTO ShelterOpeningHours (shelter_id, weekday, opens_at, closes_at)
+			VALUES ($1, $2, $3::time, $4::time)`, shelterId, hours.Weekday, hours.Opens, hours.Closes)
+			if err != nil {
+				return &customerrors.DatabaseError{Message: "could not store opening hours"}
+			}
+		}
+	}
+	if details.OpeningHoursExceptions != nil {
+		_, err := tx.Exec(ctx, `DELETE FROM ShelterOpeningHoursExceptions WHERE shelter_id = $1`, shelterId)
+		if err != nil {
+			return &customerrors.DatabaseError{}
+		}
+		for _, exception := range *details.OpeningHoursExceptions {
+			_, err = tx.Exec(ctx, `INSERT INTO ShelterOpeningHoursExceptions (shelter_id, date, opens_at, closes_at, note)
+			VALUES ($1, $2::date, $3::time, $4::time, $5)`,
+				shelterId, exception.Date, exception.Opens, exception.Closes, exception.Note)
+			if err != nil {
+				return &customerrors.DatabaseError{Message: "could not store opening hours exceptions"}
+			}
+		}
+	}
+	return nil
+}
+
+// loadShelterDetails sets the opening hours, the exceptions and the occupancy of the shelters. Exceptions
+// for dates that have passed everywhere are left out.
+func (d DogSheltersDataAccess) loadShelterDetails(ctx context.Context, shelters []model.DogShelter) error {
+	if len(shelters) == 0 {
+		return nil
+	}
+	shelterIds := make([]int, len(shelters))
+	shelterIndexes := make(map[int]int, len(shelters))
+	for i, shelter := range shelters {
+		shelterIds[i] = shelter.Id
+		shelterIndexes[shelter.Id] = i
+	}
+
+	rows, err := d.dbPool.Query(ctx, `SELECT shelter_id, weekday, to_char(opens_at, 'HH24:MI'), to_char(closes_at, 'HH24:MI')
+	FROM ShelterOpeningHours WHERE shelter_id = ANY($1) ORDER BY shelter_id, weekday, opens_at`, shelterIds)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	var shelterId int
+	var hours model.OpeningHours
+	_, err = pgx.ForEachRow(rows, []any{&shelterId, &hours.Weekday, &hours.Opens, &hours.Closes}, func() error {
+		shelter := &shelters[shelterIndexes[shelterId]]
+		shelter.OpeningHours = append(shelter.OpeningHours, hours)
+		return nil
+	})
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not load opening hours"}
+	}
+
+	rows, err = d.dbPool.Query(ctx, `SELECT shelter_id, to_char(date, 'YYYY-MM-DD'), to_char(opens_at, 'HH24:MI'),
+	to_char(closes_at, 'HH24:MI'), note FROM ShelterOpeningHoursExceptions
+	WHERE shelter_id = ANY($1) AND date >= CURRENT_DATE - 1 ORDER BY shelter_id, date`, shelterIds)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	var exception model.OpeningHoursException
+	_, err = pgx.ForEachRow(rows, []any{&shelterId, &exception.Date, &exception.Opens, &exception.Closes, &exception.Note}, func() error {
+		shelter := &shelters[shelterIndexes[shelterId]]
+		shelter.OpeningHoursExceptions = append(shelter.OpeningHoursExceptions, exception)
+		// Reset the nullable fields, so that the next row does not scan into the same pointers.
+		exception = model.OpeningHoursException{}
+		return nil
+	})
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not load opening hours exceptions"}
+	}
+
+	occupyingStatuses := []string{}
+	for _, status := range model.OccupyingDogStatuses {
+		occupyingStatuses = append(occupyingStatuses, string(status))
+	}
+	rows, err = d.dbPool.Query(ctx, `SELECT shelter_id, COUNT(*) FROM Dogs
+	WHERE shelter_id = ANY($1) AND status = ANY($2) GROUP BY shelter_id`, shelterIds, occupyingStatuses)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	var occupancy int
+	_, err = pgx.ForEachRow(rows, []any{&shelterId, &occupancy}, func() error {
+		shelters[shelterIndexes[shelterId]].Occupancy = occupancy
+		return nil
+	})
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not load shelter occupancy"}
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package dataaccess
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ShelterStaffDataAccess struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewShelterStaffDataAccess(dbPool *pgxpool.Pool) ShelterStaffDataAccess {
+	return ShelterStaffDataAccess{
+		dbPool: dbPool,
+	}
+}
+
+func (s ShelterStaffDataAccess) GetShelterStaffByUsername(ctx context.Context, username string) (model.ShelterStaff, error) {
+	emptyModel := model.ShelterStaff{}
+	query := `SELECT * FROM ShelterStaff WHERE username = $1`
+	staff, err := scanShelterStaff(s.dbPool.QueryRow(ctx, query, username))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return emptyModel, &customerrors.ShelterStaffNotFoundError{}
+		}
+		return emptyModel, &customerrors.DatabaseError{}
+	}
+	return staff, nil
+}
+
+func (s ShelterStaffDataAccess) GetShelterStaffById(ctx context.Context, staffId int) (model.ShelterStaff, error) {
+	emptyModel := model.ShelterStaff{}
+	query := `SELECT * FROM ShelterStaff WHERE id = $1`
+	staff, err := scanShelterStaff(s.dbPool.QueryRow(ctx, query, staffId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return emptyModel, &customerrors.ShelterStaffNotFoundError{}
+		}
+		return emptyModel, &customerrors.DatabaseError{}
+	}
+	return staff, nil
+}
+
+func (s ShelterStaffDataAccess) GetShelterStaff(ctx context.Context, shelterId int) ([]model.ShelterStaff, error) {
+	query := `SELECT * FROM ShelterStaff WHERE shelter_id = $1 ORDER BY id`
+	rows, err := s.dbPool.Query(ctx, query, shelterId)
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	staff, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.ShelterStaff, error) {
+		return scanShelterStaff(row)
+	})
+	if err != nil {
+		return nil, &customerrors.DatabaseError{Message: "getShelterStaff had a database error"}
+	}
+	return staff, nil
+}
+
+func (s ShelterStaffDataAccess) UpdateShelterStaffRole(ctx context.Context, shelterId, staffId int, role model.ShelterStaffRole) error {
+	query := `UPDATE ShelterStaff SET role = $1 WHERE id = $2 AND shelter_id = $3`
+	result, err := s.dbPool.Exec(ctx, query, string(role), staffId, shelterId)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.ShelterStaffNotFoundError{}
+	}
+	return nil
+}
+
+// DeleteShelterStaff removes the staff member together with its MFA enrollment and password reset tokens.
+func (s ShelterStaffDataAccess) DeleteShelterStaff(ctx context.Context, shelterId, staffId int) error {
+	tx, err := s.dbPool.Begin(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `DELETE FROM ShelterStaff WHERE id = $1 AND shelter_id = $2`, staffId, shelterId)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.ShelterStaffNotFoundError{}
+	}
+	_, err = tx.Exec(ctx, `DELETE FROM MfaEnrollments WHERE account_id = $1 AND account_role = $2`, staffId, string(model.SHELTERSTAFF))
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	_, err = tx.Exec(ctx, `DELETE FROM PasswordResetTokens WHERE account_id = $1 AND account_role = $2`, staffId, string(model.SHELTERSTAFF))
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	return nil
+}
+
+func (s ShelterStaffDataAccess) CreateStaffInvitation(ctx context.Context, invitation model.ShelterStaffInvitation) (int, error) {
+	query := `INSERT INTO ShelterStaffInvitations (shelter_id, email, role, token_hash, expires_at)
+	VALUES ($1, $2, $3, $4, $5) RETURNING id`
+	var id int
+	err := s.dbPool.QueryRow(ctx, query, invitation.ShelterId, invitation.Email, string(invitation.Role),
+		invitation.TokenHash, invitation.ExpiresAt).Scan(&id)
+	if err != nil {
+		return 0, &customerrors.DatabaseError{}
+	}
+	return id, nil
+}
+
+func (s ShelterStaffDataAccess) GetStaffInvitationById(ctx context.Context, shelterId, invitationId int) (model.ShelterStaffInvitation, error) {
+	emptyModel := model.ShelterStaffInvitation{}
+	query := `SELECT * FROM ShelterStaffInvitations WHERE id = $1 AND shelter_id = $2`
+	invitation, err := scanStaffInvitation(s.dbPool.QueryRow(ctx, query, invitationId, shelterId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return emptyModel, &customerrors.StaffInvitationNotFoundError{}
+		}
+		return emptyModel, &customerrors.DatabaseError{}
+	}
+	return invitation, nil
+}
+
+// GetStaffInvitations returns the open invitations of the shelter, which have not been accepted and have not expired.
+func (s ShelterStaffDataAccess) GetStaffInvitations(ctx context.Context, shelterId int) ([]model.ShelterStaffInvitation, error) {
+	query := `SELECT * FROM ShelterStaffInvitations
+	WHERE shelter_id = $1 AND accepted_at IS NULL AND expires_at > now() ORDER BY id`
+	rows, err := s.dbPool.Query(ctx, query, shelterId)
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	invitations, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.ShelterStaffInvitation, error) {
+		return scanStaffInvitation(row)
+	})
+	if err != nil {
+		return nil, &customerrors.DatabaseError{Message: "getStaffInvitations had a database error"}
+	}
+	return invitations, nil
+}
+
+func (s ShelterStaffDataAccess) DeleteStaffInvitation(ctx context.Context, shelterId, invitationId int) error {
+	query := `DELETE FROM ShelterStaffInvitations WHERE id = $1 AND shelter_id = $2 AND accepted_at IS NULL`
+	result, err := s.dbPool.Exec(ctx, query, invitationId, shelterId)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.StaffInvitationNotFoundError{}
+	}
+	return nil
+}
+
+// AcceptStaffInvitation consumes an open invitation and creates the staff account with the role and email of
+// the invitation in the same transaction, so that an invitation can only be used once.
+func (s ShelterStaffDataAccess) AcceptStaffInvitation(ctx context.Context, tokenHash, username, passwordHash string) (int, error) {
+	tx, err := s.dbPool.Begin(ctx)
+	if err != nil {
+		return 0, &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
+	query := `UPDATE ShelterStaffInvitations SET accepted_at = now()
+	WHERE token_hash = $1 AND accepted_at IS NULL AND expires_at > now() RETURNING *`
+	invitation, err := scanStaffInvitation(tx.QueryRow(ctx, query, tokenHash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, &customerrors.StaffInvitationNotFoundError{}
+		}
+		return 0, &customerrors.DatabaseError{}
+	}
+
+	var id int
+	err = tx.QueryRow(ctx, `INSERT INTO ShelterStaff (shelter_id, username, password, role, email)
+	VALUES ($1, $2, $3, $4, $5) RETURNING id`,
+		invitation.ShelterId, username, passwordHash, string(invitation.Role), invitation.Email).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return 0, &customerrors.UsernameTakenError{Message: "username is already taken. try another one!"}
+		}
+		return 0, &customerrors.DatabaseError{Message: "could not create shelter staff"}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, &customerrors.DatabaseError{}
+	}
+	return id, nil
+}
+
+func scanShelterStaff(row pgx.Row) (model.ShelterStaff, error) {
+	var staff model.ShelterStaff
+	err := row.Scan(
+		&staff.Id,
+		&staff.ShelterId,
+		&staff.Username,
+		&staff.Password,
+		&staff.Role,
+		&staff.Email,
+		&staff.CreatedAt,
+	)
+	return staff, err
+}
+
+func scanStaffInvitation(row pgx.Row) (model.ShelterStaffInvitation, error) {
+	var invitation model.ShelterStaffInvitation
+	err := row.Scan(
+		&invitation.Id,
+		&invitation.ShelterId,
+		&invitation.Email,
+		&invitation.Role,
+		&invitation.TokenHash,
+		&invitation.ExpiresAt,
+		&invitation.AcceptedAt,
+		&invitation.CreatedAt,
+	)
+	return invitation, err
+}
@@ -0,0 +1,84 @@
+package dataaccess
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UserFavoritesDataAccess struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewUserFavoritesDataAccess(dbPool *pgxpool.Pool) UserFavoritesDataAccess {
+	return UserFavoritesDataAccess{
+		dbPool: dbPool,
+	}
+}
+
+// AddFavorite saves the dog to the favorites of the user. Adding a dog that already is a favorite keeps
+// the time it was first added.
+func (u UserFavoritesDataAccess) AddFavorite(ctx context.Context, userId int, dogId int) error {
+	query := `INSERT INTO UserFavorites (user_id, dog_id) VALUES ($1, $2) ON CONFLICT (user_id, dog_id) DO NOTHING`
+	_, err := u.dbPool.Exec(ctx, query, userId, dogId)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		// The user is checked before, so a missing reference is the dog.
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return &customerrors.DogNotFoundError{}
+		}
+		return &customerrors.DatabaseError{Message: "could not add favorite"}
+	}
+	return nil
+}
+
+func (u UserFavoritesDataAccess) RemoveFavorite(ctx context.Context, userId int, dogId int) error {
+	query := `DELETE FROM UserFavorites WHERE user_id = $1 AND dog_id = $2`
+	result, err := u.dbPool.Exec(ctx, query, userId, dogId)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not remove favorite"}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.FavoriteNotFoundError{}
+	}
+	return nil
+}
+
+func (u UserFavoritesDataAccess) GetUserFavorites(ctx context.Context, userId int) ([]model.UserFavorite, error) {
+	query := `SELECT user_id, dog_id, created_at FROM UserFavorites WHERE user_id = $1 ORDER BY created_at, dog_id`
+	rows, err := u.dbPool.Query(ctx, query, userId)
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	favorites, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.UserFavorite, error) {
+		var favorite model.UserFavorite
+		err := row.Scan(&favorite.UserId, &favorite.DogId, &favorite.CreatedAt)
+		return favorite, err
+	})
+	if err != nil {
+		return nil, &customerrors.DatabaseError{Message: "could not load favorites"}
+	}
+	return favorites, nil
+}
+
+// GetFavoritingUsers returns the active users that have the dog as a favorite.
+func (u UserFavoritesDataAccess) GetFavoritingUsers(ctx context.Context, dogId int) ([]model.User, error) {
+	query := `SELECT Users.* FROM Users JOIN UserFavorites ON UserFavorites.user_id = Users.id
+	WHERE UserFavorites.dog_id = $1 AND Users.status = 'active' ORDER BY Users.id`
+	rows, err := u.dbPool.Query(ctx, query, dogId)
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	users, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.User, error) {
+		return scanUser(row)
+	})
+	if err != nil {
+		return nil, &customerrors.DatabaseError{Message: "could not load favoriting users"}
+	}
+	return users, nil
+}
@@ -0,0 +1,227 @@
+package dataaccess
+
+import (
+	"1dv027/aad/internal/dto"
+	usernotificationdto "1dv027/aad/internal/dto/user/notification"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UserNotificationsDataAccess struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewUserNotificationsDataAccess(dbPool *pgxpool.Pool) UserNotificationsDataAccess {
+	return UserNotificationsDataAccess{
+		dbPool: dbPool,
+	}
+}
+
+// CreateNotifications adds the notifications to the inboxes of their users. Only the newest maxPerUser
+// notifications of each of the users are kept.
+func (u UserNotificationsDataAccess) CreateNotifications(ctx context.Context, notifications []model.UserNotification,
+	maxPerUser int) error {
+	tx, err := u.dbPool.Begin(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
+	userIds := []int{}
+	query := `INSERT INTO UserNotifications (user_id, event, title, body, data) VALUES ($1, $2, $3, $4, $5)`
+	for _, notification := range notifications {
+		_, err = tx.Exec(ctx, query, notification.UserId, notification.Event, notification.Title, notification.Body,
+			notification.Data)
+		if err != nil {
+			return &customerrors.DatabaseError{Message: "could not create notification"}
+		}
+		userIds = append(userIds, notification.UserId)
+	}
+
+	pruneQuery := `DELETE FROM UserNotifications WHERE id IN (
+		SELECT id FROM (
+			SELECT id, row_number() OVER (PARTITION BY user_id ORDER BY created_at DESC, id DESC) AS position
+			FROM UserNotifications WHERE user_id = ANY($1)
+		) AS ranked WHERE position > $2
+	)`
+	_, err = tx.Exec(ctx, pruneQuery, userIds, maxPerUser)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not prune notifications"}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	return nil
+}
+
+// CreateNotificationForActiveUsers adds the notification to the inbox of every active user in a single insert.
+// Only the newest maxPerUser notifications of each user are kept.
+func (u UserNotificationsDataAccess) CreateNotificationForActiveUsers(ctx context.Context, notification model.UserNotification,
+	maxPerUser int) error {
+	tx, err := u.dbPool.Begin(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	defer tx.Rollback(ctx)
+
+	query := `INSERT INTO UserNotifications (user_id, event, title, body, data)
+	SELECT id, $1::text, $2::text, $3::text, $4::jsonb FROM Users WHERE status = $5`
+	_, err = tx.Exec(ctx, query, notification.Event, notification.Title, notification.Body, notification.Data,
+		model.USER_ACTIVE)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not create notifications"}
+	}
+
+	pruneQuery := `DELETE FROM UserNotifications WHERE id IN (
+		SELECT id FROM (
+			SELECT id, row_number() OVER (PARTITION BY user_id ORDER BY created_at DESC, id DESC) AS position
+			FROM UserNotifications
+		) AS ranked WHERE position > $1
+	)`
+	_, err = tx.Exec(ctx, pruneQuery, maxPerUser)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not prune notifications"}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	return nil
+}
+
+// GetUserNotifications returns a page of the notifications of the user, newest first, with the number of
+// unread notifications.
+func (u UserNotificationsDataAccess) GetUserNotifications(ctx context.Context, userId int,
+	queryParams dto.QueryParams) (usernotificationdto.GetNotificationsQueryResponseDTO, error) {
+	emptyDto := usernotificationdto.GetNotificationsQueryResponseDTO{}
+	qb := NewQueryBuilder("SELECT * FROM UserNotifications")
+	qb.withConditionParam("user_id = %[1]s", userId)
+	notificationsFilter := queryParams.NotificationsFilter
+	if notificationsFilter != nil && notificationsFilter.Unread != nil {
+		if *notificationsFilter.Unread {
+			qb.withCondition("read_at IS NULL")
+		} else {
+			qb.withCondition("read_at IS NOT NULL")
+		}
+	}
+	qb.withOrderBy("created_at DESC, id DESC")
+	paginationParams := queryParams.Pagination
+	if paginationParams != nil {
+		if paginationParams.Limit != nil {
+			qb.withLimit(*paginationParams.Limit)
+		}
+		if paginationParams.Page != nil {
+			qb.withPage(*paginationParams.Page)
+		}
+	}
+
+	selectQuery, filterValues := qb.build()
+	rows, err := u.dbPool.Query(ctx, selectQuery, filterValues...)
+	if err != nil {
+		return emptyDto, &customerrors.DatabaseError{}
+	}
+	notifications, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.UserNotification, error) {
+		return scanUserNotification(row)
+	})
+	if err != nil {
+		return emptyDto, &customerrors.DatabaseError{Message: "getUserNotifications had a database error"}
+	}
+
+	countQuery, _ := qb.buildForTotalCount()
+	var totalCount int
+	err = u.dbPool.QueryRow(ctx, countQuery, filterValues...).Scan(&totalCount)
+	if err != nil {
+		return emptyDto, &customerrors.DatabaseError{}
+	}
+	unreadCount, err := u.GetUnreadNotificationCount(ctx, userId)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	return usernotificationdto.GetNotificationsQueryResponseDTO{
+		Notifications:        notifications,
+		TotalAmountAvailable: totalCount,
+		UnreadCount:          unreadCount,
+	}, nil
+}
+
+func (u UserNotificationsDataAccess) GetUnreadNotificationCount(ctx context.Context, userId int) (int, error) {
+	query := `SELECT count(*) FROM UserNotifications WHERE user_id = $1 AND read_at IS NULL`
+	var unreadCount int
+	err := u.dbPool.QueryRow(ctx, query, userId).Scan(&unreadCount)
+	if err != nil {
+		return 0, &customerrors.DatabaseError{}
+	}
+	return unreadCount, nil
+}
+
+// MarkNotificationRead keeps the time a notification was first read.
+func (u UserNotificationsDataAccess) MarkNotificationRead(ctx context.Context, userId int, notificationId int) (model.UserNotification, error) {
+	query := `UPDATE UserNotifications SET read_at = COALESCE(read_at, now()) WHERE user_id = $1 AND id = $2 RETURNING *`
+	notification, err := scanUserNotification(u.dbPool.QueryRow(ctx, query, userId, notificationId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.UserNotification{}, &customerrors.NotificationNotFoundError{}
+		}
+		return model.UserNotification{}, &customerrors.DatabaseError{Message: "could not mark notification as read"}
+	}
+	return notification, nil
+}
+
+func (u UserNotificationsDataAccess) MarkAllNotificationsRead(ctx context.Context, userId int) error {
+	query := `UPDATE UserNotifications SET read_at = now() WHERE user_id = $1 AND read_at IS NULL`
+	_, err := u.dbPool.Exec(ctx, query, userId)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not mark notifications as read"}
+	}
+	return nil
+}
+
+func (u UserNotificationsDataAccess) DeleteNotificationsCreatedBefore(ctx context.Context, before time.Time) (int, error) {
+	result, err := u.dbPool.Exec(ctx, `DELETE FROM UserNotifications WHERE created_at < $1`, before)
+	if err != nil {
+		return 0, &customerrors.DatabaseError{Message: "could not delete expired notifications"}
+	}
+	return int(result.RowsAffected()), nil
+}
+
+// GetAllUserNotifications returns every notification of the user, newest first. It is used for the export of
+// the user.
+func (u UserNotificationsDataAccess) GetAllUserNotifications(ctx context.Context, userId int) ([]model.UserNotification, error) {
+	query := `SELECT * FROM UserNotifications WHERE user_id = $1 ORDER BY created_at DESC, id DESC`
+	rows, err := u.dbPool.Query(ctx, query, userId)
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	notifications, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.UserNotification, error) {
+		return scanUserNotification(row)
+	})
+	if err != nil {
+		return nil, &customerrors.DatabaseError{Message: "getAllUserNotifications had a database error"}
+	}
+	return notifications, nil
+}
+
+func scanUserNotification(row pgx.Row) (model.UserNotification, error) {
+	var notification model.UserNotification
+	err := row.Scan(
+		&notification.Id,
+		&notification.UserId,
+		&notification.Event,
+		&notification.Title,
+		&notification.Body,
+		&notification.Data,
+		&notification.CreatedAt,
+		&notification.ReadAt,
+	)
+	return notification, err
+}
@@ -0,0 +1,121 @@
+package dataaccess
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UsersApiKeysDataAccess struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewUsersApiKeysDataAccess(dbPool *pgxpool.Pool) UsersApiKeysDataAccess {
+	return UsersApiKeysDataAccess{
+		dbPool: dbPool,
+	}
+}
+
+func (u UsersApiKeysDataAccess) CreateApiKey(ctx context.Context, userId int, name, prefix, keyHash string, scopes []string) (model.ApiKey, error) {
+	query := `INSERT INTO UserApiKeys (user_id, name, key_prefix, key_hash, scopes) VALUES ($1, $2, $3, $4, $5) RETURNING *`
+	apiKey, err := u.scanApiKey(u.dbPool.QueryRow(ctx, query, userId, name, prefix, keyHash, scopes))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return model.ApiKey{}, &customerrors.UserNotFoundError{Message: "No registered user for the api key"}
+		}
+		return model.ApiKey{}, &customerrors.DatabaseError{Message: "could not create api key"}
+	}
+	return apiKey, nil
+}
+
+func (u UsersApiKeysDataAccess) GetUserApiKeys(ctx context.Context, userId int) ([]model.ApiKey, error) {
+	query := `SELECT * FROM UserApiKeys WHERE user_id = $1 ORDER BY id`
+	apiKeys := []model.ApiKey{}
+
+	rows, err := u.dbPool.Query(ctx, query, userId)
+	if err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		apiKey, err := u.scanApiKey(rows)
+		if err != nil {
+			return nil, &customerrors.DatabaseError{}
+		}
+		apiKeys = append(apiKeys, apiKey)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, &customerrors.DatabaseError{}
+	}
+	return apiKeys, nil
+}
+
+func (u UsersApiKeysDataAccess) GetUserApiKey(ctx context.Context, userId int, keyId int) (model.ApiKey, error) {
+	query := `SELECT * FROM UserApiKeys WHERE user_id = $1 AND id = $2`
+	apiKey, err := u.scanApiKey(u.dbPool.QueryRow(ctx, query, userId, keyId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ApiKey{}, &customerrors.ApiKeyNotFoundError{}
+		}
+		return model.ApiKey{}, &customerrors.DatabaseError{}
+	}
+	return apiKey, nil
+}
+
+// GetActiveApiKeyByHash only returns api keys that have not been revoked.
+func (u UsersApiKeysDataAccess) GetActiveApiKeyByHash(ctx context.Context, keyHash string) (model.ApiKey, error) {
+	query := `SELECT * FROM UserApiKeys WHERE key_hash = $1 AND revoked_at IS NULL`
+	apiKey, err := u.scanApiKey(u.dbPool.QueryRow(ctx, query, keyHash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ApiKey{}, &customerrors.ApiKeyNotFoundError{}
+		}
+		return model.ApiKey{}, &customerrors.DatabaseError{}
+	}
+	return apiKey, nil
+}
+
+func (u UsersApiKeysDataAccess) RevokeApiKey(ctx context.Context, userId int, keyId int) error {
+	query := `UPDATE UserApiKeys SET revoked_at = NOW() WHERE user_id = $1 AND id = $2 AND revoked_at IS NULL`
+	result, err := u.dbPool.Exec(ctx, query, userId, keyId)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "something went wrong trying to revoke api key"}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.ApiKeyNotFoundError{Message: "no active api key was revoked"}
+	}
+	return nil
+}
+
+func (u UsersApiKeysDataAccess) UpdateApiKeyLastUsed(ctx context.Context, keyId int) error {
+	query := `UPDATE UserApiKeys SET last_used_at = NOW() WHERE id = $1`
+	_, err := u.dbPool.Exec(ctx, query, keyId)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not update api key last used"}
+	}
+	return nil
+}
+
+func (u UsersApiKeysDataAccess) scanApiKey(row pgx.Row) (model.ApiKey, error) {
+	var apiKey model.ApiKey
+	err := row.Scan(
+		&apiKey.Id,
+		&apiKey.UserId,
+		&apiKey.Name,
+		&apiKey.Prefix,
+		&apiKey.KeyHash,
+		&apiKey.Scopes,
+		&apiKey.CreatedAt,
+		&apiKey.LastUsedAt,
+		&apiKey.RevokedAt,
+	)
+	return apiKey, err
+}
@@ -1,6 +1,7 @@
 package dataaccess
 
 import (
+	"1dv027/aad/internal/dto"
 	userdto "1dv027/aad/internal/dto/user"
 	customerrors "1dv027/aad/internal/errors"
 	"1dv027/aad/internal/model"
@@ -22,9 +23,8 @@ func NewUserDataAccess(dbPool *pgxpool.Pool) UserDataAccess {
 }
 
 func (u UserDataAccess) CreateNewUser(ctx context.Context, newUser userdto.NewUserDTO) (model.User, error) {
-	query := `INSERT INTO Users (username, password) VALUES ($1, $2) RETURNING id, username, password`
-	var user model.User
-	err := u.dbPool.QueryRow(ctx, query, &newUser.Username, &newUser.Password).Scan(&user.Id, &user.Username, &user.Password)
+	query := `INSERT INTO Users (username, password, email) VALUES ($1, $2, $3) RETURNING *`
+	user, err := scanUser(u.dbPool.QueryRow(ctx, query, &newUser.Username, &newUser.Password, &newUser.Email))
 	if err != nil {
 		return model.User{}, &customerrors.DatabaseError{}
 	}
@@ -33,9 +33,8 @@ func (u UserDataAccess) CreateNewUser(ctx context.Context, newUser userdto.NewUs
 
 func (u UserDataAccess) GetUserByUsername(ctx context.Context, username string) (model.User, error) {
 	emptyModel := model.User{}
-	query := `SELECT * FROM Users WHERE username = $1`
-	var user model.User
-	err := u.dbPool.QueryRow(ctx, query, username).Scan(&user.Id, &user.Username, &user.Password)
+	query := `SELECT * FROM Users WHERE username = $1 AND status <> 'deleted'`
+	user, err := scanUser(u.dbPool.QueryRow(ctx, query, username))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return emptyModel, &customerrors.UserNotFoundError{}
@@ -45,15 +44,193 @@ func (u UserDataAccess) GetUserByUsername(ctx context.Context, username string)
 	return user, nil
 }
 
-func (u UserDataAccess) DeleteUser(ctx context.Context, userId int) error {
-	query := `DELETE FROM Users WHERE id = $1`
-	result, err := u.dbPool.Exec(ctx, query, userId)
+// EraseUser erases the personal data of a user instead of deleting the row, so that records that
+// must be kept keep referencing an anonymized user. Credentials, tokens and integrations are deleted,
+// oauth clients owned by the user lose their owner, and the profile is cleared. Data added for users
+// in other tables has to be erased here as well.
+func (u UserDataAccess) EraseUser(ctx context.Context, userId int) error {
+	tx, err := u.dbPool.Begin(ctx)
 	if err != nil {
 		return &customerrors.DatabaseError{}
 	}
-	rowsAffected := result.RowsAffected()
-	if rowsAffected == 0 {
+	defer tx.Rollback(ctx)
+
+	var lockedId int
+	err = tx.QueryRow(ctx, `SELECT id FROM Users WHERE id = $1 AND status <> 'deleted' FOR UPDATE`, userId).Scan(&lockedId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &customerrors.UserNotFoundError{}
+		}
+		return &customerrors.DatabaseError{}
+	}
+
+	erasureQueries := []string{
+		`DELETE FROM UserWebhooks WHERE user_id = $1`,
+		`DELETE FROM UserApiKeys WHERE user_id = $1`,
+		`DELETE FROM OAuthConsents WHERE user_id = $1`,
+		`DELETE FROM OAuthAuthorizationCodes WHERE user_id = $1`,
+		`DELETE FROM OAuthRefreshTokens WHERE user_id = $1`,
+		`DELETE FROM EmailVerificationTokens WHERE user_id = $1`,
+		`DELETE FROM UserFavorites WHERE user_id = $1`,
+		`DELETE FROM SavedSearches WHERE user_id = $1`,
+		`DELETE FROM UserNotifications WHERE user_id = $1`,
+		`DELETE FROM MfaEnrollments WHERE account_id = $1 AND account_role = 'user'`,
+		`DELETE FROM PasswordResetTokens WHERE account_id = $1 AND account_role = 'user'`,
+		`UPDATE OAuthClients SET owner_user_id = NULL WHERE owner_user_id = $1`,
+		`UPDATE Users SET username = 'deleted-user-' || id, password = '', email = NULL, email_status = 'pending',
+		full_name = NULL, phone = NULL, city = NULL, country = NULL, has_children = NULL, has_other_pets = NULL,
+		has_garden = NULL, preferences = '{}', status = 'deleted', suspended_at = NULL, deleted_at = now()
+		WHERE id = $1`,
+	}
+	for _, query := range erasureQueries {
+		_, err = tx.Exec(ctx, query, userId)
+		if err != nil {
+			return &customerrors.DatabaseError{Message: "could not erase user"}
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return &customerrors.DatabaseError{}
+	}
+	return nil
+}
+
+func (u UserDataAccess) GetUserById(ctx context.Context, userId int) (model.User, error) {
+	emptyModel := model.User{}
+	query := `SELECT * FROM Users WHERE id = $1 AND status <> 'deleted'`
+	user, err := scanUser(u.dbPool.QueryRow(ctx, query, userId))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return emptyModel, &customerrors.UserNotFoundError{}
+		}
+		return emptyModel, &customerrors.DatabaseError{}
+	}
+	return user, nil
+}
+
+func (u UserDataAccess) GetUserByEmail(ctx context.Context, email string) (model.User, error) {
+	emptyModel := model.User{}
+	query := `SELECT * FROM Users WHERE lower(email) = lower($1) AND status <> 'deleted'`
+	user, err := scanUser(u.dbPool.QueryRow(ctx, query, email))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return emptyModel, &customerrors.UserNotFoundError{}
+		}
+		return emptyModel, &customerrors.DatabaseError{}
+	}
+	return user, nil
+}
+
+// UpdateUserProfile writes every profile field of the user, including the email and its status.
+func (u UserDataAccess) UpdateUserProfile(ctx context.Context, user model.User) error {
+	query := `UPDATE Users SET email = $1, email_status = $2, full_name = $3, phone = $4, city = $5, country = $6,
+	has_children = $7, has_other_pets = $8, has_garden = $9, preferences = $10 WHERE id = $11 AND status <> 'deleted'`
+	result, err := u.dbPool.Exec(ctx, query,
+		user.Email,
+		user.EmailStatus,
+		user.FullName,
+		user.Phone,
+		user.City,
+		user.Country,
+		user.Household.HasChildren,
+		user.Household.HasOtherPets,
+		user.Household.HasGarden,
+		user.Preferences,
+		user.Id,
+	)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not update user profile"}
+	}
+	if result.RowsAffected() == 0 {
+		return &customerrors.UserNotFoundError{}
+	}
+	return nil
+}
+
+func (u UserDataAccess) GetUsers(ctx context.Context, queryParams dto.QueryParams) (userdto.GetUsersQueryResponseDTO, error) {
+	emptyDto := userdto.GetUsersQueryResponseDTO{}
+	qb := NewQueryBuilder("SELECT * FROM Users")
+	qb.withCondition("status <> 'deleted'")
+	usersFilter := queryParams.UsersFilter
+	if usersFilter != nil {
+		if usersFilter.UsernamePrefix != nil {
+			qb.withPrefixFilterParam("username", *usersFilter.UsernamePrefix)
+		}
+		if usersFilter.Status != nil {
+			qb.withFilterParam("status", *usersFilter.Status)
+		}
+	}
+	qb.withOrderBy("id")
+	paginationParams := queryParams.Pagination
+	if paginationParams != nil {
+		if paginationParams.Limit != nil {
+			qb.withLimit(*paginationParams.Limit)
+		}
+		if paginationParams.Page != nil {
+			qb.withPage(*paginationParams.Page)
+		}
+	}
+
+	query, filterValues := qb.build()
+	rows, err := u.dbPool.Query(ctx, query, filterValues...)
+	if err != nil {
+		return emptyDto, &customerrors.DatabaseError{}
+	}
+	users, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.User, error) {
+		return scanUser(row)
+	})
+	if err != nil {
+		return emptyDto, &customerrors.DatabaseError{Message: "getUsers had a database error"}
+	}
+
+	var totalCount int
+	countQuery, _ := qb.buildForTotalCount()
+	err = u.dbPool.QueryRow(ctx, countQuery, filterValues...).Scan(&totalCount)
+	if err != nil {
+		return emptyDto, &customerrors.DatabaseError{}
+	}
+
+	return userdto.GetUsersQueryResponseDTO{
+		Users:                users,
+		TotalAmountAvailable: totalCount,
+	}, nil
+}
+
+// SetUserStatus changes the account status, and records when the user was suspended.
+func (u UserDataAccess) SetUserStatus(ctx context.Context, userId int, status model.UserStatus) error {
+	query := `UPDATE Users SET status = $1,
+	suspended_at = CASE WHEN $1 = 'suspended' THEN COALESCE(suspended_at, now()) ELSE NULL END
+	WHERE id = $2 AND status <> 'deleted'`
+	result, err := u.dbPool.Exec(ctx, query, string(status), userId)
+	if err != nil {
+		return &customerrors.DatabaseError{Message: "could not update user status"}
+	}
+	if result.RowsAffected() == 0 {
 		return &customerrors.UserNotFoundError{}
 	}
 	return nil
 }
+
+func scanUser(row pgx.Row) (model.User, error) {
+	var user model.User
+	err := row.Scan(
+		&user.Id,
+		&user.Username,
+		&user.Password,
+		&user.Email,
+		&user.EmailStatus,
+		&user.FullName,
+		&user.Phone,
+		&user.City,
+		&user.Country,
+		&user.Household.HasChildren,
+		&user.Household.HasOtherPets,
+		&user.Household.HasGarden,
+		&user.Preferences,
+		&user.Status,
+		&user.SuspendedAt,
+		&user.DeletedAt,
+	)
+	return user, err
+}
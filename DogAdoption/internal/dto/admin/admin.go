@@ -0,0 +1,11 @@
+package admindto
+
+type AdminDTO struct {
+	Id       int           `json:"id"`
+	Username string        `json:"username"`
+	Links    AdminLinksDTO `json:"links"`
+}
+
+type AdminLinksDTO struct {
+	SelfLink string `json:"self_link"`
+}
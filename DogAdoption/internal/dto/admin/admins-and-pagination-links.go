@@ -0,0 +1,8 @@
+package admindto
+
+import "1dv027/aad/internal/dto"
+
+type AdminsAndPaginationLinksDTO struct {
+	AdminData       []AdminDTO             `json:"admin_data"`
+	PaginationLinks dto.PaginationLinksDTO `json:"pagination_links"`
+}
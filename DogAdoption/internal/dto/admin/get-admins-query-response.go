@@ -0,0 +1,8 @@
+package admindto
+
+import "1dv027/aad/internal/model"
+
+type GetAdminsQueryResponseDTO struct {
+	Admins               []model.Admin
+	TotalAmountAvailable int
+}
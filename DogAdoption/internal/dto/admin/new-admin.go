@@ -0,0 +1,6 @@
+package admindto
+
+type NewAdminDTO struct {
+	Username *string `json:"username"`
+	Password *string `json:"password"`
+}
@@ -0,0 +1,6 @@
+package admindto
+
+// UpdateAdminDTO changes the username of an admin, passwords are changed through the password endpoint.
+type UpdateAdminDTO struct {
+	Username *string `json:"username"`
+}
@@ -0,0 +1,8 @@
+package authdto
+
+type LoginResultDTO struct {
+	Token                 string `json:"token,omitempty"`
+	MfaRequired           bool   `json:"mfa_required"`
+	MfaChallengeToken     string `json:"mfa_challenge_token,omitempty"`
+	MfaEnrollmentRequired bool   `json:"mfa_enrollment_required"`
+}
@@ -0,0 +1,11 @@
+package authdto
+
+type MfaCodeDTO struct {
+	Code *string `json:"code"`
+}
+
+type MfaChallengeDTO struct {
+	ChallengeToken *string `json:"challenge_token"`
+	Code           *string `json:"code"`
+	RecoveryCode   *string `json:"recovery_code"`
+}
@@ -0,0 +1,7 @@
+package authdto
+
+type MfaEnrollmentDTO struct {
+	Secret          string   `json:"secret"`
+	ProvisioningUri string   `json:"provisioning_uri"`
+	RecoveryCodes   []string `json:"recovery_codes"`
+}
@@ -0,0 +1,12 @@
+package authdto
+
+import "1dv027/aad/internal/model"
+
+type MfaPolicyDTO struct {
+	Role       model.UserRole `json:"role"`
+	IsRequired bool           `json:"is_required"`
+}
+
+type UpdateMfaPolicyDTO struct {
+	IsRequired *bool `json:"is_required"`
+}
@@ -0,0 +1,15 @@
+package authdto
+
+type ChangePasswordDTO struct {
+	CurrentPassword *string `json:"current_password"`
+	NewPassword     *string `json:"new_password"`
+}
+
+type ForgotPasswordDTO struct {
+	Username *string `json:"username"`
+}
+
+type ResetPasswordDTO struct {
+	Token       *string `json:"token"`
+	NewPassword *string `json:"new_password"`
+}
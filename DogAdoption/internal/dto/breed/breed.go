@@ -0,0 +1,16 @@
+package breeddto
+
+type BreedDTO struct {
+	Id        int           `json:"id"`
+	Name      string        `json:"name"`
+	Aliases   []string      `json:"aliases"`
+	SizeGroup *string       `json:"size_group"`
+	FciGroup  *int          `json:"fci_group"`
+	Links     BreedLinksDTO `json:"links"`
+}
+
+type BreedLinksDTO struct {
+	SelfLink string `json:"self_link"`
+	// DogsLink lists the dogs with the breed as their primary or secondary breed.
+	DogsLink string `json:"dogs_link"`
+}
@@ -0,0 +1,8 @@
+package breeddto
+
+import "1dv027/aad/internal/dto"
+
+type BreedsAndPaginationLinksDTO struct {
+	BreedData       []BreedDTO             `json:"breed_data"`
+	PaginationLinks dto.PaginationLinksDTO `json:"pagination_links"`
+}
@@ -0,0 +1,8 @@
+package breeddto
+
+import "1dv027/aad/internal/model"
+
+type GetBreedsQueryResponseDTO struct {
+	Breeds               []model.Breed
+	TotalAmountAvailable int
+}
@@ -0,0 +1,7 @@
+package breeddto
+
+// MergeBreedDTO names the breed that is merged into another. Its dogs move to the other breed, and its
+// name and aliases become aliases of it.
+type MergeBreedDTO struct {
+	SourceBreedId *int `json:"source_breed_id"`
+}
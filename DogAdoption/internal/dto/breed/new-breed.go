@@ -0,0 +1,8 @@
+package breeddto
+
+type NewBreedDTO struct {
+	Name      *string  `json:"name"`
+	Aliases   []string `json:"aliases"`
+	SizeGroup *string  `json:"size_group"`
+	FciGroup  *int     `json:"fci_group"`
+}
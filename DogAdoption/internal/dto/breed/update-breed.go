@@ -0,0 +1,10 @@
+package breeddto
+
+// UpdateBreedDTO changes the fields that are set. Aliases replace all aliases of the breed, an empty
+// size_group or a fci_group of 0 removes them.
+type UpdateBreedDTO struct {
+	Name      *string   `json:"name"`
+	Aliases   *[]string `json:"aliases"`
+	SizeGroup *string   `json:"size_group"`
+	FciGroup  *int      `json:"fci_group"`
+}
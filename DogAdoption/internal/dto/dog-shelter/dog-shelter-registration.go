@@ -0,0 +1,39 @@
+package dogshelterdto
+
+import (
+	"1dv027/aad/internal/dto"
+	"time"
+)
+
+type DogShelterRegistrationDTO struct {
+	Id           int                            `json:"id"`
+	Name         string                         `json:"name"`
+	Website      string                         `json:"website"`
+	Country      string                         `json:"country"`
+	City         string                         `json:"city"`
+	Address      string                         `json:"address"`
+	Username     string                         `json:"username"`
+	ContactEmail *string                        `json:"contact_email"`
+	Status       string                         `json:"status"`
+	RegisteredAt time.Time                      `json:"registered_at"`
+	ReviewedAt   *time.Time                     `json:"reviewed_at"`
+	ReviewReason *string                        `json:"review_reason"`
+	Links        DogShelterRegistrationDtoLinks `json:"links"`
+}
+
+type DogShelterRegistrationDtoLinks struct {
+	SelfLink    string `json:"self_link,omitempty"`
+	ApproveLink string `json:"approve_link,omitempty"`
+	RejectLink  string `json:"reject_link,omitempty"`
+	ShelterLink string `json:"shelter_link,omitempty"`
+}
+
+type DogShelterRegistrationsAndPaginationLinksDTO struct {
+	RegistrationData []DogShelterRegistrationDTO `json:"registration_data"`
+	PaginationLinks  dto.PaginationLinksDTO      `json:"pagination_links"`
+}
+
+// ShelterRegistrationDecisionDTO carries the reason for a decision. A reason is required when rejecting.
+type ShelterRegistrationDecisionDTO struct {
+	Reason *string `json:"reason"`
+}
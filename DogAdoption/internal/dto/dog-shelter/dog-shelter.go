@@ -1,13 +1,25 @@
 package dogshelterdto
 
 type DogShelterDTO struct {
-	Id      int                `json:"id"`
-	Name    string             `json:"name"`
-	Website string             `json:"website"`
-	Country string             `json:"country"`
-	City    string             `json:"city"`
-	Address string             `json:"address"`
-	Links   DogShelterDtoLinks `json:"links"`
+	Id                     int                        `json:"id"`
+	Name                   string                     `json:"name"`
+	Website                string                     `json:"website"`
+	Country                string                     `json:"country"`
+	City                   string                     `json:"city"`
+	Address                string                     `json:"address"`
+	Phone                  *string                    `json:"phone"`
+	Email                  *string                    `json:"email"`
+	Capacity               *int                       `json:"capacity"`
+	Occupancy              int                        `json:"occupancy"`
+	Timezone               string                     `json:"timezone"`
+	Latitude               *float64                   `json:"latitude"`
+	Longitude              *float64                   `json:"longitude"`
+	LocationAccuracy       *string                    `json:"location_accuracy"`
+	DistanceKm             *float64                   `json:"distance_km,omitempty"`
+	OpeningHours           []OpeningHoursDTO          `json:"opening_hours"`
+	OpeningHoursExceptions []OpeningHoursExceptionDTO `json:"opening_hours_exceptions"`
+	IsOpenNow              bool                       `json:"is_open_now"`
+	Links                  DogShelterDtoLinks         `json:"links"`
 }
 
 type DogShelterDtoLinks struct {
@@ -0,0 +1,8 @@
+package dogshelterdto
+
+// NewDogShelterRegistrationDTO is submitted by a shelter registering itself. The contact email
+// receives the review decision.
+type NewDogShelterRegistrationDTO struct {
+	NewDogShelterDTO
+	ContactEmail *string `json:"contact_email"`
+}
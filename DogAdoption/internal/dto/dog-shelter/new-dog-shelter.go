@@ -8,4 +8,34 @@ type NewDogShelterDTO struct {
 	Address  *string `json:"address"`
 	Username *string `json:"username"`
 	Password *string `json:"password"`
+	ShelterDetailsDTO
+}
+
+// ShelterDetailsDTO holds the optional contact details, capacity and opening hours of a shelter.
+// The timezone is an IANA name and defaults to UTC. Latitude and longitude are set together and override
+// the coordinates geocoded from the address.
+type ShelterDetailsDTO struct {
+	Phone                  *string                     `json:"phone"`
+	Email                  *string                     `json:"email"`
+	Capacity               *int                        `json:"capacity"`
+	Timezone               *string                     `json:"timezone" example:"Europe/Stockholm"`
+	Latitude               *float64                    `json:"latitude" example:"56.6634"`
+	Longitude              *float64                    `json:"longitude" example:"16.3568"`
+	OpeningHours           *[]OpeningHoursDTO          `json:"opening_hours"`
+	OpeningHoursExceptions *[]OpeningHoursExceptionDTO `json:"opening_hours_exceptions"`
+	// Location is resolved by the service from the coordinates or the address, and is stored instead of them.
+	Location *ShelterLocationDTO `json:"-"`
+}
+
+// ShelterLocationDTO holds resolved coordinates and their accuracy. Nil coordinates clear the stored ones.
+type ShelterLocationDTO struct {
+	Latitude  *float64
+	Longitude *float64
+	Accuracy  *string
+}
+
+// IsEmpty reports if no detail is set.
+func (s ShelterDetailsDTO) IsEmpty() bool {
+	return s.Phone == nil && s.Email == nil && s.Capacity == nil && s.Timezone == nil &&
+		s.Latitude == nil && s.Longitude == nil && s.OpeningHours == nil && s.OpeningHoursExceptions == nil
 }
@@ -0,0 +1,17 @@
+package dogshelterdto
+
+// OpeningHoursDTO is an interval on a weekday, 0 being Sunday, in the local time of the shelter.
+type OpeningHoursDTO struct {
+	Weekday int    `json:"weekday"`
+	Opens   string `json:"opens" example:"10:00"`
+	Closes  string `json:"closes" example:"16:00"`
+}
+
+// OpeningHoursExceptionDTO replaces the opening hours on a date. Leaving out opens and closes
+// means that the shelter is closed the whole day.
+type OpeningHoursExceptionDTO struct {
+	Date   string  `json:"date" example:"2024-12-24"`
+	Opens  *string `json:"opens"`
+	Closes *string `json:"closes"`
+	Note   *string `json:"note"`
+}
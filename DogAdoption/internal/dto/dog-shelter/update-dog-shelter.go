@@ -1,9 +1,12 @@
 package dogshelterdto
 
+// UpdateDogShelterDTO updates the fields that are set. Opening hours and exceptions replace
+// the current ones as a whole, and an empty list removes them.
 type UpdateDogShelterDTO struct {
 	Name    *string `json:"name"`
 	Website *string `json:"website"`
 	Country *string `json:"country"`
 	City    *string `json:"city"`
 	Address *string `json:"address"`
+	ShelterDetailsDTO
 }
@@ -5,22 +5,42 @@ import (
 )
 
 type DogDTO struct {
-	Id           int         `json:"id"`
-	Name         string      `json:"name"`
-	Description  string      `json:"description"`
-	BirthDate    time.Time   `json:"birth_date"`
-	Breed        string      `json:"breed"`
-	IsNeutered   bool        `json:"is_neutered"`
-	ShelterId    int         `json:"shelter_id"`
-	ImageUrl     string      `json:"image_url"`
-	AdoptionFee  int         `json:"adoption_fee"`
-	IsAdopted    bool        `json:"is_adopted"`
-	FriendlyWith string      `json:"friendly_with"`
-	Gender       string      `json:"gender"`
-	Links        DogLinksDTO `json:"links"`
+	Id          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	BirthDate   time.Time `json:"birth_date"`
+	Breed       string    `json:"breed"`
+	// PrimaryBreedId and SecondaryBreedId reference breeds, a mixed breed dog has both.
+	PrimaryBreedId   *int   `json:"primary_breed_id"`
+	SecondaryBreedId *int   `json:"secondary_breed_id"`
+	IsNeutered       bool   `json:"is_neutered"`
+	ShelterId        int    `json:"shelter_id"`
+	ImageUrl         string `json:"image_url"`
+	AdoptionFee      int    `json:"adoption_fee"`
+	IsAdopted        bool   `json:"is_adopted"`
+	// FriendlyWith describes good_with for older clients.
+	FriendlyWith    string      `json:"friendly_with"`
+	GoodWith        GoodWithDTO `json:"good_with"`
+	EnergyLevel     *string     `json:"energy_level"`
+	Size            *string     `json:"size"`
+	Gender          string      `json:"gender"`
+	Status          string      `json:"status"`
+	StatusChangedAt time.Time   `json:"status_changed_at"`
+	DistanceKm      *float64    `json:"distance_km,omitempty"`
+	// FavoritedCount is how many users have the dog as a favorite, only shown to admins and members of its shelter.
+	FavoritedCount *int        `json:"favorited_count,omitempty"`
+	Links          DogLinksDTO `json:"links"`
 }
 
 type DogLinksDTO struct {
-	ShelterLink string `json:"shelter_link"`
-	SelfLink    string `json:"self_link"`
+	ShelterLink        string `json:"shelter_link"`
+	SelfLink           string `json:"self_link"`
+	TransfersLink      string `json:"transfers_link"`
+	StatusHistoryLink  string `json:"status_history_link"`
+	PhotosLink         string `json:"photos_link"`
+	MedicalRecordsLink string `json:"medical_records_link"`
+	// PrimaryPhotoLink is the medium thumbnail of the primary photo, if the dog has photos.
+	PrimaryPhotoLink   string `json:"primary_photo_link,omitempty"`
+	PrimaryBreedLink   string `json:"primary_breed_link,omitempty"`
+	SecondaryBreedLink string `json:"secondary_breed_link,omitempty"`
 }
@@ -0,0 +1,17 @@
+package dogdto
+
+// GoodWithDTO is whether a dog gets along with children and other animals, each yes, no or unknown.
+type GoodWithDTO struct {
+	Children     string `json:"children"`
+	Dogs         string `json:"dogs"`
+	Cats         string `json:"cats"`
+	SmallAnimals string `json:"small_animals"`
+}
+
+// UpdateGoodWithDTO sets the groups that are given, each to yes, no or unknown.
+type UpdateGoodWithDTO struct {
+	Children     *string `json:"children"`
+	Dogs         *string `json:"dogs"`
+	Cats         *string `json:"cats"`
+	SmallAnimals *string `json:"small_animals"`
+}
@@ -0,0 +1,50 @@
+package dogmedicaldto
+
+import "time"
+
+type DogMedicalRecordDTO struct {
+	Id         int                      `json:"id"`
+	DogId      int                      `json:"dog_id"`
+	RecordType string                   `json:"record_type"`
+	Title      string                   `json:"title"`
+	RecordDate string                   `json:"record_date"`
+	NextDueOn  *string                  `json:"next_due_on"`
+	Notes      string                   `json:"notes"`
+	CreatedAt  time.Time                `json:"created_at"`
+	UpdatedAt  time.Time                `json:"updated_at"`
+	Links      DogMedicalRecordDtoLinks `json:"links"`
+}
+
+type DogMedicalRecordDtoLinks struct {
+	SelfLink string `json:"self_link"`
+	DogLink  string `json:"dog_link"`
+}
+
+// DogMedicalRecordsDTO is the medical record of a dog. The summary is public, while the full records,
+// including treatments and notes, are only included for the dog's shelter and admins.
+type DogMedicalRecordsDTO struct {
+	Summary      DogMedicalSummaryDTO      `json:"summary"`
+	IsFullRecord bool                      `json:"is_full_record"`
+	RecordData   []DogMedicalRecordDTO     `json:"record_data,omitempty"`
+	Links        DogMedicalRecordsDtoLinks `json:"links"`
+}
+
+type DogMedicalRecordsDtoLinks struct {
+	SelfLink string `json:"self_link"`
+	DogLink  string `json:"dog_link"`
+}
+
+type DogMedicalSummaryDTO struct {
+	DogId          int                     `json:"dog_id"`
+	IsNeutered     bool                    `json:"is_neutered"`
+	IsVaccinated   bool                    `json:"is_vaccinated"`
+	Vaccinations   []VaccinationSummaryDTO `json:"vaccinations"`
+	TreatmentCount int                     `json:"treatment_count"`
+}
+
+type VaccinationSummaryDTO struct {
+	VaccineType    string  `json:"vaccine_type"`
+	AdministeredOn string  `json:"administered_on"`
+	NextDueOn      *string `json:"next_due_on"`
+	IsCurrent      bool    `json:"is_current"`
+}
@@ -0,0 +1,20 @@
+package dogmedicaldto
+
+// NewDogMedicalRecordDTO adds a vaccination, treatment or note. Dates are formatted as YYYY-MM-DD, and
+// next_due_on is only accepted for vaccinations.
+type NewDogMedicalRecordDTO struct {
+	RecordType *string `json:"record_type"`
+	Title      *string `json:"title"`
+	RecordDate *string `json:"record_date"`
+	NextDueOn  *string `json:"next_due_on"`
+	Notes      *string `json:"notes"`
+}
+
+// UpdateDogMedicalRecordDTO changes the provided fields. An empty next_due_on removes the due date.
+type UpdateDogMedicalRecordDTO struct {
+	RecordType *string `json:"record_type"`
+	Title      *string `json:"title"`
+	RecordDate *string `json:"record_date"`
+	NextDueOn  *string `json:"next_due_on"`
+	Notes      *string `json:"notes"`
+}
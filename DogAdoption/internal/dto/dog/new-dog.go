@@ -3,15 +3,27 @@ package dogdto
 import "time"
 
 type NewDogDTO struct {
-	Name         *string    `json:"name"`
-	Description  *string    `json:"description"`
-	BirthDate    *time.Time `json:"birth_date"`
-	Breed        *string    `json:"breed"`
-	IsNeutered   *bool      `json:"is_neutered"`
-	ShelterId    *int       `json:"shelter_id"`
-	ImageUrl     *string    `json:"image_url"`
-	AdoptionFee  *int       `json:"adoption_fee"`
-	IsAdopted    *bool      `json:"is_adopted"`
-	FriendlyWith *string    `json:"friendly_with"`
-	Gender       *string    `json:"gender"`
+	Name        *string    `json:"name"`
+	Description *string    `json:"description"`
+	BirthDate   *time.Time `json:"birth_date"`
+	// Breed is resolved to a breed by its name or an alias, when primary_breed_id is not set.
+	Breed            *string `json:"breed"`
+	PrimaryBreedId   *int    `json:"primary_breed_id"`
+	SecondaryBreedId *int    `json:"secondary_breed_id"`
+	IsNeutered       *bool   `json:"is_neutered"`
+	ShelterId        *int    `json:"shelter_id"`
+	ImageUrl         *string `json:"image_url"`
+	AdoptionFee      *int    `json:"adoption_fee"`
+	IsAdopted        *bool   `json:"is_adopted"`
+	// FriendlyWith is kept for older clients. It sets good_with when good_with is not given, and is
+	// derived from good_with otherwise.
+	FriendlyWith *string            `json:"friendly_with"`
+	GoodWith     *UpdateGoodWithDTO `json:"good_with"`
+	// EnergyLevel is low, medium or high.
+	EnergyLevel *string `json:"energy_level"`
+	// Size is toy, small, medium, large or giant, and defaults to the size of the primary breed.
+	Size       *string `json:"size"`
+	Gender     *string `json:"gender"`
+	Status     *string `json:"status"`
+	StatusNote *string `json:"status_note"`
 }
@@ -0,0 +1,29 @@
+package dogphotodto
+
+import "time"
+
+type DogPhotoDTO struct {
+	Id          int              `json:"id"`
+	DogId       int              `json:"dog_id"`
+	Position    int              `json:"position"`
+	IsPrimary   bool             `json:"is_primary"`
+	ContentType string           `json:"content_type"`
+	Width       int              `json:"width"`
+	Height      int              `json:"height"`
+	SizeBytes   int              `json:"size_bytes"`
+	CreatedAt   time.Time        `json:"created_at"`
+	Links       DogPhotoDtoLinks `json:"links"`
+}
+
+type DogPhotoDtoLinks struct {
+	SelfLink     string `json:"self_link"`
+	DogLink      string `json:"dog_link"`
+	OriginalLink string `json:"original_link"`
+	LargeLink    string `json:"large_link"`
+	MediumLink   string `json:"medium_link"`
+	SmallLink    string `json:"small_link"`
+}
+
+type DogPhotoGalleryDTO struct {
+	PhotoData []DogPhotoDTO `json:"photo_data"`
+}
@@ -0,0 +1,6 @@
+package dogphotodto
+
+type UpdateDogPhotoDTO struct {
+	Position  *int  `json:"position"`
+	IsPrimary *bool `json:"is_primary"`
+}
@@ -0,0 +1,26 @@
+package dogdto
+
+import "1dv027/aad/internal/dto"
+
+// DogRecommendationDTO is an available dog scored against the profile of an adopter. Score is the weighted
+// match from 0 to 100, and Criteria explains how each criterion contributed to it.
+type DogRecommendationDTO struct {
+	Dog      DogDTO                            `json:"dog"`
+	Score    int                               `json:"score"`
+	Criteria []RecommendationCriterionScoreDTO `json:"criteria"`
+}
+
+// RecommendationCriterionScoreDTO is the score of one criterion from 0 to 1. Criteria that the adopter has not
+// answered are not applicable, and do not count towards the score of the dog.
+type RecommendationCriterionScoreDTO struct {
+	Criterion   string  `json:"criterion"`
+	Applicable  bool    `json:"applicable"`
+	Score       float64 `json:"score"`
+	Weight      float64 `json:"weight"`
+	Explanation string  `json:"explanation"`
+}
+
+type DogRecommendationsAndPaginationLinksDTO struct {
+	Recommendations []DogRecommendationDTO `json:"recommendations"`
+	PaginationLinks dto.PaginationLinksDTO `json:"pagination_links"`
+}
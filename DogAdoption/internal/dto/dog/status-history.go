@@ -0,0 +1,24 @@
+package dogdto
+
+import "time"
+
+type DogStatusChangeDTO struct {
+	Id         int       `json:"id"`
+	DogId      int       `json:"dog_id"`
+	FromStatus *string   `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	Note       *string   `json:"note"`
+	ChangedAt  time.Time `json:"changed_at"`
+}
+
+type DogStatusHistoryDTO struct {
+	Status             string                   `json:"status"`
+	AllowedTransitions []string                 `json:"allowed_transitions"`
+	StatusHistory      []DogStatusChangeDTO     `json:"status_history"`
+	Links              DogStatusHistoryLinksDTO `json:"links"`
+}
+
+type DogStatusHistoryLinksDTO struct {
+	SelfLink string `json:"self_link"`
+	DogLink  string `json:"dog_link"`
+}
@@ -0,0 +1,6 @@
+package dogtransferdto
+
+// DogTransferDecisionDTO is the optional body when declining or cancelling a transfer.
+type DogTransferDecisionDTO struct {
+	Reason *string `json:"reason"`
+}
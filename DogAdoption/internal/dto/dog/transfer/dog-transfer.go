@@ -0,0 +1,27 @@
+package dogtransferdto
+
+import "time"
+
+type DogTransferDTO struct {
+	Id             int                 `json:"id"`
+	DogId          int                 `json:"dog_id"`
+	FromShelterId  int                 `json:"from_shelter_id"`
+	ToShelterId    int                 `json:"to_shelter_id"`
+	Status         string              `json:"status"`
+	Note           *string             `json:"note"`
+	DecisionReason *string             `json:"decision_reason"`
+	CreatedAt      time.Time           `json:"created_at"`
+	DecidedAt      *time.Time          `json:"decided_at"`
+	Links          DogTransferDtoLinks `json:"links"`
+}
+
+type DogTransferDtoLinks struct {
+	SelfLink        string `json:"self_link"`
+	DogLink         string `json:"dog_link"`
+	FromShelterLink string `json:"from_shelter_link"`
+	ToShelterLink   string `json:"to_shelter_link"`
+}
+
+type DogTransferListDTO struct {
+	TransferData []DogTransferDTO `json:"transfer_data"`
+}
@@ -0,0 +1,6 @@
+package dogtransferdto
+
+type NewDogTransferDTO struct {
+	ToShelterId *int    `json:"to_shelter_id"`
+	Note        *string `json:"note"`
+}
@@ -6,4 +6,5 @@ type EntryPointLinksDTO struct {
 	DogSheltersUrl    string `json:"dog_shelters_url"`
 	AuthenticationUrl string `json:"authentication_url"`
 	UsersUrl          string `json:"users_url"`
+	BreedsUrl         string `json:"breeds_url"`
 }
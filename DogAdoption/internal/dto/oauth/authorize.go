@@ -0,0 +1,28 @@
+package oauthdto
+
+type AuthorizeRequestDTO struct {
+	ResponseType        string `json:"response_type" query:"response_type"`
+	ClientId            string `json:"client_id" query:"client_id"`
+	RedirectUri         string `json:"redirect_uri" query:"redirect_uri"`
+	Scope               string `json:"scope" query:"scope"`
+	State               string `json:"state" query:"state"`
+	CodeChallenge       string `json:"code_challenge" query:"code_challenge"`
+	CodeChallengeMethod string `json:"code_challenge_method" query:"code_challenge_method"`
+}
+
+type AuthorizeDecisionDTO struct {
+	AuthorizeRequestDTO
+	Approve *bool `json:"approve"`
+}
+
+type ConsentPromptDTO struct {
+	ClientId    string   `json:"client_id"`
+	ClientName  string   `json:"client_name"`
+	RedirectUri string   `json:"redirect_uri"`
+	Scopes      []string `json:"scopes"`
+	HasConsent  bool     `json:"has_consent"`
+}
+
+type AuthorizeResultDTO struct {
+	RedirectTo string `json:"redirect_to"`
+}
@@ -0,0 +1,33 @@
+package oauthdto
+
+import (
+	"1dv027/aad/internal/model"
+	"time"
+)
+
+type NewOAuthClientDTO struct {
+	Name           *string                 `json:"name"`
+	RedirectUris   *[]string               `json:"redirect_uris"`
+	Scopes         *[]string               `json:"scopes"`
+	GrantTypes     *[]model.OAuthGrantType `json:"grant_types"`
+	IsConfidential *bool                   `json:"is_confidential"`
+	OwnerUserId    *int                    `json:"owner_user_id"`
+}
+
+type OAuthClientDTO struct {
+	ClientId       string                 `json:"client_id"`
+	Name           string                 `json:"name"`
+	RedirectUris   []string               `json:"redirect_uris"`
+	Scopes         []string               `json:"scopes"`
+	GrantTypes     []model.OAuthGrantType `json:"grant_types"`
+	IsConfidential bool                   `json:"is_confidential"`
+	OwnerUserId    *int                   `json:"owner_user_id"`
+	CreatedAt      time.Time              `json:"created_at"`
+}
+
+// CreatedOAuthClientDTO is only returned when the client is registered, since
+// the plain text client secret is not stored.
+type CreatedOAuthClientDTO struct {
+	OAuthClientDTO
+	ClientSecret string `json:"client_secret,omitempty"`
+}
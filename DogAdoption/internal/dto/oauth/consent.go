@@ -0,0 +1,10 @@
+package oauthdto
+
+import "time"
+
+type OAuthConsentDTO struct {
+	ClientId  string    `json:"client_id"`
+	UserId    int       `json:"user_id"`
+	Scopes    []string  `json:"scopes"`
+	GrantedAt time.Time `json:"granted_at"`
+}
@@ -6,21 +6,71 @@ type PaginationParams struct {
 }
 
 type DogsFilterParams struct {
-	Breed      *string
+	// Breed matches the primary or secondary breed of a dog by its name or an alias, ignoring case.
+	Breed *string
+	// BreedId matches the primary or secondary breed of a dog.
+	BreedId    *int
 	Gender     *string
 	IsNeutered *string
 	IsAdopted  *string
 	ShelterId  *int
+	// IsVaccinated matches dogs with, or without, a vaccination that is not overdue.
+	IsVaccinated *bool
+	// Statuses matches dogs with any of the statuses.
+	Statuses []string
+	// GoodWith matches dogs that are good with all of the groups.
+	GoodWith []string
+	// EnergyLevels and Sizes match dogs with any of the values.
+	EnergyLevels []string
+	Sizes        []string
+	// FavoritedBy matches the favorites of a user. It is set by the favorites endpoint and is not a query param.
+	FavoritedBy *int
+	// DogId matches a single dog. It is set when saved searches are matched and is not a query param.
+	DogId *int
 }
 
 type DogShelterFilterParams struct {
 	Country *string
 	City    *string
 	Name    *string
+	OpenNow *bool
+}
+
+// GeoFilterParams sorts results by the distance to a point, and filters them by a radius if it is set.
+type GeoFilterParams struct {
+	Latitude  float64
+	Longitude float64
+	RadiusKm  *float64
+}
+
+type UsersFilterParams struct {
+	UsernamePrefix *string
+	Status         *string
+}
+
+type ShelterRegistrationFilterParams struct {
+	Status *string
+}
+
+type BreedsFilterParams struct {
+	// Search matches breeds with a name or an alias starting with it, ignoring case.
+	Search    *string
+	SizeGroup *string
+	FciGroup  *int
+}
+
+type NotificationsFilterParams struct {
+	// Unread matches notifications that are, or are not, unread.
+	Unread *bool
 }
 
 type QueryParams struct {
-	Pagination       *PaginationParams
-	DogsFilter       *DogsFilterParams
-	DogShelterFilter *DogShelterFilterParams
+	Pagination                *PaginationParams
+	DogsFilter                *DogsFilterParams
+	DogShelterFilter          *DogShelterFilterParams
+	UsersFilter               *UsersFilterParams
+	ShelterRegistrationFilter *ShelterRegistrationFilterParams
+	GeoFilter                 *GeoFilterParams
+	BreedsFilter              *BreedsFilterParams
+	NotificationsFilter       *NotificationsFilterParams
 }
@@ -0,0 +1,35 @@
+package reminderdto
+
+import "time"
+
+type VaccinationDueDTO struct {
+	ShelterId   int                    `json:"shelter_id"`
+	ShelterName string                 `json:"shelter_name"`
+	DogId       int                    `json:"dog_id"`
+	DogName     string                 `json:"dog_name"`
+	VaccineType string                 `json:"vaccine_type"`
+	NextDueOn   string                 `json:"next_due_on"`
+	Links       VaccinationDueDtoLinks `json:"links"`
+}
+
+type VaccinationDueDtoLinks struct {
+	DogLink            string `json:"dog_link"`
+	ShelterLink        string `json:"shelter_link"`
+	MedicalRecordsLink string `json:"medical_records_link"`
+}
+
+type PostAdoptionFollowupDTO struct {
+	ShelterId         int                          `json:"shelter_id"`
+	ShelterName       string                       `json:"shelter_name"`
+	DogId             int                          `json:"dog_id"`
+	DogName           string                       `json:"dog_name"`
+	AdoptedAt         time.Time                    `json:"adopted_at"`
+	DaysSinceAdoption int                          `json:"days_since_adoption"`
+	Links             PostAdoptionFollowupDtoLinks `json:"links"`
+}
+
+type PostAdoptionFollowupDtoLinks struct {
+	DogLink           string `json:"dog_link"`
+	ShelterLink       string `json:"shelter_link"`
+	StatusHistoryLink string `json:"status_history_link"`
+}
@@ -0,0 +1,8 @@
+package shelterstaffdto
+
+// AcceptStaffInvitationDTO creates the staff account with the token from the invitation email.
+type AcceptStaffInvitationDTO struct {
+	Token    *string `json:"token"`
+	Username *string `json:"username"`
+	Password *string `json:"password"`
+}
@@ -0,0 +1,6 @@
+package shelterstaffdto
+
+type NewStaffInvitationDTO struct {
+	Email *string `json:"email"`
+	Role  *string `json:"role"`
+}
@@ -0,0 +1,22 @@
+package shelterstaffdto
+
+import "time"
+
+type ShelterStaffDTO struct {
+	Id        int                  `json:"id"`
+	ShelterId int                  `json:"shelter_id"`
+	Username  string               `json:"username"`
+	Role      string               `json:"role"`
+	Email     string               `json:"email,omitempty"`
+	CreatedAt time.Time            `json:"created_at"`
+	Links     ShelterStaffDtoLinks `json:"links"`
+}
+
+type ShelterStaffDtoLinks struct {
+	SelfLink    string `json:"self_link"`
+	ShelterLink string `json:"shelter_link"`
+}
+
+type ShelterStaffListDTO struct {
+	StaffData []ShelterStaffDTO `json:"staff_data"`
+}
@@ -0,0 +1,23 @@
+package shelterstaffdto
+
+import "time"
+
+type StaffInvitationDTO struct {
+	Id         int                     `json:"id"`
+	ShelterId  int                     `json:"shelter_id"`
+	Email      string                  `json:"email"`
+	Role       string                  `json:"role"`
+	ExpiresAt  time.Time               `json:"expires_at"`
+	AcceptedAt *time.Time              `json:"accepted_at"`
+	CreatedAt  time.Time               `json:"created_at"`
+	Links      StaffInvitationDtoLinks `json:"links"`
+}
+
+type StaffInvitationDtoLinks struct {
+	SelfLink    string `json:"self_link"`
+	ShelterLink string `json:"shelter_link"`
+}
+
+type StaffInvitationListDTO struct {
+	InvitationData []StaffInvitationDTO `json:"invitation_data"`
+}
@@ -0,0 +1,5 @@
+package shelterstaffdto
+
+type UpdateShelterStaffDTO struct {
+	Role *string `json:"role"`
+}
@@ -1,9 +1,33 @@
 package dto
 
-import "1dv027/aad/internal/model"
+import (
+	"1dv027/aad/internal/model"
+	"slices"
+)
 
 type UserCredentials struct {
-	Username string
-	UserRole model.UserRole
-	Id       int
+	Username              string
+	UserRole              model.UserRole
+	Id                    int
+	MfaEnrollmentRequired bool
+	// ApiKeyId is set when the request was authenticated with an api key.
+	ApiKeyId int
+	// OAuthClientId is set when the request was authenticated with an oauth access token.
+	OAuthClientId string
+	// Scopes restricts the credentials to the given scopes. Nil means unrestricted.
+	Scopes []string
+	// ShelterId and ShelterStaffRole are set by the auth middleware for dog shelters and their staff.
+	// The shelter account itself acts as an owner of the shelter.
+	ShelterId        int
+	ShelterStaffRole model.ShelterStaffRole
+}
+
+// IsShelterMember reports if the credentials are of the dog shelter or of one of its staff members.
+func (u UserCredentials) IsShelterMember(shelterId int) bool {
+	return (u.UserRole == model.DOGSHELTER || u.UserRole == model.SHELTERSTAFF) && u.ShelterId == shelterId
+}
+
+// HasShelterRole reports if the credentials are a member of the dog shelter with one of the roles.
+func (u UserCredentials) HasShelterRole(shelterId int, roles ...model.ShelterStaffRole) bool {
+	return u.IsShelterMember(shelterId) && slices.Contains(roles, u.ShelterStaffRole)
 }
@@ -0,0 +1,21 @@
+package userapikeydto
+
+import "time"
+
+type ApiKeyDTO struct {
+	Id         int        `json:"id"`
+	UserId     int        `json:"user_id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
+
+// CreatedApiKeyDTO is only returned when the api key is created, since
+// the plain text key is not stored.
+type CreatedApiKeyDTO struct {
+	ApiKeyDTO
+	Key string `json:"key"`
+}
@@ -0,0 +1,6 @@
+package userapikeydto
+
+type NewApiKeyDTO struct {
+	Name   *string   `json:"name"`
+	Scopes *[]string `json:"scopes"`
+}
@@ -0,0 +1,5 @@
+package userdto
+
+type VerifyEmailDTO struct {
+	Token *string `json:"token"`
+}
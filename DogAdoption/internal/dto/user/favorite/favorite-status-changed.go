@@ -0,0 +1,14 @@
+package userfavoritedto
+
+import (
+	dogdto "1dv027/aad/internal/dto/dog"
+	"time"
+)
+
+// FavoriteStatusChangedDTO is sent to the users that have the dog as a favorite when its status changes.
+type FavoriteStatusChangedDTO struct {
+	Dog            dogdto.DogDTO `json:"dog"`
+	PreviousStatus string        `json:"previous_status"`
+	Status         string        `json:"status"`
+	ChangedAt      time.Time     `json:"changed_at"`
+}
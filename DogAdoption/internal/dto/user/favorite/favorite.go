@@ -0,0 +1,10 @@
+package userfavoritedto
+
+import "time"
+
+// FavoriteDTO is a dog that a user has saved to follow, as included in the export of the user.
+type FavoriteDTO struct {
+	DogId       int       `json:"dog_id"`
+	FavoritedAt time.Time `json:"favorited_at"`
+	DogLink     string    `json:"dog_link"`
+}
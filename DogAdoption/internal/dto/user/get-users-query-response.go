@@ -0,0 +1,8 @@
+package userdto
+
+import "1dv027/aad/internal/model"
+
+type GetUsersQueryResponseDTO struct {
+	Users                []model.User
+	TotalAmountAvailable int
+}
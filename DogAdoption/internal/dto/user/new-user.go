@@ -3,4 +3,5 @@ package userdto
 type NewUserDTO struct {
 	Username *string `json:"username"`
 	Password *string `json:"password"`
+	Email    *string `json:"email"`
 }
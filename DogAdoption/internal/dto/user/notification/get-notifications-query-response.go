@@ -0,0 +1,9 @@
+package usernotificationdto
+
+import "1dv027/aad/internal/model"
+
+type GetNotificationsQueryResponseDTO struct {
+	Notifications        []model.UserNotification
+	TotalAmountAvailable int
+	UnreadCount          int
+}
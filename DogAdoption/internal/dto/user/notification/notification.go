@@ -0,0 +1,26 @@
+package usernotificationdto
+
+import "time"
+
+type NotificationDTO struct {
+	Id     int    `json:"id"`
+	UserId int    `json:"user_id"`
+	Event  string `json:"event"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+	// Data is the payload of the event, the same as the webhook of the event without the secret.
+	Data      map[string]any       `json:"data" swaggertype:"object"`
+	IsRead    bool                 `json:"is_read"`
+	CreatedAt time.Time            `json:"created_at"`
+	ReadAt    *time.Time           `json:"read_at"`
+	Links     NotificationLinksDTO `json:"links"`
+}
+
+type NotificationLinksDTO struct {
+	// ReadLink marks the notification as read with a PUT request.
+	ReadLink string `json:"read"`
+}
+
+type UnreadCountDTO struct {
+	UnreadCount int `json:"unread_count"`
+}
@@ -0,0 +1,9 @@
+package usernotificationdto
+
+import "1dv027/aad/internal/dto"
+
+type NotificationsAndPaginationLinksDTO struct {
+	Notifications   []NotificationDTO      `json:"notifications"`
+	UnreadCount     int                    `json:"unread_count"`
+	PaginationLinks dto.PaginationLinksDTO `json:"pagination_links"`
+}
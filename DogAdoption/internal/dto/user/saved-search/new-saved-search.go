@@ -0,0 +1,10 @@
+package usersavedsearchdto
+
+// NewSavedSearchDTO is also used to update a saved search, where only the given fields change.
+type NewSavedSearchDTO struct {
+	Name *string `json:"name"`
+	// Query is a GET /dogs query string, such as breed=beagle&good-with=children.
+	Query     *string `json:"query"`
+	Channel   *string `json:"channel"`
+	Frequency *string `json:"frequency"`
+}
@@ -0,0 +1,31 @@
+package usersavedsearchdto
+
+import (
+	dogdto "1dv027/aad/internal/dto/dog"
+	"time"
+)
+
+type SavedSearchDTO struct {
+	Id        int                 `json:"id"`
+	UserId    int                 `json:"user_id"`
+	Name      string              `json:"name"`
+	Query     string              `json:"query"`
+	Channel   string              `json:"channel"`
+	Frequency string              `json:"frequency"`
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+	Links     SavedSearchLinksDTO `json:"links"`
+}
+
+type SavedSearchLinksDTO struct {
+	SelfLink string `json:"self"`
+	// DogsLink runs the search against all dogs.
+	DogsLink string `json:"dogs"`
+}
+
+// SavedSearchMatchDTO is sent to the user when dogs match a saved search, one dog at a time for instant
+// searches and the dogs of the last day for daily searches.
+type SavedSearchMatchDTO struct {
+	SavedSearch SavedSearchDTO  `json:"saved_search"`
+	Dogs        []dogdto.DogDTO `json:"dogs"`
+}
@@ -0,0 +1,13 @@
+package userdto
+
+// UpdateUserDTO updates the profile of a user. With PATCH only the given fields are changed,
+// with PUT omitted fields are cleared, except email which is required.
+type UpdateUserDTO struct {
+	Email       *string             `json:"email"`
+	FullName    *string             `json:"full_name"`
+	Phone       *string             `json:"phone"`
+	City        *string             `json:"city"`
+	Country     *string             `json:"country"`
+	Household   *UserHouseholdDTO   `json:"household"`
+	Preferences *UserPreferencesDTO `json:"preferences"`
+}
@@ -0,0 +1,39 @@
+package userdto
+
+import (
+	oauthdto "1dv027/aad/internal/dto/oauth"
+	userapikeydto "1dv027/aad/internal/dto/user/api-key"
+	userfavoritedto "1dv027/aad/internal/dto/user/favorite"
+	usernotificationdto "1dv027/aad/internal/dto/user/notification"
+	usersavedsearchdto "1dv027/aad/internal/dto/user/saved-search"
+	userwebhookdto "1dv027/aad/internal/dto/user/webhook"
+	"1dv027/aad/internal/model"
+	"time"
+)
+
+// UserExportDTO is the archive of the data held about a user. Secrets such as password hashes,
+// webhook client secrets, api key hashes and MFA secrets are left out.
+type UserExportDTO struct {
+	ExportedAt    time.Time                             `json:"exported_at"`
+	Profile       UserDTO                               `json:"profile"`
+	Webhook       *userwebhookdto.UserWebhookDTO        `json:"webhook"`
+	ApiKeys       []userapikeydto.ApiKeyDTO             `json:"api_keys"`
+	OAuthClients  []oauthdto.OAuthClientDTO             `json:"oauth_clients"`
+	OAuthConsents []oauthdto.OAuthConsentDTO            `json:"oauth_consents"`
+	MfaEnabled    bool                                  `json:"mfa_enabled"`
+	Favorites     []userfavoritedto.FavoriteDTO         `json:"favorites"`
+	SavedSearches []usersavedsearchdto.SavedSearchDTO   `json:"saved_searches"`
+	Notifications []usernotificationdto.NotificationDTO `json:"notifications"`
+}
+
+type UserExportQueryResponseDTO struct {
+	User          model.User
+	Webhook       *model.Webhook
+	ApiKeys       []model.ApiKey
+	OAuthClients  []model.OAuthClient
+	OAuthConsents []model.OAuthConsent
+	MfaEnrollment *model.MfaEnrollment
+	Favorites     []model.UserFavorite
+	SavedSearches []model.SavedSearch
+	Notifications []model.UserNotification
+}
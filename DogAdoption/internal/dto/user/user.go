@@ -1,9 +1,50 @@
 package userdto
 
-import userwebhookdto "1dv027/aad/internal/dto/user/webhook"
+import (
+	userwebhookdto "1dv027/aad/internal/dto/user/webhook"
+	"time"
+)
 
+// UserDTO is the profile of a user. Dog shelters only see the adopter profile, so the
+// contact fields email, email_status and phone, the account status, and the links to private resources, are left out for them.
 type UserDTO struct {
-	Id       int                           `json:"id"`
-	Username string                        `json:"username"`
-	Webhook  userwebhookdto.UserWebhookDTO `json:"webhook"`
+	Id          int                           `json:"id"`
+	Username    string                        `json:"username"`
+	Email       *string                       `json:"email,omitempty"`
+	EmailStatus string                        `json:"email_status,omitempty"`
+	FullName    *string                       `json:"full_name"`
+	Phone       *string                       `json:"phone,omitempty"`
+	City        *string                       `json:"city"`
+	Country     *string                       `json:"country"`
+	Household   UserHouseholdDTO              `json:"household"`
+	Preferences UserPreferencesDTO            `json:"preferences"`
+	Status      string                        `json:"status,omitempty"`
+	SuspendedAt *time.Time                    `json:"suspended_at,omitempty"`
+	Webhook     userwebhookdto.UserWebhookDTO `json:"webhook"`
+	Links       UserLinksDTO                  `json:"links"`
+}
+
+type UserHouseholdDTO struct {
+	HasChildren  *bool `json:"has_children"`
+	HasOtherPets *bool `json:"has_other_pets"`
+	HasGarden    *bool `json:"has_garden"`
+}
+
+type UserPreferencesDTO struct {
+	Breeds         []string `json:"breeds"`
+	Gender         *string  `json:"gender"`
+	MinAgeYears    *int     `json:"min_age_years"`
+	MaxAgeYears    *int     `json:"max_age_years"`
+	MaxAdoptionFee *int     `json:"max_adoption_fee"`
+	Sizes          []string `json:"sizes"`
+	EnergyLevels   []string `json:"energy_levels"`
+	MaxDistanceKm  *float64 `json:"max_distance_km"`
+}
+
+type UserLinksDTO struct {
+	SelfLink          string `json:"self_link"`
+	WebhookLink       string `json:"webhook_link,omitempty"`
+	FavoritesLink     string `json:"favorites_link,omitempty"`
+	SavedSearchesLink string `json:"saved_searches_link,omitempty"`
+	NotificationsLink string `json:"notifications_link,omitempty"`
 }
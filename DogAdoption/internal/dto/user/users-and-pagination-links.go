@@ -0,0 +1,8 @@
+package userdto
+
+import "1dv027/aad/internal/dto"
+
+type UsersAndPaginationLinksDTO struct {
+	UserData        []UserDTO              `json:"user_data"`
+	PaginationLinks dto.PaginationLinksDTO `json:"pagination_links"`
+}
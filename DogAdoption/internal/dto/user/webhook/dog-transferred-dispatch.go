@@ -0,0 +1,12 @@
+package userwebhookdto
+
+import (
+	dogdto "1dv027/aad/internal/dto/dog"
+	dogtransferdto "1dv027/aad/internal/dto/dog/transfer"
+)
+
+type DogTransferredDispatchDTO struct {
+	Transfer dogtransferdto.DogTransferDTO `json:"transfer"`
+	Dog      dogdto.DogDTO                 `json:"dog"`
+	Secret   string                        `json:"secret"`
+}
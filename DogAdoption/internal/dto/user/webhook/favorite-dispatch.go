@@ -0,0 +1,8 @@
+package userwebhookdto
+
+import userfavoritedto "1dv027/aad/internal/dto/user/favorite"
+
+type FavoriteStatusChangedDispatchDTO struct {
+	FavoriteStatusChanged userfavoritedto.FavoriteStatusChangedDTO `json:"favorite_status_changed"`
+	Secret                string                                   `json:"secret"`
+}
@@ -0,0 +1,8 @@
+package userwebhookdto
+
+import usersavedsearchdto "1dv027/aad/internal/dto/user/saved-search"
+
+type SavedSearchMatchDispatchDTO struct {
+	SavedSearchMatch usersavedsearchdto.SavedSearchMatchDTO `json:"saved_search_match"`
+	Secret           string                                 `json:"secret"`
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type AccountSuspendedError struct {
+	Message string
+}
+
+func (a *AccountSuspendedError) Error() string {
+	return a.Message
+}
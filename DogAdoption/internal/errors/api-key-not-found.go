@@ -0,0 +1,9 @@
+package customerrors
+
+type ApiKeyNotFoundError struct {
+	Message string
+}
+
+func (a *ApiKeyNotFoundError) Error() string {
+	return a.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type BreedConflictError struct {
+	Message string
+}
+
+func (b *BreedConflictError) Error() string {
+	return b.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type BreedNotFoundError struct {
+	Message string
+}
+
+func (b *BreedNotFoundError) Error() string {
+	return b.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type DogPhotoNotFoundError struct {
+	Message string
+}
+
+func (d *DogPhotoNotFoundError) Error() string {
+	return d.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type DogPhotoTooLargeError struct {
+	Message string
+}
+
+func (d *DogPhotoTooLargeError) Error() string {
+	return d.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type DogTransferConflictError struct {
+	Message string
+}
+
+func (d *DogTransferConflictError) Error() string {
+	return d.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type DogTransferNotFoundError struct {
+	Message string
+}
+
+func (d *DogTransferNotFoundError) Error() string {
+	return d.Message
+}
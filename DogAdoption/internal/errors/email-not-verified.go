@@ -0,0 +1,9 @@
+package customerrors
+
+type EmailNotVerifiedError struct {
+	Message string
+}
+
+func (e *EmailNotVerifiedError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type EmailVerificationTokenNotFoundError struct {
+	Message string
+}
+
+func (e *EmailVerificationTokenNotFoundError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type FavoriteNotFoundError struct {
+	Message string
+}
+
+func (f *FavoriteNotFoundError) Error() string {
+	return f.Message
+}
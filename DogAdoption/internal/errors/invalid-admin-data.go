@@ -0,0 +1,9 @@
+package customerrors
+
+type InvalidAdminDataError struct {
+	Message string
+}
+
+func (i *InvalidAdminDataError) Error() string {
+	return i.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type InvalidApiKeyDataError struct {
+	Message string
+}
+
+func (i *InvalidApiKeyDataError) Error() string {
+	return i.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type InvalidBreedDataError struct {
+	Message string
+}
+
+func (b *InvalidBreedDataError) Error() string {
+	return b.Message
+}
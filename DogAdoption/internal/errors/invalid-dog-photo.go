@@ -0,0 +1,9 @@
+package customerrors
+
+type InvalidDogPhotoError struct {
+	Message string
+}
+
+func (i *InvalidDogPhotoError) Error() string {
+	return i.Message
+}
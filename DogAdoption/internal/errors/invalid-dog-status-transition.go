@@ -0,0 +1,9 @@
+package customerrors
+
+type InvalidDogStatusTransitionError struct {
+	Message string
+}
+
+func (i *InvalidDogStatusTransitionError) Error() string {
+	return i.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type InvalidDogTransferDataError struct {
+	Message string
+}
+
+func (i *InvalidDogTransferDataError) Error() string {
+	return i.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type InvalidMedicalRecordDataError struct {
+	Message string
+}
+
+func (i *InvalidMedicalRecordDataError) Error() string {
+	return i.Message
+}
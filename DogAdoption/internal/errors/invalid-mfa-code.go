@@ -0,0 +1,9 @@
+package customerrors
+
+type InvalidMfaCodeError struct {
+	Message string
+}
+
+func (i *InvalidMfaCodeError) Error() string {
+	return i.Message
+}
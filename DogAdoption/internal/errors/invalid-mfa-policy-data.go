@@ -0,0 +1,9 @@
+package customerrors
+
+type InvalidMfaPolicyDataError struct {
+	Message string
+}
+
+func (i *InvalidMfaPolicyDataError) Error() string {
+	return i.Message
+}
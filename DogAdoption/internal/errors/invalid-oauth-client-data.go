@@ -0,0 +1,9 @@
+package customerrors
+
+type InvalidOAuthClientDataError struct {
+	Message string
+}
+
+func (i *InvalidOAuthClientDataError) Error() string {
+	return i.Message
+}
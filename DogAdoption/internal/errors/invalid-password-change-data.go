@@ -0,0 +1,9 @@
+package customerrors
+
+type InvalidPasswordChangeDataError struct {
+	Message string
+}
+
+func (i *InvalidPasswordChangeDataError) Error() string {
+	return i.Message
+}
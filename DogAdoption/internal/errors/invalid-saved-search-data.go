@@ -0,0 +1,9 @@
+package customerrors
+
+type InvalidSavedSearchDataError struct {
+	Message string
+}
+
+func (i *InvalidSavedSearchDataError) Error() string {
+	return i.Message
+}
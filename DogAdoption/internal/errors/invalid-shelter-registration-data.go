@@ -0,0 +1,9 @@
+package customerrors
+
+type InvalidShelterRegistrationDataError struct {
+	Message string
+}
+
+func (i *InvalidShelterRegistrationDataError) Error() string {
+	return i.Message
+}
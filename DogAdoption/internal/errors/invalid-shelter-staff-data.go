@@ -0,0 +1,9 @@
+package customerrors
+
+type InvalidShelterStaffDataError struct {
+	Message string
+}
+
+func (i *InvalidShelterStaffDataError) Error() string {
+	return i.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type InvalidUserProfileDataError struct {
+	Message string
+}
+
+func (i *InvalidUserProfileDataError) Error() string {
+	return i.Message
+}
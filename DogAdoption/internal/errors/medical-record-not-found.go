@@ -0,0 +1,9 @@
+package customerrors
+
+type MedicalRecordNotFoundError struct {
+	Message string
+}
+
+func (m *MedicalRecordNotFoundError) Error() string {
+	return m.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type MfaAlreadyEnabledError struct {
+	Message string
+}
+
+func (m *MfaAlreadyEnabledError) Error() string {
+	return m.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type MfaEnrollmentNotFoundError struct {
+	Message string
+}
+
+func (m *MfaEnrollmentNotFoundError) Error() string {
+	return m.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type MfaRequiredError struct {
+	Message string
+}
+
+func (m *MfaRequiredError) Error() string {
+	return m.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type NotificationNotFoundError struct {
+	Message string
+}
+
+func (n *NotificationNotFoundError) Error() string {
+	return n.Message
+}
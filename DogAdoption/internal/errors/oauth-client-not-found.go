@@ -0,0 +1,9 @@
+package customerrors
+
+type OAuthClientNotFoundError struct {
+	Message string
+}
+
+func (o *OAuthClientNotFoundError) Error() string {
+	return o.Message
+}
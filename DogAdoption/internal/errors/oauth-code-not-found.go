@@ -0,0 +1,9 @@
+package customerrors
+
+type OAuthCodeNotFoundError struct {
+	Message string
+}
+
+func (o *OAuthCodeNotFoundError) Error() string {
+	return o.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type OAuthConsentNotFoundError struct {
+	Message string
+}
+
+func (o *OAuthConsentNotFoundError) Error() string {
+	return o.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type OAuthRefreshTokenNotFoundError struct {
+	Message string
+}
+
+func (o *OAuthRefreshTokenNotFoundError) Error() string {
+	return o.Message
+}
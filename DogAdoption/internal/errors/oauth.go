@@ -0,0 +1,12 @@
+package customerrors
+
+// OAuthError is an error of the oauth protocol endpoints, where Code is one of the
+// error codes defined by RFC 6749, such as invalid_request or invalid_grant.
+type OAuthError struct {
+	Code    string
+	Message string
+}
+
+func (o *OAuthError) Error() string {
+	return o.Message
+}
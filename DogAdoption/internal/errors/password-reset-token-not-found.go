@@ -0,0 +1,9 @@
+package customerrors
+
+type PasswordResetTokenNotFoundError struct {
+	Message string
+}
+
+func (p *PasswordResetTokenNotFoundError) Error() string {
+	return p.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type SavedSearchNotFoundError struct {
+	Message string
+}
+
+func (s *SavedSearchNotFoundError) Error() string {
+	return s.Message
+}
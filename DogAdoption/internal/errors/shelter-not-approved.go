@@ -0,0 +1,9 @@
+package customerrors
+
+type ShelterNotApprovedError struct {
+	Message string
+}
+
+func (s *ShelterNotApprovedError) Error() string {
+	return s.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type ShelterRegistrationReviewedError struct {
+	Message string
+}
+
+func (s *ShelterRegistrationReviewedError) Error() string {
+	return s.Message
+}
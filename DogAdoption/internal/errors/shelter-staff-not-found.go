@@ -0,0 +1,9 @@
+package customerrors
+
+type ShelterStaffNotFoundError struct {
+	Message string
+}
+
+func (s *ShelterStaffNotFoundError) Error() string {
+	return s.Message
+}
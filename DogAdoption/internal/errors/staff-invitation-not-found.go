@@ -0,0 +1,9 @@
+package customerrors
+
+type StaffInvitationNotFoundError struct {
+	Message string
+}
+
+func (s *StaffInvitationNotFoundError) Error() string {
+	return s.Message
+}
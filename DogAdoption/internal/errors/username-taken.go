@@ -0,0 +1,9 @@
+package customerrors
+
+type UsernameTakenError struct {
+	Message string
+}
+
+func (u *UsernameTakenError) Error() string {
+	return u.Message
+}
@@ -0,0 +1,9 @@
+package customerrors
+
+type WeakPasswordError struct {
+	Message string
+}
+
+func (w *WeakPasswordError) Error() string {
+	return w.Message
+}
@@ -0,0 +1,116 @@
+package geocoder
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+//go:embed gazetteer.csv
+var bundledGazetteer []byte
+
+type coordinates struct {
+	latitude  float64
+	longitude float64
+}
+
+// GazetteerGeocoder resolves addresses offline from postal code and city centroids. A postal code found in
+// the address is preferred over the city. The CSV has the columns country,postal_code,city,latitude,longitude
+// with a header row, and rows without a postal code are city centroids.
+type GazetteerGeocoder struct {
+	postalCodes map[string]coordinates
+	cities      map[string]coordinates
+}
+
+// NewGazetteerGeocoder loads the gazetteer at path, or the bundled gazetteer when path is empty.
+func NewGazetteerGeocoder(path string) (GazetteerGeocoder, error) {
+	reader := io.Reader(bytes.NewReader(bundledGazetteer))
+	if path != "" {
+		file, err := os.Open(path)
+		if err != nil {
+			return GazetteerGeocoder{}, err
+		}
+		defer file.Close()
+		reader = file
+	}
+	return loadGazetteer(reader)
+}
+
+func loadGazetteer(reader io.Reader) (GazetteerGeocoder, error) {
+	gazetteer := GazetteerGeocoder{
+		postalCodes: map[string]coordinates{},
+		cities:      map[string]coordinates{},
+	}
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = 5
+	csvReader.ReuseRecord = true
+
+	_, err := csvReader.Read()
+	if err != nil {
+		return gazetteer, fmt.Errorf("gazetteer has no header: %w", err)
+	}
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return gazetteer, fmt.Errorf("invalid gazetteer row: %w", err)
+		}
+		latitude, latErr := strconv.ParseFloat(record[3], 64)
+		longitude, lngErr := strconv.ParseFloat(record[4], 64)
+		if latErr != nil || lngErr != nil {
+			return gazetteer, fmt.Errorf("invalid gazetteer coordinates for %s", strings.Join(record, ","))
+		}
+		point := coordinates{latitude: latitude, longitude: longitude}
+		country := normalize(record[0])
+		if postalCode := normalizePostalCode(record[1]); postalCode != "" {
+			gazetteer.postalCodes[country+"|"+postalCode] = point
+		} else {
+			gazetteer.cities[country+"|"+normalize(record[2])] = point
+		}
+	}
+	return gazetteer, nil
+}
+
+func (g GazetteerGeocoder) Geocode(ctx context.Context, query Query) (Result, error) {
+	country := normalize(query.Country)
+	if len(g.postalCodes) > 0 {
+		for _, candidate := range postalCodeCandidates(query.Address) {
+			point, ok := g.postalCodes[country+"|"+candidate]
+			if ok {
+				return Result{Latitude: point.latitude, Longitude: point.longitude, Accuracy: ACCURACY_POSTAL_CODE}, nil
+			}
+		}
+	}
+	point, ok := g.cities[country+"|"+normalize(query.City)]
+	if ok {
+		return Result{Latitude: point.latitude, Longitude: point.longitude, Accuracy: ACCURACY_CITY}, nil
+	}
+	return Result{}, ErrNoMatch
+}
+
+// postalCodeCandidates returns runs of up to three words of each comma separated part of the address, since
+// postal codes like "392 31" and "SW1A 1AA" are written with spaces.
+func postalCodeCandidates(address string) []string {
+	var candidates []string
+	for _, part := range strings.Split(address, ",") {
+		words := strings.Fields(part)
+		for i := range words {
+			for j := i + 1; j <= len(words) && j <= i+3; j++ {
+				candidates = append(candidates, normalizePostalCode(strings.Join(words[i:j], "")))
+			}
+		}
+	}
+	return candidates
+}
+
+func normalizePostalCode(postalCode string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(postalCode)))
+}
@@ -0,0 +1,44 @@
+package geocoder
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+type Accuracy string
+
+const (
+	// ACCURACY_MANUAL coordinates are set by the shelter and are not geocoded.
+	ACCURACY_MANUAL      Accuracy = "manual"
+	ACCURACY_ADDRESS     Accuracy = "address"
+	ACCURACY_STREET      Accuracy = "street"
+	ACCURACY_POSTAL_CODE Accuracy = "postal_code"
+	ACCURACY_CITY        Accuracy = "city"
+	ACCURACY_APPROXIMATE Accuracy = "approximate"
+)
+
+// ErrNoMatch is returned when an address could not be resolved to coordinates.
+var ErrNoMatch = errors.New("no coordinates found for the address")
+
+type Query struct {
+	Address string
+	City    string
+	Country string
+}
+
+type Result struct {
+	Latitude  float64
+	Longitude float64
+	Accuracy  Accuracy
+}
+
+// Geocoder resolves shelter addresses to coordinates.
+type Geocoder interface {
+	Geocode(ctx context.Context, query Query) (Result, error)
+}
+
+// normalize lowercases and collapses whitespace, so that names match regardless of formatting.
+func normalize(value string) string {
+	return strings.Join(strings.Fields(strings.ToLower(value)), " ")
+}
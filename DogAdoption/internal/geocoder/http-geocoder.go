@@ -0,0 +1,101 @@
+package geocoder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HttpGeocoder resolves addresses with an external provider that has a Nominatim compatible search API,
+// such as Nominatim or LocationIQ. The API key is sent as the key query parameter when it is set.
+type HttpGeocoder struct {
+	endpoint  string
+	apiKey    string
+	userAgent string
+	client    *http.Client
+}
+
+func NewHttpGeocoder(endpoint, apiKey, userAgent string) HttpGeocoder {
+	if userAgent == "" {
+		userAgent = "DogAdoptionApp"
+	}
+	return HttpGeocoder{
+		endpoint:  endpoint,
+		apiKey:    apiKey,
+		userAgent: userAgent,
+		client:    &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+type searchResult struct {
+	Latitude    string `json:"lat"`
+	Longitude   string `json:"lon"`
+	AddressType string `json:"addresstype"`
+}
+
+func (h HttpGeocoder) Geocode(ctx context.Context, query Query) (Result, error) {
+	params := url.Values{}
+	params.Set("q", strings.Join([]string{query.Address, query.City, query.Country}, ", "))
+	params.Set("format", "jsonv2")
+	params.Set("limit", "1")
+	if h.apiKey != "" {
+		params.Set("key", h.apiKey)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, h.endpoint+"?"+params.Encode(), nil)
+	if err != nil {
+		return Result{}, err
+	}
+	request.Header.Set("User-Agent", h.userAgent)
+	request.Header.Set("Accept", "application/json")
+
+	response, err := h.client.Do(request)
+	if err != nil {
+		return Result{}, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusNotFound {
+		return Result{}, ErrNoMatch
+	}
+	if response.StatusCode != http.StatusOK {
+		return Result{}, fmt.Errorf("geocoding provider responded with status %d", response.StatusCode)
+	}
+
+	var results []searchResult
+	err = json.NewDecoder(response.Body).Decode(&results)
+	if err != nil {
+		return Result{}, err
+	}
+	if len(results) == 0 {
+		return Result{}, ErrNoMatch
+	}
+	latitude, err := strconv.ParseFloat(results[0].Latitude, 64)
+	if err != nil {
+		return Result{}, err
+	}
+	longitude, err := strconv.ParseFloat(results[0].Longitude, 64)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{Latitude: latitude, Longitude: longitude, Accuracy: accuracyFromAddressType(results[0].AddressType)}, nil
+}
+
+func accuracyFromAddressType(addressType string) Accuracy {
+	switch addressType {
+	case "house", "building", "amenity":
+		return ACCURACY_ADDRESS
+	case "road":
+		return ACCURACY_STREET
+	case "postcode":
+		return ACCURACY_POSTAL_CODE
+	case "city", "town", "village", "municipality", "suburb", "hamlet":
+		return ACCURACY_CITY
+	default:
+		return ACCURACY_APPROXIMATE
+	}
+}
@@ -0,0 +1,47 @@
+package adminhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type DeleteAdminService interface {
+	DeleteAdmin(ctx context.Context, idParam string, credentials dto.UserCredentials) error
+}
+
+type DeleteAdminHandler struct {
+	service DeleteAdminService
+}
+
+func NewDeleteAdminHandler(service DeleteAdminService) DeleteAdminHandler {
+	return DeleteAdminHandler{
+		service: service,
+	}
+}
+
+// Handle deletes an admin.
+// @Summary Delete an admin
+// @Description Deletes an admin account, only available to admins. The last admin can not be deleted.
+// @Tags admins
+// @Accept  json
+// @Produce  json
+// @Param   id   path      integer  true  "Admin ID"
+// @Success 204  "Admin deleted successfully"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter format is incorrect or the admin is the last one"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an admin"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no admin matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /admins/{id} [delete]
+// @Security BearerAuth
+func (d DeleteAdminHandler) Handle(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	err := d.service.DeleteAdmin(c.Context(), idParam, userCredentials)
+	if err != nil {
+		return handleAdminError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
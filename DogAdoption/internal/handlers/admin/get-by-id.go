@@ -0,0 +1,90 @@
+package adminhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	admindto "1dv027/aad/internal/dto/admin"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetAdminByIdService interface {
+	GetAdminById(ctx context.Context, idParam string, credentials dto.UserCredentials) (admindto.AdminDTO, error)
+}
+
+type GetAdminByIdHandler struct {
+	service GetAdminByIdService
+}
+
+func NewGetAdminByIdHandler(service GetAdminByIdService) GetAdminByIdHandler {
+	return GetAdminByIdHandler{
+		service: service,
+	}
+}
+
+// Handle retrieves a specific admin by ID.
+// @Summary Get an admin
+// @Description Retrieves an admin by its ID, only available to admins.
+// @Tags admins
+// @Accept  json
+// @Produce  json
+// @Param   id   path      integer  true  "Admin ID"
+// @Success 200  {object}  admindto.AdminDTO  "Success, returns the admin"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter format is incorrect"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an admin"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no admin matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /admins/{id} [get]
+// @Security BearerAuth
+func (g GetAdminByIdHandler) Handle(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	adminDto, err := g.service.GetAdminById(c.Context(), idParam, userCredentials)
+	if err != nil {
+		return handleAdminError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"admin": adminDto,
+	})
+}
+
+// handleAdminError maps the errors of the admin services to responses.
+func handleAdminError(c *fiber.Ctx, err error) error {
+	var integerConversionError *customerrors.IntegerConversionError
+	if errors.As(err, &integerConversionError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "id parameter must be a number",
+		})
+	}
+	var invalidDataError *customerrors.InvalidAdminDataError
+	if errors.As(err, &invalidDataError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": invalidDataError.Message,
+		})
+	}
+	var weakPasswordError *customerrors.WeakPasswordError
+	if errors.As(err, &weakPasswordError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": weakPasswordError.Message,
+		})
+	}
+	var unauthorizedError *customerrors.UnauthorizedError
+	if errors.As(err, &unauthorizedError) {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "unauthorized to perform action",
+		})
+	}
+	var adminNotFound *customerrors.AdminNotFoundError
+	if errors.As(err, &adminNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "admin not found",
+		})
+	}
+
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": "something went wrong internally. try again later.",
+	})
+}
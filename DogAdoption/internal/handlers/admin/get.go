@@ -0,0 +1,58 @@
+package adminhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	admindto "1dv027/aad/internal/dto/admin"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetAdminsService interface {
+	GetAdmins(ctx context.Context, credentials dto.UserCredentials, queryParams dto.QueryParams) (admindto.AdminsAndPaginationLinksDTO, error)
+}
+
+type GetAdminsHandler struct {
+	service GetAdminsService
+}
+
+func NewGetAdminsHandler(service GetAdminsService) GetAdminsHandler {
+	return GetAdminsHandler{
+		service: service,
+	}
+}
+
+// Handle retrieves all admins.
+// @Summary Get admins
+// @Description Retrieves a paginated list of admins, only available to admins.
+// @Tags admins
+// @Accept  json
+// @Produce  json
+// @Param   page   query     integer false  "Page number"
+// @Param   limit  query     integer false  "Page size"
+// @Success 200  {object}  admindto.AdminsAndPaginationLinksDTO  "Success, returns a list of admins"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request if the query parameters are invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an admin"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error if an error occurs while processing the request"
+// @Router /admins [get]
+// @Security BearerAuth
+func (g GetAdminsHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	queryParamsDto := c.Locals("queryParams").(dto.QueryParams)
+
+	getAdminsResult, err := g.service.GetAdmins(c.Context(), userCredentials, queryParamsDto)
+	if err != nil {
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized to perform action",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(getAdminsResult)
+}
@@ -0,0 +1,56 @@
+package adminhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	admindto "1dv027/aad/internal/dto/admin"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PostAdminService interface {
+	CreateAdmin(ctx context.Context, credentials dto.UserCredentials, newAdmin admindto.NewAdminDTO) (admindto.AdminDTO, error)
+}
+
+type PostAdminHandler struct {
+	service PostAdminService
+}
+
+func NewPostAdminHandler(service PostAdminService) PostAdminHandler {
+	return PostAdminHandler{
+		service: service,
+	}
+}
+
+// Handle creates a new admin.
+// @Summary Create an admin
+// @Description Creates a new admin account, only available to admins. The password must meet the password policy.
+// @Tags admins
+// @Accept  json
+// @Produce  json
+// @Param   payload  body      admindto.NewAdminDTO  true  "Username and password of the new admin"
+// @Success 201  {object}  admindto.AdminDTO  "Created, returns the new admin"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the body is invalid, the username is taken or the password does not meet the password policy"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an admin"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /admins [post]
+// @Security BearerAuth
+func (p PostAdminHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	var newAdmin admindto.NewAdminDTO
+	err := c.BodyParser(&newAdmin)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request. visit documentation for more endpoint information.",
+		})
+	}
+
+	adminDto, err := p.service.CreateAdmin(c.Context(), userCredentials, newAdmin)
+	if err != nil {
+		return handleAdminError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"admin": adminDto,
+	})
+}
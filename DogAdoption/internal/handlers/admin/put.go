@@ -0,0 +1,59 @@
+package adminhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	admindto "1dv027/aad/internal/dto/admin"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PutAdminService interface {
+	UpdateAdmin(ctx context.Context, idParam string, credentials dto.UserCredentials, data admindto.UpdateAdminDTO) (admindto.AdminDTO, error)
+}
+
+type PutAdminHandler struct {
+	service PutAdminService
+}
+
+func NewPutAdminHandler(service PutAdminService) PutAdminHandler {
+	return PutAdminHandler{
+		service: service,
+	}
+}
+
+// Handle updates an admin.
+// @Summary Update an admin
+// @Description Changes the username of an admin, only available to admins.
+// @Tags admins
+// @Accept  json
+// @Produce  json
+// @Param   id       path      integer                  true  "Admin ID"
+// @Param   payload  body      admindto.UpdateAdminDTO  true  "New username"
+// @Success 200  {object}  admindto.AdminDTO  "Success, returns the updated admin"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter or body is invalid, or the username is taken"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an admin"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no admin matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /admins/{id} [put]
+// @Security BearerAuth
+func (p PutAdminHandler) Handle(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	var updateAdmin admindto.UpdateAdminDTO
+	err := c.BodyParser(&updateAdmin)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request. visit documentation for more endpoint information.",
+		})
+	}
+
+	adminDto, err := p.service.UpdateAdmin(c.Context(), idParam, userCredentials, updateAdmin)
+	if err != nil {
+		return handleAdminError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"admin": adminDto,
+	})
+}
@@ -0,0 +1,60 @@
+package authhandler
+
+import (
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ForgotPasswordService interface {
+	RequestPasswordReset(ctx context.Context, data authdto.ForgotPasswordDTO) error
+}
+
+type ForgotPasswordHandler struct {
+	service ForgotPasswordService
+}
+
+func NewForgotPasswordHandler(service ForgotPasswordService) ForgotPasswordHandler {
+	return ForgotPasswordHandler{
+		service: service,
+	}
+}
+
+// Handle requests a password reset token.
+// @Summary Request password reset
+// @Description Sends a single use password reset token to the dog shelter or user with the username, through the configured notifier. The token expires after 30 minutes.
+// @Description The response is the same whether or not the username exists.
+// @Tags auth
+// @Accept  json
+// @Produce  json
+// @Param   payload  body      authdto.ForgotPasswordDTO  true  "Username of the account"
+// @Success 202  "Accepted, a reset token is sent if the account exists"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the body cannot be parsed or the username is missing"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /auth/password-reset [post]
+func (f ForgotPasswordHandler) Handle(c *fiber.Ctx) error {
+	var forgotDto authdto.ForgotPasswordDTO
+	err := c.BodyParser(&forgotDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request. visit documentation for more endpoint information.",
+		})
+	}
+
+	err = f.service.RequestPasswordReset(c.Context(), forgotDto)
+	if err != nil {
+		var invalidDataError *customerrors.InvalidPasswordChangeDataError
+		if errors.As(err, &invalidDataError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": invalidDataError.Message,
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.SendStatus(fiber.StatusAccepted)
+}
@@ -1,6 +1,7 @@
 package authhandler
 
 import (
+	authdto "1dv027/aad/internal/dto/auth"
 	customerrors "1dv027/aad/internal/errors"
 	"context"
 	"errors"
@@ -13,12 +14,8 @@ type Payload struct {
 	Password string `json:"password"`
 }
 
-type Response struct {
-	Token string `json:"token"`
-}
-
 type AuthService interface {
-	ValidateUsernameAndPassword(ctx context.Context, username, password string) (string, error)
+	ValidateUsernameAndPassword(ctx context.Context, username, password string) (authdto.LoginResultDTO, error)
 	GetAllowedFields() map[string]any
 }
 
@@ -41,13 +38,16 @@ func NewLoginHandler(authService AuthService, bodyValidator RequestBodyValidator
 // HandleLogin logs in a user and returns a JWT token.
 // @Summary User login
 // @Description Authenticates a user by username and password, and returns a JWT token if successful.
+// @Description If the account has MFA enabled, mfa_required is true and a short lived mfa_challenge_token is returned instead, which is exchanged for a JWT at /auth/mfa/challenge.
+// @Description If MFA is required for the role but not yet enrolled, mfa_enrollment_required is true and the token can only be used to enroll.
 // @Tags auth
 // @Accept  json
 // @Produce  json
 // @Param   payload  body      Payload  true  "Login Credentials"
-// @Success 200  {object}  Response "Returns JWT token"
+// @Success 200  {object}  authdto.LoginResultDTO "Returns JWT token or MFA challenge"
 // @Failure 400  {object}  dto.ErrorResponse "Bad request when the JSON body cannot be parsed or wrong payload type"
 // @Failure 401  {object}  dto.ErrorResponse "Unauthorized, when the username or password is incorrect"
+// @Failure 403  {object}  dto.ErrorResponse "Forbidden, when the account is suspended or the dog shelter registration is not approved"
 // @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, something went wrong with the server"
 // @Router /auth/login [post]
 func (l LoginHandler) Handle(c *fiber.Ctx) error {
@@ -68,7 +68,7 @@ func (l LoginHandler) Handle(c *fiber.Ctx) error {
 		})
 	}
 
-	jwt, err := l.authService.ValidateUsernameAndPassword(c.Context(), payload.Username, payload.Password)
+	loginResult, err := l.authService.ValidateUsernameAndPassword(c.Context(), payload.Username, payload.Password)
 	if err != nil {
 		// Specific error handling
 		var wrongCredentialsErr *customerrors.WrongCredentialsError
@@ -78,6 +78,18 @@ func (l LoginHandler) Handle(c *fiber.Ctx) error {
 				"error": "invalid username or password",
 			})
 		}
+		var accountSuspendedErr *customerrors.AccountSuspendedError
+		if errors.As(err, &accountSuspendedErr) {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": accountSuspendedErr.Message,
+			})
+		}
+		var shelterNotApprovedErr *customerrors.ShelterNotApprovedError
+		if errors.As(err, &shelterNotApprovedErr) {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": shelterNotApprovedErr.Message,
+			})
+		}
 		// For all other errors
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "something went wrong with the server. try again later",
@@ -85,8 +97,6 @@ func (l LoginHandler) Handle(c *fiber.Ctx) error {
 	}
 
 	// Successful response
-	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"token": jwt,
-	})
+	return c.Status(fiber.StatusOK).JSON(loginResult)
 
 }
@@ -0,0 +1,68 @@
+package mfahandler
+
+import (
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ChallengeMfaService interface {
+	CompleteChallenge(ctx context.Context, data authdto.MfaChallengeDTO) (authdto.LoginResultDTO, error)
+}
+
+type ChallengeMfaHandler struct {
+	service ChallengeMfaService
+}
+
+func NewChallengeMfaHandler(service ChallengeMfaService) ChallengeMfaHandler {
+	return ChallengeMfaHandler{
+		service: service,
+	}
+}
+
+// Handle completes the second step of a login for accounts with MFA enabled.
+// @Summary Complete MFA login challenge
+// @Description Exchanges the mfa_challenge_token returned by /auth/login and a TOTP code or recovery code for a JWT token. Each TOTP code and recovery code can only be used once.
+// @Tags auth/mfa
+// @Accept  json
+// @Produce  json
+// @Param   challenge  body      authdto.MfaChallengeDTO  true  "Challenge token and TOTP code or recovery code"
+// @Success 200  {object}  authdto.LoginResultDTO "Returns JWT token"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the body cannot be parsed or the code is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the challenge token is invalid or expired"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /auth/mfa/challenge [post]
+func (ch ChallengeMfaHandler) Handle(c *fiber.Ctx) error {
+	var challengeDto authdto.MfaChallengeDTO
+	err := c.BodyParser(&challengeDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request. visit documentation for more endpoint information.",
+		})
+	}
+
+	loginResult, err := ch.service.CompleteChallenge(c.Context(), challengeDto)
+	if err != nil {
+		var invalidMfaCodeError *customerrors.InvalidMfaCodeError
+		if errors.As(err, &invalidMfaCodeError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": invalidMfaCodeError.Message,
+			})
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		var enrollmentNotFoundError *customerrors.MfaEnrollmentNotFoundError
+		if errors.As(err, &unauthorizedError) || errors.As(err, &enrollmentNotFoundError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "invalid or expired challenge token",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(loginResult)
+}
@@ -0,0 +1,84 @@
+package mfahandler
+
+import (
+	"1dv027/aad/internal/dto"
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type DisableMfaService interface {
+	Disable(ctx context.Context, credentials dto.UserCredentials, data authdto.MfaChallengeDTO) error
+}
+
+type DisableMfaHandler struct {
+	service DisableMfaService
+}
+
+func NewDisableMfaHandler(service DisableMfaService) DisableMfaHandler {
+	return DisableMfaHandler{
+		service: service,
+	}
+}
+
+// Handle disables MFA for the authenticated admin or dog shelter.
+// @Summary Disable MFA
+// @Description Disables MFA for the authenticated admin or dog shelter. Requires a valid TOTP code or recovery code. Not allowed when MFA is required for the role.
+// @Tags auth/mfa
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param   code  body      authdto.MfaChallengeDTO  true  "TOTP code or recovery code, challenge_token is ignored"
+// @Success 204  "No Content, mfa is disabled"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the body cannot be parsed or the code is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the account is not an admin or dog shelter"
+// @Failure 403  {object}  dto.ErrorResponse "Forbidden, if mfa is required for the role"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if mfa is not enrolled"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /auth/mfa/disable [post]
+func (d DisableMfaHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	var codeDto authdto.MfaChallengeDTO
+	err := c.BodyParser(&codeDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request. visit documentation for more endpoint information.",
+		})
+	}
+
+	err = d.service.Disable(c.Context(), userCredentials, codeDto)
+	if err != nil {
+		var invalidMfaCodeError *customerrors.InvalidMfaCodeError
+		if errors.As(err, &invalidMfaCodeError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": invalidMfaCodeError.Message,
+			})
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+		var mfaRequiredError *customerrors.MfaRequiredError
+		if errors.As(err, &mfaRequiredError) {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": mfaRequiredError.Message,
+			})
+		}
+		var enrollmentNotFoundError *customerrors.MfaEnrollmentNotFoundError
+		if errors.As(err, &enrollmentNotFoundError) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "mfa is not enrolled",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
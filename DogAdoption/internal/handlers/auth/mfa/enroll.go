@@ -0,0 +1,61 @@
+package mfahandler
+
+import (
+	"1dv027/aad/internal/dto"
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type EnrollMfaService interface {
+	Enroll(ctx context.Context, credentials dto.UserCredentials) (authdto.MfaEnrollmentDTO, error)
+}
+
+type EnrollMfaHandler struct {
+	service EnrollMfaService
+}
+
+func NewEnrollMfaHandler(service EnrollMfaService) EnrollMfaHandler {
+	return EnrollMfaHandler{
+		service: service,
+	}
+}
+
+// Handle starts a TOTP enrollment for the authenticated admin or dog shelter.
+// @Summary Start MFA enrollment
+// @Description Generates a new TOTP secret and recovery codes for the authenticated admin or dog shelter. The secret and recovery codes are only shown once. The enrollment is activated with /auth/mfa/verify.
+// @Tags auth/mfa
+// @Produce  json
+// @Security BearerAuth
+// @Success 201  {object}  authdto.MfaEnrollmentDTO "Success, returns the secret, provisioning uri and recovery codes"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the account is not an admin or dog shelter"
+// @Failure 409  {object}  dto.ErrorResponse "Conflict, if mfa is already enabled"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /auth/mfa/enroll [post]
+func (e EnrollMfaHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	enrollmentDto, err := e.service.Enroll(c.Context(), userCredentials)
+	if err != nil {
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+		var mfaAlreadyEnabledError *customerrors.MfaAlreadyEnabledError
+		if errors.As(err, &mfaAlreadyEnabledError) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"error": "mfa is already enabled",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.Status(fiber.StatusCreated).JSON(enrollmentDto)
+}
@@ -0,0 +1,54 @@
+package mfahandler
+
+import (
+	"1dv027/aad/internal/dto"
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetMfaPoliciesService interface {
+	GetPolicies(ctx context.Context, credentials dto.UserCredentials) ([]authdto.MfaPolicyDTO, error)
+}
+
+type GetMfaPoliciesHandler struct {
+	service GetMfaPoliciesService
+}
+
+func NewGetMfaPoliciesHandler(service GetMfaPoliciesService) GetMfaPoliciesHandler {
+	return GetMfaPoliciesHandler{
+		service: service,
+	}
+}
+
+// Handle retrieves the MFA policies per role.
+// @Summary Get MFA policies
+// @Description Retrieves whether MFA is required for each role that can enroll in MFA. Admin only.
+// @Tags auth/mfa
+// @Produce  json
+// @Security BearerAuth
+// @Success 200  {array}   authdto.MfaPolicyDTO "Success, returns the mfa policies"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the account is not an admin"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /auth/mfa/policies [get]
+func (g GetMfaPoliciesHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	policies, err := g.service.GetPolicies(c.Context(), userCredentials)
+	if err != nil {
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(policies)
+}
@@ -0,0 +1,72 @@
+package mfahandler
+
+import (
+	"1dv027/aad/internal/dto"
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PutMfaPolicyService interface {
+	UpdatePolicy(ctx context.Context, roleParam string, credentials dto.UserCredentials, data authdto.UpdateMfaPolicyDTO) (authdto.MfaPolicyDTO, error)
+}
+
+type PutMfaPolicyHandler struct {
+	service PutMfaPolicyService
+}
+
+func NewPutMfaPolicyHandler(service PutMfaPolicyService) PutMfaPolicyHandler {
+	return PutMfaPolicyHandler{
+		service: service,
+	}
+}
+
+// Handle updates the MFA policy of a role.
+// @Summary Update MFA policy
+// @Description Sets whether MFA is required for a role. Accounts of the role without MFA will only be able to enroll after login. Admin only.
+// @Tags auth/mfa
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param   role    path      string  true  "Role, admin or dog_shelter"
+// @Param   policy  body      authdto.UpdateMfaPolicyDTO  true  "Policy data"
+// @Success 200  {object}  authdto.MfaPolicyDTO "Success, returns the updated policy"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the body cannot be parsed or the policy data is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the account is not an admin"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /auth/mfa/policies/{role} [put]
+func (p PutMfaPolicyHandler) Handle(c *fiber.Ctx) error {
+	roleParam := c.Params("role")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	var policyDto authdto.UpdateMfaPolicyDTO
+	err := c.BodyParser(&policyDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request. visit documentation for more endpoint information.",
+		})
+	}
+
+	policy, err := p.service.UpdatePolicy(c.Context(), roleParam, userCredentials, policyDto)
+	if err != nil {
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+		var invalidPolicyDataError *customerrors.InvalidMfaPolicyDataError
+		if errors.As(err, &invalidPolicyDataError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": invalidPolicyDataError.Message,
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(policy)
+}
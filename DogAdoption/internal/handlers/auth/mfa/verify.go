@@ -0,0 +1,84 @@
+package mfahandler
+
+import (
+	"1dv027/aad/internal/dto"
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type VerifyMfaService interface {
+	Verify(ctx context.Context, credentials dto.UserCredentials, data authdto.MfaCodeDTO) error
+}
+
+type VerifyMfaHandler struct {
+	service VerifyMfaService
+}
+
+func NewVerifyMfaHandler(service VerifyMfaService) VerifyMfaHandler {
+	return VerifyMfaHandler{
+		service: service,
+	}
+}
+
+// Handle activates a pending TOTP enrollment.
+// @Summary Verify MFA enrollment
+// @Description Activates the pending TOTP enrollment of the authenticated admin or dog shelter by verifying a code from the authenticator app. After activation, login requires a second factor.
+// @Tags auth/mfa
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param   code  body      authdto.MfaCodeDTO  true  "TOTP code"
+// @Success 204  "No Content, mfa is enabled"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the body cannot be parsed or the code is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the account is not an admin or dog shelter"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no enrollment has been started"
+// @Failure 409  {object}  dto.ErrorResponse "Conflict, if mfa is already enabled"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /auth/mfa/verify [post]
+func (v VerifyMfaHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	var codeDto authdto.MfaCodeDTO
+	err := c.BodyParser(&codeDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request. visit documentation for more endpoint information.",
+		})
+	}
+
+	err = v.service.Verify(c.Context(), userCredentials, codeDto)
+	if err != nil {
+		var invalidMfaCodeError *customerrors.InvalidMfaCodeError
+		if errors.As(err, &invalidMfaCodeError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": invalidMfaCodeError.Message,
+			})
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+		var enrollmentNotFoundError *customerrors.MfaEnrollmentNotFoundError
+		if errors.As(err, &enrollmentNotFoundError) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "no mfa enrollment has been started",
+			})
+		}
+		var mfaAlreadyEnabledError *customerrors.MfaAlreadyEnabledError
+		if errors.As(err, &mfaAlreadyEnabledError) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"error": "mfa is already enabled",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
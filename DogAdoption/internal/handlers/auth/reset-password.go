@@ -0,0 +1,71 @@
+package authhandler
+
+import (
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ResetPasswordService interface {
+	ResetPassword(ctx context.Context, data authdto.ResetPasswordDTO) error
+}
+
+type ResetPasswordHandler struct {
+	service ResetPasswordService
+}
+
+func NewResetPasswordHandler(service ResetPasswordService) ResetPasswordHandler {
+	return ResetPasswordHandler{
+		service: service,
+	}
+}
+
+// Handle sets a new password with a password reset token.
+// @Summary Confirm password reset
+// @Description Sets a new password for the account the reset token was issued to. The token can only be used once.
+// @Tags auth
+// @Accept  json
+// @Produce  json
+// @Param   payload  body      authdto.ResetPasswordDTO  true  "Reset token and new password"
+// @Success 204  "No Content, the password is changed"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the body is invalid, the new password does not meet the password policy, or the token is invalid, used or expired"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /auth/password-reset/confirm [post]
+func (r ResetPasswordHandler) Handle(c *fiber.Ctx) error {
+	var resetDto authdto.ResetPasswordDTO
+	err := c.BodyParser(&resetDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request. visit documentation for more endpoint information.",
+		})
+	}
+
+	err = r.service.ResetPassword(c.Context(), resetDto)
+	if err != nil {
+		var invalidDataError *customerrors.InvalidPasswordChangeDataError
+		if errors.As(err, &invalidDataError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": invalidDataError.Message,
+			})
+		}
+		var weakPasswordError *customerrors.WeakPasswordError
+		if errors.As(err, &weakPasswordError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": weakPasswordError.Message,
+			})
+		}
+		var tokenNotFoundError *customerrors.PasswordResetTokenNotFoundError
+		if errors.As(err, &tokenNotFoundError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "reset token is invalid or expired",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
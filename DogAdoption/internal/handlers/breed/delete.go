@@ -0,0 +1,46 @@
+package breedhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type DeleteBreedService interface {
+	DeleteBreed(ctx context.Context, breedIdParam string, credentials dto.UserCredentials) error
+}
+
+type DeleteBreedHandler struct {
+	service DeleteBreedService
+}
+
+func NewDeleteBreedHandler(service DeleteBreedService) DeleteBreedHandler {
+	return DeleteBreedHandler{
+		service: service,
+	}
+}
+
+// Handle deletes a breed.
+// @Summary Delete a breed
+// @Description Deletes a breed that no dog has. A breed of dogs is merged into another breed instead. Only available to admins.
+// @Tags breeds
+// @Param   id  path  integer  true  "Breed ID"
+// @Success 204 "No Content, the breed is deleted"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter is not a number"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an admin"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no breed matches the provided ID"
+// @Failure 409  {object}  dto.ErrorResponse "Conflict, if dogs have the breed"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /breeds/{id} [delete]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (d DeleteBreedHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	err := d.service.DeleteBreed(c.Context(), c.Params("id"), userCredentials)
+	if err != nil {
+		return handleBreedError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,45 @@
+package breedhandler
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// handleBreedError maps the errors of the breed endpoints to responses.
+func handleBreedError(c *fiber.Ctx, err error) error {
+	var integerConversionError *customerrors.IntegerConversionError
+	if errors.As(err, &integerConversionError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "id parameter must be a number",
+		})
+	}
+	var invalidDataError *customerrors.InvalidBreedDataError
+	if errors.As(err, &invalidDataError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": invalidDataError.Message,
+		})
+	}
+	var unauthorizedError *customerrors.UnauthorizedError
+	if errors.As(err, &unauthorizedError) {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "unauthorized to perform action",
+		})
+	}
+	var breedNotFound *customerrors.BreedNotFoundError
+	if errors.As(err, &breedNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "breed not found",
+		})
+	}
+	var conflictError *customerrors.BreedConflictError
+	if errors.As(err, &conflictError) {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": conflictError.Message,
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": "something went wrong internally. try again later.",
+	})
+}
@@ -0,0 +1,42 @@
+package breedhandler
+
+import (
+	breeddto "1dv027/aad/internal/dto/breed"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetBreedByIdService interface {
+	GetBreed(ctx context.Context, breedIdParam string) (breeddto.BreedDTO, error)
+}
+
+type GetBreedByIdHandler struct {
+	service GetBreedByIdService
+}
+
+func NewGetBreedByIdHandler(service GetBreedByIdService) GetBreedByIdHandler {
+	return GetBreedByIdHandler{
+		service: service,
+	}
+}
+
+// Handle retrieves a breed.
+// @Summary Get a breed
+// @Description Retrieves a breed with its aliases.
+// @Tags breeds
+// @Accept  json
+// @Produce  json
+// @Param   id  path  integer  true  "Breed ID"
+// @Success 200  {object}  breeddto.BreedDTO  "OK, returns the breed"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter is not a number"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no breed matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /breeds/{id} [get]
+func (g GetBreedByIdHandler) Handle(c *fiber.Ctx) error {
+	breed, err := g.service.GetBreed(c.Context(), c.Params("id"))
+	if err != nil {
+		return handleBreedError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(breed)
+}
@@ -0,0 +1,48 @@
+package breedhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	breeddto "1dv027/aad/internal/dto/breed"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetBreedsService interface {
+	GetBreeds(ctx context.Context, queryParams dto.QueryParams) (breeddto.BreedsAndPaginationLinksDTO, error)
+}
+
+type GetBreedsHandler struct {
+	service GetBreedsService
+}
+
+func NewGetBreedsHandler(service GetBreedsService) GetBreedsHandler {
+	return GetBreedsHandler{
+		service: service,
+	}
+}
+
+// Handle retrieves the breed taxonomy.
+// @Summary Get breeds
+// @Description Retrieves the breeds that dogs reference, in alphabetical order.
+// @Tags breeds
+// @Accept  json
+// @Produce  json
+// @Param   search   query     string  false  "Filter by breeds with a name or an alias starting with the value, ignoring case"
+// @Param   size-group   query     string  false  "Filter by size group, one of toy, small, medium, large and giant"
+// @Param   fci-group   query     integer  false  "Filter by FCI group, 1 to 10"
+// @Param   page   query     integer  false  "Page number"
+// @Param   limit   query     integer  false  "Number of breeds per page"
+// @Success 200  {object}  breeddto.BreedsAndPaginationLinksDTO  "Success, returns a list of breeds"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request if the query parameters are invalid"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error if an error occurs while processing the request"
+// @Router /breeds [get]
+func (g GetBreedsHandler) Handle(c *fiber.Ctx) error {
+	queryParamsDto := c.Locals("queryParams").(dto.QueryParams)
+
+	breeds, err := g.service.GetBreeds(c.Context(), queryParamsDto)
+	if err != nil {
+		return handleBreedError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(breeds)
+}
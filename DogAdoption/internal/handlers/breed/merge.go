@@ -0,0 +1,57 @@
+package breedhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	breeddto "1dv027/aad/internal/dto/breed"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type MergeBreedService interface {
+	MergeBreed(ctx context.Context, breedIdParam string, merge breeddto.MergeBreedDTO,
+		credentials dto.UserCredentials) (breeddto.BreedDTO, error)
+}
+
+type MergeBreedHandler struct {
+	service MergeBreedService
+}
+
+func NewMergeBreedHandler(service MergeBreedService) MergeBreedHandler {
+	return MergeBreedHandler{
+		service: service,
+	}
+}
+
+// Handle merges a duplicate breed into a breed.
+// @Summary Merge a breed into another
+// @Description Moves the dogs of the source breed to the breed, makes the name and aliases of the source aliases of the breed, and deletes the source. Only available to admins.
+// @Tags breeds
+// @Accept  json
+// @Produce  json
+// @Param   id       path  integer  true  "ID of the breed that is kept"
+// @Param   payload  body  breeddto.MergeBreedDTO  true  "The breed that is merged"
+// @Success 200  {object}  breeddto.BreedDTO  "OK, returns the merged breed"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter or the body is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an admin"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if either breed does not exist"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /breeds/{id}/merge [post]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (m MergeBreedHandler) Handle(c *fiber.Ctx) error {
+	var merge breeddto.MergeBreedDTO
+	err := c.BodyParser(&merge)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request body. visit documentation for endpoint information.",
+		})
+	}
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	breed, err := m.service.MergeBreed(c.Context(), c.Params("id"), merge, userCredentials)
+	if err != nil {
+		return handleBreedError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(breed)
+}
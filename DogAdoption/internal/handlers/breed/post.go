@@ -0,0 +1,55 @@
+package breedhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	breeddto "1dv027/aad/internal/dto/breed"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PostBreedService interface {
+	CreateBreed(ctx context.Context, newBreed breeddto.NewBreedDTO, credentials dto.UserCredentials) (breeddto.BreedDTO, error)
+}
+
+type PostBreedHandler struct {
+	service PostBreedService
+}
+
+func NewPostBreedHandler(service PostBreedService) PostBreedHandler {
+	return PostBreedHandler{
+		service: service,
+	}
+}
+
+// Handle adds a breed to the taxonomy.
+// @Summary Create a breed
+// @Description Adds a breed with its aliases. Names and aliases are unique across all breeds, ignoring case. Only available to admins.
+// @Tags breeds
+// @Accept  json
+// @Produce  json
+// @Param   payload  body  breeddto.NewBreedDTO  true  "The breed"
+// @Success 201  {object}  breeddto.BreedDTO  "Created, returns the breed"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the body is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an admin"
+// @Failure 409  {object}  dto.ErrorResponse "Conflict, if the name or an alias is used by another breed"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /breeds [post]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (p PostBreedHandler) Handle(c *fiber.Ctx) error {
+	var newBreed breeddto.NewBreedDTO
+	err := c.BodyParser(&newBreed)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request body. visit documentation for endpoint information.",
+		})
+	}
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	breed, err := p.service.CreateBreed(c.Context(), newBreed, userCredentials)
+	if err != nil {
+		return handleBreedError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(breed)
+}
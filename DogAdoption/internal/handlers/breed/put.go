@@ -0,0 +1,58 @@
+package breedhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	breeddto "1dv027/aad/internal/dto/breed"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PutBreedService interface {
+	UpdateBreed(ctx context.Context, breedIdParam string, update breeddto.UpdateBreedDTO,
+		credentials dto.UserCredentials) (breeddto.BreedDTO, error)
+}
+
+type PutBreedHandler struct {
+	service PutBreedService
+}
+
+func NewPutBreedHandler(service PutBreedService) PutBreedHandler {
+	return PutBreedHandler{
+		service: service,
+	}
+}
+
+// Handle updates a breed.
+// @Summary Update a breed
+// @Description Changes the provided fields of the breed. Aliases replace all aliases, and dogs of a renamed breed get the new name. Only available to admins.
+// @Tags breeds
+// @Accept  json
+// @Produce  json
+// @Param   id       path  integer  true  "Breed ID"
+// @Param   payload  body  breeddto.UpdateBreedDTO  true  "The fields to change"
+// @Success 200  {object}  breeddto.BreedDTO  "OK, returns the breed"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter or the body is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an admin"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no breed matches the provided ID"
+// @Failure 409  {object}  dto.ErrorResponse "Conflict, if the name or an alias is used by another breed"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /breeds/{id} [put]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (p PutBreedHandler) Handle(c *fiber.Ctx) error {
+	var update breeddto.UpdateBreedDTO
+	err := c.BodyParser(&update)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request body. visit documentation for endpoint information.",
+		})
+	}
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	breed, err := p.service.UpdateBreed(c.Context(), c.Params("id"), update, userCredentials)
+	if err != nil {
+		return handleBreedError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(breed)
+}
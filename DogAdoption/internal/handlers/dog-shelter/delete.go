@@ -37,6 +37,7 @@ func NewDeleteShelterHandler(service DeleteDogShelterService) DeleteDogShelterHa
 // @Failure 500  {object}  dto.ErrorResponse "Internal Server Error for any server errors"
 // @Router /dogshelters/{id} [delete]
 // @Security BearerAuth
+// @Security ApiKeyAuth
 func (d DeleteDogShelterHandler) Handle(c *fiber.Ctx) error {
 	userCredentials := c.Locals("user").(dto.UserCredentials)
 	err := d.service.DeleteDogShelter(c.Context(), c.Params("id"), userCredentials)
@@ -31,6 +31,9 @@ func NewGetDogShelterHandler(service GetDogShelterService) GetDogShelterHandler
 // @Param   name   query     string  false  "Filter by name"
 // @Param   country   query     string  false  "Filter by country"
 // @Param   city   query     string     false  "Filter by city"
+// @Param   open-now   query     boolean     false  "Filter by shelters that are open, or closed, at the moment in their own timezone"
+// @Param   near   query     string  false  "Sort by distance to a point formatted as lat,lng, and return the distance in kilometers"
+// @Param   radius-km   query     number  false  "Only return results within this many kilometers of near"
 // @Success 200  {object}  dogshelterdto.DogSheltersAndPaginationLinksDTO  "Success, returns a list of dog shelters"
 // @Failure 400  {object}  dto.ErrorResponse "Bad Request if the query parameters are invalid"
 // @Failure 500  {object}  dto.ErrorResponse "Internal Server Error if an error occurs while processing the request"
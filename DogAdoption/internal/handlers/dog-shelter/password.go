@@ -0,0 +1,99 @@
+package dogshelterhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ChangeDogShelterPasswordService interface {
+	ChangePassword(ctx context.Context, idParam string, credentials dto.UserCredentials, data authdto.ChangePasswordDTO) error
+}
+
+type ChangeDogShelterPasswordHandler struct {
+	service ChangeDogShelterPasswordService
+}
+
+func NewChangeDogShelterPasswordHandler(service ChangeDogShelterPasswordService) ChangeDogShelterPasswordHandler {
+	return ChangeDogShelterPasswordHandler{
+		service: service,
+	}
+}
+
+// Handle changes the password of a dog shelter.
+// @Summary Change dog shelter password
+// @Description Changes the password of the authenticated dog shelter. The current password is required, and outstanding password reset tokens are invalidated.
+// @Tags dogshelters
+// @Accept  json
+// @Produce  json
+// @Param   id       path      integer                    true  "Dog shelter ID"
+// @Param   payload  body      authdto.ChangePasswordDTO  true  "Current and new password"
+// @Success 204  "No Content, the password is changed"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter or body is invalid, or the new password does not meet the password policy"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the current password is wrong or the requester is not the dog shelter"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no dog shelter matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogshelters/{id}/password [post]
+// @Security BearerAuth
+func (h ChangeDogShelterPasswordHandler) Handle(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	var passwordDto authdto.ChangePasswordDTO
+	err := c.BodyParser(&passwordDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request. visit documentation for more endpoint information.",
+		})
+	}
+
+	err = h.service.ChangePassword(c.Context(), idParam, userCredentials, passwordDto)
+	if err != nil {
+		var integerConversionError *customerrors.IntegerConversionError
+		if errors.As(err, &integerConversionError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "id parameter must be a number",
+			})
+		}
+		var invalidDataError *customerrors.InvalidPasswordChangeDataError
+		if errors.As(err, &invalidDataError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": invalidDataError.Message,
+			})
+		}
+		var weakPasswordError *customerrors.WeakPasswordError
+		if errors.As(err, &weakPasswordError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": weakPasswordError.Message,
+			})
+		}
+		var wrongCredentialsError *customerrors.WrongCredentialsError
+		if errors.As(err, &wrongCredentialsError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "current password is incorrect",
+			})
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized to perform action",
+			})
+		}
+		var dogShelterNotFound *customerrors.DogShelterNotFoundError
+		if errors.As(err, &dogShelterNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "dog shelter not found",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later.",
+		})
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
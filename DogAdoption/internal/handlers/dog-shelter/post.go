@@ -26,17 +26,19 @@ func NewPostShelterHandler(service PostDogShelterService) PostDogShelterHandler
 
 // Handle creates a new dog shelter.
 // @Summary Add a new dog shelter
-// @Description Adds a new dog shelter to the system with the provided shelter data in JSON format.
+// @Description Adds a new dog shelter to the system with the provided shelter data in JSON format. The coordinates are geocoded from the address unless latitude and longitude are given.
 // @Tags dogshelters
 // @Accept  json
 // @Produce  json
 // @Param   shelter  body      dogshelterdto.NewDogShelterDTO  true  "Dog Shelter Data"  "The information for the new dog shelter"
 // @Success 201  {object}  dogshelterdto.DogShelterDTO  "Success, returns the newly created dog shelter information"
-// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the JSON body cannot be parsed, mandatory fields are missing, or the dog shelter data is incomplete"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the JSON body cannot be parsed, mandatory fields are missing, the dog shelter data is incomplete, or the password does not meet the password policy"
 // @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the user does not have permission to add a dog shelter"
+// @Failure 409  {object}  dto.ErrorResponse "Conflict, if the username is used by another account or registration"
 // @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
 // @Router /dogshelters [post]
 // @Security BearerAuth
+// @Security ApiKeyAuth
 func (p PostDogShelterHandler) Handle(c *fiber.Ctx) error {
 	var newDogShelterDto dogshelterdto.NewDogShelterDTO
 	err := c.BodyParser(&newDogShelterDto)
@@ -55,6 +57,12 @@ func (p PostDogShelterHandler) Handle(c *fiber.Ctx) error {
 				"error": "request body incomplete. visit documentation for endpoint information.",
 			})
 		}
+		var weakPasswordErr *customerrors.WeakPasswordError
+		if errors.As(err, &weakPasswordErr) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": weakPasswordErr.Message,
+			})
+		}
 		var unauthorizedErr *customerrors.UnauthorizedError
 		if errors.As(err, &unauthorizedErr) {
 			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
@@ -67,6 +75,12 @@ func (p PostDogShelterHandler) Handle(c *fiber.Ctx) error {
 				"error": "request body is not valid. visit documentation for endpoint information.",
 			})
 		}
+		var usernameTakenErr *customerrors.UsernameTakenError
+		if errors.As(err, &usernameTakenErr) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"error": usernameTakenErr.Message,
+			})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "something went wrong internally. try again later!",
 		})
@@ -28,7 +28,7 @@ func NewPutDogShelterHandler(service PutDogShelterService) PutDogShelterHandler
 
 // Handle updates an existing dog shelter by ID.
 // @Summary Update a dog shelter
-// @Description Updates the information for an existing dog shelter specified by its ID with the provided shelter data in JSON format.
+// @Description Updates the information for an existing dog shelter specified by its ID with the provided shelter data in JSON format. Changing the address geocodes the shelter again, unless latitude and longitude are given as a manual override.
 // @Tags dogshelters
 // @Accept  json
 // @Produce  json
@@ -40,6 +40,7 @@ func NewPutDogShelterHandler(service PutDogShelterService) PutDogShelterHandler
 // @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
 // @Router /dogshelters/{id} [put]
 // @Security BearerAuth
+// @Security ApiKeyAuth
 func (p PutDogShelterHandler) Handle(c *fiber.Ctx) error {
 	userCredentials := c.Locals("user").(dto.UserCredentials)
 	var updateDogShelterDto dogshelterdto.UpdateDogShelterDTO
@@ -0,0 +1,78 @@
+package dogshelterhandler
+
+import (
+	dogshelterdto "1dv027/aad/internal/dto/dog-shelter"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type RegisterDogShelterService interface {
+	RegisterDogShelter(ctx context.Context, registration dogshelterdto.NewDogShelterRegistrationDTO) (dogshelterdto.DogShelterRegistrationDTO, error)
+}
+
+type RegisterDogShelterHandler struct {
+	service RegisterDogShelterService
+}
+
+func NewRegisterDogShelterHandler(service RegisterDogShelterService) RegisterDogShelterHandler {
+	return RegisterDogShelterHandler{
+		service: service,
+	}
+}
+
+// Handle registers a new dog shelter for review.
+// @Summary Register a dog shelter
+// @Description Registers a dog shelter without an account. The shelter is pending until an admin approves it, and can not log in or be listed before that. The decision is sent to the contact email.
+// @Tags dogshelters
+// @Accept  json
+// @Produce  json
+// @Param   registration  body      dogshelterdto.NewDogShelterRegistrationDTO  true  "Dog Shelter Registration Data"
+// @Success 202  {object}  dogshelterdto.DogShelterRegistrationDTO  "Accepted, returns the pending registration"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the JSON body cannot be parsed, fields are missing, the contact email is invalid, or the password does not meet the password policy"
+// @Failure 409  {object}  dto.ErrorResponse "Conflict, if the username is used by another account or registration"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogshelters/registrations [post]
+func (r RegisterDogShelterHandler) Handle(c *fiber.Ctx) error {
+	var registrationDto dogshelterdto.NewDogShelterRegistrationDTO
+	err := c.BodyParser(&registrationDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request body. visit documentation for endpoint information.",
+		})
+	}
+
+	registration, err := r.service.RegisterDogShelter(c.Context(), registrationDto)
+	if err != nil {
+		var incompleteDogShelterDataErr *customerrors.IncompleteDogShelterDataError
+		if errors.As(err, &incompleteDogShelterDataErr) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": incompleteDogShelterDataErr.Message,
+			})
+		}
+		var weakPasswordErr *customerrors.WeakPasswordError
+		if errors.As(err, &weakPasswordErr) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": weakPasswordErr.Message,
+			})
+		}
+		var invalidRegistrationErr *customerrors.InvalidShelterRegistrationDataError
+		if errors.As(err, &invalidRegistrationErr) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": invalidRegistrationErr.Message,
+			})
+		}
+		var usernameTakenErr *customerrors.UsernameTakenError
+		if errors.As(err, &usernameTakenErr) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"error": usernameTakenErr.Message,
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.Status(fiber.StatusAccepted).JSON(registration)
+}
@@ -0,0 +1,59 @@
+package dogshelterhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogshelterdto "1dv027/aad/internal/dto/dog-shelter"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ApproveShelterRegistrationService interface {
+	ApproveRegistration(ctx context.Context, idParam string, decision dogshelterdto.ShelterRegistrationDecisionDTO,
+		credentials dto.UserCredentials) (dogshelterdto.DogShelterRegistrationDTO, error)
+}
+
+type ApproveShelterRegistrationHandler struct {
+	service ApproveShelterRegistrationService
+}
+
+func NewApproveShelterRegistrationHandler(service ApproveShelterRegistrationService) ApproveShelterRegistrationHandler {
+	return ApproveShelterRegistrationHandler{
+		service: service,
+	}
+}
+
+// Handle approves a dog shelter registration.
+// @Summary Approve a dog shelter registration
+// @Description Approves a pending dog shelter registration, after which the shelter can log in and is publicly listed. An optional reason is included in the notification sent to the shelter. Only available to admins.
+// @Tags admin
+// @Accept  json
+// @Produce  json
+// @Param   id        path      integer  true  "Dog shelter ID"
+// @Param   decision  body      dogshelterdto.ShelterRegistrationDecisionDTO  false  "Reason for the decision"
+// @Success 200  {object}  dogshelterdto.DogShelterRegistrationDTO  "Success, returns the approved registration"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter format is incorrect or the body cannot be parsed"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an admin"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no dog shelter matches the provided ID"
+// @Failure 409  {object}  dto.ErrorResponse "Conflict, if the registration has already been reviewed"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /admin/shelter-registrations/{id}/approve [post]
+// @Security BearerAuth
+func (h ApproveShelterRegistrationHandler) Handle(c *fiber.Ctx) error {
+	var decision dogshelterdto.ShelterRegistrationDecisionDTO
+	if len(c.Body()) > 0 {
+		err := c.BodyParser(&decision)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "bad request body. visit documentation for endpoint information.",
+			})
+		}
+	}
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	registration, err := h.service.ApproveRegistration(c.Context(), c.Params("id"), decision, userCredentials)
+	if err != nil {
+		return handleShelterRegistrationError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(registration)
+}
@@ -0,0 +1,85 @@
+package dogshelterhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogshelterdto "1dv027/aad/internal/dto/dog-shelter"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetShelterRegistrationByIdService interface {
+	GetRegistrationById(ctx context.Context, idParam string, credentials dto.UserCredentials) (dogshelterdto.DogShelterRegistrationDTO, error)
+}
+
+type GetShelterRegistrationByIdHandler struct {
+	service GetShelterRegistrationByIdService
+}
+
+func NewGetShelterRegistrationByIdHandler(service GetShelterRegistrationByIdService) GetShelterRegistrationByIdHandler {
+	return GetShelterRegistrationByIdHandler{
+		service: service,
+	}
+}
+
+// Handle gets a dog shelter registration.
+// @Summary Get a dog shelter registration
+// @Description Gets the registration of a dog shelter with its review status. Only available to admins.
+// @Tags admin
+// @Produce  json
+// @Param   id   path      integer  true  "Dog shelter ID"
+// @Success 200  {object}  dogshelterdto.DogShelterRegistrationDTO  "Success, returns the registration"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter format is incorrect"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an admin"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no dog shelter matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /admin/shelter-registrations/{id} [get]
+// @Security BearerAuth
+func (g GetShelterRegistrationByIdHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	registration, err := g.service.GetRegistrationById(c.Context(), c.Params("id"), userCredentials)
+	if err != nil {
+		return handleShelterRegistrationError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(registration)
+}
+
+// handleShelterRegistrationError maps the errors of the registration review endpoints to responses.
+func handleShelterRegistrationError(c *fiber.Ctx, err error) error {
+	var integerConversionError *customerrors.IntegerConversionError
+	if errors.As(err, &integerConversionError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "id parameter must be a number",
+		})
+	}
+	var invalidRegistrationErr *customerrors.InvalidShelterRegistrationDataError
+	if errors.As(err, &invalidRegistrationErr) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": invalidRegistrationErr.Message,
+		})
+	}
+	var unauthorizedError *customerrors.UnauthorizedError
+	if errors.As(err, &unauthorizedError) {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "unauthorized to perform action",
+		})
+	}
+	var dogShelterNotFoundError *customerrors.DogShelterNotFoundError
+	if errors.As(err, &dogShelterNotFoundError) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "dog shelter registration not found",
+		})
+	}
+	var reviewedError *customerrors.ShelterRegistrationReviewedError
+	if errors.As(err, &reviewedError) {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": reviewedError.Message,
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": "something went wrong internally. try again later.",
+	})
+}
@@ -0,0 +1,59 @@
+package dogshelterhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogshelterdto "1dv027/aad/internal/dto/dog-shelter"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type RejectShelterRegistrationService interface {
+	RejectRegistration(ctx context.Context, idParam string, decision dogshelterdto.ShelterRegistrationDecisionDTO,
+		credentials dto.UserCredentials) (dogshelterdto.DogShelterRegistrationDTO, error)
+}
+
+type RejectShelterRegistrationHandler struct {
+	service RejectShelterRegistrationService
+}
+
+func NewRejectShelterRegistrationHandler(service RejectShelterRegistrationService) RejectShelterRegistrationHandler {
+	return RejectShelterRegistrationHandler{
+		service: service,
+	}
+}
+
+// Handle rejects a dog shelter registration.
+// @Summary Reject a dog shelter registration
+// @Description Rejects a pending dog shelter registration with a required reason, which is included in the notification sent to the shelter. Only available to admins.
+// @Tags admin
+// @Accept  json
+// @Produce  json
+// @Param   id        path      integer  true  "Dog shelter ID"
+// @Param   decision  body      dogshelterdto.ShelterRegistrationDecisionDTO  true  "Reason for the decision"
+// @Success 200  {object}  dogshelterdto.DogShelterRegistrationDTO  "Success, returns the rejected registration"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter format is incorrect, the body cannot be parsed or the reason is missing"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an admin"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no dog shelter matches the provided ID"
+// @Failure 409  {object}  dto.ErrorResponse "Conflict, if the registration has already been reviewed"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /admin/shelter-registrations/{id}/reject [post]
+// @Security BearerAuth
+func (h RejectShelterRegistrationHandler) Handle(c *fiber.Ctx) error {
+	var decision dogshelterdto.ShelterRegistrationDecisionDTO
+	if len(c.Body()) > 0 {
+		err := c.BodyParser(&decision)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "bad request body. visit documentation for endpoint information.",
+			})
+		}
+	}
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	registration, err := h.service.RejectRegistration(c.Context(), c.Params("id"), decision, userCredentials)
+	if err != nil {
+		return handleShelterRegistrationError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(registration)
+}
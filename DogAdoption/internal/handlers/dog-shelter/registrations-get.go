@@ -0,0 +1,59 @@
+package dogshelterhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogshelterdto "1dv027/aad/internal/dto/dog-shelter"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetShelterRegistrationsService interface {
+	GetRegistrations(ctx context.Context, credentials dto.UserCredentials,
+		queryParams dto.QueryParams) (dogshelterdto.DogShelterRegistrationsAndPaginationLinksDTO, error)
+}
+
+type GetShelterRegistrationsHandler struct {
+	service GetShelterRegistrationsService
+}
+
+func NewGetShelterRegistrationsHandler(service GetShelterRegistrationsService) GetShelterRegistrationsHandler {
+	return GetShelterRegistrationsHandler{
+		service: service,
+	}
+}
+
+// Handle lists dog shelter registrations.
+// @Summary Get dog shelter registrations
+// @Description Lists self-registered dog shelters, oldest first. Pending registrations are listed unless another registration-status is given. Only available to admins.
+// @Tags admin
+// @Produce  json
+// @Param   registration-status  query     string   false  "Filter by registration status, pending (default), approved or rejected"
+// @Param   page                 query     integer  false  "Page number for pagination"
+// @Param   limit                query     integer  false  "Number of items per page for pagination"
+// @Success 200  {object}  dogshelterdto.DogShelterRegistrationsAndPaginationLinksDTO  "Success, returns the registrations"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the query parameters are invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an admin"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /admin/shelter-registrations [get]
+// @Security BearerAuth
+func (g GetShelterRegistrationsHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	queryParams := c.Locals("queryParams").(dto.QueryParams)
+
+	registrations, err := g.service.GetRegistrations(c.Context(), userCredentials, queryParams)
+	if err != nil {
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized to perform action",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later.",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(registrations)
+}
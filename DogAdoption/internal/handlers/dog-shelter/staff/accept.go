@@ -0,0 +1,51 @@
+package shelterstaffhandler
+
+import (
+	shelterstaffdto "1dv027/aad/internal/dto/shelter-staff"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AcceptStaffInvitationService interface {
+	AcceptInvitation(ctx context.Context, data shelterstaffdto.AcceptStaffInvitationDTO) (shelterstaffdto.ShelterStaffDTO, error)
+}
+
+type AcceptStaffInvitationHandler struct {
+	service AcceptStaffInvitationService
+}
+
+func NewAcceptStaffInvitationHandler(service AcceptStaffInvitationService) AcceptStaffInvitationHandler {
+	return AcceptStaffInvitationHandler{
+		service: service,
+	}
+}
+
+// Handle accepts a staff invitation.
+// @Summary Accept a staff invitation
+// @Description Creates a staff account with the token from an invitation email. The shelter, role and email are taken from the invitation, and the staff member logs in with the chosen username and password.
+// @Tags dogshelters
+// @Accept  json
+// @Produce  json
+// @Param   payload  body      shelterstaffdto.AcceptStaffInvitationDTO  true  "Invitation token and account credentials"
+// @Success 201  {object}  shelterstaffdto.ShelterStaffDTO  "Created, returns the new staff member"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the body is invalid or the password does not meet the password policy"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the invitation does not exist, is already accepted or has expired"
+// @Failure 409  {object}  dto.ErrorResponse "Conflict, if the username is used by another account or registration"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogshelters/staff-invitations/accept [post]
+func (a AcceptStaffInvitationHandler) Handle(c *fiber.Ctx) error {
+	var acceptDto shelterstaffdto.AcceptStaffInvitationDTO
+	err := c.BodyParser(&acceptDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request body. visit documentation for endpoint information.",
+		})
+	}
+
+	staff, err := a.service.AcceptInvitation(c.Context(), acceptDto)
+	if err != nil {
+		return handleShelterStaffError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(staff)
+}
@@ -0,0 +1,44 @@
+package shelterstaffhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type DeleteShelterStaffService interface {
+	DeleteStaff(ctx context.Context, shelterIdParam, staffIdParam string, credentials dto.UserCredentials) error
+}
+
+type DeleteShelterStaffHandler struct {
+	service DeleteShelterStaffService
+}
+
+func NewDeleteShelterStaffHandler(service DeleteShelterStaffService) DeleteShelterStaffHandler {
+	return DeleteShelterStaffHandler{
+		service: service,
+	}
+}
+
+// Handle removes a staff member from a dog shelter.
+// @Summary Remove a staff member
+// @Description Removes a staff account from the shelter. Available to admins, owners of the shelter and to staff members removing themselves.
+// @Tags dogshelters
+// @Param   id       path      integer  true  "Dog shelter ID"
+// @Param   staffId  path      integer  true  "Staff member ID"
+// @Success 204  "No Content, the staff member is removed"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameters are invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester may not remove the staff member"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the staff member is not part of the shelter"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogshelters/{id}/staff/{staffId} [delete]
+// @Security BearerAuth
+func (d DeleteShelterStaffHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	err := d.service.DeleteStaff(c.Context(), c.Params("id"), c.Params("staffId"), userCredentials)
+	if err != nil {
+		return handleShelterStaffError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
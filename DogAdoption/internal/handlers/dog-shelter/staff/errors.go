@@ -0,0 +1,63 @@
+package shelterstaffhandler
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// handleShelterStaffError maps the errors of the shelter staff endpoints to responses.
+func handleShelterStaffError(c *fiber.Ctx, err error) error {
+	var integerConversionError *customerrors.IntegerConversionError
+	if errors.As(err, &integerConversionError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "id parameters must be numbers",
+		})
+	}
+	var invalidDataError *customerrors.InvalidShelterStaffDataError
+	if errors.As(err, &invalidDataError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": invalidDataError.Message,
+		})
+	}
+	var usernameTakenError *customerrors.UsernameTakenError
+	if errors.As(err, &usernameTakenError) {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": usernameTakenError.Message,
+		})
+	}
+	var weakPasswordError *customerrors.WeakPasswordError
+	if errors.As(err, &weakPasswordError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": weakPasswordError.Message,
+		})
+	}
+	var unauthorizedError *customerrors.UnauthorizedError
+	if errors.As(err, &unauthorizedError) {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "unauthorized to perform action",
+		})
+	}
+	var dogShelterNotFound *customerrors.DogShelterNotFoundError
+	if errors.As(err, &dogShelterNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "dog shelter not found",
+		})
+	}
+	var staffNotFound *customerrors.ShelterStaffNotFoundError
+	if errors.As(err, &staffNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "staff member not found",
+		})
+	}
+	var invitationNotFound *customerrors.StaffInvitationNotFoundError
+	if errors.As(err, &invitationNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "invitation not found, already accepted or expired",
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": "something went wrong internally. try again later.",
+	})
+}
@@ -0,0 +1,45 @@
+package shelterstaffhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	shelterstaffdto "1dv027/aad/internal/dto/shelter-staff"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetShelterStaffService interface {
+	GetStaff(ctx context.Context, shelterIdParam string, credentials dto.UserCredentials) (shelterstaffdto.ShelterStaffListDTO, error)
+}
+
+type GetShelterStaffHandler struct {
+	service GetShelterStaffService
+}
+
+func NewGetShelterStaffHandler(service GetShelterStaffService) GetShelterStaffHandler {
+	return GetShelterStaffHandler{
+		service: service,
+	}
+}
+
+// Handle lists the staff of a dog shelter.
+// @Summary Get dog shelter staff
+// @Description Lists the staff accounts of a dog shelter with their roles. Available to admins and members of the shelter. Emails are only shown to owners and managers.
+// @Tags dogshelters
+// @Produce  json
+// @Param   id   path      integer  true  "Dog shelter ID"
+// @Success 200  {object}  shelterstaffdto.ShelterStaffListDTO  "Success, returns the staff of the shelter"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter format is incorrect"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an admin or a member of the shelter"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no dog shelter matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogshelters/{id}/staff [get]
+// @Security BearerAuth
+func (g GetShelterStaffHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	staff, err := g.service.GetStaff(c.Context(), c.Params("id"), userCredentials)
+	if err != nil {
+		return handleShelterStaffError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(staff)
+}
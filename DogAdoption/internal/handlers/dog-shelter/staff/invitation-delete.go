@@ -0,0 +1,44 @@
+package shelterstaffhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type RevokeStaffInvitationService interface {
+	RevokeInvitation(ctx context.Context, shelterIdParam, invitationIdParam string, credentials dto.UserCredentials) error
+}
+
+type DeleteStaffInvitationHandler struct {
+	service RevokeStaffInvitationService
+}
+
+func NewDeleteStaffInvitationHandler(service RevokeStaffInvitationService) DeleteStaffInvitationHandler {
+	return DeleteStaffInvitationHandler{
+		service: service,
+	}
+}
+
+// Handle revokes a staff invitation.
+// @Summary Revoke a staff invitation
+// @Description Revokes an invitation that has not been accepted yet. Only available to owners of the shelter.
+// @Tags dogshelters
+// @Param   id            path      integer  true  "Dog shelter ID"
+// @Param   invitationId  path      integer  true  "Invitation ID"
+// @Success 204  "No Content, the invitation is revoked"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameters are invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an owner of the shelter"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no open invitation matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogshelters/{id}/staff-invitations/{invitationId} [delete]
+// @Security BearerAuth
+func (d DeleteStaffInvitationHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	err := d.service.RevokeInvitation(c.Context(), c.Params("id"), c.Params("invitationId"), userCredentials)
+	if err != nil {
+		return handleShelterStaffError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,44 @@
+package shelterstaffhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	shelterstaffdto "1dv027/aad/internal/dto/shelter-staff"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetStaffInvitationsService interface {
+	GetInvitations(ctx context.Context, shelterIdParam string, credentials dto.UserCredentials) (shelterstaffdto.StaffInvitationListDTO, error)
+}
+
+type GetStaffInvitationsHandler struct {
+	service GetStaffInvitationsService
+}
+
+func NewGetStaffInvitationsHandler(service GetStaffInvitationsService) GetStaffInvitationsHandler {
+	return GetStaffInvitationsHandler{
+		service: service,
+	}
+}
+
+// Handle lists the open staff invitations of a dog shelter.
+// @Summary Get open staff invitations
+// @Description Lists the invitations of the shelter that have not been accepted and have not expired. Only available to owners of the shelter.
+// @Tags dogshelters
+// @Produce  json
+// @Param   id   path      integer  true  "Dog shelter ID"
+// @Success 200  {object}  shelterstaffdto.StaffInvitationListDTO  "Success, returns the open invitations"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter format is incorrect"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an owner of the shelter"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogshelters/{id}/staff-invitations [get]
+// @Security BearerAuth
+func (g GetStaffInvitationsHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	invitations, err := g.service.GetInvitations(c.Context(), c.Params("id"), userCredentials)
+	if err != nil {
+		return handleShelterStaffError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(invitations)
+}
@@ -0,0 +1,56 @@
+package shelterstaffhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	shelterstaffdto "1dv027/aad/internal/dto/shelter-staff"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type InviteShelterStaffService interface {
+	InviteStaff(ctx context.Context, shelterIdParam string, data shelterstaffdto.NewStaffInvitationDTO,
+		credentials dto.UserCredentials) (shelterstaffdto.StaffInvitationDTO, error)
+}
+
+type InviteShelterStaffHandler struct {
+	service InviteShelterStaffService
+}
+
+func NewInviteShelterStaffHandler(service InviteShelterStaffService) InviteShelterStaffHandler {
+	return InviteShelterStaffHandler{
+		service: service,
+	}
+}
+
+// Handle invites a staff member to a dog shelter.
+// @Summary Invite a staff member
+// @Description Sends an invitation token to the email address. The invited person creates a staff account with the given role by accepting the invitation within 7 days. Only available to owners of the shelter.
+// @Tags dogshelters
+// @Accept  json
+// @Produce  json
+// @Param   id       path      integer  true  "Dog shelter ID"
+// @Param   payload  body      shelterstaffdto.NewStaffInvitationDTO  true  "Email and role of the invited staff member"
+// @Success 201  {object}  shelterstaffdto.StaffInvitationDTO  "Created, returns the invitation"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter or body is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an owner of the shelter"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no dog shelter matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogshelters/{id}/staff-invitations [post]
+// @Security BearerAuth
+func (i InviteShelterStaffHandler) Handle(c *fiber.Ctx) error {
+	var invitationDto shelterstaffdto.NewStaffInvitationDTO
+	err := c.BodyParser(&invitationDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request body. visit documentation for endpoint information.",
+		})
+	}
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	invitation, err := i.service.InviteStaff(c.Context(), c.Params("id"), invitationDto, userCredentials)
+	if err != nil {
+		return handleShelterStaffError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(invitation)
+}
@@ -0,0 +1,72 @@
+package shelterstaffhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ChangeShelterStaffPasswordService interface {
+	ChangePassword(ctx context.Context, idParam string, credentials dto.UserCredentials, data authdto.ChangePasswordDTO) error
+}
+
+type ChangeShelterStaffPasswordHandler struct {
+	service ChangeShelterStaffPasswordService
+}
+
+func NewChangeShelterStaffPasswordHandler(service ChangeShelterStaffPasswordService) ChangeShelterStaffPasswordHandler {
+	return ChangeShelterStaffPasswordHandler{
+		service: service,
+	}
+}
+
+// Handle changes the password of a shelter staff member.
+// @Summary Change shelter staff password
+// @Description Changes the password of the authenticated staff member. The current password is required, and outstanding password reset tokens are invalidated.
+// @Tags dogshelters
+// @Accept  json
+// @Produce  json
+// @Param   id       path      integer                    true  "Staff member ID"
+// @Param   payload  body      authdto.ChangePasswordDTO  true  "Current and new password"
+// @Success 204  "No Content, the password is changed"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter or body is invalid, or the new password does not meet the password policy"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the current password is wrong or the requester is not the staff member"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no staff member matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogshelters/staff/{id}/password [post]
+// @Security BearerAuth
+func (h ChangeShelterStaffPasswordHandler) Handle(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	var passwordDto authdto.ChangePasswordDTO
+	err := c.BodyParser(&passwordDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request. visit documentation for more endpoint information.",
+		})
+	}
+
+	err = h.service.ChangePassword(c.Context(), idParam, userCredentials, passwordDto)
+	if err != nil {
+		var invalidDataError *customerrors.InvalidPasswordChangeDataError
+		if errors.As(err, &invalidDataError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": invalidDataError.Message,
+			})
+		}
+		var wrongCredentialsError *customerrors.WrongCredentialsError
+		if errors.As(err, &wrongCredentialsError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "current password is incorrect",
+			})
+		}
+		return handleShelterStaffError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
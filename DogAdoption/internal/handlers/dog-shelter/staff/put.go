@@ -0,0 +1,57 @@
+package shelterstaffhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	shelterstaffdto "1dv027/aad/internal/dto/shelter-staff"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type UpdateShelterStaffService interface {
+	UpdateStaffRole(ctx context.Context, shelterIdParam, staffIdParam string, data shelterstaffdto.UpdateShelterStaffDTO,
+		credentials dto.UserCredentials) (shelterstaffdto.ShelterStaffDTO, error)
+}
+
+type PutShelterStaffHandler struct {
+	service UpdateShelterStaffService
+}
+
+func NewPutShelterStaffHandler(service UpdateShelterStaffService) PutShelterStaffHandler {
+	return PutShelterStaffHandler{
+		service: service,
+	}
+}
+
+// Handle changes the role of a staff member.
+// @Summary Change the role of a staff member
+// @Description Changes the role of a staff member to owner, manager or volunteer. Only available to owners of the shelter.
+// @Tags dogshelters
+// @Accept  json
+// @Produce  json
+// @Param   id       path      integer  true  "Dog shelter ID"
+// @Param   staffId  path      integer  true  "Staff member ID"
+// @Param   payload  body      shelterstaffdto.UpdateShelterStaffDTO  true  "New role"
+// @Success 200  {object}  shelterstaffdto.ShelterStaffDTO  "Success, returns the updated staff member"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameters or body are invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an owner of the shelter"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the staff member is not part of the shelter"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogshelters/{id}/staff/{staffId} [put]
+// @Security BearerAuth
+func (p PutShelterStaffHandler) Handle(c *fiber.Ctx) error {
+	var updateDto shelterstaffdto.UpdateShelterStaffDTO
+	err := c.BodyParser(&updateDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request body. visit documentation for endpoint information.",
+		})
+	}
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	staff, err := p.service.UpdateStaffRole(c.Context(), c.Params("id"), c.Params("staffId"), updateDto, userCredentials)
+	if err != nil {
+		return handleShelterStaffError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(staff)
+}
@@ -0,0 +1,17 @@
+package doghandler
+
+import (
+	"1dv027/aad/internal/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// optionalCredentials returns the credentials of an authenticated request, and empty credentials for
+// anonymous requests.
+func optionalCredentials(c *fiber.Ctx) dto.UserCredentials {
+	userCredentials, ok := c.Locals("user").(dto.UserCredentials)
+	if !ok {
+		return dto.UserCredentials{}
+	}
+	return userCredentials
+}
@@ -37,6 +37,7 @@ func NewDeleteDogHandler(service DeleteDogService) DeleteDogHandler {
 // @Failure 500  {object}  dto.ErrorResponse "Internal Server Error for any server errors"
 // @Router /dogs/{id} [delete]
 // @Security BearerAuth
+// @Security ApiKeyAuth
 func (d DeleteDogHandler) Handle(c *fiber.Ctx) error {
 	userCredentials := c.Locals("user").(dto.UserCredentials)
 	err := d.service.DeleteDog(c.Context(), c.Params("id"), userCredentials)
@@ -1,6 +1,7 @@
 package doghandler
 
 import (
+	"1dv027/aad/internal/dto"
 	dogdto "1dv027/aad/internal/dto/dog"
 	customerrors "1dv027/aad/internal/errors"
 	"context"
@@ -10,7 +11,7 @@ import (
 )
 
 type GetDogByIdService interface {
-	GetDogById(ctx context.Context, dogId string) (dogdto.DogDTO, error)
+	GetDogById(ctx context.Context, dogId string, viewer dto.UserCredentials) (dogdto.DogDTO, error)
 }
 
 type GetDogByIdHandler struct {
@@ -25,17 +26,20 @@ func NewGetDogByIdHandler(service GetDogByIdService) GetDogByIdHandler {
 
 // Handle retrieves a dog by its ID.
 // @Summary Get a dog by ID
-// @Description Retrieves detailed information about a dog specified by its ID.
+// @Description Retrieves detailed information about a dog specified by its ID. Authentication is optional. Admins and members of the dog's shelter also get favorited_count.
 // @Tags dogs
 // @Accept  json
 // @Produce  json
 // @Param   id   path      integer  true  "Dog ID"  "The unique identifier of the dog to retrieve"
 // @Success 200  {object}  dogdto.DogDTO  "Success, returns detailed information about the dog"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if provided credentials are invalid"
 // @Failure 404  {object}  dto.ErrorResponse "Not Found, if no dog matches the provided ID"
 // @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
 // @Router /dogs/{id} [get]
+// @Security BearerAuth
+// @Security ApiKeyAuth
 func (g GetDogByIdHandler) Handle(c *fiber.Ctx) error {
-	dogDataResponse, err := g.service.GetDogById(c.Context(), c.Params("id"))
+	dogDataResponse, err := g.service.GetDogById(c.Context(), c.Params("id"), optionalCredentials(c))
 	if err != nil {
 		var notFoundErr *customerrors.DogNotFoundError
 		if errors.As(err, &notFoundErr) {
@@ -9,7 +9,7 @@ import (
 )
 
 type GetDogsService interface {
-	GetDogs(ctx context.Context, queryParams dto.QueryParams) (dogdto.DogsAndPaginationLinksDTO, error)
+	GetDogs(ctx context.Context, queryParams dto.QueryParams, viewer dto.UserCredentials) (dogdto.DogsAndPaginationLinksDTO, error)
 }
 
 type GetDogsHandler struct {
@@ -24,23 +24,34 @@ func NewGetDogsHandler(service GetDogsService) GetDogsHandler {
 
 // Handle retrieves dogs based on query parameters.
 // @Summary Get dogs
-// @Description Retrieves a list of dogs based on provided query parameters like breed, size, and age.
+// @Description Retrieves a list of dogs based on provided query parameters like breed, size, and age. Authentication is optional. Admins and members of the dog's shelter also get favorited_count.
 // @Tags dogs
 // @Accept  json
 // @Produce  json
-// @Param   breed   query     string  false  "Filter by dog breed"
+// @Param   breed   query     string  false  "Filter by the name or an alias of the primary or secondary breed, ignoring case"
+// @Param   breed-id   query     integer  false  "Filter by the primary or secondary breed"
 // @Param   gender    query     string  false  "Filter by dog gender"
 // @Param   is_neutered     query     boolean     false  "Filter by if dog is neutered"
 // @Param   is_adopted     query     boolean     false  "Filter by if dog is adopted"
+// @Param   status     query     string     false  "Filter by status, several statuses can be given separated by commas. Valid statuses are available, reserved, on_hold, in_foster, adopted, returned and deceased"
+// @Param   good-with     query     string     false  "Filter by dogs that are good with all of the comma separated groups children, dogs, cats and small-animals"
+// @Param   energy-level     query     string     false  "Filter by any of the comma separated energy levels low, medium and high"
+// @Param   dog-size     query     string     false  "Filter by any of the comma separated sizes toy, small, medium, large and giant"
+// @Param   vaccinated     query     boolean     false  "Filter by if dog has a vaccination that is not overdue"
 // @Param shelter_id	query	integer		false	"Filter dogs that are from a specific dog shelter"
+// @Param   near   query     string  false  "Sort by distance to a point formatted as lat,lng, and return the distance in kilometers"
+// @Param   radius-km   query     number  false  "Only return results within this many kilometers of near"
 // @Success 200  {object}  dogdto.DogsAndPaginationLinksDTO  "Success, returns a list of dogs along with pagination details"
 // @Failure 400  {object}  dto.ErrorResponse "Bad Request if the query parameters are invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if provided credentials are invalid"
 // @Failure 500  {object}  dto.ErrorResponse "Internal Server Error if an error occurs while processing the request"
 // @Router /dogs [get]
+// @Security BearerAuth
+// @Security ApiKeyAuth
 func (g GetDogsHandler) Handle(c *fiber.Ctx) error {
 	queryParamsDto := c.Locals("queryParams").(dto.QueryParams)
 
-	dogsDataResponse, err := g.service.GetDogs(c.Context(), queryParamsDto)
+	dogsDataResponse, err := g.service.GetDogs(c.Context(), queryParamsDto, optionalCredentials(c))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"error": "bad request",
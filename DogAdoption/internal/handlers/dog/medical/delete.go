@@ -0,0 +1,46 @@
+package dogmedicalhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type DeleteDogMedicalRecordService interface {
+	DeleteMedicalRecord(ctx context.Context, dogIdParam, recordIdParam string, credentials dto.UserCredentials) error
+}
+
+type DeleteDogMedicalRecordHandler struct {
+	service DeleteDogMedicalRecordService
+}
+
+func NewDeleteDogMedicalRecordHandler(service DeleteDogMedicalRecordService) DeleteDogMedicalRecordHandler {
+	return DeleteDogMedicalRecordHandler{
+		service: service,
+	}
+}
+
+// Handle deletes an entry from the medical record of a dog.
+// @Summary Delete a medical record entry
+// @Description Removes a vaccination, treatment or note. Only available to admins and members of the dog's shelter.
+// @Tags dogs
+// @Param   id        path  integer  true  "Dog ID"
+// @Param   recordId  path  integer  true  "Medical record ID"
+// @Success 204  "Record deleted successfully"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an ID parameter is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester may not manage the medical record"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no record of the dog matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/medical-records/{recordId} [delete]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (d DeleteDogMedicalRecordHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	err := d.service.DeleteMedicalRecord(c.Context(), c.Params("id"), c.Params("recordId"), userCredentials)
+	if err != nil {
+		return handleDogMedicalRecordError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
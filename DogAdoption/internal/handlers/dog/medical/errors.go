@@ -0,0 +1,56 @@
+package dogmedicalhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	customerrors "1dv027/aad/internal/errors"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// handleDogMedicalRecordError maps the errors of the medical record endpoints to responses.
+func handleDogMedicalRecordError(c *fiber.Ctx, err error) error {
+	var integerConversionError *customerrors.IntegerConversionError
+	if errors.As(err, &integerConversionError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "id parameters must be numbers",
+		})
+	}
+	var invalidDataError *customerrors.InvalidMedicalRecordDataError
+	if errors.As(err, &invalidDataError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": invalidDataError.Message,
+		})
+	}
+	var unauthorizedError *customerrors.UnauthorizedError
+	if errors.As(err, &unauthorizedError) {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "unauthorized to perform action",
+		})
+	}
+	var dogNotFound *customerrors.DogNotFoundError
+	if errors.As(err, &dogNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "dog not found",
+		})
+	}
+	var recordNotFound *customerrors.MedicalRecordNotFoundError
+	if errors.As(err, &recordNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "medical record not found",
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": "something went wrong internally. try again later.",
+	})
+}
+
+// optionalCredentials returns the credentials of an authenticated request, and empty credentials for
+// anonymous requests.
+func optionalCredentials(c *fiber.Ctx) dto.UserCredentials {
+	userCredentials, ok := c.Locals("user").(dto.UserCredentials)
+	if !ok {
+		return dto.UserCredentials{}
+	}
+	return userCredentials
+}
@@ -0,0 +1,48 @@
+package dogmedicalhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogmedicaldto "1dv027/aad/internal/dto/dog/medical"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetDogMedicalRecordService interface {
+	GetMedicalRecord(ctx context.Context, dogIdParam, recordIdParam string, credentials dto.UserCredentials) (dogmedicaldto.DogMedicalRecordDTO, error)
+}
+
+type GetDogMedicalRecordHandler struct {
+	service GetDogMedicalRecordService
+}
+
+func NewGetDogMedicalRecordHandler(service GetDogMedicalRecordService) GetDogMedicalRecordHandler {
+	return GetDogMedicalRecordHandler{
+		service: service,
+	}
+}
+
+// Handle retrieves a medical record entry of a dog.
+// @Summary Get a medical record entry
+// @Description Retrieves a vaccination, treatment or note. Only available to admins and members of the dog's shelter.
+// @Tags dogs
+// @Produce  json
+// @Param   id        path  integer  true  "Dog ID"
+// @Param   recordId  path  integer  true  "Medical record ID"
+// @Success 200  {object}  dogmedicaldto.DogMedicalRecordDTO  "OK, returns the record"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an ID parameter is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester may not read the full medical record"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no record of the dog matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/medical-records/{recordId} [get]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (g GetDogMedicalRecordHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	record, err := g.service.GetMedicalRecord(c.Context(), c.Params("id"), c.Params("recordId"), userCredentials)
+	if err != nil {
+		return handleDogMedicalRecordError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(record)
+}
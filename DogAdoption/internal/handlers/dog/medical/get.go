@@ -0,0 +1,45 @@
+package dogmedicalhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogmedicaldto "1dv027/aad/internal/dto/dog/medical"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetDogMedicalRecordsService interface {
+	GetMedicalRecords(ctx context.Context, dogIdParam string, credentials dto.UserCredentials) (dogmedicaldto.DogMedicalRecordsDTO, error)
+}
+
+type GetDogMedicalRecordsHandler struct {
+	service GetDogMedicalRecordsService
+}
+
+func NewGetDogMedicalRecordsHandler(service GetDogMedicalRecordsService) GetDogMedicalRecordsHandler {
+	return GetDogMedicalRecordsHandler{
+		service: service,
+	}
+}
+
+// Handle retrieves the medical record of a dog.
+// @Summary Get the medical record of a dog
+// @Description Returns a public summary with neutering, vaccinations and the number of treatments. Admins and members of the dog's shelter also get every record, including treatments and notes. Authentication is optional.
+// @Tags dogs
+// @Produce  json
+// @Param   id  path  integer  true  "Dog ID"
+// @Success 200  {object}  dogmedicaldto.DogMedicalRecordsDTO  "OK, returns the medical record"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if provided credentials are invalid"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no dog matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/medical-records [get]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (g GetDogMedicalRecordsHandler) Handle(c *fiber.Ctx) error {
+	medicalRecords, err := g.service.GetMedicalRecords(c.Context(), c.Params("id"), optionalCredentials(c))
+	if err != nil {
+		return handleDogMedicalRecordError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(medicalRecords)
+}
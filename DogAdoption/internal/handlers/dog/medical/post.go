@@ -0,0 +1,57 @@
+package dogmedicalhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogmedicaldto "1dv027/aad/internal/dto/dog/medical"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PostDogMedicalRecordService interface {
+	CreateMedicalRecord(ctx context.Context, dogIdParam string, newRecord dogmedicaldto.NewDogMedicalRecordDTO,
+		credentials dto.UserCredentials) (dogmedicaldto.DogMedicalRecordDTO, error)
+}
+
+type PostDogMedicalRecordHandler struct {
+	service PostDogMedicalRecordService
+}
+
+func NewPostDogMedicalRecordHandler(service PostDogMedicalRecordService) PostDogMedicalRecordHandler {
+	return PostDogMedicalRecordHandler{
+		service: service,
+	}
+}
+
+// Handle adds an entry to the medical record of a dog.
+// @Summary Add a medical record entry
+// @Description Adds a vaccination, treatment or note. Dates are formatted as YYYY-MM-DD and cannot be in the future. Only vaccinations can have a next_due_on date. Only available to admins and members of the dog's shelter.
+// @Tags dogs
+// @Accept  json
+// @Produce  json
+// @Param   id       path  integer  true  "Dog ID"
+// @Param   payload  body  dogmedicaldto.NewDogMedicalRecordDTO  true  "The record"
+// @Success 201  {object}  dogmedicaldto.DogMedicalRecordDTO  "Created, returns the record"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter or body is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester may not manage the medical record"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no dog matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/medical-records [post]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (p PostDogMedicalRecordHandler) Handle(c *fiber.Ctx) error {
+	var recordDto dogmedicaldto.NewDogMedicalRecordDTO
+	err := c.BodyParser(&recordDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request body. visit documentation for endpoint information.",
+		})
+	}
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	record, err := p.service.CreateMedicalRecord(c.Context(), c.Params("id"), recordDto, userCredentials)
+	if err != nil {
+		return handleDogMedicalRecordError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(record)
+}
@@ -0,0 +1,58 @@
+package dogmedicalhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogmedicaldto "1dv027/aad/internal/dto/dog/medical"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PutDogMedicalRecordService interface {
+	UpdateMedicalRecord(ctx context.Context, dogIdParam, recordIdParam string, update dogmedicaldto.UpdateDogMedicalRecordDTO,
+		credentials dto.UserCredentials) (dogmedicaldto.DogMedicalRecordDTO, error)
+}
+
+type PutDogMedicalRecordHandler struct {
+	service PutDogMedicalRecordService
+}
+
+func NewPutDogMedicalRecordHandler(service PutDogMedicalRecordService) PutDogMedicalRecordHandler {
+	return PutDogMedicalRecordHandler{
+		service: service,
+	}
+}
+
+// Handle updates an entry in the medical record of a dog.
+// @Summary Update a medical record entry
+// @Description Changes the provided fields of the record. An empty next_due_on removes the due date. Only available to admins and members of the dog's shelter.
+// @Tags dogs
+// @Accept  json
+// @Produce  json
+// @Param   id        path  integer  true  "Dog ID"
+// @Param   recordId  path  integer  true  "Medical record ID"
+// @Param   payload   body  dogmedicaldto.UpdateDogMedicalRecordDTO  true  "The fields to change"
+// @Success 200  {object}  dogmedicaldto.DogMedicalRecordDTO  "OK, returns the record"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an ID parameter or the body is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester may not manage the medical record"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no record of the dog matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/medical-records/{recordId} [put]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (p PutDogMedicalRecordHandler) Handle(c *fiber.Ctx) error {
+	var updateDto dogmedicaldto.UpdateDogMedicalRecordDTO
+	err := c.BodyParser(&updateDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request body. visit documentation for endpoint information.",
+		})
+	}
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	record, err := p.service.UpdateMedicalRecord(c.Context(), c.Params("id"), c.Params("recordId"), updateDto, userCredentials)
+	if err != nil {
+		return handleDogMedicalRecordError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(record)
+}
@@ -0,0 +1,46 @@
+package dogphotohandler
+
+import (
+	"1dv027/aad/internal/dto"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type DeleteDogPhotoService interface {
+	DeletePhoto(ctx context.Context, dogIdParam, photoIdParam string, credentials dto.UserCredentials) error
+}
+
+type DeleteDogPhotoHandler struct {
+	service DeleteDogPhotoService
+}
+
+func NewDeleteDogPhotoHandler(service DeleteDogPhotoService) DeleteDogPhotoHandler {
+	return DeleteDogPhotoHandler{
+		service: service,
+	}
+}
+
+// Handle deletes a dog photo.
+// @Summary Delete a dog photo
+// @Description Removes the photo and its thumbnails. If it was the primary photo, the first remaining photo becomes primary. Only available to admins and members of the dog's shelter.
+// @Tags dogs
+// @Param   id       path  integer  true  "Dog ID"
+// @Param   photoId  path  integer  true  "Photo ID"
+// @Success 204  "Photo deleted successfully"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an ID parameter is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester may not manage the photos of the dog"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no photo of the dog matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/photos/{photoId} [delete]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (d DeleteDogPhotoHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	err := d.service.DeletePhoto(c.Context(), c.Params("id"), c.Params("photoId"), userCredentials)
+	if err != nil {
+		return handleDogPhotoError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
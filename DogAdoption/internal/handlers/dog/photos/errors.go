@@ -0,0 +1,51 @@
+package dogphotohandler
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// handleDogPhotoError maps the errors of the dog photo endpoints to responses.
+func handleDogPhotoError(c *fiber.Ctx, err error) error {
+	var integerConversionError *customerrors.IntegerConversionError
+	if errors.As(err, &integerConversionError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "id parameters must be numbers",
+		})
+	}
+	var invalidPhotoError *customerrors.InvalidDogPhotoError
+	if errors.As(err, &invalidPhotoError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": invalidPhotoError.Message,
+		})
+	}
+	var tooLargeError *customerrors.DogPhotoTooLargeError
+	if errors.As(err, &tooLargeError) {
+		return c.Status(fiber.StatusRequestEntityTooLarge).JSON(fiber.Map{
+			"error": tooLargeError.Message,
+		})
+	}
+	var unauthorizedError *customerrors.UnauthorizedError
+	if errors.As(err, &unauthorizedError) {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "unauthorized to perform action",
+		})
+	}
+	var dogNotFound *customerrors.DogNotFoundError
+	if errors.As(err, &dogNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "dog not found",
+		})
+	}
+	var photoNotFound *customerrors.DogPhotoNotFoundError
+	if errors.As(err, &photoNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "photo not found",
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": "something went wrong internally. try again later.",
+	})
+}
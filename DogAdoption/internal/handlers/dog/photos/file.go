@@ -0,0 +1,48 @@
+package dogphotohandler
+
+import (
+	"context"
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetDogPhotoFileService interface {
+	GetPhotoFile(ctx context.Context, dogIdParam, photoIdParam, sizeParam string) (io.ReadCloser, string, error)
+}
+
+type GetDogPhotoFileHandler struct {
+	service GetDogPhotoFileService
+}
+
+func NewGetDogPhotoFileHandler(service GetDogPhotoFileService) GetDogPhotoFileHandler {
+	return GetDogPhotoFileHandler{
+		service: service,
+	}
+}
+
+// Handle streams the original or a thumbnail of a dog photo.
+// @Summary Download a dog photo
+// @Description Streams the original upload or one of the JPEG thumbnails. Files never change once stored, so they may be cached indefinitely.
+// @Tags dogs
+// @Produce  image/jpeg
+// @Produce  image/png
+// @Produce  image/gif
+// @Param   id       path  integer  true  "Dog ID"
+// @Param   photoId  path  integer  true  "Photo ID"
+// @Param   size     path  string   true  "original, large, medium or small"
+// @Success 200  {file}  file  "OK, returns the image"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an ID parameter or the size is invalid"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no photo of the dog matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/photos/{photoId}/{size} [get]
+func (g GetDogPhotoFileHandler) Handle(c *fiber.Ctx) error {
+	file, contentType, err := g.service.GetPhotoFile(c.Context(), c.Params("id"), c.Params("photoId"), c.Params("size"))
+	if err != nil {
+		return handleDogPhotoError(c, err)
+	}
+	c.Set(fiber.HeaderContentType, contentType)
+	c.Set(fiber.HeaderCacheControl, "public, max-age=31536000, immutable")
+	// SendStream closes the file once the response has been written.
+	return c.Status(fiber.StatusOK).SendStream(file)
+}
@@ -0,0 +1,42 @@
+package dogphotohandler
+
+import (
+	dogphotodto "1dv027/aad/internal/dto/dog/photo"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetDogPhotoService interface {
+	GetPhoto(ctx context.Context, dogIdParam, photoIdParam string) (dogphotodto.DogPhotoDTO, error)
+}
+
+type GetDogPhotoHandler struct {
+	service GetDogPhotoService
+}
+
+func NewGetDogPhotoHandler(service GetDogPhotoService) GetDogPhotoHandler {
+	return GetDogPhotoHandler{
+		service: service,
+	}
+}
+
+// Handle retrieves a photo of a dog.
+// @Summary Get a dog photo
+// @Description Retrieves the metadata of a photo, with links to the original and the thumbnails.
+// @Tags dogs
+// @Produce  json
+// @Param   id       path  integer  true  "Dog ID"
+// @Param   photoId  path  integer  true  "Photo ID"
+// @Success 200  {object}  dogphotodto.DogPhotoDTO  "OK, returns the photo"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an ID parameter is invalid"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no photo of the dog matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/photos/{photoId} [get]
+func (g GetDogPhotoHandler) Handle(c *fiber.Ctx) error {
+	photo, err := g.service.GetPhoto(c.Context(), c.Params("id"), c.Params("photoId"))
+	if err != nil {
+		return handleDogPhotoError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(photo)
+}
@@ -0,0 +1,41 @@
+package dogphotohandler
+
+import (
+	dogphotodto "1dv027/aad/internal/dto/dog/photo"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetDogPhotosService interface {
+	GetPhotos(ctx context.Context, dogIdParam string) (dogphotodto.DogPhotoGalleryDTO, error)
+}
+
+type GetDogPhotosHandler struct {
+	service GetDogPhotosService
+}
+
+func NewGetDogPhotosHandler(service GetDogPhotosService) GetDogPhotosHandler {
+	return GetDogPhotosHandler{
+		service: service,
+	}
+}
+
+// Handle retrieves the photo gallery of a dog.
+// @Summary Get dog photos
+// @Description Lists the photos of the dog in display order, with links to the original and the thumbnails.
+// @Tags dogs
+// @Produce  json
+// @Param   id  path  integer  true  "Dog ID"
+// @Success 200  {object}  dogphotodto.DogPhotoGalleryDTO  "OK, returns the gallery"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter is invalid"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no dog matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/photos [get]
+func (g GetDogPhotosHandler) Handle(c *fiber.Ctx) error {
+	gallery, err := g.service.GetPhotos(c.Context(), c.Params("id"))
+	if err != nil {
+		return handleDogPhotoError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(gallery)
+}
@@ -0,0 +1,76 @@
+package dogphotohandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogphotodto "1dv027/aad/internal/dto/dog/photo"
+	"context"
+	"io"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type UploadDogPhotoService interface {
+	UploadPhoto(ctx context.Context, dogIdParam string, photo io.Reader, makePrimary bool,
+		credentials dto.UserCredentials) (dogphotodto.DogPhotoDTO, error)
+}
+
+type UploadDogPhotoHandler struct {
+	service UploadDogPhotoService
+}
+
+func NewUploadDogPhotoHandler(service UploadDogPhotoService) UploadDogPhotoHandler {
+	return UploadDogPhotoHandler{
+		service: service,
+	}
+}
+
+// Handle uploads a photo of a dog.
+// @Summary Upload a dog photo
+// @Description Adds a JPEG, PNG or GIF photo last in the gallery of the dog. Large, medium and small JPEG thumbnails are generated. The first photo of a dog becomes its primary photo. Only available to admins and members of the dog's shelter.
+// @Tags dogs
+// @Accept  multipart/form-data
+// @Produce  json
+// @Param   id          path      integer  true   "Dog ID"
+// @Param   photo       formData  file     true   "The photo"
+// @Param   is_primary  formData  boolean  false  "Make the photo the primary photo of the dog"
+// @Success 201  {object}  dogphotodto.DogPhotoDTO  "Created, returns the photo"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter is invalid or the file is not a supported image"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester may not manage the photos of the dog"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no dog matches the provided ID"
+// @Failure 413  {object}  dto.ErrorResponse "Request Entity Too Large, if the photo exceeds the size limit"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/photos [post]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (u UploadDogPhotoHandler) Handle(c *fiber.Ctx) error {
+	fileHeader, err := c.FormFile("photo")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "a photo must be uploaded in the multipart field photo",
+		})
+	}
+	makePrimary := false
+	if isPrimary := c.FormValue("is_primary"); isPrimary != "" {
+		makePrimary, err = strconv.ParseBool(isPrimary)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "is_primary must be true or false",
+			})
+		}
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "the uploaded photo could not be read",
+		})
+	}
+	defer file.Close()
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	photo, err := u.service.UploadPhoto(c.Context(), c.Params("id"), file, makePrimary, userCredentials)
+	if err != nil {
+		return handleDogPhotoError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(photo)
+}
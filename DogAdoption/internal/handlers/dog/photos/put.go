@@ -0,0 +1,58 @@
+package dogphotohandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogphotodto "1dv027/aad/internal/dto/dog/photo"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type UpdateDogPhotoService interface {
+	UpdatePhoto(ctx context.Context, dogIdParam, photoIdParam string, update dogphotodto.UpdateDogPhotoDTO,
+		credentials dto.UserCredentials) (dogphotodto.DogPhotoDTO, error)
+}
+
+type UpdateDogPhotoHandler struct {
+	service UpdateDogPhotoService
+}
+
+func NewUpdateDogPhotoHandler(service UpdateDogPhotoService) UpdateDogPhotoHandler {
+	return UpdateDogPhotoHandler{
+		service: service,
+	}
+}
+
+// Handle reorders a dog photo or makes it the primary photo.
+// @Summary Update a dog photo
+// @Description Moves the photo to another position in the gallery and/or makes it the primary photo of the dog. Only available to admins and members of the dog's shelter.
+// @Tags dogs
+// @Accept  json
+// @Produce  json
+// @Param   id       path  integer  true  "Dog ID"
+// @Param   photoId  path  integer  true  "Photo ID"
+// @Param   payload  body  dogphotodto.UpdateDogPhotoDTO  true  "New position and/or is_primary true"
+// @Success 200  {object}  dogphotodto.DogPhotoDTO  "OK, returns the photo"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an ID parameter or the body is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester may not manage the photos of the dog"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no photo of the dog matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/photos/{photoId} [put]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (u UpdateDogPhotoHandler) Handle(c *fiber.Ctx) error {
+	var updateDto dogphotodto.UpdateDogPhotoDTO
+	err := c.BodyParser(&updateDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request body. visit documentation for endpoint information.",
+		})
+	}
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	photo, err := u.service.UpdatePhoto(c.Context(), c.Params("id"), c.Params("photoId"), updateDto, userCredentials)
+	if err != nil {
+		return handleDogPhotoError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(photo)
+}
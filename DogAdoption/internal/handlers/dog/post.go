@@ -26,7 +26,7 @@ func NewPostDogHandler(service PostDogService) PostDogHandler {
 
 // Handle posts a new dog to the system.
 // @Summary Add a new dog
-// @Description Adds a new dog to the system with the provided dog data in JSON format. shelter_id field is for admins only.
+// @Description Adds a new dog to the system with the provided dog data in JSON format. shelter_id field is for admins only. New dogs are available unless status or is_adopted is given. The breed is given by primary_breed_id, or by the name or an alias of a breed in breed, and secondary_breed_id makes the dog a mixed breed. The good_with flags are yes, no or unknown, and are derived from friendly_with for older clients that only send friendly_with.
 // @Tags dogs
 // @Accept  json
 // @Produce  json
@@ -37,6 +37,7 @@ func NewPostDogHandler(service PostDogService) PostDogHandler {
 // @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
 // @Router /dogs [post]
 // @Security BearerAuth
+// @Security ApiKeyAuth
 func (p PostDogHandler) Handle(c *fiber.Ctx) error {
 	var newDogDto dogdto.NewDogDTO
 	err := c.BodyParser(&newDogDto)
@@ -26,7 +26,7 @@ func NewPutDogHandler(service PutDogService) PutDogHandler {
 
 // Handle updates an existing dog by ID.
 // @Summary Update dog information
-// @Description Updates the information for an existing dog specified by its ID with the provided dog data in JSON format.
+// @Description Updates the information for an existing dog specified by its ID with the provided dog data in JSON format. The status follows the lifecycle available → reserved/on_hold/in_foster → adopted → returned, and deceased is final. Changes are recorded in the status history of the dog. Setting is_adopted is the same as setting the status to adopted, or returning an adopted dog. A breed is resolved through the names and aliases of the breeds, and a secondary_breed_id of 0 removes the secondary breed.
 // @Tags dogs
 // @Accept  json
 // @Produce  json
@@ -35,9 +35,12 @@ func NewPutDogHandler(service PutDogService) PutDogHandler {
 // @Success 200  {object}  dogdto.DogDTO  "Success, returns the updated dog information"
 // @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the JSON body cannot be parsed, mandatory fields are missing, or the dog data is incomplete"
 // @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the user does not have permission to update the dog"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no dog matches the provided ID"
+// @Failure 409  {object}  dto.ErrorResponse "Conflict, if the dog cannot go from its current status to the new status"
 // @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
 // @Router /dogs/{id} [put]
 // @Security BearerAuth
+// @Security ApiKeyAuth
 func (p PutDogHandler) Handle(c *fiber.Ctx) error {
 	userCredentials := c.Locals("user").(dto.UserCredentials)
 	var updateDogDto dogdto.UpdateDogDTO
@@ -62,6 +65,12 @@ func (p PutDogHandler) Handle(c *fiber.Ctx) error {
 				"error": "invalid request body data. visit the documentation for endpoint information.",
 			})
 		}
+		var statusTransitionErr *customerrors.InvalidDogStatusTransitionError
+		if errors.As(err, &statusTransitionErr) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"error": statusTransitionErr.Message,
+			})
+		}
 		var dogNotFoundError *customerrors.DogNotFoundError
 		if errors.As(err, &dogNotFoundError) {
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
@@ -0,0 +1,57 @@
+package doghandler
+
+import (
+	dogdto "1dv027/aad/internal/dto/dog"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetDogStatusHistoryService interface {
+	GetStatusHistory(ctx context.Context, dogId string) (dogdto.DogStatusHistoryDTO, error)
+}
+
+type GetDogStatusHistoryHandler struct {
+	service GetDogStatusHistoryService
+}
+
+func NewGetDogStatusHistoryHandler(service GetDogStatusHistoryService) GetDogStatusHistoryHandler {
+	return GetDogStatusHistoryHandler{
+		service: service,
+	}
+}
+
+// Handle retrieves the status history of a dog.
+// @Summary Get the status history of a dog
+// @Description Retrieves the current status of a dog, the statuses it can move to, and every status change with its timestamp, oldest first.
+// @Tags dogs
+// @Produce  json
+// @Param   id   path      integer  true  "Dog ID"
+// @Success 200  {object}  dogdto.DogStatusHistoryDTO  "Success, returns the status history"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter is invalid"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no dog matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/status-history [get]
+func (g GetDogStatusHistoryHandler) Handle(c *fiber.Ctx) error {
+	history, err := g.service.GetStatusHistory(c.Context(), c.Params("id"))
+	if err != nil {
+		var notFoundErr *customerrors.DogNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "dog not found",
+			})
+		}
+		var integerConversionErr *customerrors.IntegerConversionError
+		if errors.As(err, &integerConversionErr) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "invalid id parameter",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(history)
+}
@@ -0,0 +1,48 @@
+package dogtransferhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogtransferdto "1dv027/aad/internal/dto/dog/transfer"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AcceptDogTransferService interface {
+	AcceptTransfer(ctx context.Context, dogIdParam, transferIdParam string, credentials dto.UserCredentials) (dogtransferdto.DogTransferDTO, error)
+}
+
+type AcceptDogTransferHandler struct {
+	service AcceptDogTransferService
+}
+
+func NewAcceptDogTransferHandler(service AcceptDogTransferService) AcceptDogTransferHandler {
+	return AcceptDogTransferHandler{
+		service: service,
+	}
+}
+
+// Handle accepts a pending transfer of a dog.
+// @Summary Accept a dog transfer
+// @Description Accepts the pending transfer and moves the dog to the destination shelter. Subscribers to the dog_transferred webhook are notified. Only available to admins and owners and managers of the destination shelter.
+// @Tags dogs
+// @Produce  json
+// @Param   id          path      integer  true  "Dog ID"
+// @Param   transferId  path      integer  true  "Transfer ID"
+// @Success 200  {object}  dogtransferdto.DogTransferDTO  "OK, returns the accepted transfer"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an ID parameter is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester may not accept the transfer"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no transfer of the dog matches the provided ID"
+// @Failure 409  {object}  dto.ErrorResponse "Conflict, if the transfer is no longer pending or the dog has left the source shelter"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/transfers/{transferId}/accept [post]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (a AcceptDogTransferHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	transfer, err := a.service.AcceptTransfer(c.Context(), c.Params("id"), c.Params("transferId"), userCredentials)
+	if err != nil {
+		return handleDogTransferError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(transfer)
+}
@@ -0,0 +1,58 @@
+package dogtransferhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogtransferdto "1dv027/aad/internal/dto/dog/transfer"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CancelDogTransferService interface {
+	CancelTransfer(ctx context.Context, dogIdParam, transferIdParam string, data dogtransferdto.DogTransferDecisionDTO,
+		credentials dto.UserCredentials) (dogtransferdto.DogTransferDTO, error)
+}
+
+type CancelDogTransferHandler struct {
+	service CancelDogTransferService
+}
+
+func NewCancelDogTransferHandler(service CancelDogTransferService) CancelDogTransferHandler {
+	return CancelDogTransferHandler{
+		service: service,
+	}
+}
+
+// Handle cancels a pending transfer of a dog.
+// @Summary Cancel a dog transfer
+// @Description Withdraws the pending transfer. Only available to admins and owners and managers of the source shelter.
+// @Tags dogs
+// @Accept  json
+// @Produce  json
+// @Param   id          path      integer  true   "Dog ID"
+// @Param   transferId  path      integer  true   "Transfer ID"
+// @Param   payload     body      dogtransferdto.DogTransferDecisionDTO  false  "Optional reason"
+// @Success 200  {object}  dogtransferdto.DogTransferDTO  "OK, returns the transfer"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an ID parameter or the body is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester may not cancel the transfer"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no transfer of the dog matches the provided ID"
+// @Failure 409  {object}  dto.ErrorResponse "Conflict, if the transfer is no longer pending"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/transfers/{transferId}/cancel [post]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (h CancelDogTransferHandler) Handle(c *fiber.Ctx) error {
+	decisionDto, err := parseDecisionBody(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request body. visit documentation for endpoint information.",
+		})
+	}
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	transfer, err := h.service.CancelTransfer(c.Context(), c.Params("id"), c.Params("transferId"), decisionDto, userCredentials)
+	if err != nil {
+		return handleDogTransferError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(transfer)
+}
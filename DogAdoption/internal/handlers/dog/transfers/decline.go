@@ -0,0 +1,58 @@
+package dogtransferhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogtransferdto "1dv027/aad/internal/dto/dog/transfer"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type DeclineDogTransferService interface {
+	DeclineTransfer(ctx context.Context, dogIdParam, transferIdParam string, data dogtransferdto.DogTransferDecisionDTO,
+		credentials dto.UserCredentials) (dogtransferdto.DogTransferDTO, error)
+}
+
+type DeclineDogTransferHandler struct {
+	service DeclineDogTransferService
+}
+
+func NewDeclineDogTransferHandler(service DeclineDogTransferService) DeclineDogTransferHandler {
+	return DeclineDogTransferHandler{
+		service: service,
+	}
+}
+
+// Handle declines a pending transfer of a dog.
+// @Summary Decline a dog transfer
+// @Description Declines the pending transfer, the dog stays at its current shelter. Only available to admins and owners and managers of the destination shelter.
+// @Tags dogs
+// @Accept  json
+// @Produce  json
+// @Param   id          path      integer  true   "Dog ID"
+// @Param   transferId  path      integer  true   "Transfer ID"
+// @Param   payload     body      dogtransferdto.DogTransferDecisionDTO  false  "Optional reason"
+// @Success 200  {object}  dogtransferdto.DogTransferDTO  "OK, returns the transfer"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an ID parameter or the body is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester may not decline the transfer"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no transfer of the dog matches the provided ID"
+// @Failure 409  {object}  dto.ErrorResponse "Conflict, if the transfer is no longer pending"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/transfers/{transferId}/decline [post]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (d DeclineDogTransferHandler) Handle(c *fiber.Ctx) error {
+	decisionDto, err := parseDecisionBody(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request body. visit documentation for endpoint information.",
+		})
+	}
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	transfer, err := d.service.DeclineTransfer(c.Context(), c.Params("id"), c.Params("transferId"), decisionDto, userCredentials)
+	if err != nil {
+		return handleDogTransferError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(transfer)
+}
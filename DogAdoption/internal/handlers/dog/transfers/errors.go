@@ -0,0 +1,62 @@
+package dogtransferhandler
+
+import (
+	dogtransferdto "1dv027/aad/internal/dto/dog/transfer"
+	customerrors "1dv027/aad/internal/errors"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// handleDogTransferError maps the errors of the dog transfer endpoints to responses.
+func handleDogTransferError(c *fiber.Ctx, err error) error {
+	var integerConversionError *customerrors.IntegerConversionError
+	if errors.As(err, &integerConversionError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "id parameters must be numbers",
+		})
+	}
+	var invalidDataError *customerrors.InvalidDogTransferDataError
+	if errors.As(err, &invalidDataError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": invalidDataError.Message,
+		})
+	}
+	var unauthorizedError *customerrors.UnauthorizedError
+	if errors.As(err, &unauthorizedError) {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "unauthorized to perform action",
+		})
+	}
+	var dogNotFound *customerrors.DogNotFoundError
+	if errors.As(err, &dogNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "dog not found",
+		})
+	}
+	var transferNotFound *customerrors.DogTransferNotFoundError
+	if errors.As(err, &transferNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "transfer not found",
+		})
+	}
+	var conflictError *customerrors.DogTransferConflictError
+	if errors.As(err, &conflictError) {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": conflictError.Message,
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": "something went wrong internally. try again later.",
+	})
+}
+
+// parseDecisionBody reads the optional reason of a decline or cancel.
+func parseDecisionBody(c *fiber.Ctx) (dogtransferdto.DogTransferDecisionDTO, error) {
+	var decisionDto dogtransferdto.DogTransferDecisionDTO
+	if len(c.Body()) == 0 {
+		return decisionDto, nil
+	}
+	err := c.BodyParser(&decisionDto)
+	return decisionDto, err
+}
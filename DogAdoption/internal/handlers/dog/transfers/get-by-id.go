@@ -0,0 +1,47 @@
+package dogtransferhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogtransferdto "1dv027/aad/internal/dto/dog/transfer"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetDogTransferByIdService interface {
+	GetTransfer(ctx context.Context, dogIdParam, transferIdParam string, credentials dto.UserCredentials) (dogtransferdto.DogTransferDTO, error)
+}
+
+type GetDogTransferByIdHandler struct {
+	service GetDogTransferByIdService
+}
+
+func NewGetDogTransferByIdHandler(service GetDogTransferByIdService) GetDogTransferByIdHandler {
+	return GetDogTransferByIdHandler{
+		service: service,
+	}
+}
+
+// Handle returns a transfer of a dog.
+// @Summary Get a dog transfer
+// @Description Returns the transfer. Available to admins and members of the source and destination shelters.
+// @Tags dogs
+// @Produce  json
+// @Param   id          path      integer  true  "Dog ID"
+// @Param   transferId  path      integer  true  "Transfer ID"
+// @Success 200  {object}  dogtransferdto.DogTransferDTO  "OK, returns the transfer"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an ID parameter is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester may not view the transfer"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no transfer of the dog matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/transfers/{transferId} [get]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (g GetDogTransferByIdHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	transfer, err := g.service.GetTransfer(c.Context(), c.Params("id"), c.Params("transferId"), userCredentials)
+	if err != nil {
+		return handleDogTransferError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(transfer)
+}
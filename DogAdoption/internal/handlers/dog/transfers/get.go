@@ -0,0 +1,46 @@
+package dogtransferhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogtransferdto "1dv027/aad/internal/dto/dog/transfer"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetDogTransfersService interface {
+	GetTransfers(ctx context.Context, dogIdParam string, credentials dto.UserCredentials) (dogtransferdto.DogTransferListDTO, error)
+}
+
+type GetDogTransfersHandler struct {
+	service GetDogTransfersService
+}
+
+func NewGetDogTransfersHandler(service GetDogTransfersService) GetDogTransfersHandler {
+	return GetDogTransfersHandler{
+		service: service,
+	}
+}
+
+// Handle returns the transfer history of a dog.
+// @Summary Get the transfer history of a dog
+// @Description Returns all transfers of the dog, oldest first. Only available to admins and members of the dog's current shelter.
+// @Tags dogs
+// @Produce  json
+// @Param   id   path      integer  true  "Dog ID"
+// @Success 200  {object}  dogtransferdto.DogTransferListDTO  "OK, returns the transfers"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester may not view the transfers"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no dog matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/transfers [get]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (g GetDogTransfersHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	transfers, err := g.service.GetTransfers(c.Context(), c.Params("id"), userCredentials)
+	if err != nil {
+		return handleDogTransferError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(transfers)
+}
@@ -0,0 +1,58 @@
+package dogtransferhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogtransferdto "1dv027/aad/internal/dto/dog/transfer"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ProposeDogTransferService interface {
+	ProposeTransfer(ctx context.Context, dogIdParam string, data dogtransferdto.NewDogTransferDTO,
+		credentials dto.UserCredentials) (dogtransferdto.DogTransferDTO, error)
+}
+
+type ProposeDogTransferHandler struct {
+	service ProposeDogTransferService
+}
+
+func NewProposeDogTransferHandler(service ProposeDogTransferService) ProposeDogTransferHandler {
+	return ProposeDogTransferHandler{
+		service: service,
+	}
+}
+
+// Handle proposes to transfer a dog to another shelter.
+// @Summary Propose a dog transfer
+// @Description Proposes to move the dog to another dog shelter. The dog stays at its current shelter until the destination accepts. Only available to admins and owners and managers of the dog's shelter. A dog can only have one pending transfer.
+// @Tags dogs
+// @Accept  json
+// @Produce  json
+// @Param   id       path      integer  true  "Dog ID"
+// @Param   payload  body      dogtransferdto.NewDogTransferDTO  true  "Destination shelter and an optional note"
+// @Success 201  {object}  dogtransferdto.DogTransferDTO  "Created, returns the transfer"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter or body is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester may not transfer the dog"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no dog matches the provided ID"
+// @Failure 409  {object}  dto.ErrorResponse "Conflict, if the dog already has a pending transfer or is adopted or deceased"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /dogs/{id}/transfers [post]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (p ProposeDogTransferHandler) Handle(c *fiber.Ctx) error {
+	var transferDto dogtransferdto.NewDogTransferDTO
+	err := c.BodyParser(&transferDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request body. visit documentation for endpoint information.",
+		})
+	}
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	transfer, err := p.service.ProposeTransfer(c.Context(), c.Params("id"), transferDto, userCredentials)
+	if err != nil {
+		return handleDogTransferError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(transfer)
+}
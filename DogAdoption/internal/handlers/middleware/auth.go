@@ -2,6 +2,9 @@ package middleware
 
 import (
 	"1dv027/aad/internal/dto"
+	"1dv027/aad/internal/model"
+	"context"
+	"slices"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
@@ -11,39 +14,160 @@ type JwtService interface {
 	ValidateToken(jwt string) (dto.UserCredentials, error)
 }
 
+type ApiKeyService interface {
+	ValidateApiKey(ctx context.Context, key string) (dto.UserCredentials, error)
+}
+
+type UserStatusService interface {
+	IsUserSuspended(ctx context.Context, userId int) (bool, error)
+}
+
+type ShelterMembershipService interface {
+	GetShelterMembership(ctx context.Context, staffId int) (model.ShelterStaff, error)
+}
+
 type AuthMiddleware struct {
-	jwtService JwtService
+	jwtService        JwtService
+	apiKeyService     ApiKeyService
+	userStatusService UserStatusService
+	membershipService ShelterMembershipService
 }
 
-func NewAuthMiddleware(jwtService JwtService) AuthMiddleware {
+func NewAuthMiddleware(jwtService JwtService, apiKeyService ApiKeyService, userStatusService UserStatusService,
+	membershipService ShelterMembershipService) AuthMiddleware {
 	return AuthMiddleware{
-		jwtService: jwtService,
+		jwtService:        jwtService,
+		apiKeyService:     apiKeyService,
+		userStatusService: userStatusService,
+		membershipService: membershipService,
 	}
 }
 
+// AuthenticateRequest authenticates requests with a full access token, or an api key in the
+// X-API-Key header. Tokens that were only issued for completing a required MFA enrollment are
+// rejected, as are requests from suspended users. Shelter staff are resolved to their current shelter membership
+// on every request, so that removed staff lose access at once. Scoped credentials must have the scope of the requested resource.
 func (a AuthMiddleware) AuthenticateRequest(c *fiber.Ctx) error {
-	authHeader := c.Get("Authorization")
-	if authHeader == "" {
+	var userData dto.UserCredentials
+	var errMessage string
+	if apiKey := c.Get("X-API-Key"); apiKey != "" {
+		userData, errMessage = a.validateApiKey(c, apiKey)
+	} else {
+		userData, errMessage = a.validateBearerToken(c)
+	}
+	if errMessage != "" {
 		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"error": "authorization header is required",
+			"error": errMessage,
 		})
 	}
+	if userData.MfaEnrollmentRequired {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "mfa enrollment is required before accessing this resource",
+		})
+	}
+	if userData.UserRole == model.USER {
+		isSuspended, err := a.userStatusService.IsUserSuspended(c.Context(), userData.Id)
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+		if isSuspended {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "account is suspended",
+			})
+		}
+	}
+	switch userData.UserRole {
+	case model.DOGSHELTER:
+		userData.ShelterId = userData.Id
+		userData.ShelterStaffRole = model.STAFF_OWNER
+	case model.SHELTERSTAFF:
+		membership, err := a.membershipService.GetShelterMembership(c.Context(), userData.Id)
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+		userData.ShelterId = membership.ShelterId
+		userData.ShelterStaffRole = membership.Role
+	}
+	if userData.Scopes != nil {
+		requiredScope := a.getRequiredScope(c)
+		if !slices.Contains(userData.Scopes, requiredScope) {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "insufficient scope, requires " + requiredScope,
+			})
+		}
+	}
+	c.Locals("user", userData)
+	return c.Next()
+}
 
-	bearerToken := strings.Split(authHeader, " ")
-	if len(bearerToken) != 2 || bearerToken[0] != "Bearer" {
+// AuthenticateOptionalRequest lets anonymous requests through without credentials, and authenticates
+// requests that provide a token or an api key like AuthenticateRequest.
+func (a AuthMiddleware) AuthenticateOptionalRequest(c *fiber.Ctx) error {
+	if c.Get("X-API-Key") == "" && c.Get("Authorization") == "" {
+		return c.Next()
+	}
+	return a.AuthenticateRequest(c)
+}
+
+// AuthenticateMfaEnrollmentRequest authenticates requests to the MFA enrollment endpoints, which
+// also accept tokens that were only issued for completing a required MFA enrollment.
+func (a AuthMiddleware) AuthenticateMfaEnrollmentRequest(c *fiber.Ctx) error {
+	userData, errMessage := a.validateBearerToken(c)
+	if errMessage != "" {
 		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"error": "invalid authorization header format",
+			"error": errMessage,
 		})
 	}
+	c.Locals("user", userData)
+	return c.Next()
+}
+
+// validateBearerToken returns the credentials of the bearer token, or an error message
+// when the authorization header is missing or invalid.
+func (a AuthMiddleware) validateBearerToken(c *fiber.Ctx) (dto.UserCredentials, string) {
+	authHeader := c.Get("Authorization")
+	if authHeader == "" {
+		return dto.UserCredentials{}, "authorization header is required"
+	}
+
+	bearerToken := strings.Split(authHeader, " ")
+	if len(bearerToken) != 2 || bearerToken[0] != "Bearer" {
+		return dto.UserCredentials{}, "invalid authorization header format"
+	}
 
 	token := bearerToken[1]
 
 	userData, err := a.jwtService.ValidateToken(token)
 	if err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"error": "unauthorized",
-		})
+		return dto.UserCredentials{}, "unauthorized"
 	}
-	c.Locals("user", userData)
-	return c.Next()
+	return userData, ""
+}
+
+func (a AuthMiddleware) validateApiKey(c *fiber.Ctx, apiKey string) (dto.UserCredentials, string) {
+	userData, err := a.apiKeyService.ValidateApiKey(c.Context(), apiKey)
+	if err != nil {
+		return dto.UserCredentials{}, "invalid api key"
+	}
+	return userData, ""
+}
+
+// getRequiredScope returns the scope needed for the request, made up of the first path
+// segment after /api/v1 and read access for GET requests or write access otherwise.
+func (a AuthMiddleware) getRequiredScope(c *fiber.Ctx) string {
+	resource := c.Path()
+	if index := strings.Index(resource, "/api/v1/"); index != -1 {
+		resource = resource[index+len("/api/v1/"):]
+	}
+	resource = strings.Split(strings.Trim(resource, "/"), "/")[0]
+
+	access := "write"
+	if c.Method() == fiber.MethodGet || c.Method() == fiber.MethodHead {
+		access = "read"
+	}
+	return resource + ":" + access
 }
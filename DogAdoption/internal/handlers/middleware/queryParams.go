@@ -2,8 +2,13 @@ package middleware
 
 import (
 	"1dv027/aad/internal/dto"
+	"1dv027/aad/internal/model"
 	"fmt"
+	"math"
+	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -38,6 +43,41 @@ func (q QueryParamsValidator) ValidateQueryParams(c *fiber.Ctx) error {
 		})
 	}
 
+	usersParams, err := q.validateUsersParams(queryParams)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	shelterRegistrationParams, err := q.validateShelterRegistrationParams(queryParams)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	geoParams, err := q.validateGeoParams(queryParams)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	breedsParams, err := q.validateBreedsParams(queryParams)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	notificationsParams, err := q.validateNotificationsParams(queryParams)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
 	if len(queryParams) > 0 {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"error": "Invalid query params supplied. Please check documentation for valid query params.",
@@ -45,9 +85,14 @@ func (q QueryParamsValidator) ValidateQueryParams(c *fiber.Ctx) error {
 	}
 
 	queryParamsDto := dto.QueryParams{
-		Pagination:       &paginationParams,
-		DogsFilter:       &dogFilterParams,
-		DogShelterFilter: &dogShelterParams,
+		Pagination:                &paginationParams,
+		DogsFilter:                &dogFilterParams,
+		DogShelterFilter:          &dogShelterParams,
+		UsersFilter:               &usersParams,
+		ShelterRegistrationFilter: &shelterRegistrationParams,
+		GeoFilter:                 geoParams,
+		BreedsFilter:              &breedsParams,
+		NotificationsFilter:       &notificationsParams,
 	}
 
 	c.Locals("queryParams", queryParamsDto)
@@ -92,10 +137,16 @@ func (q QueryParamsValidator) validateDogsParams(query map[string]string) (dto.D
 	dogFilterParams := dto.DogsFilterParams{}
 
 	breed := query["breed"]
+	breedId := query["breed-id"]
 	gender := query["gender"]
 	isNeutered := query["is-neutered"]
 	isAdopted := query["is-adopted"]
+	status := query["status"]
 	shelterId := query["shelter-id"]
+	vaccinated := query["vaccinated"]
+	goodWith := query["good-with"]
+	energyLevel := query["energy-level"]
+	dogSize := query["dog-size"]
 
 	if breed != "" {
 		if len(breed) > 64 {
@@ -105,6 +156,15 @@ func (q QueryParamsValidator) validateDogsParams(query map[string]string) (dto.D
 		delete(query, "breed")
 	}
 
+	if breedId != "" {
+		breedIdInt, err := strconv.Atoi(breedId)
+		if err != nil {
+			return dogFilterParams, fmt.Errorf("breed-id must be a number")
+		}
+		dogFilterParams.BreedId = &breedIdInt
+		delete(query, "breed-id")
+	}
+
 	if gender != "" {
 		if gender != "male" && gender != "female" {
 			return dogFilterParams, fmt.Errorf("invalid gender value. only male and female are accepted")
@@ -129,22 +189,134 @@ func (q QueryParamsValidator) validateDogsParams(query map[string]string) (dto.D
 		delete(query, "is-adopted")
 	}
 
+	if status != "" {
+		for _, statusValue := range strings.Split(status, ",") {
+			statusValue = strings.TrimSpace(statusValue)
+			_, ok := model.StringToDogStatus(statusValue)
+			if !ok {
+				return dogFilterParams, fmt.Errorf("invalid status value: %s", statusValue)
+			}
+			if !slices.Contains(dogFilterParams.Statuses, statusValue) {
+				dogFilterParams.Statuses = append(dogFilterParams.Statuses, statusValue)
+			}
+		}
+		delete(query, "status")
+	}
+
+	if vaccinated != "" {
+		if vaccinated != "true" && vaccinated != "false" {
+			return dogFilterParams, fmt.Errorf("invalid vaccinated value. only true and false are accepted")
+		}
+		isVaccinated := vaccinated == "true"
+		dogFilterParams.IsVaccinated = &isVaccinated
+		delete(query, "vaccinated")
+	}
+
+	if goodWith != "" {
+		groups, err := q.parseListParam(goodWith, func(group string) bool {
+			return slices.Contains(model.CompatibilityGroups, group)
+		})
+		if err != nil {
+			return dogFilterParams, fmt.Errorf("invalid good-with value: %s. only children, dogs, cats and small-animals are accepted", err.Error())
+		}
+		dogFilterParams.GoodWith = groups
+		delete(query, "good-with")
+	}
+
+	if energyLevel != "" {
+		energyLevels, err := q.parseListParam(energyLevel, func(level string) bool {
+			_, ok := model.StringToEnergyLevel(level)
+			return ok
+		})
+		if err != nil {
+			return dogFilterParams, fmt.Errorf("invalid energy-level value: %s. only low, medium and high are accepted", err.Error())
+		}
+		dogFilterParams.EnergyLevels = energyLevels
+		delete(query, "energy-level")
+	}
+
+	if dogSize != "" {
+		sizes, err := q.parseListParam(dogSize, func(size string) bool {
+			_, ok := model.StringToBreedSizeGroup(size)
+			return ok
+		})
+		if err != nil {
+			return dogFilterParams, fmt.Errorf("invalid dog-size value: %s. only toy, small, medium, large and giant are accepted", err.Error())
+		}
+		dogFilterParams.Sizes = sizes
+		delete(query, "dog-size")
+	}
+
 	if shelterId != "" {
 		shelterIdInt, err := strconv.Atoi(shelterId)
 		if err != nil {
 			return dogFilterParams, fmt.Errorf("shelter-id must be a number")
 		}
 		dogFilterParams.ShelterId = &shelterIdInt
+		delete(query, "shelter-id")
 	}
 	return dogFilterParams, nil
 }
 
+// ParseDogsQuery validates a GET /dogs query string the same way as the query params of a request. It is
+// used for saved searches, which are matched against dogs without pagination, so only the dog and distance
+// filters are accepted.
+func (q QueryParamsValidator) ParseDogsQuery(rawQuery string) (dto.QueryParams, error) {
+	values, err := url.ParseQuery(strings.TrimPrefix(rawQuery, "?"))
+	if err != nil {
+		return dto.QueryParams{}, fmt.Errorf("query is not a valid query string")
+	}
+	query := map[string]string{}
+	for key := range values {
+		query[key] = values.Get(key)
+	}
+
+	dogFilterParams, err := q.validateDogsParams(query)
+	if err != nil {
+		return dto.QueryParams{}, err
+	}
+	geoParams, err := q.validateGeoParams(query)
+	if err != nil {
+		return dto.QueryParams{}, err
+	}
+	if len(query) > 0 {
+		invalidParams := []string{}
+		for key := range query {
+			invalidParams = append(invalidParams, key)
+		}
+		slices.Sort(invalidParams)
+		return dto.QueryParams{}, fmt.Errorf("query params %s cannot be used in a search", strings.Join(invalidParams, ", "))
+	}
+
+	return dto.QueryParams{
+		DogsFilter: &dogFilterParams,
+		GeoFilter:  geoParams,
+	}, nil
+}
+
+// parseListParam splits a comma separated param into its distinct values. The error is the first
+// value that is not valid.
+func (q QueryParamsValidator) parseListParam(param string, isValid func(value string) bool) ([]string, error) {
+	var values []string
+	for _, value := range strings.Split(param, ",") {
+		value = strings.TrimSpace(value)
+		if !isValid(value) {
+			return nil, fmt.Errorf("%s", value)
+		}
+		if !slices.Contains(values, value) {
+			values = append(values, value)
+		}
+	}
+	return values, nil
+}
+
 func (q QueryParamsValidator) validateDogShelterParams(query map[string]string) (dto.DogShelterFilterParams, error) {
 	dogShelterFilterParams := dto.DogShelterFilterParams{}
 
 	country := query["country"]
 	city := query["city"]
 	name := query["name"]
+	openNow := query["open-now"]
 
 	if country != "" {
 		if len(country) > 65 {
@@ -170,5 +342,155 @@ func (q QueryParamsValidator) validateDogShelterParams(query map[string]string)
 		delete(query, "name")
 	}
 
+	if openNow != "" {
+		if openNow != "true" && openNow != "false" {
+			return dogShelterFilterParams, fmt.Errorf("invalid open-now value. only true and false are accepted")
+		}
+		isOpenNow := openNow == "true"
+		dogShelterFilterParams.OpenNow = &isOpenNow
+		delete(query, "open-now")
+	}
+
 	return dogShelterFilterParams, nil
 }
+
+func (q QueryParamsValidator) validateUsersParams(query map[string]string) (dto.UsersFilterParams, error) {
+	usersFilterParams := dto.UsersFilterParams{}
+
+	usernamePrefix := query["username-prefix"]
+	status := query["account-status"]
+
+	if usernamePrefix != "" {
+		if len(usernamePrefix) > 65 {
+			return usersFilterParams, fmt.Errorf("value for username-prefix is too long")
+		}
+		usersFilterParams.UsernamePrefix = &usernamePrefix
+		delete(query, "username-prefix")
+	}
+
+	if status != "" {
+		if status != string(model.USER_ACTIVE) && status != string(model.USER_SUSPENDED) {
+			return usersFilterParams, fmt.Errorf("invalid account-status value. only active and suspended are accepted")
+		}
+		usersFilterParams.Status = &status
+		delete(query, "account-status")
+	}
+
+	return usersFilterParams, nil
+}
+
+func (q QueryParamsValidator) validateShelterRegistrationParams(query map[string]string) (dto.ShelterRegistrationFilterParams, error) {
+	shelterRegistrationFilterParams := dto.ShelterRegistrationFilterParams{}
+
+	status := query["registration-status"]
+
+	if status != "" {
+		if status != string(model.SHELTER_PENDING) && status != string(model.SHELTER_APPROVED) && status != string(model.SHELTER_REJECTED) {
+			return shelterRegistrationFilterParams, fmt.Errorf("invalid registration-status value. only pending, approved and rejected are accepted")
+		}
+		shelterRegistrationFilterParams.Status = &status
+		delete(query, "registration-status")
+	}
+
+	return shelterRegistrationFilterParams, nil
+}
+
+func (q QueryParamsValidator) validateBreedsParams(query map[string]string) (dto.BreedsFilterParams, error) {
+	breedsFilterParams := dto.BreedsFilterParams{}
+
+	search := query["search"]
+	sizeGroup := query["size-group"]
+	fciGroup := query["fci-group"]
+
+	if search != "" {
+		if len(search) > 64 {
+			return breedsFilterParams, fmt.Errorf("value for search is too long")
+		}
+		breedsFilterParams.Search = &search
+		delete(query, "search")
+	}
+
+	if sizeGroup != "" {
+		_, ok := model.StringToBreedSizeGroup(sizeGroup)
+		if !ok {
+			return breedsFilterParams, fmt.Errorf("invalid size-group value. only toy, small, medium, large and giant are accepted")
+		}
+		breedsFilterParams.SizeGroup = &sizeGroup
+		delete(query, "size-group")
+	}
+
+	if fciGroup != "" {
+		fciGroupInt, err := strconv.Atoi(fciGroup)
+		if err != nil || fciGroupInt < 1 || fciGroupInt > 10 {
+			return breedsFilterParams, fmt.Errorf("invalid fci-group value. it must be a number between 1 and 10")
+		}
+		breedsFilterParams.FciGroup = &fciGroupInt
+		delete(query, "fci-group")
+	}
+
+	return breedsFilterParams, nil
+}
+
+func (q QueryParamsValidator) validateNotificationsParams(query map[string]string) (dto.NotificationsFilterParams, error) {
+	notificationsFilterParams := dto.NotificationsFilterParams{}
+
+	unread := query["unread"]
+
+	if unread != "" {
+		if unread != "true" && unread != "false" {
+			return notificationsFilterParams, fmt.Errorf("invalid unread value. only true and false are accepted")
+		}
+		isUnread := unread == "true"
+		notificationsFilterParams.Unread = &isUnread
+		delete(query, "unread")
+	}
+
+	return notificationsFilterParams, nil
+}
+
+// validateGeoParams returns nil when no distance search is requested. The near param is formatted as lat,lng.
+func (q QueryParamsValidator) validateGeoParams(query map[string]string) (*dto.GeoFilterParams, error) {
+	near := query["near"]
+	radiusKm := query["radius-km"]
+
+	if near == "" {
+		if radiusKm != "" {
+			return nil, fmt.Errorf("radius-km can only be used together with near")
+		}
+		return nil, nil
+	}
+
+	coordinates := strings.Split(near, ",")
+	if len(coordinates) != 2 {
+		return nil, fmt.Errorf("invalid near value. use the format lat,lng")
+	}
+	// ParseFloat accepts NaN and Inf, which pass every range check, so they are rejected explicitly.
+	latitude, err := strconv.ParseFloat(strings.TrimSpace(coordinates[0]), 64)
+	if err != nil || !isFinite(latitude) || latitude < -90 || latitude > 90 {
+		return nil, fmt.Errorf("invalid near latitude. it must be a number between -90 and 90")
+	}
+	longitude, err := strconv.ParseFloat(strings.TrimSpace(coordinates[1]), 64)
+	if err != nil || !isFinite(longitude) || longitude < -180 || longitude > 180 {
+		return nil, fmt.Errorf("invalid near longitude. it must be a number between -180 and 180")
+	}
+	geoFilterParams := dto.GeoFilterParams{
+		Latitude:  latitude,
+		Longitude: longitude,
+	}
+	delete(query, "near")
+
+	if radiusKm != "" {
+		radius, err := strconv.ParseFloat(radiusKm, 64)
+		if err != nil || !isFinite(radius) || radius <= 0 || radius > 20040 {
+			return nil, fmt.Errorf("invalid radius-km value. it must be a positive number of kilometers")
+		}
+		geoFilterParams.RadiusKm = &radius
+		delete(query, "radius-km")
+	}
+
+	return &geoFilterParams, nil
+}
+
+func isFinite(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
@@ -0,0 +1,73 @@
+package oauthhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	oauthdto "1dv027/aad/internal/dto/oauth"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetAuthorizeService interface {
+	GetConsentPrompt(ctx context.Context, credentials dto.UserCredentials, request oauthdto.AuthorizeRequestDTO) (oauthdto.ConsentPromptDTO, error)
+}
+
+type GetAuthorizeHandler struct {
+	service GetAuthorizeService
+}
+
+func NewGetAuthorizeHandler(service GetAuthorizeService) GetAuthorizeHandler {
+	return GetAuthorizeHandler{
+		service: service,
+	}
+}
+
+// Handle validates an authorization request and returns what the user is asked to consent to.
+// @Summary Start an oauth authorization
+// @Description Validates an authorization code request for the authenticated user and returns the client and scopes to consent to. PKCE with code_challenge_method S256 is required. The decision is sent with POST /oauth/authorize.
+// @Tags oauth
+// @Produce  json
+// @Security BearerAuth
+// @Param   response_type          query     string  true   "Must be code"
+// @Param   client_id              query     string  true   "Client ID"
+// @Param   redirect_uri           query     string  false  "Registered redirect uri, optional if the client has only one"
+// @Param   scope                  query     string  false  "Space delimited scopes, defaults to all scopes of the client"
+// @Param   state                  query     string  false  "Opaque value returned in the redirect"
+// @Param   code_challenge         query     string  true   "PKCE code challenge"
+// @Param   code_challenge_method  query     string  true   "Must be S256"
+// @Success 200  {object}  oauthdto.ConsentPromptDTO  "Success, returns the consent prompt"
+// @Failure 400  {object}  oauthdto.OAuthErrorResponse "Bad Request, if the authorization request is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the account is not a user"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /oauth/authorize [get]
+func (g GetAuthorizeHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	var request oauthdto.AuthorizeRequestDTO
+	err := c.QueryParser(&request)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request. visit documentation for more endpoint information.",
+		})
+	}
+
+	prompt, err := g.service.GetConsentPrompt(c.Context(), userCredentials, request)
+	if err != nil {
+		var oauthError *customerrors.OAuthError
+		if errors.As(err, &oauthError) {
+			return sendOAuthError(c, oauthError)
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(prompt)
+}
@@ -0,0 +1,68 @@
+package oauthhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	oauthdto "1dv027/aad/internal/dto/oauth"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PostAuthorizeService interface {
+	Authorize(ctx context.Context, credentials dto.UserCredentials, decision oauthdto.AuthorizeDecisionDTO) (oauthdto.AuthorizeResultDTO, error)
+}
+
+type PostAuthorizeHandler struct {
+	service PostAuthorizeService
+}
+
+func NewPostAuthorizeHandler(service PostAuthorizeService) PostAuthorizeHandler {
+	return PostAuthorizeHandler{
+		service: service,
+	}
+}
+
+// Handle records the consent decision of the user.
+// @Summary Approve or deny an oauth authorization
+// @Description Records the decision of the authenticated user for an authorization request. When approved, the consent is saved and the returned redirect uri contains an authorization code valid for 10 minutes, otherwise it contains error=access_denied.
+// @Tags oauth
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param   decision  body      oauthdto.AuthorizeDecisionDTO  true  "The authorization request parameters and the decision"
+// @Success 200  {object}  oauthdto.AuthorizeResultDTO  "Success, returns the uri to redirect the user to"
+// @Failure 400  {object}  oauthdto.OAuthErrorResponse "Bad Request, if the authorization request is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the account is not a user"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /oauth/authorize [post]
+func (p PostAuthorizeHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	var decision oauthdto.AuthorizeDecisionDTO
+	err := c.BodyParser(&decision)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request. visit documentation for more endpoint information.",
+		})
+	}
+
+	result, err := p.service.Authorize(c.Context(), userCredentials, decision)
+	if err != nil {
+		var oauthError *customerrors.OAuthError
+		if errors.As(err, &oauthError) {
+			return sendOAuthError(c, oauthError)
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(result)
+}
@@ -0,0 +1,62 @@
+package oauthhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type DeleteOAuthClientService interface {
+	DeleteClient(ctx context.Context, clientIdParam string, credentials dto.UserCredentials) error
+}
+
+type DeleteOAuthClientHandler struct {
+	service DeleteOAuthClientService
+}
+
+func NewDeleteOAuthClientHandler(service DeleteOAuthClientService) DeleteOAuthClientHandler {
+	return DeleteOAuthClientHandler{
+		service: service,
+	}
+}
+
+// Handle deletes an oauth client.
+// @Summary Delete an oauth client
+// @Description Deletes an oauth client together with its consents and refresh tokens. Admin only.
+// @Tags oauth
+// @Produce  json
+// @Security BearerAuth
+// @Param   clientId  path      string  true  "Client ID"
+// @Success 204  "Client deleted successfully"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the account is not an admin"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no client with the id exists"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /oauth/clients/{clientId} [delete]
+func (d DeleteOAuthClientHandler) Handle(c *fiber.Ctx) error {
+	clientIdParam := c.Params("clientId")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	err := d.service.DeleteClient(c.Context(), clientIdParam, userCredentials)
+	if err != nil {
+		var clientNotFoundError *customerrors.OAuthClientNotFoundError
+		if errors.As(err, &clientNotFoundError) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "no oauth client found",
+			})
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later.",
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
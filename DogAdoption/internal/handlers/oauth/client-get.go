@@ -0,0 +1,54 @@
+package oauthhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	oauthdto "1dv027/aad/internal/dto/oauth"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetOAuthClientsService interface {
+	GetClients(ctx context.Context, credentials dto.UserCredentials) ([]oauthdto.OAuthClientDTO, error)
+}
+
+type GetOAuthClientsHandler struct {
+	service GetOAuthClientsService
+}
+
+func NewGetOAuthClientsHandler(service GetOAuthClientsService) GetOAuthClientsHandler {
+	return GetOAuthClientsHandler{
+		service: service,
+	}
+}
+
+// Handle lists the registered oauth clients.
+// @Summary List oauth clients
+// @Description Lists all registered oauth clients. Admin only.
+// @Tags oauth
+// @Produce  json
+// @Security BearerAuth
+// @Success 200  {array}   oauthdto.OAuthClientDTO  "Success, returns the clients"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the account is not an admin"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /oauth/clients [get]
+func (g GetOAuthClientsHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	clients, err := g.service.GetClients(c.Context(), userCredentials)
+	if err != nil {
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(clients)
+}
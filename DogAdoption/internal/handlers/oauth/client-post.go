@@ -0,0 +1,70 @@
+package oauthhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	oauthdto "1dv027/aad/internal/dto/oauth"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PostOAuthClientService interface {
+	RegisterClient(ctx context.Context, credentials dto.UserCredentials, data oauthdto.NewOAuthClientDTO) (oauthdto.CreatedOAuthClientDTO, error)
+}
+
+type PostOAuthClientHandler struct {
+	service PostOAuthClientService
+}
+
+func NewPostOAuthClientHandler(service PostOAuthClientService) PostOAuthClientHandler {
+	return PostOAuthClientHandler{
+		service: service,
+	}
+}
+
+// Handle registers a new oauth client.
+// @Summary Register an oauth client
+// @Description Registers a third party application as an oauth client. Confidential clients get a client secret that is only shown in this response. The client_credentials grant requires a confidential client with an owner_user_id, the account the client acts as. Admin only.
+// @Tags oauth
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param   client  body      oauthdto.NewOAuthClientDTO  true  "Client data"
+// @Success 201  {object}  oauthdto.CreatedOAuthClientDTO  "Success, returns the client including the client secret"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the JSON body cannot be parsed or the client data is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the account is not an admin"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /oauth/clients [post]
+func (p PostOAuthClientHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	var newClientDto oauthdto.NewOAuthClientDTO
+	err := c.BodyParser(&newClientDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request. visit documentation for more endpoint information.",
+		})
+	}
+
+	clientDto, err := p.service.RegisterClient(c.Context(), userCredentials, newClientDto)
+	if err != nil {
+		var invalidClientDataError *customerrors.InvalidOAuthClientDataError
+		if errors.As(err, &invalidClientDataError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": invalidClientDataError.Message,
+			})
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.Status(fiber.StatusCreated).JSON(clientDto)
+}
@@ -0,0 +1,71 @@
+package oauthhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type DeleteOAuthConsentService interface {
+	DeleteConsent(ctx context.Context, idParam string, clientIdParam string, credentials dto.UserCredentials) error
+}
+
+type DeleteOAuthConsentHandler struct {
+	service DeleteOAuthConsentService
+}
+
+func NewDeleteOAuthConsentHandler(service DeleteOAuthConsentService) DeleteOAuthConsentHandler {
+	return DeleteOAuthConsentHandler{
+		service: service,
+	}
+}
+
+// Handle withdraws the consent given to an oauth client.
+// @Summary Withdraw an oauth consent
+// @Description Withdraws the consent given to an oauth client and revokes its refresh tokens. Access tokens already issued stay valid until they expire.
+// @Tags users/{id}/oauth-consents
+// @Produce  json
+// @Security BearerAuth
+// @Param   id        path      integer  true  "User ID"
+// @Param   clientId  path      string   true  "Client ID"
+// @Success 204  "Consent withdrawn successfully"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the id is not a number"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the user credentials do not match or are invalid"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if there is no consent for the client"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/oauth-consents/{clientId} [delete]
+func (d DeleteOAuthConsentHandler) Handle(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	clientIdParam := c.Params("clientId")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	err := d.service.DeleteConsent(c.Context(), idParam, clientIdParam, userCredentials)
+	if err != nil {
+		var integerConversionError *customerrors.IntegerConversionError
+		if errors.As(err, &integerConversionError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "id param must be a number",
+			})
+		}
+		var consentNotFoundError *customerrors.OAuthConsentNotFoundError
+		if errors.As(err, &consentNotFoundError) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "no consent found",
+			})
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later.",
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
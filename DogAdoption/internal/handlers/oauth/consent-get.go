@@ -0,0 +1,70 @@
+package oauthhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	oauthdto "1dv027/aad/internal/dto/oauth"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetOAuthConsentsService interface {
+	GetConsents(ctx context.Context, idParam string, credentials dto.UserCredentials) ([]oauthdto.OAuthConsentDTO, error)
+}
+
+type GetOAuthConsentsHandler struct {
+	service GetOAuthConsentsService
+}
+
+func NewGetOAuthConsentsHandler(service GetOAuthConsentsService) GetOAuthConsentsHandler {
+	return GetOAuthConsentsHandler{
+		service: service,
+	}
+}
+
+// Handle lists the oauth clients the user has given consent to.
+// @Summary List oauth consents
+// @Description Lists the oauth clients the user has authorized and the scopes they were granted.
+// @Tags users/{id}/oauth-consents
+// @Produce  json
+// @Security BearerAuth
+// @Param   id   path      integer  true  "User ID"
+// @Success 200  {array}   oauthdto.OAuthConsentDTO  "Success, returns the consents"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the id is not a number"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the user credentials do not match or are invalid"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the specified user does not exist"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/oauth-consents [get]
+func (g GetOAuthConsentsHandler) Handle(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	consents, err := g.service.GetConsents(c.Context(), idParam, userCredentials)
+	if err != nil {
+		var integerConversionError *customerrors.IntegerConversionError
+		if errors.As(err, &integerConversionError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "id param must be a number",
+			})
+		}
+		var userNotFoundError *customerrors.UserNotFoundError
+		if errors.As(err, &userNotFoundError) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "resource not found.",
+			})
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(consents)
+}
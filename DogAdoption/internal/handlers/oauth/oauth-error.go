@@ -0,0 +1,19 @@
+package oauthhandler
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// sendOAuthError responds with the error format of RFC 6749.
+func sendOAuthError(c *fiber.Ctx, oauthError *customerrors.OAuthError) error {
+	status := fiber.StatusBadRequest
+	if oauthError.Code == "invalid_client" {
+		status = fiber.StatusUnauthorized
+	}
+	return c.Status(status).JSON(fiber.Map{
+		"error":             oauthError.Code,
+		"error_description": oauthError.Message,
+	})
+}
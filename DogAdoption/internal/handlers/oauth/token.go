@@ -0,0 +1,94 @@
+package oauthhandler
+
+import (
+	oauthdto "1dv027/aad/internal/dto/oauth"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type TokenService interface {
+	IssueToken(ctx context.Context, request oauthdto.TokenRequestDTO) (oauthdto.TokenResponseDTO, error)
+}
+
+type TokenHandler struct {
+	service TokenService
+}
+
+func NewTokenHandler(service TokenService) TokenHandler {
+	return TokenHandler{
+		service: service,
+	}
+}
+
+// Handle issues oauth tokens.
+// @Summary Issue oauth tokens
+// @Description Issues an access token for the authorization_code, refresh_token and client_credentials grants. Confidential clients authenticate with client_id and client_secret in the body or with HTTP Basic authentication. Refresh tokens are rotated on every use.
+// @Tags oauth
+// @Accept  x-www-form-urlencoded,json
+// @Produce  json
+// @Param   grant_type     formData  string  true   "authorization_code, refresh_token or client_credentials"
+// @Param   code           formData  string  false  "Authorization code, for the authorization_code grant"
+// @Param   redirect_uri   formData  string  false  "Redirect uri used in the authorization request, required if it was included in the authorization request"
+// @Param   code_verifier  formData  string  false  "PKCE code verifier, for the authorization_code grant"
+// @Param   refresh_token  formData  string  false  "Refresh token, for the refresh_token grant"
+// @Param   client_id      formData  string  false  "Client ID, unless HTTP Basic authentication is used"
+// @Param   client_secret  formData  string  false  "Client secret for confidential clients, unless HTTP Basic authentication is used"
+// @Param   scope          formData  string  false  "Space delimited scopes to narrow the token to"
+// @Success 200  {object}  oauthdto.TokenResponseDTO  "Success, returns the tokens"
+// @Failure 400  {object}  oauthdto.OAuthErrorResponse "Bad Request, if the token request is invalid"
+// @Failure 401  {object}  oauthdto.OAuthErrorResponse "Unauthorized, if the client authentication failed"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /oauth/token [post]
+func (t TokenHandler) Handle(c *fiber.Ctx) error {
+	var request oauthdto.TokenRequestDTO
+	err := c.BodyParser(&request)
+	if err != nil {
+		return sendOAuthError(c, &customerrors.OAuthError{Code: "invalid_request", Message: "the request body could not be parsed"})
+	}
+	t.applyBasicAuthentication(c, &request)
+
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	tokenResponse, err := t.service.IssueToken(c.Context(), request)
+	if err != nil {
+		var oauthError *customerrors.OAuthError
+		if errors.As(err, &oauthError) {
+			return sendOAuthError(c, oauthError)
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(tokenResponse)
+}
+
+// applyBasicAuthentication uses the client credentials of a HTTP Basic authorization header.
+func (t TokenHandler) applyBasicAuthentication(c *fiber.Ctx, request *oauthdto.TokenRequestDTO) {
+	authHeader := c.Get(fiber.HeaderAuthorization)
+	encoded, found := strings.CutPrefix(authHeader, "Basic ")
+	if !found {
+		return
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return
+	}
+	clientId, clientSecret, found := strings.Cut(string(decoded), ":")
+	if !found {
+		return
+	}
+	if unescaped, err := url.QueryUnescape(clientId); err == nil {
+		clientId = unescaped
+	}
+	if unescaped, err := url.QueryUnescape(clientSecret); err == nil {
+		clientSecret = unescaped
+	}
+	request.ClientId = clientId
+	request.ClientSecret = clientSecret
+}
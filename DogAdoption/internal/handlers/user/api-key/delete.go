@@ -0,0 +1,72 @@
+package userapikeyhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	userapikeydto "1dv027/aad/internal/dto/user/api-key"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type DeleteUserApiKeyService interface {
+	RevokeApiKey(ctx context.Context, idParam string, keyIdParam string, user dto.UserCredentials) (userapikeydto.ApiKeyDTO, error)
+}
+
+type DeleteUserApiKeyHandler struct {
+	service DeleteUserApiKeyService
+}
+
+func NewDeleteUserApiKeyHandler(service DeleteUserApiKeyService) DeleteUserApiKeyHandler {
+	return DeleteUserApiKeyHandler{
+		service: service,
+	}
+}
+
+// Handle revokes an api key of the user.
+// @Summary Revoke an api key
+// @Description Revokes an api key of the user. Revoked keys can no longer be used but are still listed.
+// @Tags users/{id}/api-keys
+// @Produce  json
+// @Security BearerAuth
+// @Param   id      path      integer  true  "User ID"
+// @Param   keyId   path      integer  true  "Api key ID"
+// @Success 200  {object}  userapikeydto.ApiKeyDTO  "Success, returns the revoked api key"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an id is not a number"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the user credentials do not match or are invalid"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if there is no active api key with the id"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/api-keys/{keyId} [delete]
+func (d DeleteUserApiKeyHandler) Handle(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	keyIdParam := c.Params("keyId")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	apiKey, err := d.service.RevokeApiKey(c.Context(), idParam, keyIdParam, userCredentials)
+	if err != nil {
+		var integerConversionError *customerrors.IntegerConversionError
+		if errors.As(err, &integerConversionError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "id params must be numbers",
+			})
+		}
+		var apiKeyNotFoundError *customerrors.ApiKeyNotFoundError
+		if errors.As(err, &apiKeyNotFoundError) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "no active api key found",
+			})
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later.",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(apiKey)
+}
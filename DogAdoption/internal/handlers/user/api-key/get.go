@@ -0,0 +1,70 @@
+package userapikeyhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	userapikeydto "1dv027/aad/internal/dto/user/api-key"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetUserApiKeysService interface {
+	GetApiKeys(ctx context.Context, idParam string, user dto.UserCredentials) ([]userapikeydto.ApiKeyDTO, error)
+}
+
+type GetUserApiKeysHandler struct {
+	service GetUserApiKeysService
+}
+
+func NewGetUserApiKeysHandler(service GetUserApiKeysService) GetUserApiKeysHandler {
+	return GetUserApiKeysHandler{
+		service: service,
+	}
+}
+
+// Handle lists the api keys of the user.
+// @Summary List api keys
+// @Description Lists the api keys of the user, including revoked keys and when each key was last used. The keys themselves are never returned.
+// @Tags users/{id}/api-keys
+// @Produce  json
+// @Security BearerAuth
+// @Param   id   path      integer  true  "User ID"
+// @Success 200  {array}   userapikeydto.ApiKeyDTO  "Success, returns the api keys"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the id is not a number"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the user credentials do not match or are invalid"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the specified user does not exist"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/api-keys [get]
+func (g GetUserApiKeysHandler) Handle(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	apiKeys, err := g.service.GetApiKeys(c.Context(), idParam, userCredentials)
+	if err != nil {
+		var integerConversionError *customerrors.IntegerConversionError
+		if errors.As(err, &integerConversionError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "id param must be a number",
+			})
+		}
+		var userNotFoundError *customerrors.UserNotFoundError
+		if errors.As(err, &userNotFoundError) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "resource not found.",
+			})
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(apiKeys)
+}
@@ -0,0 +1,85 @@
+package userapikeyhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	userapikeydto "1dv027/aad/internal/dto/user/api-key"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PostUserApiKeyService interface {
+	CreateApiKey(ctx context.Context, idParam string, user dto.UserCredentials, data userapikeydto.NewApiKeyDTO) (userapikeydto.CreatedApiKeyDTO, error)
+}
+
+type PostUserApiKeyHandler struct {
+	service PostUserApiKeyService
+}
+
+func NewPostUserApiKeyHandler(service PostUserApiKeyService) PostUserApiKeyHandler {
+	return PostUserApiKeyHandler{
+		service: service,
+	}
+}
+
+// Handle creates a new api key for the user.
+// @Summary Create a new api key
+// @Description Creates a long lived api key for machine integrations. The key is only shown in this response and is sent in the X-API-Key header. Valid scopes are dogs:read, dogs:write, dogshelters:read, dogshelters:write, users:read and users:write.
+// @Tags users/{id}/api-keys
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param   id   path      integer  true  "User ID"
+// @Param   apikey  body      userapikeydto.NewApiKeyDTO  true  "Api key data"
+// @Success 201  {object}  userapikeydto.CreatedApiKeyDTO  "Success, returns the api key including the plain text key"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the JSON body cannot be parsed or the api key data is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the user credentials do not match or are invalid"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the specified user does not exist"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/api-keys [post]
+func (p PostUserApiKeyHandler) Handle(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	var newApiKeyDto userapikeydto.NewApiKeyDTO
+	err := c.BodyParser(&newApiKeyDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request. visit documentation for more endpoint information.",
+		})
+	}
+
+	apiKeyDto, err := p.service.CreateApiKey(c.Context(), idParam, userCredentials, newApiKeyDto)
+	if err != nil {
+		var integerConversionError *customerrors.IntegerConversionError
+		if errors.As(err, &integerConversionError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "id param must be a number",
+			})
+		}
+		var invalidApiKeyDataError *customerrors.InvalidApiKeyDataError
+		if errors.As(err, &invalidApiKeyDataError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": invalidApiKeyDataError.Message,
+			})
+		}
+		var userNotFoundError *customerrors.UserNotFoundError
+		if errors.As(err, &userNotFoundError) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "resource not found.",
+			})
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.Status(fiber.StatusCreated).JSON(apiKeyDto)
+}
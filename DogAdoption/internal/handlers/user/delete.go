@@ -25,7 +25,7 @@ func NewDeleteUserHandler(service DeleteUserService) DeleteUserHandler {
 
 // Handle deletes a specific user by ID.
 // @Summary Delete a user
-// @Description Deletes a user identified by its unique ID, provided the requester has the necessary permissions.
+// @Description Deletes a user identified by its unique ID, provided the requester has the necessary permissions. The personal data of the user, its credentials and integrations are erased, and the account is kept anonymized for records that must be retained.
 // @Tags users
 // @Accept  json
 // @Produce  json
@@ -37,6 +37,7 @@ func NewDeleteUserHandler(service DeleteUserService) DeleteUserHandler {
 // @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
 // @Router /users/{id} [delete]
 // @Security BearerAuth
+// @Security ApiKeyAuth
 func (d DeleteUserHandler) Handle(c *fiber.Ctx) error {
 	idParam := c.Params("id")
 	userCredentials := c.Locals("user").(dto.UserCredentials)
@@ -0,0 +1,74 @@
+package useremailverificationhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ResendEmailVerificationService interface {
+	ResendVerificationEmail(ctx context.Context, idParam string, credentials dto.UserCredentials) error
+}
+
+type ResendEmailVerificationHandler struct {
+	service ResendEmailVerificationService
+}
+
+func NewResendEmailVerificationHandler(service ResendEmailVerificationService) ResendEmailVerificationHandler {
+	return ResendEmailVerificationHandler{
+		service: service,
+	}
+}
+
+// Handle sends a new verification email.
+// @Summary Resend verification email
+// @Description Sends a new verification token to the email of the authenticated user, which invalidates earlier tokens. Nothing is sent if the email is already verified.
+// @Tags users
+// @Produce  json
+// @Param   id   path      integer  true  "User ID"
+// @Success 202  "Accepted, the verification email is sent"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter is not a number or the user has no email"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not the user"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no user matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/email-verification [post]
+// @Security BearerAuth
+func (r ResendEmailVerificationHandler) Handle(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	err := r.service.ResendVerificationEmail(c.Context(), idParam, userCredentials)
+	if err != nil {
+		var integerConversionError *customerrors.IntegerConversionError
+		if errors.As(err, &integerConversionError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "id parameter must be a number",
+			})
+		}
+		var invalidUserDataError *customerrors.InvalidNewUserDataError
+		if errors.As(err, &invalidUserDataError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": invalidUserDataError.Message,
+			})
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized to perform action",
+			})
+		}
+		var userNotFound *customerrors.UserNotFoundError
+		if errors.As(err, &userNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "user not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later.",
+		})
+	}
+	return c.SendStatus(fiber.StatusAccepted)
+}
@@ -0,0 +1,59 @@
+package useremailverificationhandler
+
+import (
+	userdto "1dv027/aad/internal/dto/user"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type VerifyEmailService interface {
+	VerifyEmail(ctx context.Context, data userdto.VerifyEmailDTO) error
+}
+
+type VerifyEmailHandler struct {
+	service VerifyEmailService
+}
+
+func NewVerifyEmailHandler(service VerifyEmailService) VerifyEmailHandler {
+	return VerifyEmailHandler{
+		service: service,
+	}
+}
+
+// Handle verifies the email of a user.
+// @Summary Verify email
+// @Description Verifies the email of a user with the token from the verification email. The token can only be used once, and expires after 24 hours.
+// @Tags users
+// @Accept  json
+// @Produce  json
+// @Param   payload  body      userdto.VerifyEmailDTO  true  "Verification token"
+// @Success 204  "No Content, the email is verified"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the body cannot be parsed or the token is invalid, used or expired"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/email-verification [post]
+func (v VerifyEmailHandler) Handle(c *fiber.Ctx) error {
+	var verifyDto userdto.VerifyEmailDTO
+	err := c.BodyParser(&verifyDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request. visit documentation for more endpoint information.",
+		})
+	}
+
+	err = v.service.VerifyEmail(c.Context(), verifyDto)
+	if err != nil {
+		var tokenNotFoundError *customerrors.EmailVerificationTokenNotFoundError
+		if errors.As(err, &tokenNotFoundError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "verification token is invalid or expired",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,74 @@
+package userhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	userdto "1dv027/aad/internal/dto/user"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ExportUserService interface {
+	ExportUser(ctx context.Context, idParam string, credentials dto.UserCredentials) (userdto.UserExportDTO, error)
+}
+
+type ExportUserHandler struct {
+	service ExportUserService
+}
+
+func NewExportUserHandler(service ExportUserService) ExportUserHandler {
+	return ExportUserHandler{
+		service: service,
+	}
+}
+
+// Handle exports the data held about a user.
+// @Summary Export user data
+// @Description Returns a JSON archive of the data held about the user: the profile, the webhook, api keys, owned oauth clients, oauth consents, whether MFA is enabled, favorites, saved searches and in-app notifications. Secrets and hashes are not included. Available to the user itself and admins, but not with api keys or oauth tokens.
+// @Tags users
+// @Accept  json
+// @Produce  json
+// @Param   id   path      integer  true  "User ID"
+// @Success 200  {object}  userdto.UserExportDTO  "Success, returns the archive as an attachment"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter format is incorrect"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not the user or an admin"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no user matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/export [get]
+// @Security BearerAuth
+func (e ExportUserHandler) Handle(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	export, err := e.service.ExportUser(c.Context(), idParam, userCredentials)
+	if err != nil {
+		var integerConversionError *customerrors.IntegerConversionError
+		if errors.As(err, &integerConversionError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "id parameter must be a number",
+			})
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized to perform action",
+			})
+		}
+		var userNotFound *customerrors.UserNotFoundError
+		if errors.As(err, &userNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "user not found",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later.",
+		})
+	}
+
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="user-%d-export.json"`, export.Profile.Id))
+	return c.Status(fiber.StatusOK).JSON(export)
+}
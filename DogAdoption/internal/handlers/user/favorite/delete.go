@@ -0,0 +1,47 @@
+package userfavoritehandler
+
+import (
+	"1dv027/aad/internal/dto"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type RemoveFavoriteService interface {
+	RemoveFavorite(ctx context.Context, idParam, dogIdParam string, credentials dto.UserCredentials) error
+}
+
+type DeleteFavoriteHandler struct {
+	service RemoveFavoriteService
+}
+
+func NewDeleteFavoriteHandler(service RemoveFavoriteService) DeleteFavoriteHandler {
+	return DeleteFavoriteHandler{
+		service: service,
+	}
+}
+
+// Handle removes a dog from the favorites of the user.
+// @Summary Remove a favorite dog
+// @Description Removes the dog from the favorites of the user. Only the user itself can remove favorites.
+// @Tags users/{id}/favorites
+// @Produce  json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param   id      path      integer  true  "User ID"
+// @Param   dogId   path      integer  true  "Dog ID"
+// @Success 204  "No Content, the dog is removed from the favorites"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an id is not a number"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the request is not made by the user"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the dog is not a favorite of the user"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/favorites/{dogId} [delete]
+func (d DeleteFavoriteHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	err := d.service.RemoveFavorite(c.Context(), c.Params("id"), c.Params("dogId"), userCredentials)
+	if err != nil {
+		return handleFavoriteError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,44 @@
+package userfavoritehandler
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func handleFavoriteError(c *fiber.Ctx, err error) error {
+	var integerConversionError *customerrors.IntegerConversionError
+	if errors.As(err, &integerConversionError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "id params must be numbers",
+		})
+	}
+	var unauthorizedError *customerrors.UnauthorizedError
+	if errors.As(err, &unauthorizedError) {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "unauthorized",
+		})
+	}
+	var userNotFoundError *customerrors.UserNotFoundError
+	if errors.As(err, &userNotFoundError) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "user not found",
+		})
+	}
+	var dogNotFoundError *customerrors.DogNotFoundError
+	if errors.As(err, &dogNotFoundError) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "dog not found",
+		})
+	}
+	var favoriteNotFoundError *customerrors.FavoriteNotFoundError
+	if errors.As(err, &favoriteNotFoundError) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "the dog is not a favorite of the user",
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": "something went wrong internally. try again later.",
+	})
+}
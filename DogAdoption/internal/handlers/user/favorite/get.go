@@ -0,0 +1,52 @@
+package userfavoritehandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogdto "1dv027/aad/internal/dto/dog"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetFavoritesService interface {
+	GetFavorites(ctx context.Context, idParam string, credentials dto.UserCredentials,
+		queryParams dto.QueryParams) (dogdto.DogsAndPaginationLinksDTO, error)
+}
+
+type GetFavoritesHandler struct {
+	service GetFavoritesService
+}
+
+func NewGetFavoritesHandler(service GetFavoritesService) GetFavoritesHandler {
+	return GetFavoritesHandler{
+		service: service,
+	}
+}
+
+// Handle retrieves the favorite dogs of the user.
+// @Summary Get the favorite dogs of a user
+// @Description Retrieves a page of the favorite dogs of the user. The dog filters of GET /dogs can be used. Only available to the user itself and admins.
+// @Tags users/{id}/favorites
+// @Produce  json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param   id      path      integer  true  "User ID"
+// @Param   page   query     integer  false  "Page of favorites"
+// @Param   limit   query     integer  false  "Favorites per page"
+// @Param   status     query     string     false  "Filter by status, several statuses can be given separated by commas"
+// @Success 200  {object}  dogdto.DogsAndPaginationLinksDTO  "Success, returns the favorite dogs along with pagination details"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the id is not a number or the query parameters are invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the request is not made by the user or an admin"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the user does not exist"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/favorites [get]
+func (g GetFavoritesHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	queryParamsDto := c.Locals("queryParams").(dto.QueryParams)
+
+	favorites, err := g.service.GetFavorites(c.Context(), c.Params("id"), userCredentials, queryParamsDto)
+	if err != nil {
+		return handleFavoriteError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(favorites)
+}
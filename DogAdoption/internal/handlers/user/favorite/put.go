@@ -0,0 +1,47 @@
+package userfavoritehandler
+
+import (
+	"1dv027/aad/internal/dto"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AddFavoriteService interface {
+	AddFavorite(ctx context.Context, idParam, dogIdParam string, credentials dto.UserCredentials) error
+}
+
+type PutFavoriteHandler struct {
+	service AddFavoriteService
+}
+
+func NewPutFavoriteHandler(service AddFavoriteService) PutFavoriteHandler {
+	return PutFavoriteHandler{
+		service: service,
+	}
+}
+
+// Handle adds a dog to the favorites of the user.
+// @Summary Add a favorite dog
+// @Description Saves the dog to the favorites of the user. Adding a dog that already is a favorite changes nothing. The user is notified when the status of a favorite dog changes, with a notification and the favorite_status_changed webhook. Only the user itself can add favorites.
+// @Tags users/{id}/favorites
+// @Produce  json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param   id      path      integer  true  "User ID"
+// @Param   dogId   path      integer  true  "Dog ID"
+// @Success 204  "No Content, the dog is a favorite of the user"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an id is not a number"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the request is not made by the user"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the user or the dog does not exist"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/favorites/{dogId} [put]
+func (p PutFavoriteHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	err := p.service.AddFavorite(c.Context(), c.Params("id"), c.Params("dogId"), userCredentials)
+	if err != nil {
+		return handleFavoriteError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
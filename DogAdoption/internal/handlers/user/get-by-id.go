@@ -0,0 +1,75 @@
+package userhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	userdto "1dv027/aad/internal/dto/user"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetUserByIdService interface {
+	GetUserById(ctx context.Context, idParam string, credentials dto.UserCredentials) (userdto.UserDTO, error)
+}
+
+type GetUserByIdHandler struct {
+	service GetUserByIdService
+}
+
+func NewGetUserByIdHandler(service GetUserByIdService) GetUserByIdHandler {
+	return GetUserByIdHandler{
+		service: service,
+	}
+}
+
+// Handle retrieves the profile of a specific user.
+// @Summary Get a user profile
+// @Description Retrieves the profile of a user. Users can read their own profile and admins any profile. Dog shelters can read the adopter profile of any user, without email and phone.
+// @Tags users
+// @Accept  json
+// @Produce  json
+// @Param   id   path      integer  true  "User ID"
+// @Success 200  {object}  userdto.UserDTO  "Success, returns the user profile"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter format is incorrect"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not allowed to read the profile"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no user matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id} [get]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (g GetUserByIdHandler) Handle(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	userDto, err := g.service.GetUserById(c.Context(), idParam, userCredentials)
+	if err != nil {
+		var integerConversionError *customerrors.IntegerConversionError
+		if errors.As(err, &integerConversionError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "id parameter must be a number",
+			})
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized to perform action",
+			})
+		}
+		var userNotFound *customerrors.UserNotFoundError
+		if errors.As(err, &userNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "user not found",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later.",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"user": userDto,
+	})
+}
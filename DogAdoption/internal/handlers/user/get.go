@@ -0,0 +1,60 @@
+package userhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	userdto "1dv027/aad/internal/dto/user"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetUsersService interface {
+	GetUsers(ctx context.Context, credentials dto.UserCredentials, queryParams dto.QueryParams) (userdto.UsersAndPaginationLinksDTO, error)
+}
+
+type GetUsersHandler struct {
+	service GetUsersService
+}
+
+func NewGetUsersHandler(service GetUsersService) GetUsersHandler {
+	return GetUsersHandler{
+		service: service,
+	}
+}
+
+// Handle retrieves users based on query parameters.
+// @Summary Get users
+// @Description Retrieves a paginated list of users, only available to admins.
+// @Tags users
+// @Accept  json
+// @Produce  json
+// @Param   username-prefix  query     string  false  "Filter by usernames starting with the value, ignoring case"
+// @Param   account-status   query     string  false  "Filter by account status, active or suspended"
+// @Param   page             query     integer false  "Page number"
+// @Param   limit            query     integer false  "Page size"
+// @Success 200  {object}  userdto.UsersAndPaginationLinksDTO  "Success, returns a list of users"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request if the query parameters are invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an admin"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error if an error occurs while processing the request"
+// @Router /users [get]
+// @Security BearerAuth
+func (g GetUsersHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	queryParamsDto := c.Locals("queryParams").(dto.QueryParams)
+
+	getUsersResult, err := g.service.GetUsers(c.Context(), userCredentials, queryParamsDto)
+	if err != nil {
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized to perform action",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later!",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(getUsersResult)
+}
@@ -31,6 +31,7 @@ func NewGetUserMeHandler(service GetUserMeService) GetUserMeHandler {
 // @Accept  json
 // @Produce  json
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Success 200  {object}  userdto.UserDTO  "Success, returns detailed information about the authenticated user"
 // @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the user credentials do not match or are invalid"
 // @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
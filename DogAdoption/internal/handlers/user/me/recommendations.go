@@ -0,0 +1,62 @@
+package usermehandler
+
+import (
+	"1dv027/aad/internal/dto"
+	dogdto "1dv027/aad/internal/dto/dog"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetUserMeRecommendationsService interface {
+	RecommendDogs(ctx context.Context, user dto.UserCredentials, queryParams dto.QueryParams) (dogdto.DogRecommendationsAndPaginationLinksDTO, error)
+}
+
+type GetUserMeRecommendationsHandler struct {
+	service GetUserMeRecommendationsService
+}
+
+func NewGetUserMeRecommendationsHandler(service GetUserMeRecommendationsService) GetUserMeRecommendationsHandler {
+	return GetUserMeRecommendationsHandler{
+		service: service,
+	}
+}
+
+// Handle recommends available dogs to the authenticated user.
+// @Summary Get dog recommendations
+// @Description Scores the available dogs against the household and preferences of the authenticated user, and returns them with the best matches first. Each recommendation explains the score of every criterion: children, other_pets, size, energy, distance, fee, breed, gender and age. Criteria that the user has not answered are not applicable and do not count. The distance is measured from near, or else from the city of the user.
+// @Tags users
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param   page   query     integer  false  "Page of recommendations"
+// @Param   limit   query     integer  false  "Recommendations per page"
+// @Param   near   query     string  false  "Measure the distance from a point formatted as lat,lng instead of the city of the user"
+// @Param   radius-km   query     number  false  "Only recommend dogs within this many kilometers of near"
+// @Success 200  {object}  dogdto.DogRecommendationsAndPaginationLinksDTO  "Success, returns the recommended dogs along with pagination details"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request if the query parameters are invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the request is not made by a user"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/me/recommendations [get]
+func (g GetUserMeRecommendationsHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	queryParamsDto := c.Locals("queryParams").(dto.QueryParams)
+
+	recommendations, err := g.service.RecommendDogs(c.Context(), userCredentials, queryParamsDto)
+	if err != nil {
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later.",
+		})
+	}
+	return c.Status(fiber.StatusOK).JSON(recommendations)
+}
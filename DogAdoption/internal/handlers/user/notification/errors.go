@@ -0,0 +1,38 @@
+package usernotificationhandler
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func handleNotificationError(c *fiber.Ctx, err error) error {
+	var integerConversionError *customerrors.IntegerConversionError
+	if errors.As(err, &integerConversionError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "id params must be numbers",
+		})
+	}
+	var unauthorizedError *customerrors.UnauthorizedError
+	if errors.As(err, &unauthorizedError) {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "unauthorized",
+		})
+	}
+	var userNotFoundError *customerrors.UserNotFoundError
+	if errors.As(err, &userNotFoundError) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "user not found",
+		})
+	}
+	var notificationNotFoundError *customerrors.NotificationNotFoundError
+	if errors.As(err, &notificationNotFoundError) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "notification not found",
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": "something went wrong internally. try again later.",
+	})
+}
@@ -0,0 +1,52 @@
+package usernotificationhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	usernotificationdto "1dv027/aad/internal/dto/user/notification"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetNotificationsService interface {
+	GetNotifications(ctx context.Context, idParam string, credentials dto.UserCredentials,
+		queryParams dto.QueryParams) (usernotificationdto.NotificationsAndPaginationLinksDTO, error)
+}
+
+type GetNotificationsHandler struct {
+	service GetNotificationsService
+}
+
+func NewGetNotificationsHandler(service GetNotificationsService) GetNotificationsHandler {
+	return GetNotificationsHandler{
+		service: service,
+	}
+}
+
+// Handle retrieves the notifications of the user.
+// @Summary Get the notifications of a user
+// @Description Retrieves a page of the in-app notifications of the user, newest first. The notifications are created from the same events as the webhooks: new and transferred dogs for every active user, the status changes of the favorite dogs of the user, and the matches of saved searches with the inbox channel. The data of a notification is the payload of its webhook without the secret. Only available to the user itself.
+// @Tags users/{id}/notifications
+// @Produce  json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param   id      path      integer  true  "User ID"
+// @Param   page    query     integer  false  "Page of notifications"
+// @Param   limit   query     integer  false  "Notifications per page"
+// @Param   unread  query     boolean  false  "Only unread notifications if true, only read notifications if false"
+// @Success 200  {object}  usernotificationdto.NotificationsAndPaginationLinksDTO  "Success, returns the notifications along with pagination details"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the id is not a number or the query parameters are invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the request is not made by the user"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the user does not exist"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/notifications [get]
+func (g GetNotificationsHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+	queryParamsDto := c.Locals("queryParams").(dto.QueryParams)
+
+	notifications, err := g.service.GetNotifications(c.Context(), c.Params("id"), userCredentials, queryParamsDto)
+	if err != nil {
+		return handleNotificationError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(notifications)
+}
@@ -0,0 +1,46 @@
+package usernotificationhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ReadAllNotificationsService interface {
+	MarkAllRead(ctx context.Context, idParam string, credentials dto.UserCredentials) error
+}
+
+type ReadAllNotificationsHandler struct {
+	service ReadAllNotificationsService
+}
+
+func NewReadAllNotificationsHandler(service ReadAllNotificationsService) ReadAllNotificationsHandler {
+	return ReadAllNotificationsHandler{
+		service: service,
+	}
+}
+
+// Handle marks all notifications of the user as read.
+// @Summary Mark all notifications as read
+// @Description Marks every unread notification of the user as read. Only available to the user itself.
+// @Tags users/{id}/notifications
+// @Produce  json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param   id      path      integer  true  "User ID"
+// @Success 204  "No Content, the notifications are marked as read"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the id is not a number"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the request is not made by the user"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the user does not exist"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/notifications/read [put]
+func (r ReadAllNotificationsHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	err := r.service.MarkAllRead(c.Context(), c.Params("id"), userCredentials)
+	if err != nil {
+		return handleNotificationError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
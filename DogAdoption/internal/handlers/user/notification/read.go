@@ -0,0 +1,49 @@
+package usernotificationhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	usernotificationdto "1dv027/aad/internal/dto/user/notification"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ReadNotificationService interface {
+	MarkRead(ctx context.Context, idParam, notificationIdParam string,
+		credentials dto.UserCredentials) (usernotificationdto.NotificationDTO, error)
+}
+
+type ReadNotificationHandler struct {
+	service ReadNotificationService
+}
+
+func NewReadNotificationHandler(service ReadNotificationService) ReadNotificationHandler {
+	return ReadNotificationHandler{
+		service: service,
+	}
+}
+
+// Handle marks a notification of the user as read.
+// @Summary Mark a notification as read
+// @Description Marks the notification as read. Marking a notification that is already read keeps the time it was first read. Only available to the user itself.
+// @Tags users/{id}/notifications
+// @Produce  json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param   id              path  integer  true  "User ID"
+// @Param   notificationId  path  integer  true  "Notification ID"
+// @Success 200  {object}  usernotificationdto.NotificationDTO  "OK, returns the notification"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an id is not a number"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the request is not made by the user"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the user has no notification with the id"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/notifications/{notificationId}/read [put]
+func (r ReadNotificationHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	notification, err := r.service.MarkRead(c.Context(), c.Params("id"), c.Params("notificationId"), userCredentials)
+	if err != nil {
+		return handleNotificationError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(notification)
+}
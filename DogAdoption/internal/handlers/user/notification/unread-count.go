@@ -0,0 +1,47 @@
+package usernotificationhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	usernotificationdto "1dv027/aad/internal/dto/user/notification"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetUnreadCountService interface {
+	GetUnreadCount(ctx context.Context, idParam string, credentials dto.UserCredentials) (usernotificationdto.UnreadCountDTO, error)
+}
+
+type GetUnreadCountHandler struct {
+	service GetUnreadCountService
+}
+
+func NewGetUnreadCountHandler(service GetUnreadCountService) GetUnreadCountHandler {
+	return GetUnreadCountHandler{
+		service: service,
+	}
+}
+
+// Handle retrieves the number of unread notifications of the user.
+// @Summary Get the unread notification count of a user
+// @Description Retrieves the number of unread notifications of the user. Only available to the user itself.
+// @Tags users/{id}/notifications
+// @Produce  json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param   id      path      integer  true  "User ID"
+// @Success 200  {object}  usernotificationdto.UnreadCountDTO  "Success, returns the unread count"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the id is not a number"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the request is not made by the user"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the user does not exist"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/notifications/unread-count [get]
+func (g GetUnreadCountHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	unreadCount, err := g.service.GetUnreadCount(c.Context(), c.Params("id"), userCredentials)
+	if err != nil {
+		return handleNotificationError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(unreadCount)
+}
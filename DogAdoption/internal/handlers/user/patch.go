@@ -0,0 +1,90 @@
+package userhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	userdto "1dv027/aad/internal/dto/user"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PatchUserService interface {
+	UpdateUser(ctx context.Context, idParam string, credentials dto.UserCredentials, data userdto.UpdateUserDTO) (userdto.UserDTO, error)
+}
+
+type PatchUserHandler struct {
+	service PatchUserService
+}
+
+func NewPatchUserHandler(service PatchUserService) PatchUserHandler {
+	return PatchUserHandler{
+		service: service,
+	}
+}
+
+// Handle updates parts of the profile of a user.
+// @Summary Update a user profile
+// @Description Updates the given fields of a user profile. A changed email has to be verified again.
+// @Tags users
+// @Accept  json
+// @Produce  json
+// @Param   id       path      integer                true  "User ID"
+// @Param   payload  body      userdto.UpdateUserDTO  true  "Profile data"
+// @Success 200  {object}  userdto.UserDTO  "Success, returns the updated user profile"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter or the profile data is invalid, or the email is already registered"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not the user or an admin"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no user matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id} [patch]
+// @Security BearerAuth
+// @Security ApiKeyAuth
+func (h PatchUserHandler) Handle(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	var updateDto userdto.UpdateUserDTO
+	err := c.BodyParser(&updateDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request. visit documentation for more endpoint information.",
+		})
+	}
+
+	userDto, err := h.service.UpdateUser(c.Context(), idParam, userCredentials, updateDto)
+	if err != nil {
+		var integerConversionError *customerrors.IntegerConversionError
+		if errors.As(err, &integerConversionError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "id parameter must be a number",
+			})
+		}
+		var invalidDataError *customerrors.InvalidUserProfileDataError
+		if errors.As(err, &invalidDataError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": invalidDataError.Message,
+			})
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized to perform action",
+			})
+		}
+		var userNotFound *customerrors.UserNotFoundError
+		if errors.As(err, &userNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "user not found",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later.",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"user": userDto,
+	})
+}
@@ -26,12 +26,14 @@ func NewPostUserHandler(service PostUserService) PostUserHandler {
 // Handle creates a new user.
 // @Summary Create a new user
 // @Description Adds a new user to the system with the provided user data in JSON format.
+// @Description An email is required, and a verification token is mailed to it. Webhooks can not be created until the email is verified.
+// @Description The password must meet the password policy, and can not be a common or breached password or the same as the username.
 // @Tags users
 // @Accept  json
 // @Produce  json
 // @Param   user  body      userdto.NewUserDTO  true  "New User Data"  "The information for creating a new user"
 // @Success 201  {object}  userdto.UserDTO  "Success, returns the newly created user information"
-// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the request body is incomplete or contains invalid data"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the request body is incomplete, contains invalid data or the password does not meet the password policy"
 // @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if something goes wrong internally"
 // @Router /users [post]
 func (p PostUserHandler) Handle(c *fiber.Ctx) error {
@@ -52,8 +54,18 @@ func (p PostUserHandler) Handle(c *fiber.Ctx) error {
 		}
 		var invalidNewUserData *customerrors.InvalidNewUserDataError
 		if errors.As(err, &invalidNewUserData) {
+			errMessage := "invalid request body. check documentation for endpoint information."
+			if invalidNewUserData.Message != "" {
+				errMessage = invalidNewUserData.Message
+			}
 			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-				"error": "invalid request body. check documentation for endpoint information.",
+				"error": errMessage,
+			})
+		}
+		var weakPassword *customerrors.WeakPasswordError
+		if errors.As(err, &weakPassword) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": weakPassword.Message,
 			})
 		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
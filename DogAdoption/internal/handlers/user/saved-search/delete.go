@@ -0,0 +1,47 @@
+package usersavedsearchhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type DeleteSavedSearchService interface {
+	DeleteSavedSearch(ctx context.Context, idParam, searchIdParam string, credentials dto.UserCredentials) error
+}
+
+type DeleteSavedSearchHandler struct {
+	service DeleteSavedSearchService
+}
+
+func NewDeleteSavedSearchHandler(service DeleteSavedSearchService) DeleteSavedSearchHandler {
+	return DeleteSavedSearchHandler{
+		service: service,
+	}
+}
+
+// Handle deletes a saved search of the user.
+// @Summary Delete a saved search
+// @Description Deletes the saved search. Matches waiting for the daily digest are not sent. Only the user itself can delete saved searches.
+// @Tags users/{id}/saved-searches
+// @Produce  json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param   id        path      integer  true  "User ID"
+// @Param   searchId  path      integer  true  "Saved search ID"
+// @Success 204  "No Content, the saved search is deleted"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an id is not a number"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the request is not made by the user"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the user has no saved search with the id"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/saved-searches/{searchId} [delete]
+func (d DeleteSavedSearchHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	err := d.service.DeleteSavedSearch(c.Context(), c.Params("id"), c.Params("searchId"), userCredentials)
+	if err != nil {
+		return handleSavedSearchError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
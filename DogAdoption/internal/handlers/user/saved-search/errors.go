@@ -0,0 +1,44 @@
+package usersavedsearchhandler
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func handleSavedSearchError(c *fiber.Ctx, err error) error {
+	var integerConversionError *customerrors.IntegerConversionError
+	if errors.As(err, &integerConversionError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "id params must be numbers",
+		})
+	}
+	var invalidDataError *customerrors.InvalidSavedSearchDataError
+	if errors.As(err, &invalidDataError) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": invalidDataError.Message,
+		})
+	}
+	var unauthorizedError *customerrors.UnauthorizedError
+	if errors.As(err, &unauthorizedError) {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "unauthorized",
+		})
+	}
+	var userNotFoundError *customerrors.UserNotFoundError
+	if errors.As(err, &userNotFoundError) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "user not found",
+		})
+	}
+	var savedSearchNotFoundError *customerrors.SavedSearchNotFoundError
+	if errors.As(err, &savedSearchNotFoundError) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "saved search not found",
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": "something went wrong internally. try again later.",
+	})
+}
@@ -0,0 +1,49 @@
+package usersavedsearchhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	usersavedsearchdto "1dv027/aad/internal/dto/user/saved-search"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetSavedSearchService interface {
+	GetSavedSearch(ctx context.Context, idParam, searchIdParam string,
+		credentials dto.UserCredentials) (usersavedsearchdto.SavedSearchDTO, error)
+}
+
+type GetSavedSearchByIdHandler struct {
+	service GetSavedSearchService
+}
+
+func NewGetSavedSearchByIdHandler(service GetSavedSearchService) GetSavedSearchByIdHandler {
+	return GetSavedSearchByIdHandler{
+		service: service,
+	}
+}
+
+// Handle retrieves a saved search of the user.
+// @Summary Get a saved search
+// @Description Retrieves a saved search of the user. The dogs link runs the search against all dogs. Only available to the user itself and admins.
+// @Tags users/{id}/saved-searches
+// @Produce  json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param   id        path      integer  true  "User ID"
+// @Param   searchId  path      integer  true  "Saved search ID"
+// @Success 200  {object}  usersavedsearchdto.SavedSearchDTO  "Success, returns the saved search"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an id is not a number"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the request is not made by the user or an admin"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the user has no saved search with the id"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/saved-searches/{searchId} [get]
+func (g GetSavedSearchByIdHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	savedSearch, err := g.service.GetSavedSearch(c.Context(), c.Params("id"), c.Params("searchId"), userCredentials)
+	if err != nil {
+		return handleSavedSearchError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(savedSearch)
+}
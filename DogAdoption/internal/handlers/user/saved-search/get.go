@@ -0,0 +1,47 @@
+package usersavedsearchhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	usersavedsearchdto "1dv027/aad/internal/dto/user/saved-search"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetSavedSearchesService interface {
+	GetSavedSearches(ctx context.Context, idParam string, credentials dto.UserCredentials) ([]usersavedsearchdto.SavedSearchDTO, error)
+}
+
+type GetSavedSearchesHandler struct {
+	service GetSavedSearchesService
+}
+
+func NewGetSavedSearchesHandler(service GetSavedSearchesService) GetSavedSearchesHandler {
+	return GetSavedSearchesHandler{
+		service: service,
+	}
+}
+
+// Handle retrieves the saved searches of the user.
+// @Summary Get the saved searches of a user
+// @Description Retrieves all saved searches of the user. Only available to the user itself and admins.
+// @Tags users/{id}/saved-searches
+// @Produce  json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param   id      path      integer  true  "User ID"
+// @Success 200  {array}   usersavedsearchdto.SavedSearchDTO  "Success, returns the saved searches"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the id is not a number"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the request is not made by the user or an admin"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the user does not exist"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/saved-searches [get]
+func (g GetSavedSearchesHandler) Handle(c *fiber.Ctx) error {
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	savedSearches, err := g.service.GetSavedSearches(c.Context(), c.Params("id"), userCredentials)
+	if err != nil {
+		return handleSavedSearchError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(savedSearches)
+}
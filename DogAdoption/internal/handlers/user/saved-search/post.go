@@ -0,0 +1,57 @@
+package usersavedsearchhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	usersavedsearchdto "1dv027/aad/internal/dto/user/saved-search"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PostSavedSearchService interface {
+	CreateSavedSearch(ctx context.Context, idParam string, newSearch usersavedsearchdto.NewSavedSearchDTO,
+		credentials dto.UserCredentials) (usersavedsearchdto.SavedSearchDTO, error)
+}
+
+type PostSavedSearchHandler struct {
+	service PostSavedSearchService
+}
+
+func NewPostSavedSearchHandler(service PostSavedSearchService) PostSavedSearchHandler {
+	return PostSavedSearchHandler{
+		service: service,
+	}
+}
+
+// Handle saves a search for the user.
+// @Summary Save a search
+// @Description Saves a GET /dogs query string under a name. The dog filters and the near and radius-km params can be used. When a dog is created or updated and matches the search for the first time, the user is alerted on the channel of the search, either the inbox or the saved_search_match webhook. Instant searches alert for every dog, and daily searches send one digest a day. The channel defaults to inbox and the frequency to instant. Only the user itself can save searches.
+// @Tags users/{id}/saved-searches
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param   id       path  integer  true  "User ID"
+// @Param   payload  body  usersavedsearchdto.NewSavedSearchDTO  true  "The saved search"
+// @Success 201  {object}  usersavedsearchdto.SavedSearchDTO  "Created, returns the saved search"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the id is not a number or the body is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the request is not made by the user"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the user does not exist"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/saved-searches [post]
+func (p PostSavedSearchHandler) Handle(c *fiber.Ctx) error {
+	var newSearchDto usersavedsearchdto.NewSavedSearchDTO
+	err := c.BodyParser(&newSearchDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request body. visit documentation for endpoint information.",
+		})
+	}
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	savedSearch, err := p.service.CreateSavedSearch(c.Context(), c.Params("id"), newSearchDto, userCredentials)
+	if err != nil {
+		return handleSavedSearchError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(savedSearch)
+}
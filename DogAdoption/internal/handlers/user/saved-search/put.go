@@ -0,0 +1,58 @@
+package usersavedsearchhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	usersavedsearchdto "1dv027/aad/internal/dto/user/saved-search"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PutSavedSearchService interface {
+	UpdateSavedSearch(ctx context.Context, idParam, searchIdParam string, update usersavedsearchdto.NewSavedSearchDTO,
+		credentials dto.UserCredentials) (usersavedsearchdto.SavedSearchDTO, error)
+}
+
+type PutSavedSearchHandler struct {
+	service PutSavedSearchService
+}
+
+func NewPutSavedSearchHandler(service PutSavedSearchService) PutSavedSearchHandler {
+	return PutSavedSearchHandler{
+		service: service,
+	}
+}
+
+// Handle updates a saved search of the user.
+// @Summary Update a saved search
+// @Description Changes the provided fields of the saved search. Dogs that already matched the search are not sent again. Only the user itself can update saved searches.
+// @Tags users/{id}/saved-searches
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Param   id        path  integer  true  "User ID"
+// @Param   searchId  path  integer  true  "Saved search ID"
+// @Param   payload   body  usersavedsearchdto.NewSavedSearchDTO  true  "The fields to change"
+// @Success 200  {object}  usersavedsearchdto.SavedSearchDTO  "OK, returns the saved search"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if an id is not a number or the body is invalid"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the request is not made by the user"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if the user has no saved search with the id"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/saved-searches/{searchId} [put]
+func (p PutSavedSearchHandler) Handle(c *fiber.Ctx) error {
+	var updateDto usersavedsearchdto.NewSavedSearchDTO
+	err := c.BodyParser(&updateDto)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "bad request body. visit documentation for endpoint information.",
+		})
+	}
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	savedSearch, err := p.service.UpdateSavedSearch(c.Context(), c.Params("id"), c.Params("searchId"), updateDto, userCredentials)
+	if err != nil {
+		return handleSavedSearchError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(savedSearch)
+}
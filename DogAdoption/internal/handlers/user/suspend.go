@@ -0,0 +1,74 @@
+package userhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	userdto "1dv027/aad/internal/dto/user"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type SuspendUserService interface {
+	SuspendUser(ctx context.Context, idParam string, credentials dto.UserCredentials) (userdto.UserDTO, error)
+}
+
+type SuspendUserHandler struct {
+	service SuspendUserService
+}
+
+func NewSuspendUserHandler(service SuspendUserService) SuspendUserHandler {
+	return SuspendUserHandler{
+		service: service,
+	}
+}
+
+// Handle suspends a user.
+// @Summary Suspend a user
+// @Description Suspends a user. Suspended users can not log in, and their tokens, api keys and oauth clients are rejected until the user is unsuspended. Only available to admins.
+// @Tags users
+// @Accept  json
+// @Produce  json
+// @Param   id   path      integer  true  "User ID"
+// @Success 200  {object}  userdto.UserDTO  "Success, returns the updated user"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter format is incorrect"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an admin"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no user matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/suspension [put]
+// @Security BearerAuth
+func (h SuspendUserHandler) Handle(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	userDto, err := h.service.SuspendUser(c.Context(), idParam, userCredentials)
+	if err != nil {
+		var integerConversionError *customerrors.IntegerConversionError
+		if errors.As(err, &integerConversionError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "id parameter must be a number",
+			})
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized to perform action",
+			})
+		}
+		var userNotFound *customerrors.UserNotFoundError
+		if errors.As(err, &userNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "user not found",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later.",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"user": userDto,
+	})
+}
@@ -0,0 +1,74 @@
+package userhandler
+
+import (
+	"1dv027/aad/internal/dto"
+	userdto "1dv027/aad/internal/dto/user"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type UnsuspendUserService interface {
+	UnsuspendUser(ctx context.Context, idParam string, credentials dto.UserCredentials) (userdto.UserDTO, error)
+}
+
+type UnsuspendUserHandler struct {
+	service UnsuspendUserService
+}
+
+func NewUnsuspendUserHandler(service UnsuspendUserService) UnsuspendUserHandler {
+	return UnsuspendUserHandler{
+		service: service,
+	}
+}
+
+// Handle lifts the suspension of a user.
+// @Summary Unsuspend a user
+// @Description Lifts the suspension of a user. Only available to admins.
+// @Tags users
+// @Accept  json
+// @Produce  json
+// @Param   id   path      integer  true  "User ID"
+// @Success 200  {object}  userdto.UserDTO  "Success, returns the updated user"
+// @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the ID parameter format is incorrect"
+// @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the requester is not an admin"
+// @Failure 404  {object}  dto.ErrorResponse "Not Found, if no user matches the provided ID"
+// @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
+// @Router /users/{id}/suspension [delete]
+// @Security BearerAuth
+func (h UnsuspendUserHandler) Handle(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	userCredentials := c.Locals("user").(dto.UserCredentials)
+
+	userDto, err := h.service.UnsuspendUser(c.Context(), idParam, userCredentials)
+	if err != nil {
+		var integerConversionError *customerrors.IntegerConversionError
+		if errors.As(err, &integerConversionError) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "id parameter must be a number",
+			})
+		}
+		var unauthorizedError *customerrors.UnauthorizedError
+		if errors.As(err, &unauthorizedError) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized to perform action",
+			})
+		}
+		var userNotFound *customerrors.UserNotFoundError
+		if errors.As(err, &userNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "user not found",
+			})
+		}
+
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "something went wrong internally. try again later.",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"user": userDto,
+	})
+}
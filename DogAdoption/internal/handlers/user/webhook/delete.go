@@ -37,6 +37,7 @@ func NewDeleteUserWebhookHandler(service DeleteUserWebhookService) DeleteUserWeb
 // @Failure 500  {object}  dto.ErrorResponse "Internal Server Error - Something went wrong internally, try again later"
 // @Router /users/{id}/webhook [delete]
 // @Security BearerAuth
+// @Security ApiKeyAuth
 func (d DeleteUserWebhookHandler) Handle(c *fiber.Ctx) error {
 	idParam := c.Params("id")
 	userCredentials := c.Locals("user").(dto.UserCredentials)
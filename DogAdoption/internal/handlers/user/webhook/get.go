@@ -36,6 +36,7 @@ func NewGetUserWebhookHandler(service GetUserWebhookService) GetUserWebhookHandl
 // @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the user credentials are invalid or do not grant access to the requested resource"
 // @Router /users/{id}/webhook [get]
 // @Security BearerAuth
+// @Security ApiKeyAuth
 func (g GetUserWebhookHandler) Handle(c *fiber.Ctx) error {
 	idParam := c.Params("id")
 	userCredentials := c.Locals("user").(dto.UserCredentials)
@@ -31,11 +31,13 @@ func NewPostUserWebhookHandler(service PostUserWebhookService) PostUserWebhookHa
 // @Accept  json
 // @Produce  json
 // @Security BearerAuth
+// @Security ApiKeyAuth
 // @Param   id   path      integer  true  "User ID"  "The unique identifier of the user for whom the webhook is being created"
 // @Param   webhook  body      userwebhookdto.NewUserWebhookDTO  true  "Webhook Data"  "The information for the new user webhook"
 // @Success 201  {object}  userwebhookdto.UserWebhookDTO  "Success, returns the newly created user webhook information"
 // @Failure 400  {object}  dto.ErrorResponse "Bad Request, if the JSON body cannot be parsed, mandatory fields are missing, or the webhook data is incomplete"
 // @Failure 401  {object}  dto.ErrorResponse "Unauthorized, if the user credentials do not match or are invalid"
+// @Failure 403  {object}  dto.ErrorResponse "Forbidden, if the email of the user is not verified"
 // @Failure 404  {object}  dto.ErrorResponse "Not Found, if the specified user does not exist"
 // @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
 // @Router /users/{id}/webhook [post]
@@ -70,6 +72,12 @@ func (p PostUserWebhookHandler) Handle(c *fiber.Ctx) error {
 				"error": "resource not found.",
 			})
 		}
+		var emailNotVerifiedError *customerrors.EmailNotVerifiedError
+		if errors.As(err, &emailNotVerifiedError) {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": emailNotVerifiedError.Message,
+			})
+		}
 		var unauthorziedError *customerrors.UnauthorizedError
 		if errors.As(err, &unauthorziedError) {
 			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
@@ -39,6 +39,7 @@ func NewPutUserWebhookHandler(service PutUserWebhookService) PutUserWebhookHandl
 // @Failure 500  {object}  dto.ErrorResponse "Internal Server Error, if an error occurs while processing the request"
 // @Router /users/{id}/webhook [put]
 // @Security BearerAuth
+// @Security ApiKeyAuth
 func (p PutUserWebhookHandler) Handle(c *fiber.Ctx) error {
 	idParam := c.Params("id")
 	userCredentials := c.Locals("user").(dto.UserCredentials)
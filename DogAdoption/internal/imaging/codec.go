@@ -0,0 +1,58 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	"net/http"
+
+	// Register the decoders of the accepted upload formats.
+	_ "image/gif"
+	_ "image/png"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrTooManyPixels     = errors.New("image has too many pixels")
+)
+
+// acceptedContentTypes are the sniffed content types that the standard library can decode.
+var acceptedContentTypes = []string{"image/jpeg", "image/png", "image/gif"}
+
+// SniffContentType detects the content type from the data itself, ignoring what the client claims.
+func SniffContentType(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	for _, accepted := range acceptedContentTypes {
+		if contentType == accepted {
+			return contentType, nil
+		}
+	}
+	return "", ErrUnsupportedFormat
+}
+
+// Decode reads the dimensions before decoding, so that small files that decode into huge images
+// are rejected without allocating them.
+func Decode(data []byte, maxPixels int) (image.Image, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxPixels {
+		return nil, ErrTooManyPixels
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	return img, nil
+}
+
+func EncodeJpeg(img image.Image) ([]byte, error) {
+	var buffer bytes.Buffer
+	err := jpeg.Encode(&buffer, img, &jpeg.Options{Quality: 85})
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
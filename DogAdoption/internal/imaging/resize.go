@@ -0,0 +1,66 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// Fit scales the image down so that its longest side is at most maxSide pixels, keeping the aspect
+// ratio. Smaller images are not scaled up. Transparent areas are flattened onto white, since the
+// result is encoded as JPEG.
+func Fit(src image.Image, maxSide int) image.Image {
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	targetWidth, targetHeight := width, height
+	if width > maxSide || height > maxSide {
+		if width >= height {
+			targetWidth = maxSide
+			targetHeight = max(1, height*maxSide/width)
+		} else {
+			targetHeight = maxSide
+			targetWidth = max(1, width*maxSide/height)
+		}
+	}
+
+	flattened := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(flattened, flattened.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(flattened, flattened.Bounds(), src, bounds.Min, draw.Over)
+	if targetWidth == width && targetHeight == height {
+		return flattened
+	}
+	return boxResize(flattened, targetWidth, targetHeight)
+}
+
+// boxResize downscales by averaging all source pixels that fall into each destination pixel,
+// which avoids the aliasing of nearest neighbour sampling.
+func boxResize(src *image.RGBA, targetWidth, targetHeight int) *image.RGBA {
+	width, height := src.Bounds().Dx(), src.Bounds().Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, targetWidth, targetHeight))
+	for y := 0; y < targetHeight; y++ {
+		y0 := y * height / targetHeight
+		y1 := max(y0+1, (y+1)*height/targetHeight)
+		for x := 0; x < targetWidth; x++ {
+			x0 := x * width / targetWidth
+			x1 := max(x0+1, (x+1)*width/targetWidth)
+			var r, g, b, a, count uint64
+			for sy := y0; sy < y1; sy++ {
+				offset := src.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					r += uint64(src.Pix[offset])
+					g += uint64(src.Pix[offset+1])
+					b += uint64(src.Pix[offset+2])
+					a += uint64(src.Pix[offset+3])
+					offset += 4
+					count++
+				}
+			}
+			dstOffset := dst.PixOffset(x, y)
+			dst.Pix[dstOffset] = uint8(r / count)
+			dst.Pix[dstOffset+1] = uint8(g / count)
+			dst.Pix[dstOffset+2] = uint8(b / count)
+			dst.Pix[dstOffset+3] = uint8(a / count)
+		}
+	}
+	return dst
+}
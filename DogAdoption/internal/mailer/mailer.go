@@ -0,0 +1,14 @@
+package mailer
+
+import "context"
+
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers emails to users, such as email verification links.
+type Mailer interface {
+	Send(ctx context.Context, mail Mail) error
+}
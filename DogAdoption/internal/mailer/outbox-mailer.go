@@ -0,0 +1,35 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// OutboxMailer writes every email as an .eml file to a local directory instead of sending it,
+// for local development and tests.
+type OutboxMailer struct {
+	directory string
+	from      string
+}
+
+func NewOutboxMailer(directory, from string) OutboxMailer {
+	if directory == "" {
+		directory = "mail-outbox"
+	}
+	return OutboxMailer{
+		directory: directory,
+		from:      from,
+	}
+}
+
+func (o OutboxMailer) Send(ctx context.Context, mail Mail) error {
+	err := os.MkdirAll(o.directory, 0700)
+	if err != nil {
+		return err
+	}
+	fileName := fmt.Sprintf("%d.eml", time.Now().UnixNano())
+	return os.WriteFile(filepath.Join(o.directory, fileName), formatMessage(o.from, mail), 0600)
+}
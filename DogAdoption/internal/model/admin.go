@@ -5,3 +5,10 @@ type Admin struct {
 	Username string
 	Password string
 }
+
+func (a *Admin) ToJson() map[string]any {
+	return map[string]any{
+		"id":       a.Id,
+		"username": a.Username,
+	}
+}
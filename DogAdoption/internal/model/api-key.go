@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+type ApiKey struct {
+	Id         int        `json:"id"`
+	UserId     int        `json:"user_id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	KeyHash    string     `json:"key_hash"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
+
+func (a ApiKey) ToJson() map[string]any {
+	return map[string]any{
+		"id":           a.Id,
+		"user_id":      a.UserId,
+		"name":         a.Name,
+		"prefix":       a.Prefix,
+		"key_hash":     a.KeyHash,
+		"scopes":       a.Scopes,
+		"created_at":   a.CreatedAt,
+		"last_used_at": a.LastUsedAt,
+		"revoked_at":   a.RevokedAt,
+	}
+}
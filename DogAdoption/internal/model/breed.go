@@ -0,0 +1,48 @@
+package model
+
+import "time"
+
+type BreedSizeGroup string
+
+const (
+	BREED_SIZE_TOY    BreedSizeGroup = "toy"
+	BREED_SIZE_SMALL  BreedSizeGroup = "small"
+	BREED_SIZE_MEDIUM BreedSizeGroup = "medium"
+	BREED_SIZE_LARGE  BreedSizeGroup = "large"
+	BREED_SIZE_GIANT  BreedSizeGroup = "giant"
+)
+
+func StringToBreedSizeGroup(sizeGroup string) (BreedSizeGroup, bool) {
+	switch BreedSizeGroup(sizeGroup) {
+	case BREED_SIZE_TOY, BREED_SIZE_SMALL, BREED_SIZE_MEDIUM, BREED_SIZE_LARGE, BREED_SIZE_GIANT:
+		return BreedSizeGroup(sizeGroup), true
+	}
+	return "", false
+}
+
+// Breed is a canonical breed of the taxonomy that dogs reference. Aliases are other names of the
+// breed, such as "Lab" for "Labrador Retriever", and are matched regardless of case.
+type Breed struct {
+	Id        int
+	Name      string
+	Aliases   []string
+	SizeGroup *BreedSizeGroup
+	// FciGroup is the group of the breed in the FCI nomenclature, 1 to 10.
+	FciGroup  *int
+	CreatedAt time.Time
+}
+
+func (b *Breed) ToJson() map[string]any {
+	aliases := b.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+	return map[string]any{
+		"id":         b.Id,
+		"name":       b.Name,
+		"aliases":    aliases,
+		"size_group": b.SizeGroup,
+		"fci_group":  b.FciGroup,
+		"created_at": b.CreatedAt,
+	}
+}
@@ -0,0 +1,140 @@
+package model
+
+import "strings"
+
+type Compatibility string
+
+const (
+	COMPATIBILITY_YES     Compatibility = "yes"
+	COMPATIBILITY_NO      Compatibility = "no"
+	COMPATIBILITY_UNKNOWN Compatibility = "unknown"
+)
+
+func StringToCompatibility(compatibility string) (Compatibility, bool) {
+	switch Compatibility(compatibility) {
+	case COMPATIBILITY_YES, COMPATIBILITY_NO, COMPATIBILITY_UNKNOWN:
+		return Compatibility(compatibility), true
+	}
+	return "", false
+}
+
+type EnergyLevel string
+
+const (
+	ENERGY_LOW    EnergyLevel = "low"
+	ENERGY_MEDIUM EnergyLevel = "medium"
+	ENERGY_HIGH   EnergyLevel = "high"
+)
+
+func StringToEnergyLevel(energyLevel string) (EnergyLevel, bool) {
+	switch EnergyLevel(energyLevel) {
+	case ENERGY_LOW, ENERGY_MEDIUM, ENERGY_HIGH:
+		return EnergyLevel(energyLevel), true
+	}
+	return "", false
+}
+
+const (
+	GOOD_WITH_CHILDREN      = "children"
+	GOOD_WITH_DOGS          = "dogs"
+	GOOD_WITH_CATS          = "cats"
+	GOOD_WITH_SMALL_ANIMALS = "small-animals"
+)
+
+// CompatibilityGroups are the groups a dog can be good with, as used by the good-with filter.
+var CompatibilityGroups = []string{GOOD_WITH_CHILDREN, GOOD_WITH_DOGS, GOOD_WITH_CATS, GOOD_WITH_SMALL_ANIMALS}
+
+// DogCompatibility is whether a dog gets along with children and other animals.
+type DogCompatibility struct {
+	Children     Compatibility
+	Dogs         Compatibility
+	Cats         Compatibility
+	SmallAnimals Compatibility
+}
+
+func UnknownDogCompatibility() DogCompatibility {
+	return DogCompatibility{
+		Children:     COMPATIBILITY_UNKNOWN,
+		Dogs:         COMPATIBILITY_UNKNOWN,
+		Cats:         COMPATIBILITY_UNKNOWN,
+		SmallAnimals: COMPATIBILITY_UNKNOWN,
+	}
+}
+
+func (d DogCompatibility) ToJson() map[string]any {
+	return map[string]any{
+		"children":      d.Children,
+		"dogs":          d.Dogs,
+		"cats":          d.Cats,
+		"small_animals": d.SmallAnimals,
+	}
+}
+
+// FriendlyWith describes the compatibility as the free text friendly_with of clients written before
+// the structured flags, such as "Children, dogs and cats".
+func (d DogCompatibility) FriendlyWith() string {
+	groups := []struct {
+		name          string
+		compatibility Compatibility
+	}{
+		{"children", d.Children},
+		{"dogs", d.Dogs},
+		{"cats", d.Cats},
+		{"small animals", d.SmallAnimals},
+	}
+	var friendlyWith []string
+	isKnown := false
+	for _, group := range groups {
+		if group.compatibility == COMPATIBILITY_YES {
+			friendlyWith = append(friendlyWith, group.name)
+		}
+		isKnown = isKnown || group.compatibility != COMPATIBILITY_UNKNOWN
+	}
+	if len(friendlyWith) == 0 {
+		if isKnown {
+			return "None"
+		}
+		return ""
+	}
+	description := friendlyWith[0]
+	if len(friendlyWith) > 1 {
+		description = strings.Join(friendlyWith[:len(friendlyWith)-1], ", ") + " and " + friendlyWith[len(friendlyWith)-1]
+	}
+	return strings.ToUpper(description[:1]) + description[1:]
+}
+
+// ParseFriendlyWith derives the compatibility from the free text friendly_with of older clients. Groups
+// that are not mentioned are unknown, and "None" means that the dog is good with none of them.
+func ParseFriendlyWith(friendlyWith string) DogCompatibility {
+	compatibility := UnknownDogCompatibility()
+	text := strings.ToLower(strings.TrimSpace(friendlyWith))
+	if text == "none" {
+		return DogCompatibility{
+			Children:     COMPATIBILITY_NO,
+			Dogs:         COMPATIBILITY_NO,
+			Cats:         COMPATIBILITY_NO,
+			SmallAnimals: COMPATIBILITY_NO,
+		}
+	}
+	mentions := func(words ...string) bool {
+		for _, word := range words {
+			if strings.Contains(text, word) {
+				return true
+			}
+		}
+		return false
+	}
+	if mentions("child", "kid") {
+		compatibility.Children = COMPATIBILITY_YES
+	}
+	if mentions("dog") {
+		compatibility.Dogs = COMPATIBILITY_YES
+	}
+	if mentions("cat") {
+		compatibility.Cats = COMPATIBILITY_YES
+	}
+	if mentions("small animal", "rabbit", "hamster", "guinea pig") {
+		compatibility.SmallAnimals = COMPATIBILITY_YES
+	}
+	return compatibility
+}
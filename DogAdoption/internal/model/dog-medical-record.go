@@ -0,0 +1,66 @@
+package model
+
+import "time"
+
+type MedicalRecordType string
+
+const (
+	MEDICAL_VACCINATION MedicalRecordType = "vaccination"
+	MEDICAL_TREATMENT   MedicalRecordType = "treatment"
+	MEDICAL_NOTE        MedicalRecordType = "note"
+)
+
+var MedicalRecordTypes = []MedicalRecordType{MEDICAL_VACCINATION, MEDICAL_TREATMENT, MEDICAL_NOTE}
+
+// MEDICAL_DATE_LAYOUT is the format of the dates of medical records in requests and responses.
+const MEDICAL_DATE_LAYOUT = "2006-01-02"
+
+func StringToMedicalRecordType(typeString string) (MedicalRecordType, bool) {
+	for _, recordType := range MedicalRecordTypes {
+		if string(recordType) == typeString {
+			return recordType, true
+		}
+	}
+	return "", false
+}
+
+// DogMedicalRecord is an entry in the medical history of a dog. For vaccinations the title is the
+// vaccine type and NextDueOn is when the next dose is due. Only vaccinations have a next due date.
+type DogMedicalRecord struct {
+	Id         int
+	DogId      int
+	RecordType MedicalRecordType
+	Title      string
+	RecordDate time.Time
+	NextDueOn  *time.Time
+	Notes      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (d *DogMedicalRecord) ToJson() map[string]any {
+	var nextDueOn *string
+	if d.NextDueOn != nil {
+		formatted := d.NextDueOn.Format(MEDICAL_DATE_LAYOUT)
+		nextDueOn = &formatted
+	}
+	return map[string]any{
+		"id":          d.Id,
+		"dog_id":      d.DogId,
+		"record_type": d.RecordType,
+		"title":       d.Title,
+		"record_date": d.RecordDate.Format(MEDICAL_DATE_LAYOUT),
+		"next_due_on": nextDueOn,
+		"notes":       d.Notes,
+		"created_at":  d.CreatedAt,
+		"updated_at":  d.UpdatedAt,
+	}
+}
+
+// IsCurrentVaccination reports if the record is a vaccination that is not overdue on the given day.
+func (d *DogMedicalRecord) IsCurrentVaccination(today time.Time) bool {
+	if d.RecordType != MEDICAL_VACCINATION {
+		return false
+	}
+	return d.NextDueOn == nil || !d.NextDueOn.Before(today.Truncate(24*time.Hour))
+}
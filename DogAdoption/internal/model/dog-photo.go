@@ -0,0 +1,72 @@
+package model
+
+import "time"
+
+type DogPhotoSize string
+
+const (
+	PHOTO_ORIGINAL DogPhotoSize = "original"
+	PHOTO_LARGE    DogPhotoSize = "large"
+	PHOTO_MEDIUM   DogPhotoSize = "medium"
+	PHOTO_SMALL    DogPhotoSize = "small"
+)
+
+// DogPhotoThumbnailSizes are the longest sides in pixels of the thumbnails made of every photo.
+var DogPhotoThumbnailSizes = map[DogPhotoSize]int{
+	PHOTO_LARGE:  1200,
+	PHOTO_MEDIUM: 480,
+	PHOTO_SMALL:  160,
+}
+
+func StringToDogPhotoSize(sizeString string) (DogPhotoSize, bool) {
+	size := DogPhotoSize(sizeString)
+	if size == PHOTO_ORIGINAL {
+		return size, true
+	}
+	_, ok := DogPhotoThumbnailSizes[size]
+	return size, ok
+}
+
+// DogPhoto is a photo in the gallery of a dog. The original and its thumbnails are stored in the
+// blob store below StorageKey. Position orders the gallery, starting at 1.
+type DogPhoto struct {
+	Id          int
+	DogId       int
+	Position    int
+	IsPrimary   bool
+	ContentType string
+	Width       int
+	Height      int
+	SizeBytes   int
+	StorageKey  string
+	CreatedAt   time.Time
+}
+
+func (d *DogPhoto) ToJson() map[string]any {
+	return map[string]any{
+		"id":           d.Id,
+		"dog_id":       d.DogId,
+		"position":     d.Position,
+		"is_primary":   d.IsPrimary,
+		"content_type": d.ContentType,
+		"width":        d.Width,
+		"height":       d.Height,
+		"size_bytes":   d.SizeBytes,
+		"created_at":   d.CreatedAt,
+	}
+}
+
+// BlobKey is the key of the original or a thumbnail in the blob store. Thumbnails are always JPEG.
+func (d *DogPhoto) BlobKey(size DogPhotoSize) string {
+	if size == PHOTO_ORIGINAL {
+		return d.StorageKey + "/original"
+	}
+	return d.StorageKey + "/" + string(size) + ".jpg"
+}
+
+func (d *DogPhoto) ContentTypeOf(size DogPhotoSize) string {
+	if size == PHOTO_ORIGINAL {
+		return d.ContentType
+	}
+	return "image/jpeg"
+}
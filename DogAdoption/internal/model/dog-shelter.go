@@ -1,25 +1,77 @@
 package model
 
+import "time"
+
+type DogShelterStatus string
+
+const (
+	// SHELTER_PENDING shelters have registered themselves and wait for an admin review.
+	SHELTER_PENDING  DogShelterStatus = "pending"
+	SHELTER_APPROVED DogShelterStatus = "approved"
+	SHELTER_REJECTED DogShelterStatus = "rejected"
+)
+
 type DogShelter struct {
-	Id       int
-	Name     string
-	Website  string
-	Country  string
-	City     string
-	Address  string
-	Username string
-	Password string
+	Id           int
+	Name         string
+	Website      string
+	Country      string
+	City         string
+	Address      string
+	Username     string
+	Password     string
+	Status       DogShelterStatus
+	ContactEmail *string
+	RegisteredAt time.Time
+	ReviewedAt   *time.Time
+	ReviewReason *string
+	Phone        *string
+	Email        *string
+	Capacity     *int
+	Timezone     string
+	Latitude     *float64
+	Longitude    *float64
+	// GeocodeAccuracy is manual for coordinates set by the shelter, and otherwise the precision of the geocoding.
+	GeocodeAccuracy *string
+	// DistanceKm is the distance to the point of a distance search.
+	DistanceKm *float64
+	// Occupancy is the number of dogs taking up a place at the shelter, see OccupyingDogStatuses.
+	Occupancy              int
+	OpeningHours           []OpeningHours
+	OpeningHoursExceptions []OpeningHoursException
 }
 
 func (d *DogShelter) ToJson() map[string]any {
 	return map[string]any{
-		"id":       d.Id,
-		"name":     d.Name,
-		"website":  d.Website,
-		"country":  d.Country,
-		"city":     d.City,
-		"address":  d.Address,
-		"username": d.Username,
-		"password": d.Password,
+		"id":                       d.Id,
+		"name":                     d.Name,
+		"website":                  d.Website,
+		"country":                  d.Country,
+		"city":                     d.City,
+		"address":                  d.Address,
+		"username":                 d.Username,
+		"password":                 d.Password,
+		"status":                   d.Status,
+		"contact_email":            d.ContactEmail,
+		"registered_at":            d.RegisteredAt,
+		"reviewed_at":              d.ReviewedAt,
+		"review_reason":            d.ReviewReason,
+		"phone":                    d.Phone,
+		"email":                    d.Email,
+		"capacity":                 d.Capacity,
+		"timezone":                 d.Timezone,
+		"latitude":                 d.Latitude,
+		"longitude":                d.Longitude,
+		"location_accuracy":        d.GeocodeAccuracy,
+		"distance_km":              d.DistanceKm,
+		"occupancy":                d.Occupancy,
+		"opening_hours":            d.OpeningHours,
+		"opening_hours_exceptions": d.OpeningHoursExceptions,
 	}
 }
+
+// IsApproved reports if the shelter may log in and is publicly listed. Shelters created
+// by an admin are approved directly, self-registered shelters after an admin review.
+func (d *DogShelter) IsApproved() bool {
+	return d.Status == SHELTER_APPROVED
+}
@@ -0,0 +1,89 @@
+package model
+
+import "time"
+
+type DogStatus string
+
+const (
+	DOG_AVAILABLE DogStatus = "available"
+	DOG_RESERVED  DogStatus = "reserved"
+	DOG_ON_HOLD   DogStatus = "on_hold"
+	DOG_IN_FOSTER DogStatus = "in_foster"
+	DOG_ADOPTED   DogStatus = "adopted"
+	DOG_RETURNED  DogStatus = "returned"
+	DOG_DECEASED  DogStatus = "deceased"
+)
+
+var DogStatuses = []DogStatus{DOG_AVAILABLE, DOG_RESERVED, DOG_ON_HOLD, DOG_IN_FOSTER, DOG_ADOPTED, DOG_RETURNED, DOG_DECEASED}
+
+// OccupyingDogStatuses are the statuses of dogs that take up a place at their shelter. Dogs in
+// foster homes are still the responsibility of the shelter, but do not count towards its capacity.
+var OccupyingDogStatuses = []DogStatus{DOG_AVAILABLE, DOG_RESERVED, DOG_ON_HOLD, DOG_RETURNED}
+
+// dogStatusTransitions is the lifecycle of a dog. A status can only change to the statuses listed
+// for it, and deceased is final.
+var dogStatusTransitions = map[DogStatus][]DogStatus{
+	DOG_AVAILABLE: {DOG_RESERVED, DOG_ON_HOLD, DOG_IN_FOSTER, DOG_ADOPTED, DOG_DECEASED},
+	DOG_RESERVED:  {DOG_AVAILABLE, DOG_ON_HOLD, DOG_ADOPTED, DOG_DECEASED},
+	DOG_ON_HOLD:   {DOG_AVAILABLE, DOG_IN_FOSTER, DOG_DECEASED},
+	DOG_IN_FOSTER: {DOG_AVAILABLE, DOG_RESERVED, DOG_ON_HOLD, DOG_ADOPTED, DOG_DECEASED},
+	DOG_ADOPTED:   {DOG_RETURNED, DOG_DECEASED},
+	DOG_RETURNED:  {DOG_AVAILABLE, DOG_ON_HOLD, DOG_IN_FOSTER, DOG_DECEASED},
+	DOG_DECEASED:  {},
+}
+
+func StringToDogStatus(statusString string) (DogStatus, bool) {
+	for _, status := range DogStatuses {
+		if string(status) == statusString {
+			return status, true
+		}
+	}
+	return "", false
+}
+
+func (d DogStatus) CanTransitionTo(status DogStatus) bool {
+	for _, allowed := range dogStatusTransitions[d] {
+		if allowed == status {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowedTransitions returns the statuses the dog can move to from this status.
+func (d DogStatus) AllowedTransitions() []DogStatus {
+	return dogStatusTransitions[d]
+}
+
+// Describe writes statuses such as on_hold as "on hold".
+func (d DogStatus) Describe() string {
+	switch d {
+	case DOG_ON_HOLD:
+		return "on hold"
+	case DOG_IN_FOSTER:
+		return "in foster"
+	}
+	return string(d)
+}
+
+// DogStatusChange is an entry in the status history of a dog. FromStatus is nil for the status the
+// dog was created with.
+type DogStatusChange struct {
+	Id         int
+	DogId      int
+	FromStatus *DogStatus
+	ToStatus   DogStatus
+	Note       *string
+	ChangedAt  time.Time
+}
+
+func (d *DogStatusChange) ToJson() map[string]any {
+	return map[string]any{
+		"id":          d.Id,
+		"dog_id":      d.DogId,
+		"from_status": d.FromStatus,
+		"to_status":   d.ToStatus,
+		"note":        d.Note,
+		"changed_at":  d.ChangedAt,
+	}
+}
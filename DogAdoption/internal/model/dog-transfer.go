@@ -0,0 +1,44 @@
+package model
+
+import "time"
+
+type DogTransferStatus string
+
+const (
+	TRANSFER_PENDING   DogTransferStatus = "pending"
+	TRANSFER_ACCEPTED  DogTransferStatus = "accepted"
+	TRANSFER_DECLINED  DogTransferStatus = "declined"
+	TRANSFER_CANCELLED DogTransferStatus = "cancelled"
+)
+
+// DogTransfer is a proposal by the shelter of a dog to move it to another shelter. The dog changes
+// shelter when the destination accepts, and the transfers of a dog form its shelter history.
+type DogTransfer struct {
+	Id             int
+	DogId          int
+	FromShelterId  int
+	ToShelterId    int
+	Status         DogTransferStatus
+	Note           *string
+	DecisionReason *string
+	CreatedAt      time.Time
+	DecidedAt      *time.Time
+}
+
+func (d *DogTransfer) ToJson() map[string]any {
+	return map[string]any{
+		"id":              d.Id,
+		"dog_id":          d.DogId,
+		"from_shelter_id": d.FromShelterId,
+		"to_shelter_id":   d.ToShelterId,
+		"status":          d.Status,
+		"note":            d.Note,
+		"decision_reason": d.DecisionReason,
+		"created_at":      d.CreatedAt,
+		"decided_at":      d.DecidedAt,
+	}
+}
+
+func (d *DogTransfer) IsPending() bool {
+	return d.Status == TRANSFER_PENDING
+}
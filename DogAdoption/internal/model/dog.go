@@ -3,33 +3,64 @@ package model
 import "time"
 
 type Dog struct {
-	Id           int       `json:"id"`
-	Name         string    `json:"name"`
-	Description  string    `json:"description"`
-	BirthDate    time.Time `json:"birth_date"`
-	Breed        string    `json:"breed"`
-	IsNeutered   bool      `json:"is_neutered"`
-	ShelterId    int       `json:"shelter_id"`
-	ImageUrl     string    `json:"image_url"`
-	AdoptionFee  int       `json:"adoption_fee"`
-	IsAdopted    bool      `json:"is_adopted"`
+	Id          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	BirthDate   time.Time `json:"birth_date"`
+	Breed       string    `json:"breed"`
+	IsNeutered  bool      `json:"is_neutered"`
+	ShelterId   int       `json:"shelter_id"`
+	ImageUrl    string    `json:"image_url"`
+	AdoptionFee int       `json:"adoption_fee"`
+	// FriendlyWith is the free text of clients written before Compatibility.
 	FriendlyWith string    `json:"friendly_with"`
 	Gender       string    `json:"gender"`
+	Status       DogStatus `json:"status"`
+	// StatusChangedAt is when the dog got its current status.
+	StatusChangedAt time.Time `json:"status_changed_at"`
+	// PrimaryBreedId and SecondaryBreedId reference the breed taxonomy, a mixed breed dog has both.
+	// Breed is the canonical name of the primary breed.
+	PrimaryBreedId   *int             `json:"primary_breed_id"`
+	SecondaryBreedId *int             `json:"secondary_breed_id"`
+	Compatibility    DogCompatibility `json:"good_with"`
+	EnergyLevel      *EnergyLevel     `json:"energy_level"`
+	// Size uses the same groups as the size of breeds.
+	Size *BreedSizeGroup `json:"size"`
+	// PrimaryPhotoId is the photo shown for the dog in listings, if it has any photos.
+	PrimaryPhotoId *int `json:"primary_photo_id"`
+	// DistanceKm is the distance from the shelter of the dog to the point of a distance search.
+	DistanceKm *float64 `json:"distance_km"`
+	// FavoritedCount is how many users have the dog as a favorite. It is only shown to shelters, so it is
+	// left out of ToJson.
+	FavoritedCount int `json:"-"`
 }
 
 func (d *Dog) ToJson() map[string]any {
 	return map[string]any{
-		"id":            d.Id,
-		"name":          d.Name,
-		"description":   d.Description,
-		"birth_date":    d.BirthDate,
-		"breed":         d.Breed,
-		"is_neutered":   d.IsNeutered,
-		"shelter_id":    d.ShelterId,
-		"image_url":     d.ImageUrl,
-		"adoption_fee":  d.AdoptionFee,
-		"is_adopted":    d.IsAdopted,
-		"friendly_with": d.FriendlyWith,
-		"gender":        d.Gender,
+		"id":                 d.Id,
+		"name":               d.Name,
+		"description":        d.Description,
+		"birth_date":         d.BirthDate,
+		"breed":              d.Breed,
+		"is_neutered":        d.IsNeutered,
+		"shelter_id":         d.ShelterId,
+		"image_url":          d.ImageUrl,
+		"adoption_fee":       d.AdoptionFee,
+		"is_adopted":         d.IsAdopted(),
+		"friendly_with":      d.FriendlyWith,
+		"gender":             d.Gender,
+		"status":             d.Status,
+		"status_changed_at":  d.StatusChangedAt,
+		"primary_breed_id":   d.PrimaryBreedId,
+		"secondary_breed_id": d.SecondaryBreedId,
+		"good_with":          d.Compatibility.ToJson(),
+		"energy_level":       d.EnergyLevel,
+		"size":               d.Size,
+		"distance_km":        d.DistanceKm,
 	}
 }
+
+// IsAdopted is derived from the status, and kept for clients written before the status lifecycle.
+func (d *Dog) IsAdopted() bool {
+	return d.Status == DOG_ADOPTED
+}
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+type EmailVerificationToken struct {
+	TokenHash string
+	UserId    int
+	Email     string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
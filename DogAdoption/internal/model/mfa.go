@@ -0,0 +1,23 @@
+package model
+
+type MfaEnrollment struct {
+	Id            int
+	AccountId     int
+	AccountRole   UserRole
+	Secret        string
+	IsEnabled     bool
+	RecoveryCodes []string
+	LastUsedStep  int64
+}
+
+type MfaPolicy struct {
+	Role       UserRole `json:"role"`
+	IsRequired bool     `json:"is_required"`
+}
+
+func (m MfaPolicy) ToJson() map[string]any {
+	return map[string]any{
+		"role":        m.Role,
+		"is_required": m.IsRequired,
+	}
+}
@@ -0,0 +1,80 @@
+package model
+
+import "time"
+
+type OAuthGrantType string
+
+const (
+	AUTHORIZATION_CODE OAuthGrantType = "authorization_code"
+	REFRESH_TOKEN      OAuthGrantType = "refresh_token"
+	CLIENT_CREDENTIALS OAuthGrantType = "client_credentials"
+)
+
+type OAuthClient struct {
+	Id               int              `json:"id"`
+	ClientId         string           `json:"client_id"`
+	ClientSecretHash string           `json:"client_secret_hash"`
+	Name             string           `json:"name"`
+	RedirectUris     []string         `json:"redirect_uris"`
+	Scopes           []string         `json:"scopes"`
+	GrantTypes       []OAuthGrantType `json:"grant_types"`
+	IsConfidential   bool             `json:"is_confidential"`
+	OwnerUserId      *int             `json:"owner_user_id"`
+	CreatedAt        time.Time        `json:"created_at"`
+}
+
+func (o OAuthClient) ToJson() map[string]any {
+	return map[string]any{
+		"id":                 o.Id,
+		"client_id":          o.ClientId,
+		"client_secret_hash": o.ClientSecretHash,
+		"name":               o.Name,
+		"redirect_uris":      o.RedirectUris,
+		"scopes":             o.Scopes,
+		"grant_types":        o.GrantTypes,
+		"is_confidential":    o.IsConfidential,
+		"owner_user_id":      o.OwnerUserId,
+		"created_at":         o.CreatedAt,
+	}
+}
+
+type OAuthConsent struct {
+	Id        int       `json:"id"`
+	UserId    int       `json:"user_id"`
+	ClientId  string    `json:"client_id"`
+	Scopes    []string  `json:"scopes"`
+	GrantedAt time.Time `json:"granted_at"`
+}
+
+func (o OAuthConsent) ToJson() map[string]any {
+	return map[string]any{
+		"id":         o.Id,
+		"user_id":    o.UserId,
+		"client_id":  o.ClientId,
+		"scopes":     o.Scopes,
+		"granted_at": o.GrantedAt,
+	}
+}
+
+// OAuthAuthorizationCode keeps whether the redirect_uri was included in the authorization request,
+// since the token request then has to repeat it.
+type OAuthAuthorizationCode struct {
+	CodeHash            string
+	ClientId            string
+	UserId              int
+	RedirectUri         string
+	RedirectUriSupplied bool
+	Scopes              []string
+	CodeChallenge       string
+	ExpiresAt           time.Time
+	IsUsed              bool
+}
+
+type OAuthRefreshToken struct {
+	TokenHash string
+	ClientId  string
+	UserId    int
+	Scopes    []string
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+}
@@ -0,0 +1,45 @@
+package model
+
+import "time"
+
+// OpeningHours is an interval on a weekday in the local time of the shelter. A weekday can have
+// several intervals, for example around a lunch break.
+type OpeningHours struct {
+	Weekday int    `json:"weekday"` // 0 is Sunday, as in time.Weekday
+	Opens   string `json:"opens"`   // HH:MM
+	Closes  string `json:"closes"`  // HH:MM
+}
+
+// OpeningHoursException replaces the weekly opening hours on a date, for example a holiday.
+// The shelter is closed the whole day when Opens and Closes are nil.
+type OpeningHoursException struct {
+	Date   string  `json:"date"` // YYYY-MM-DD
+	Opens  *string `json:"opens"`
+	Closes *string `json:"closes"`
+	Note   *string `json:"note"`
+}
+
+// IsOpenAt reports if the shelter is open at t in its own timezone. An exception for the local
+// date takes precedence over the weekly opening hours.
+func (d *DogShelter) IsOpenAt(t time.Time) bool {
+	location, err := time.LoadLocation(d.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	local := t.In(location)
+	date := local.Format("2006-01-02")
+	clock := local.Format("15:04")
+
+	for _, exception := range d.OpeningHoursExceptions {
+		if exception.Date == date {
+			return exception.Opens != nil && exception.Closes != nil &&
+				clock >= *exception.Opens && clock < *exception.Closes
+		}
+	}
+	for _, hours := range d.OpeningHours {
+		if hours.Weekday == int(local.Weekday()) && clock >= hours.Opens && clock < hours.Closes {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+type PasswordResetToken struct {
+	TokenHash   string
+	AccountId   int
+	AccountRole UserRole
+	ExpiresAt   time.Time
+	UsedAt      *time.Time
+}
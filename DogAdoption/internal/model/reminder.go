@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+type ReminderKind string
+
+const (
+	REMINDER_VACCINATION_DUE        ReminderKind = "vaccination_due"
+	REMINDER_POST_ADOPTION_FOLLOWUP ReminderKind = "post_adoption_followup"
+)
+
+// ReminderShelter is the shelter that a reminder is sent to.
+type ReminderShelter struct {
+	Id           int
+	Name         string
+	Username     string
+	Email        *string
+	ContactEmail *string
+}
+
+// VaccinationDueReminder is a vaccination of a dog whose next dose is due soon.
+type VaccinationDueReminder struct {
+	Vaccination DogMedicalRecord
+	DogName     string
+	Shelter     ReminderShelter
+}
+
+// PostAdoptionFollowupReminder is an adoption that the shelter should check in on.
+type PostAdoptionFollowupReminder struct {
+	StatusChangeId int
+	DogId          int
+	DogName        string
+	AdoptedAt      time.Time
+	Shelter        ReminderShelter
+}
@@ -7,7 +7,9 @@ type UserRole string
 const (
 	ADMIN      UserRole = "admin"
 	DOGSHELTER UserRole = "dog_shelter"
-	USER       UserRole = "user"
+	// SHELTERSTAFF accounts act for the dog shelter they are a staff member of.
+	SHELTERSTAFF UserRole = "shelter_staff"
+	USER         UserRole = "user"
 )
 
 func StringToUserRole(roleString string) (UserRole, error) {
@@ -16,6 +18,8 @@ func StringToUserRole(roleString string) (UserRole, error) {
 		return ADMIN, nil
 	case string(DOGSHELTER):
 		return DOGSHELTER, nil
+	case string(SHELTERSTAFF):
+		return SHELTERSTAFF, nil
 	case string(USER):
 		return USER, nil
 	default:
@@ -0,0 +1,69 @@
+package model
+
+import "time"
+
+// SavedSearchChannel is how the matches of a saved search reach the user.
+type SavedSearchChannel string
+
+const (
+	SAVED_SEARCH_WEBHOOK SavedSearchChannel = "webhook"
+	SAVED_SEARCH_INBOX   SavedSearchChannel = "inbox"
+)
+
+func StringToSavedSearchChannel(channel string) (SavedSearchChannel, bool) {
+	switch SavedSearchChannel(channel) {
+	case SAVED_SEARCH_WEBHOOK, SAVED_SEARCH_INBOX:
+		return SavedSearchChannel(channel), true
+	}
+	return "", false
+}
+
+// SavedSearchFrequency is how often the user is told about the matches of a saved search.
+type SavedSearchFrequency string
+
+const (
+	SAVED_SEARCH_INSTANT SavedSearchFrequency = "instant"
+	SAVED_SEARCH_DAILY   SavedSearchFrequency = "daily"
+)
+
+func StringToSavedSearchFrequency(frequency string) (SavedSearchFrequency, bool) {
+	switch SavedSearchFrequency(frequency) {
+	case SAVED_SEARCH_INSTANT, SAVED_SEARCH_DAILY:
+		return SavedSearchFrequency(frequency), true
+	}
+	return "", false
+}
+
+// SavedSearch is a GET /dogs query string that a user is alerted about when new or updated dogs match it.
+type SavedSearch struct {
+	Id        int                  `json:"id"`
+	UserId    int                  `json:"user_id"`
+	Name      string               `json:"name"`
+	Query     string               `json:"query"`
+	Channel   SavedSearchChannel   `json:"channel"`
+	Frequency SavedSearchFrequency `json:"frequency"`
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+func (s SavedSearch) ToJson() map[string]any {
+	return map[string]any{
+		"id":         s.Id,
+		"user_id":    s.UserId,
+		"name":       s.Name,
+		"query":      s.Query,
+		"channel":    s.Channel,
+		"frequency":  s.Frequency,
+		"created_at": s.CreatedAt,
+		"updated_at": s.UpdatedAt,
+	}
+}
+
+// SAVED_SEARCH_DIGEST_JOB is the scheduled job that sends the daily digests of saved searches.
+const SAVED_SEARCH_DIGEST_JOB = "saved_search_digest"
+
+// SavedSearchDigest is a daily saved search with the dogs that matched it since the last digest.
+type SavedSearchDigest struct {
+	SavedSearch SavedSearch
+	DogIds      []int
+}
@@ -0,0 +1,13 @@
+package model
+
+// AccessScopes are the scopes that api keys and oauth clients can be granted. A scope is made up
+// of the resource, as the first path segment after /api/v1, and the access level.
+// Read access covers GET requests, write access covers all other methods.
+var AccessScopes = []string{
+	"dogs:read",
+	"dogs:write",
+	"dogshelters:read",
+	"dogshelters:write",
+	"users:read",
+	"users:write",
+}
@@ -0,0 +1,71 @@
+package model
+
+import "time"
+
+type ShelterStaffRole string
+
+const (
+	STAFF_OWNER     ShelterStaffRole = "owner"
+	STAFF_MANAGER   ShelterStaffRole = "manager"
+	STAFF_VOLUNTEER ShelterStaffRole = "volunteer"
+)
+
+func StringToShelterStaffRole(roleString string) (ShelterStaffRole, bool) {
+	switch roleString {
+	case string(STAFF_OWNER):
+		return STAFF_OWNER, true
+	case string(STAFF_MANAGER):
+		return STAFF_MANAGER, true
+	case string(STAFF_VOLUNTEER):
+		return STAFF_VOLUNTEER, true
+	default:
+		return "", false
+	}
+}
+
+// ShelterStaff is a personal login of someone working for a dog shelter.
+type ShelterStaff struct {
+	Id        int
+	ShelterId int
+	Username  string
+	Password  string
+	Role      ShelterStaffRole
+	Email     string
+	CreatedAt time.Time
+}
+
+func (s *ShelterStaff) ToJson() map[string]any {
+	return map[string]any{
+		"id":         s.Id,
+		"shelter_id": s.ShelterId,
+		"username":   s.Username,
+		"password":   s.Password,
+		"role":       s.Role,
+		"email":      s.Email,
+		"created_at": s.CreatedAt,
+	}
+}
+
+// ShelterStaffInvitation is sent by a shelter owner to an email address. The token is only stored hashed.
+type ShelterStaffInvitation struct {
+	Id         int
+	ShelterId  int
+	Email      string
+	Role       ShelterStaffRole
+	TokenHash  string
+	ExpiresAt  time.Time
+	AcceptedAt *time.Time
+	CreatedAt  time.Time
+}
+
+func (s *ShelterStaffInvitation) ToJson() map[string]any {
+	return map[string]any{
+		"id":          s.Id,
+		"shelter_id":  s.ShelterId,
+		"email":       s.Email,
+		"role":        s.Role,
+		"expires_at":  s.ExpiresAt,
+		"accepted_at": s.AcceptedAt,
+		"created_at":  s.CreatedAt,
+	}
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// UserFavorite is a dog that a user has saved to follow.
+type UserFavorite struct {
+	UserId    int       `json:"user_id"`
+	DogId     int       `json:"dog_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (u UserFavorite) ToJson() map[string]any {
+	return map[string]any{
+		"user_id":    u.UserId,
+		"dog_id":     u.DogId,
+		"created_at": u.CreatedAt,
+	}
+}
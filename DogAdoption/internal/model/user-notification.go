@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// UserNotification is an entry in the in-app inbox of a user. The event is the webhook action that the
+// notification was made from, and the data is the payload of the event.
+type UserNotification struct {
+	Id        int            `json:"id"`
+	UserId    int            `json:"user_id"`
+	Event     WebhookAction  `json:"event"`
+	Title     string         `json:"title"`
+	Body      string         `json:"body"`
+	Data      map[string]any `json:"data"`
+	CreatedAt time.Time      `json:"created_at"`
+	ReadAt    *time.Time     `json:"read_at"`
+}
+
+func (u UserNotification) ToJson() map[string]any {
+	return map[string]any{
+		"id":         u.Id,
+		"user_id":    u.UserId,
+		"event":      u.Event,
+		"title":      u.Title,
+		"body":       u.Body,
+		"data":       u.Data,
+		"created_at": u.CreatedAt,
+		"read_at":    u.ReadAt,
+		"is_read":    u.ReadAt != nil,
+	}
+}
+
+// NOTIFICATION_RETENTION_JOB is the scheduled job that deletes the notifications that are past retention.
+const NOTIFICATION_RETENTION_JOB = "notification_retention"
@@ -1,15 +1,86 @@
 package model
 
+import "time"
+
+type EmailStatus string
+
+const (
+	EMAIL_PENDING  EmailStatus = "pending"
+	EMAIL_VERIFIED EmailStatus = "verified"
+)
+
+type UserStatus string
+
+const (
+	USER_ACTIVE    UserStatus = "active"
+	USER_SUSPENDED UserStatus = "suspended"
+	// USER_DELETED users are anonymized and kept, so that records referencing them remain valid.
+	USER_DELETED UserStatus = "deleted"
+)
+
+// UserHousehold describes the home of an adopter. Nil values are unanswered.
+type UserHousehold struct {
+	HasChildren  *bool `json:"has_children"`
+	HasOtherPets *bool `json:"has_other_pets"`
+	HasGarden    *bool `json:"has_garden"`
+}
+
+// UserPreferences describes the dogs an adopter is looking for, stored as JSON.
+type UserPreferences struct {
+	Breeds         []string `json:"breeds"`
+	Gender         *string  `json:"gender"`
+	MinAgeYears    *int     `json:"min_age_years"`
+	MaxAgeYears    *int     `json:"max_age_years"`
+	MaxAdoptionFee *int     `json:"max_adoption_fee"`
+	// Sizes and EnergyLevels match dogs with any of the values.
+	Sizes        []string `json:"sizes"`
+	EnergyLevels []string `json:"energy_levels"`
+	// MaxDistanceKm is how far from the adopter the shelter of a dog may be.
+	MaxDistanceKm *float64 `json:"max_distance_km"`
+}
+
 type User struct {
-	Id       int
-	Username string
-	Password string
+	Id          int
+	Username    string
+	Password    string
+	Email       *string
+	EmailStatus EmailStatus
+	FullName    *string
+	Phone       *string
+	City        *string
+	Country     *string
+	Household   UserHousehold
+	Preferences UserPreferences
+	Status      UserStatus
+	SuspendedAt *time.Time
+	DeletedAt   *time.Time
 }
 
 func (u *User) ToJson() map[string]any {
 	return map[string]any{
-		"id":       u.Id,
-		"username": u.Username,
-		"password": u.Password,
+		"id":           u.Id,
+		"username":     u.Username,
+		"password":     u.Password,
+		"email":        u.Email,
+		"email_status": u.EmailStatus,
+		"full_name":    u.FullName,
+		"phone":        u.Phone,
+		"city":         u.City,
+		"country":      u.Country,
+		"household":    u.Household,
+		"preferences":  u.Preferences,
+		"status":       u.Status,
+		"suspended_at": u.SuspendedAt,
 	}
 }
+
+// IsEmailVerified reports if the user has verified an email address, which is required
+// for actions such as creating webhooks.
+func (u *User) IsEmailVerified() bool {
+	return u.Email != nil && u.EmailStatus == EMAIL_VERIFIED
+}
+
+// IsSuspended reports if an admin has suspended the user, which blocks login and all authenticated requests.
+func (u *User) IsSuspended() bool {
+	return u.Status == USER_SUSPENDED
+}
@@ -21,5 +21,10 @@ func (w Webhook) ToJson() map[string]any {
 type WebhookAction string
 
 const (
-	NEW_DOG_ADDED WebhookAction = "new_dog_added"
+	NEW_DOG_ADDED   WebhookAction = "new_dog_added"
+	DOG_TRANSFERRED WebhookAction = "dog_transferred"
+	// FAVORITE_STATUS_CHANGED is only sent to the users that have the dog as a favorite.
+	FAVORITE_STATUS_CHANGED WebhookAction = "favorite_status_changed"
+	// SAVED_SEARCH_MATCH is only sent to the owner of a saved search that is delivered by webhook.
+	SAVED_SEARCH_MATCH WebhookAction = "saved_search_match"
 )
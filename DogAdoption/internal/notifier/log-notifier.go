@@ -0,0 +1,51 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+type logEntry struct {
+	Notification
+	SentAt time.Time `json:"sent_at"`
+}
+
+// LogNotifier is a Notifier for local runs that appends notifications as JSON lines to a file,
+// or writes them to the log when no file path is given.
+type LogNotifier struct {
+	filePath string
+	mutex    *sync.Mutex
+}
+
+func NewLogNotifier(filePath string) LogNotifier {
+	return LogNotifier{
+		filePath: filePath,
+		mutex:    &sync.Mutex{},
+	}
+}
+
+func (l LogNotifier) Notify(ctx context.Context, notification Notification) error {
+	data, err := json.Marshal(logEntry{Notification: notification, SentAt: time.Now()})
+	if err != nil {
+		return err
+	}
+
+	if l.filePath == "" {
+		log.Printf("Notification: %s", data)
+		return nil
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	file, err := os.OpenFile(l.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = file.Write(append(data, '\n'))
+	return err
+}
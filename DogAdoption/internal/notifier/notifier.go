@@ -0,0 +1,18 @@
+package notifier
+
+import "context"
+
+// Notification is a message to an account, such as a password reset link. Notifications of events, such
+// as reminders, also carry the event and its payload.
+type Notification struct {
+	Recipient string `json:"recipient"`
+	Subject   string `json:"subject"`
+	Body      string `json:"body"`
+	Event     string `json:"event,omitempty"`
+	Data      any    `json:"data,omitempty"`
+}
+
+// Notifier delivers notifications to accounts. Implementations decide the channel.
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"1dv027/aad/internal/dto"
+	admindto "1dv027/aad/internal/dto/admin"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+)
+
+type AdminsDataAccess interface {
+	GetAdminByUsername(ctx context.Context, username string) (model.Admin, error)
+	GetAdminById(ctx context.Context, adminId int) (model.Admin, error)
+	GetAdmins(ctx context.Context, queryParams dto.QueryParams) (admindto.GetAdminsQueryResponseDTO, error)
+	CreateAdmin(ctx context.Context, username, passwordHash string) (int, error)
+	UpdateAdminUsername(ctx context.Context, adminId int, username string) error
+	DeleteAdmin(ctx context.Context, adminId int) error
+}
+
+// AdminsRepository also looks up dog shelters, shelter staff and users, since login resolves usernames
+// across all account types and an admin username must not shadow another account.
+type AdminsRepository struct {
+	adminsDataAccess      AdminsDataAccess
+	dogSheltersDataAccess GetDogSheltersDataAccess
+	staffDataAccess       GetShelterStaffDataAccess
+	usersDataAccess       GetUsersDataAccess
+}
+
+func NewAdminsRepository(adminsDataAccess AdminsDataAccess, dogSheltersDataAccess GetDogSheltersDataAccess,
+	staffDataAccess GetShelterStaffDataAccess, usersDataAccess GetUsersDataAccess) AdminsRepository {
+	return AdminsRepository{
+		adminsDataAccess:      adminsDataAccess,
+		dogSheltersDataAccess: dogSheltersDataAccess,
+		staffDataAccess:       staffDataAccess,
+		usersDataAccess:       usersDataAccess,
+	}
+}
+
+func (a AdminsRepository) GetAdmins(ctx context.Context, queryParams dto.QueryParams) (admindto.GetAdminsQueryResponseDTO, error) {
+	return a.adminsDataAccess.GetAdmins(ctx, queryParams)
+}
+
+func (a AdminsRepository) GetAdminById(ctx context.Context, adminId int) (model.Admin, error) {
+	return a.adminsDataAccess.GetAdminById(ctx, adminId)
+}
+
+func (a AdminsRepository) CreateAdmin(ctx context.Context, username, passwordHash string) (model.Admin, error) {
+	err := a.checkUsernameAvailable(ctx, username, 0)
+	if err != nil {
+		return model.Admin{}, err
+	}
+	id, err := a.adminsDataAccess.CreateAdmin(ctx, username, passwordHash)
+	if err != nil {
+		return model.Admin{}, err
+	}
+	return a.adminsDataAccess.GetAdminById(ctx, id)
+}
+
+func (a AdminsRepository) UpdateAdminUsername(ctx context.Context, adminId int, username string) (model.Admin, error) {
+	err := a.checkUsernameAvailable(ctx, username, adminId)
+	if err != nil {
+		return model.Admin{}, err
+	}
+	err = a.adminsDataAccess.UpdateAdminUsername(ctx, adminId, username)
+	if err != nil {
+		return model.Admin{}, err
+	}
+	return a.adminsDataAccess.GetAdminById(ctx, adminId)
+}
+
+func (a AdminsRepository) DeleteAdmin(ctx context.Context, adminId int) error {
+	return a.adminsDataAccess.DeleteAdmin(ctx, adminId)
+}
+
+// checkUsernameAvailable returns an error if any account other than the admin with adminId uses the username.
+func (a AdminsRepository) checkUsernameAvailable(ctx context.Context, username string, adminId int) error {
+	usernameTakenError := &customerrors.InvalidAdminDataError{Message: "invalid username. try another one!"}
+
+	admin, err := a.adminsDataAccess.GetAdminByUsername(ctx, username)
+	if err != nil {
+		var adminNotFoundError *customerrors.AdminNotFoundError
+		if !errors.As(err, &adminNotFoundError) {
+			return err
+		}
+	} else if admin.Id != adminId {
+		return usernameTakenError
+	}
+
+	_, err = a.dogSheltersDataAccess.GetDogShelterByUsername(ctx, username)
+	if err != nil {
+		var dogShelterNotFoundError *customerrors.DogShelterNotFoundError
+		if !errors.As(err, &dogShelterNotFoundError) {
+			return err
+		}
+	} else {
+		return usernameTakenError
+	}
+
+	_, err = a.staffDataAccess.GetShelterStaffByUsername(ctx, username)
+	if err != nil {
+		var staffNotFoundError *customerrors.ShelterStaffNotFoundError
+		if !errors.As(err, &staffNotFoundError) {
+			return err
+		}
+	} else {
+		return usernameTakenError
+	}
+
+	_, err = a.usersDataAccess.GetUserByUsername(ctx, username)
+	if err != nil {
+		var userNotFoundError *customerrors.UserNotFoundError
+		if !errors.As(err, &userNotFoundError) {
+			return err
+		}
+	} else {
+		return usernameTakenError
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"1dv027/aad/internal/dto"
+	breeddto "1dv027/aad/internal/dto/breed"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type BreedsDataAccess interface {
+	GetBreeds(ctx context.Context, queryParams dto.QueryParams) (breeddto.GetBreedsQueryResponseDTO, error)
+	GetBreedById(ctx context.Context, breedId int) (model.Breed, error)
+	GetBreedByName(ctx context.Context, name string) (model.Breed, error)
+	CreateBreed(ctx context.Context, breed model.Breed) (int, error)
+	UpdateBreed(ctx context.Context, breed model.Breed) error
+	DeleteBreed(ctx context.Context, breedId int) error
+	MergeBreeds(ctx context.Context, targetBreedId, sourceBreedId int) error
+}
+
+type BreedsRepository struct {
+	dataAccess BreedsDataAccess
+}
+
+func NewBreedsRepository(dataAccess BreedsDataAccess) BreedsRepository {
+	return BreedsRepository{
+		dataAccess: dataAccess,
+	}
+}
+
+func (b BreedsRepository) GetBreeds(ctx context.Context, queryParams dto.QueryParams) (breeddto.GetBreedsQueryResponseDTO, error) {
+	return b.dataAccess.GetBreeds(ctx, queryParams)
+}
+
+func (b BreedsRepository) GetBreedById(ctx context.Context, breedId int) (model.Breed, error) {
+	return b.dataAccess.GetBreedById(ctx, breedId)
+}
+
+func (b BreedsRepository) GetBreedByName(ctx context.Context, name string) (model.Breed, error) {
+	return b.dataAccess.GetBreedByName(ctx, name)
+}
+
+func (b BreedsRepository) CreateBreed(ctx context.Context, breed model.Breed) (model.Breed, error) {
+	id, err := b.dataAccess.CreateBreed(ctx, breed)
+	if err != nil {
+		return model.Breed{}, err
+	}
+	return b.dataAccess.GetBreedById(ctx, id)
+}
+
+func (b BreedsRepository) UpdateBreed(ctx context.Context, breed model.Breed) (model.Breed, error) {
+	err := b.dataAccess.UpdateBreed(ctx, breed)
+	if err != nil {
+		return model.Breed{}, err
+	}
+	return b.dataAccess.GetBreedById(ctx, breed.Id)
+}
+
+func (b BreedsRepository) DeleteBreed(ctx context.Context, breedId int) error {
+	return b.dataAccess.DeleteBreed(ctx, breedId)
+}
+
+func (b BreedsRepository) MergeBreeds(ctx context.Context, targetBreedId, sourceBreedId int) (model.Breed, error) {
+	err := b.dataAccess.MergeBreeds(ctx, targetBreedId, sourceBreedId)
+	if err != nil {
+		return model.Breed{}, err
+	}
+	return b.dataAccess.GetBreedById(ctx, targetBreedId)
+}
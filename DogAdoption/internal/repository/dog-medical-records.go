@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type DogMedicalRecordsDataAccess interface {
+	CreateDogMedicalRecord(ctx context.Context, record model.DogMedicalRecord) (model.DogMedicalRecord, error)
+	GetDogMedicalRecords(ctx context.Context, dogId int) ([]model.DogMedicalRecord, error)
+	GetDogMedicalRecordById(ctx context.Context, dogId, recordId int) (model.DogMedicalRecord, error)
+	UpdateDogMedicalRecord(ctx context.Context, record model.DogMedicalRecord) error
+	DeleteDogMedicalRecord(ctx context.Context, dogId, recordId int) error
+}
+
+type DogMedicalRecordsRepository struct {
+	dataAccess     DogMedicalRecordsDataAccess
+	dogsDataAccess GetDogByIdDataAccess
+}
+
+func NewDogMedicalRecordsRepository(dataAccess DogMedicalRecordsDataAccess, dogsDataAccess GetDogByIdDataAccess) DogMedicalRecordsRepository {
+	return DogMedicalRecordsRepository{
+		dataAccess:     dataAccess,
+		dogsDataAccess: dogsDataAccess,
+	}
+}
+
+func (d DogMedicalRecordsRepository) GetDogById(ctx context.Context, dogId int) (model.Dog, error) {
+	return d.dogsDataAccess.GetDogById(ctx, dogId)
+}
+
+func (d DogMedicalRecordsRepository) CreateDogMedicalRecord(ctx context.Context, record model.DogMedicalRecord) (model.DogMedicalRecord, error) {
+	return d.dataAccess.CreateDogMedicalRecord(ctx, record)
+}
+
+func (d DogMedicalRecordsRepository) GetDogMedicalRecords(ctx context.Context, dogId int) ([]model.DogMedicalRecord, error) {
+	return d.dataAccess.GetDogMedicalRecords(ctx, dogId)
+}
+
+func (d DogMedicalRecordsRepository) GetDogMedicalRecordById(ctx context.Context, dogId, recordId int) (model.DogMedicalRecord, error) {
+	return d.dataAccess.GetDogMedicalRecordById(ctx, dogId, recordId)
+}
+
+func (d DogMedicalRecordsRepository) UpdateDogMedicalRecord(ctx context.Context, record model.DogMedicalRecord) (model.DogMedicalRecord, error) {
+	err := d.dataAccess.UpdateDogMedicalRecord(ctx, record)
+	if err != nil {
+		return model.DogMedicalRecord{}, err
+	}
+	return d.dataAccess.GetDogMedicalRecordById(ctx, record.DogId, record.Id)
+}
+
+func (d DogMedicalRecordsRepository) DeleteDogMedicalRecord(ctx context.Context, dogId, recordId int) error {
+	return d.dataAccess.DeleteDogMedicalRecord(ctx, dogId, recordId)
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type DogPhotosDataAccess interface {
+	CreateDogPhoto(ctx context.Context, photo model.DogPhoto, makePrimary bool) (model.DogPhoto, error)
+	GetDogPhotos(ctx context.Context, dogId int) ([]model.DogPhoto, error)
+	GetDogPhotoById(ctx context.Context, dogId, photoId int) (model.DogPhoto, error)
+	UpdateDogPhoto(ctx context.Context, dogId, photoId int, position *int, makePrimary bool) error
+	DeleteDogPhoto(ctx context.Context, dogId, photoId int) (model.DogPhoto, error)
+}
+
+type DogPhotosRepository struct {
+	dataAccess     DogPhotosDataAccess
+	dogsDataAccess GetDogByIdDataAccess
+}
+
+func NewDogPhotosRepository(dataAccess DogPhotosDataAccess, dogsDataAccess GetDogByIdDataAccess) DogPhotosRepository {
+	return DogPhotosRepository{
+		dataAccess:     dataAccess,
+		dogsDataAccess: dogsDataAccess,
+	}
+}
+
+func (d DogPhotosRepository) GetDogById(ctx context.Context, dogId int) (model.Dog, error) {
+	return d.dogsDataAccess.GetDogById(ctx, dogId)
+}
+
+func (d DogPhotosRepository) CreateDogPhoto(ctx context.Context, photo model.DogPhoto, makePrimary bool) (model.DogPhoto, error) {
+	return d.dataAccess.CreateDogPhoto(ctx, photo, makePrimary)
+}
+
+func (d DogPhotosRepository) GetDogPhotos(ctx context.Context, dogId int) ([]model.DogPhoto, error) {
+	return d.dataAccess.GetDogPhotos(ctx, dogId)
+}
+
+func (d DogPhotosRepository) GetDogPhotoById(ctx context.Context, dogId, photoId int) (model.DogPhoto, error) {
+	return d.dataAccess.GetDogPhotoById(ctx, dogId, photoId)
+}
+
+func (d DogPhotosRepository) UpdateDogPhoto(ctx context.Context, dogId, photoId int, position *int, makePrimary bool) (model.DogPhoto, error) {
+	err := d.dataAccess.UpdateDogPhoto(ctx, dogId, photoId, position, makePrimary)
+	if err != nil {
+		return model.DogPhoto{}, err
+	}
+	return d.dataAccess.GetDogPhotoById(ctx, dogId, photoId)
+}
+
+func (d DogPhotosRepository) DeleteDogPhoto(ctx context.Context, dogId, photoId int) (model.DogPhoto, error) {
+	return d.dataAccess.DeleteDogPhoto(ctx, dogId, photoId)
+}
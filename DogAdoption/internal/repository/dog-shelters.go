@@ -16,13 +16,23 @@ type DogSheltersDataAccess interface {
 	CreateDogShelter(ctx context.Context, newShelter dogshelterdto.NewDogShelterDTO) (int, error)
 }
 
+// DogSheltersRepository also looks up admins, shelter staff and users, since the username of a new
+// shelter must not be used by another account.
 type DogSheltersRepository struct {
 	dataAccess DogSheltersDataAccess
+	usernames  usernameLookups
 }
 
-func NewDogSheltersRepository(dataAccess DogSheltersDataAccess) DogSheltersRepository {
+func NewDogSheltersRepository(dataAccess DogSheltersDataAccess, adminsDataAccess GetAdminsDataAccess,
+	staffDataAccess GetShelterStaffDataAccess, usersDataAccess GetUsersDataAccess) DogSheltersRepository {
 	return DogSheltersRepository{
 		dataAccess: dataAccess,
+		usernames: usernameLookups{
+			adminsDataAccess:      adminsDataAccess,
+			dogSheltersDataAccess: dataAccess,
+			staffDataAccess:       staffDataAccess,
+			usersDataAccess:       usersDataAccess,
+		},
 	}
 }
 
@@ -52,6 +62,10 @@ func (d DogSheltersRepository) UpdateDogShelter(ctx context.Context, dogShelterI
 
 func (d DogSheltersRepository) CreateDogShelter(ctx context.Context, dogShelter dogshelterdto.NewDogShelterDTO) (model.DogShelter, error) {
 	emptyDto := model.DogShelter{}
+	err := d.usernames.checkUsernameAvailable(ctx, *dogShelter.Username)
+	if err != nil {
+		return emptyDto, err
+	}
 	id, err := d.dataAccess.CreateDogShelter(ctx, dogShelter)
 	if err != nil {
 		return emptyDto, err
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type DogTransfersDataAccess interface {
+	CreateDogTransfer(ctx context.Context, transfer model.DogTransfer) (model.DogTransfer, error)
+	GetDogTransferById(ctx context.Context, dogId, transferId int) (model.DogTransfer, error)
+	GetDogTransfers(ctx context.Context, dogId int) ([]model.DogTransfer, error)
+	AcceptDogTransfer(ctx context.Context, dogId, transferId int) error
+	CloseDogTransfer(ctx context.Context, dogId, transferId int, status model.DogTransferStatus, reason *string) error
+}
+
+type GetDogByIdDataAccess interface {
+	GetDogById(ctx context.Context, dogId int) (model.Dog, error)
+}
+
+type DogTransfersRepository struct {
+	dataAccess            DogTransfersDataAccess
+	dogsDataAccess        GetDogByIdDataAccess
+	shelterByIdDataAccess GetDogShelterByIdDataAccess
+}
+
+func NewDogTransfersRepository(dataAccess DogTransfersDataAccess, dogsDataAccess GetDogByIdDataAccess,
+	shelterByIdDataAccess GetDogShelterByIdDataAccess) DogTransfersRepository {
+	return DogTransfersRepository{
+		dataAccess:            dataAccess,
+		dogsDataAccess:        dogsDataAccess,
+		shelterByIdDataAccess: shelterByIdDataAccess,
+	}
+}
+
+func (d DogTransfersRepository) GetDogById(ctx context.Context, dogId int) (model.Dog, error) {
+	return d.dogsDataAccess.GetDogById(ctx, dogId)
+}
+
+func (d DogTransfersRepository) GetDogShelterById(ctx context.Context, shelterId int) (model.DogShelter, error) {
+	return d.shelterByIdDataAccess.GetDogShelterById(ctx, shelterId)
+}
+
+func (d DogTransfersRepository) CreateDogTransfer(ctx context.Context, transfer model.DogTransfer) (model.DogTransfer, error) {
+	return d.dataAccess.CreateDogTransfer(ctx, transfer)
+}
+
+func (d DogTransfersRepository) GetDogTransferById(ctx context.Context, dogId, transferId int) (model.DogTransfer, error) {
+	return d.dataAccess.GetDogTransferById(ctx, dogId, transferId)
+}
+
+func (d DogTransfersRepository) GetDogTransfers(ctx context.Context, dogId int) ([]model.DogTransfer, error) {
+	return d.dataAccess.GetDogTransfers(ctx, dogId)
+}
+
+func (d DogTransfersRepository) AcceptDogTransfer(ctx context.Context, dogId, transferId int) (model.DogTransfer, error) {
+	err := d.dataAccess.AcceptDogTransfer(ctx, dogId, transferId)
+	if err != nil {
+		return model.DogTransfer{}, err
+	}
+	return d.dataAccess.GetDogTransferById(ctx, dogId, transferId)
+}
+
+func (d DogTransfersRepository) CloseDogTransfer(ctx context.Context, dogId, transferId int,
+	status model.DogTransferStatus, reason *string) (model.DogTransfer, error) {
+	err := d.dataAccess.CloseDogTransfer(ctx, dogId, transferId, status, reason)
+	if err != nil {
+		return model.DogTransfer{}, err
+	}
+	return d.dataAccess.GetDogTransferById(ctx, dogId, transferId)
+}
@@ -13,6 +13,7 @@ type DogsDataAccess interface {
 	DeleteDog(ctx context.Context, dogId int) error
 	UpdateDog(ctx context.Context, dogId int, updatedDogData dogdto.UpdateDogDTO) error
 	CreateDog(ctx context.Context, newDog dogdto.NewDogDTO) (int, error)
+	GetDogStatusHistory(ctx context.Context, dogId int) ([]model.DogStatusChange, error)
 }
 
 type DogsRepository struct {
@@ -34,6 +35,10 @@ func (d DogsRepository) GetDogById(ctx context.Context, dogId int) (model.Dog, e
 
 }
 
+func (d DogsRepository) GetDogStatusHistory(ctx context.Context, dogId int) ([]model.DogStatusChange, error) {
+	return d.dogsDataAccess.GetDogStatusHistory(ctx, dogId)
+}
+
 func (d DogsRepository) DeleteDog(ctx context.Context, dogId int) error {
 	return d.dogsDataAccess.DeleteDog(ctx, dogId)
 }
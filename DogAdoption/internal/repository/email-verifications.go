@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type EmailVerificationsDataAccess interface {
+	CreateEmailVerificationToken(ctx context.Context, token model.EmailVerificationToken) error
+	VerifyEmail(ctx context.Context, tokenHash string) (model.EmailVerificationToken, error)
+}
+
+type EmailVerificationsRepository struct {
+	dataaccess      EmailVerificationsDataAccess
+	usersDataAccess GetUserByIdDataAccess
+}
+
+func NewEmailVerificationsRepository(dataaccess EmailVerificationsDataAccess, usersDataAccess GetUserByIdDataAccess) EmailVerificationsRepository {
+	return EmailVerificationsRepository{
+		dataaccess:      dataaccess,
+		usersDataAccess: usersDataAccess,
+	}
+}
+
+func (e EmailVerificationsRepository) CreateEmailVerificationToken(ctx context.Context, token model.EmailVerificationToken) error {
+	return e.dataaccess.CreateEmailVerificationToken(ctx, token)
+}
+
+func (e EmailVerificationsRepository) VerifyEmail(ctx context.Context, tokenHash string) (model.EmailVerificationToken, error) {
+	return e.dataaccess.VerifyEmail(ctx, tokenHash)
+}
+
+func (e EmailVerificationsRepository) GetUserById(ctx context.Context, userId int) (model.User, error) {
+	return e.usersDataAccess.GetUserById(ctx, userId)
+}
@@ -20,14 +20,17 @@ type GetUsersDataAccess interface {
 type LoginRepository struct {
 	adminsDataAccess      GetAdminsDataAccess
 	dogSheltersDataAccess GetDogSheltersDataAccess
+	staffDataAccess       GetShelterStaffDataAccess
 	usersDataAccess       GetUsersDataAccess
 }
 
 func NewLoginRepository(adminsDataAccess GetAdminsDataAccess,
-	dogSheltersDataAccess GetDogSheltersDataAccess, usersDataAccess GetUsersDataAccess) *LoginRepository {
+	dogSheltersDataAccess GetDogSheltersDataAccess, staffDataAccess GetShelterStaffDataAccess,
+	usersDataAccess GetUsersDataAccess) *LoginRepository {
 	return &LoginRepository{
 		adminsDataAccess:      adminsDataAccess,
 		dogSheltersDataAccess: dogSheltersDataAccess,
+		staffDataAccess:       staffDataAccess,
 		usersDataAccess:       usersDataAccess,
 	}
 }
@@ -58,3 +61,7 @@ func (l LoginRepository) GetUserByUsername(ctx context.Context, username string)
 	}
 	return user, nil
 }
+
+func (l LoginRepository) GetShelterStaffByUsername(ctx context.Context, username string) (model.ShelterStaff, error) {
+	return l.staffDataAccess.GetShelterStaffByUsername(ctx, username)
+}
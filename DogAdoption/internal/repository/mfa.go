@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type MfaDataAccess interface {
+	GetMfaEnrollment(ctx context.Context, accountId int, role model.UserRole) (model.MfaEnrollment, error)
+	UpsertMfaEnrollment(ctx context.Context, accountId int, role model.UserRole, secret string, recoveryCodes []string) error
+	EnableMfaEnrollment(ctx context.Context, accountId int, role model.UserRole, usedStep int64) error
+	UpdateMfaLastUsedStep(ctx context.Context, accountId int, role model.UserRole, usedStep int64) error
+	ConsumeMfaRecoveryCode(ctx context.Context, accountId int, role model.UserRole, hashedCode string) error
+	DeleteMfaEnrollment(ctx context.Context, accountId int, role model.UserRole) error
+	GetMfaPolicy(ctx context.Context, role model.UserRole) (model.MfaPolicy, error)
+	UpsertMfaPolicy(ctx context.Context, policy model.MfaPolicy) error
+}
+
+type MfaRepository struct {
+	dataAccess MfaDataAccess
+}
+
+func NewMfaRepository(dataAccess MfaDataAccess) MfaRepository {
+	return MfaRepository{
+		dataAccess: dataAccess,
+	}
+}
+
+func (m MfaRepository) GetMfaEnrollment(ctx context.Context, accountId int, role model.UserRole) (model.MfaEnrollment, error) {
+	return m.dataAccess.GetMfaEnrollment(ctx, accountId, role)
+}
+
+func (m MfaRepository) CreateMfaEnrollment(ctx context.Context, accountId int, role model.UserRole, secret string, recoveryCodes []string) (model.MfaEnrollment, error) {
+	err := m.dataAccess.UpsertMfaEnrollment(ctx, accountId, role, secret, recoveryCodes)
+	if err != nil {
+		return model.MfaEnrollment{}, err
+	}
+	return m.dataAccess.GetMfaEnrollment(ctx, accountId, role)
+}
+
+func (m MfaRepository) EnableMfaEnrollment(ctx context.Context, accountId int, role model.UserRole, usedStep int64) error {
+	return m.dataAccess.EnableMfaEnrollment(ctx, accountId, role, usedStep)
+}
+
+func (m MfaRepository) UpdateMfaLastUsedStep(ctx context.Context, accountId int, role model.UserRole, usedStep int64) error {
+	return m.dataAccess.UpdateMfaLastUsedStep(ctx, accountId, role, usedStep)
+}
+
+func (m MfaRepository) ConsumeMfaRecoveryCode(ctx context.Context, accountId int, role model.UserRole, hashedCode string) error {
+	return m.dataAccess.ConsumeMfaRecoveryCode(ctx, accountId, role, hashedCode)
+}
+
+func (m MfaRepository) DeleteMfaEnrollment(ctx context.Context, accountId int, role model.UserRole) error {
+	return m.dataAccess.DeleteMfaEnrollment(ctx, accountId, role)
+}
+
+func (m MfaRepository) GetMfaPolicy(ctx context.Context, role model.UserRole) (model.MfaPolicy, error) {
+	return m.dataAccess.GetMfaPolicy(ctx, role)
+}
+
+func (m MfaRepository) GetMfaPolicies(ctx context.Context, roles []model.UserRole) ([]model.MfaPolicy, error) {
+	policies := []model.MfaPolicy{}
+	for _, role := range roles {
+		policy, err := m.dataAccess.GetMfaPolicy(ctx, role)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+func (m MfaRepository) UpdateMfaPolicy(ctx context.Context, policy model.MfaPolicy) (model.MfaPolicy, error) {
+	err := m.dataAccess.UpsertMfaPolicy(ctx, policy)
+	if err != nil {
+		return model.MfaPolicy{}, err
+	}
+	return m.dataAccess.GetMfaPolicy(ctx, policy.Role)
+}
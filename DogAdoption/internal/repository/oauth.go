@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type OAuthDataAccess interface {
+	CreateClient(ctx context.Context, client model.OAuthClient) (model.OAuthClient, error)
+	GetClients(ctx context.Context) ([]model.OAuthClient, error)
+	GetClient(ctx context.Context, clientId string) (model.OAuthClient, error)
+	DeleteClient(ctx context.Context, clientId string) error
+	GetConsent(ctx context.Context, userId int, clientId string) (model.OAuthConsent, error)
+	GetUserConsents(ctx context.Context, userId int) ([]model.OAuthConsent, error)
+	UpsertConsent(ctx context.Context, userId int, clientId string, scopes []string) error
+	DeleteConsent(ctx context.Context, userId int, clientId string) error
+	CreateAuthorizationCode(ctx context.Context, code model.OAuthAuthorizationCode) error
+	ConsumeAuthorizationCode(ctx context.Context, codeHash string, clientId string) (model.OAuthAuthorizationCode, error)
+	CreateRefreshToken(ctx context.Context, token model.OAuthRefreshToken) error
+	ConsumeRefreshToken(ctx context.Context, tokenHash string, clientId string) (model.OAuthRefreshToken, error)
+	RevokeRefreshTokens(ctx context.Context, userId int, clientId string) error
+}
+
+type OAuthRepository struct {
+	dataaccess      OAuthDataAccess
+	usersDataAccess GetUserByIdDataAccess
+}
+
+func NewOAuthRepository(dataaccess OAuthDataAccess, usersDataAccess GetUserByIdDataAccess) OAuthRepository {
+	return OAuthRepository{
+		dataaccess:      dataaccess,
+		usersDataAccess: usersDataAccess,
+	}
+}
+
+func (o OAuthRepository) CreateClient(ctx context.Context, client model.OAuthClient) (model.OAuthClient, error) {
+	return o.dataaccess.CreateClient(ctx, client)
+}
+
+func (o OAuthRepository) GetClients(ctx context.Context) ([]model.OAuthClient, error) {
+	return o.dataaccess.GetClients(ctx)
+}
+
+func (o OAuthRepository) GetClient(ctx context.Context, clientId string) (model.OAuthClient, error) {
+	return o.dataaccess.GetClient(ctx, clientId)
+}
+
+func (o OAuthRepository) DeleteClient(ctx context.Context, clientId string) error {
+	return o.dataaccess.DeleteClient(ctx, clientId)
+}
+
+func (o OAuthRepository) GetConsent(ctx context.Context, userId int, clientId string) (model.OAuthConsent, error) {
+	return o.dataaccess.GetConsent(ctx, userId, clientId)
+}
+
+func (o OAuthRepository) GetUserConsents(ctx context.Context, userId int) ([]model.OAuthConsent, error) {
+	_, err := o.usersDataAccess.GetUserById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	return o.dataaccess.GetUserConsents(ctx, userId)
+}
+
+func (o OAuthRepository) SaveConsent(ctx context.Context, userId int, clientId string, scopes []string) (model.OAuthConsent, error) {
+	err := o.dataaccess.UpsertConsent(ctx, userId, clientId, scopes)
+	if err != nil {
+		return model.OAuthConsent{}, err
+	}
+	return o.dataaccess.GetConsent(ctx, userId, clientId)
+}
+
+// DeleteConsent removes the consent and revokes the refresh tokens the client was issued for the user.
+func (o OAuthRepository) DeleteConsent(ctx context.Context, userId int, clientId string) error {
+	err := o.dataaccess.DeleteConsent(ctx, userId, clientId)
+	if err != nil {
+		return err
+	}
+	return o.dataaccess.RevokeRefreshTokens(ctx, userId, clientId)
+}
+
+func (o OAuthRepository) CreateAuthorizationCode(ctx context.Context, code model.OAuthAuthorizationCode) error {
+	return o.dataaccess.CreateAuthorizationCode(ctx, code)
+}
+
+func (o OAuthRepository) ConsumeAuthorizationCode(ctx context.Context, codeHash string, clientId string) (model.OAuthAuthorizationCode, error) {
+	return o.dataaccess.ConsumeAuthorizationCode(ctx, codeHash, clientId)
+}
+
+func (o OAuthRepository) CreateRefreshToken(ctx context.Context, token model.OAuthRefreshToken) error {
+	return o.dataaccess.CreateRefreshToken(ctx, token)
+}
+
+func (o OAuthRepository) ConsumeRefreshToken(ctx context.Context, tokenHash string, clientId string) (model.OAuthRefreshToken, error) {
+	return o.dataaccess.ConsumeRefreshToken(ctx, tokenHash, clientId)
+}
+
+func (o OAuthRepository) GetUserById(ctx context.Context, userId int) (model.User, error) {
+	return o.usersDataAccess.GetUserById(ctx, userId)
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type PasswordsDataAccess interface {
+	GetPasswordHash(ctx context.Context, accountId int, role model.UserRole) (string, error)
+	UpdatePassword(ctx context.Context, accountId int, role model.UserRole, passwordHash string) error
+	UpdatePasswordHash(ctx context.Context, accountId int, role model.UserRole, passwordHash string) error
+	GetAccountUsername(ctx context.Context, accountId int, role model.UserRole) (string, error)
+	CreatePasswordResetToken(ctx context.Context, token model.PasswordResetToken) error
+	GetPasswordResetToken(ctx context.Context, tokenHash string) (model.PasswordResetToken, error)
+	ConsumePasswordResetToken(ctx context.Context, tokenHash string) (model.PasswordResetToken, error)
+}
+
+type PasswordsRepository struct {
+	dataaccess            PasswordsDataAccess
+	dogSheltersDataAccess GetDogSheltersDataAccess
+	staffDataAccess       GetShelterStaffDataAccess
+	usersDataAccess       GetUsersDataAccess
+}
+
+func NewPasswordsRepository(dataaccess PasswordsDataAccess, dogSheltersDataAccess GetDogSheltersDataAccess,
+	staffDataAccess GetShelterStaffDataAccess, usersDataAccess GetUsersDataAccess) PasswordsRepository {
+	return PasswordsRepository{
+		dataaccess:            dataaccess,
+		dogSheltersDataAccess: dogSheltersDataAccess,
+		staffDataAccess:       staffDataAccess,
+		usersDataAccess:       usersDataAccess,
+	}
+}
+
+func (p PasswordsRepository) GetPasswordHash(ctx context.Context, accountId int, role model.UserRole) (string, error) {
+	return p.dataaccess.GetPasswordHash(ctx, accountId, role)
+}
+
+func (p PasswordsRepository) UpdatePassword(ctx context.Context, accountId int, role model.UserRole, passwordHash string) error {
+	return p.dataaccess.UpdatePassword(ctx, accountId, role, passwordHash)
+}
+
+func (p PasswordsRepository) UpdatePasswordHash(ctx context.Context, accountId int, role model.UserRole, passwordHash string) error {
+	return p.dataaccess.UpdatePasswordHash(ctx, accountId, role, passwordHash)
+}
+
+func (p PasswordsRepository) GetAccountUsername(ctx context.Context, accountId int, role model.UserRole) (string, error) {
+	return p.dataaccess.GetAccountUsername(ctx, accountId, role)
+}
+
+func (p PasswordsRepository) CreatePasswordResetToken(ctx context.Context, token model.PasswordResetToken) error {
+	return p.dataaccess.CreatePasswordResetToken(ctx, token)
+}
+
+func (p PasswordsRepository) GetPasswordResetToken(ctx context.Context, tokenHash string) (model.PasswordResetToken, error) {
+	return p.dataaccess.GetPasswordResetToken(ctx, tokenHash)
+}
+
+func (p PasswordsRepository) ConsumePasswordResetToken(ctx context.Context, tokenHash string) (model.PasswordResetToken, error) {
+	return p.dataaccess.ConsumePasswordResetToken(ctx, tokenHash)
+}
+
+func (p PasswordsRepository) GetDogShelterByUsername(ctx context.Context, username string) (model.DogShelter, error) {
+	return p.dogSheltersDataAccess.GetDogShelterByUsername(ctx, username)
+}
+
+func (p PasswordsRepository) GetShelterStaffByUsername(ctx context.Context, username string) (model.ShelterStaff, error) {
+	return p.staffDataAccess.GetShelterStaffByUsername(ctx, username)
+}
+
+func (p PasswordsRepository) GetUserByUsername(ctx context.Context, username string) (model.User, error) {
+	return p.usersDataAccess.GetUserByUsername(ctx, username)
+}
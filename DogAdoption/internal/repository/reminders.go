@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"1dv027/aad/internal/model"
+	"context"
+	"time"
+)
+
+type RemindersDataAccess interface {
+	GetDueVaccinations(ctx context.Context, from, to time.Time) ([]model.VaccinationDueReminder, error)
+	GetAdoptionsForFollowup(ctx context.Context, from, to time.Time) ([]model.PostAdoptionFollowupReminder, error)
+	MarkReminderSent(ctx context.Context, kind model.ReminderKind, referenceId int, dueOn time.Time) (bool, error)
+}
+
+type ReminderStaffDataAccess interface {
+	GetShelterStaff(ctx context.Context, shelterId int) ([]model.ShelterStaff, error)
+}
+
+type RemindersRepository struct {
+	dataAccess      RemindersDataAccess
+	staffDataAccess ReminderStaffDataAccess
+}
+
+func NewRemindersRepository(dataAccess RemindersDataAccess, staffDataAccess ReminderStaffDataAccess) RemindersRepository {
+	return RemindersRepository{
+		dataAccess:      dataAccess,
+		staffDataAccess: staffDataAccess,
+	}
+}
+
+func (r RemindersRepository) GetDueVaccinations(ctx context.Context, from, to time.Time) ([]model.VaccinationDueReminder, error) {
+	return r.dataAccess.GetDueVaccinations(ctx, from, to)
+}
+
+func (r RemindersRepository) GetAdoptionsForFollowup(ctx context.Context, from, to time.Time) ([]model.PostAdoptionFollowupReminder, error) {
+	return r.dataAccess.GetAdoptionsForFollowup(ctx, from, to)
+}
+
+// GetShelterStaff returns the staff of the shelter, who get the reminders of the shelter too.
+func (r RemindersRepository) GetShelterStaff(ctx context.Context, shelterId int) ([]model.ShelterStaff, error) {
+	return r.staffDataAccess.GetShelterStaff(ctx, shelterId)
+}
+
+func (r RemindersRepository) MarkReminderSent(ctx context.Context, kind model.ReminderKind, referenceId int, dueOn time.Time) (bool, error) {
+	return r.dataAccess.MarkReminderSent(ctx, kind, referenceId, dueOn)
+}
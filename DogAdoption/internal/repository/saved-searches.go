@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"1dv027/aad/internal/dto"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type SavedSearchesDataAccess interface {
+	CreateSavedSearch(ctx context.Context, savedSearch model.SavedSearch) (model.SavedSearch, error)
+	GetUserSavedSearches(ctx context.Context, userId int) ([]model.SavedSearch, error)
+	GetUserSavedSearch(ctx context.Context, userId int, searchId int) (model.SavedSearch, error)
+	GetActiveSavedSearches(ctx context.Context) ([]model.SavedSearch, error)
+	UpdateSavedSearch(ctx context.Context, savedSearch model.SavedSearch) (model.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, userId int, searchId int) error
+	AddSavedSearchMatch(ctx context.Context, searchId int, dogId int) (bool, error)
+	MarkSavedSearchMatchesNotified(ctx context.Context, searchId int, dogIds []int) error
+	GetPendingSavedSearchDigests(ctx context.Context) ([]model.SavedSearchDigest, error)
+}
+
+type SavedSearchDogsDataAccess interface {
+	GetSearchesMatchingDog(ctx context.Context, dogId int, searches map[int]dto.QueryParams) ([]int, error)
+	GetDogById(ctx context.Context, dogId int) (model.Dog, error)
+}
+
+type SavedSearchesRepository struct {
+	dataaccess      SavedSearchesDataAccess
+	usersDataAccess GetUserByIdDataAccess
+	dogsDataAccess  SavedSearchDogsDataAccess
+}
+
+func NewSavedSearchesRepository(dataaccess SavedSearchesDataAccess, usersDataAccess GetUserByIdDataAccess,
+	dogsDataAccess SavedSearchDogsDataAccess) SavedSearchesRepository {
+	return SavedSearchesRepository{
+		dataaccess:      dataaccess,
+		usersDataAccess: usersDataAccess,
+		dogsDataAccess:  dogsDataAccess,
+	}
+}
+
+func (s SavedSearchesRepository) CreateSavedSearch(ctx context.Context, savedSearch model.SavedSearch) (model.SavedSearch, error) {
+	return s.dataaccess.CreateSavedSearch(ctx, savedSearch)
+}
+
+func (s SavedSearchesRepository) GetUserSavedSearches(ctx context.Context, userId int) ([]model.SavedSearch, error) {
+	_, err := s.usersDataAccess.GetUserById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	return s.dataaccess.GetUserSavedSearches(ctx, userId)
+}
+
+func (s SavedSearchesRepository) GetUserSavedSearch(ctx context.Context, userId int, searchId int) (model.SavedSearch, error) {
+	return s.dataaccess.GetUserSavedSearch(ctx, userId, searchId)
+}
+
+func (s SavedSearchesRepository) GetActiveSavedSearches(ctx context.Context) ([]model.SavedSearch, error) {
+	return s.dataaccess.GetActiveSavedSearches(ctx)
+}
+
+func (s SavedSearchesRepository) UpdateSavedSearch(ctx context.Context, savedSearch model.SavedSearch) (model.SavedSearch, error) {
+	return s.dataaccess.UpdateSavedSearch(ctx, savedSearch)
+}
+
+func (s SavedSearchesRepository) DeleteSavedSearch(ctx context.Context, userId int, searchId int) error {
+	return s.dataaccess.DeleteSavedSearch(ctx, userId, searchId)
+}
+
+// GetSavedSearchesMatchingDog returns the ids of the saved searches whose filters find the dog. The searches
+// are given by id with their parsed queries.
+func (s SavedSearchesRepository) GetSavedSearchesMatchingDog(ctx context.Context, dogId int,
+	searches map[int]dto.QueryParams) ([]int, error) {
+	return s.dogsDataAccess.GetSearchesMatchingDog(ctx, dogId, searches)
+}
+
+func (s SavedSearchesRepository) AddSavedSearchMatch(ctx context.Context, searchId int, dogId int) (bool, error) {
+	return s.dataaccess.AddSavedSearchMatch(ctx, searchId, dogId)
+}
+
+func (s SavedSearchesRepository) MarkSavedSearchMatchesNotified(ctx context.Context, searchId int, dogIds []int) error {
+	return s.dataaccess.MarkSavedSearchMatchesNotified(ctx, searchId, dogIds)
+}
+
+func (s SavedSearchesRepository) GetPendingSavedSearchDigests(ctx context.Context) ([]model.SavedSearchDigest, error) {
+	return s.dataaccess.GetPendingSavedSearchDigests(ctx)
+}
+
+func (s SavedSearchesRepository) GetUserById(ctx context.Context, userId int) (model.User, error) {
+	return s.usersDataAccess.GetUserById(ctx, userId)
+}
+
+func (s SavedSearchesRepository) GetDogById(ctx context.Context, dogId int) (model.Dog, error) {
+	return s.dogsDataAccess.GetDogById(ctx, dogId)
+}
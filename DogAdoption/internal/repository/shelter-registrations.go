@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"1dv027/aad/internal/dto"
+	dogshelterdto "1dv027/aad/internal/dto/dog-shelter"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type ShelterRegistrationsDataAccess interface {
+	GetDogShelterById(ctx context.Context, shelterId int) (model.DogShelter, error)
+	GetDogShelterByUsername(ctx context.Context, username string) (model.DogShelter, error)
+	CreateDogShelterRegistration(ctx context.Context, registration dogshelterdto.NewDogShelterRegistrationDTO) (int, error)
+	GetDogShelterRegistrations(ctx context.Context, queryParams dto.QueryParams) (dogshelterdto.GetDogSheltersQueryResponseDTO, error)
+	ReviewDogShelterRegistration(ctx context.Context, shelterId int, status model.DogShelterStatus, reason *string) error
+}
+
+// ShelterRegistrationsRepository also looks up admins, shelter staff and users, since a self-registered
+// shelter must not be able to take a username that login resolves to another account.
+type ShelterRegistrationsRepository struct {
+	dataAccess ShelterRegistrationsDataAccess
+	usernames  usernameLookups
+}
+
+func NewShelterRegistrationsRepository(dataAccess ShelterRegistrationsDataAccess, adminsDataAccess GetAdminsDataAccess,
+	staffDataAccess GetShelterStaffDataAccess, usersDataAccess GetUsersDataAccess) ShelterRegistrationsRepository {
+	return ShelterRegistrationsRepository{
+		dataAccess: dataAccess,
+		usernames: usernameLookups{
+			adminsDataAccess:      adminsDataAccess,
+			dogSheltersDataAccess: dataAccess,
+			staffDataAccess:       staffDataAccess,
+			usersDataAccess:       usersDataAccess,
+		},
+	}
+}
+
+func (s ShelterRegistrationsRepository) CreateRegistration(ctx context.Context, registration dogshelterdto.NewDogShelterRegistrationDTO) (model.DogShelter, error) {
+	err := s.usernames.checkUsernameAvailable(ctx, *registration.Username)
+	if err != nil {
+		return model.DogShelter{}, err
+	}
+	id, err := s.dataAccess.CreateDogShelterRegistration(ctx, registration)
+	if err != nil {
+		return model.DogShelter{}, err
+	}
+	return s.dataAccess.GetDogShelterById(ctx, id)
+}
+
+func (s ShelterRegistrationsRepository) GetRegistrations(ctx context.Context, queryParams dto.QueryParams) (dogshelterdto.GetDogSheltersQueryResponseDTO, error) {
+	return s.dataAccess.GetDogShelterRegistrations(ctx, queryParams)
+}
+
+func (s ShelterRegistrationsRepository) GetRegistrationById(ctx context.Context, shelterId int) (model.DogShelter, error) {
+	return s.dataAccess.GetDogShelterById(ctx, shelterId)
+}
+
+func (s ShelterRegistrationsRepository) ReviewRegistration(ctx context.Context, shelterId int, status model.DogShelterStatus, reason *string) (model.DogShelter, error) {
+	err := s.dataAccess.ReviewDogShelterRegistration(ctx, shelterId, status, reason)
+	if err != nil {
+		return model.DogShelter{}, err
+	}
+	return s.dataAccess.GetDogShelterById(ctx, shelterId)
+}
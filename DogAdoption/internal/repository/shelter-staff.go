@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type ShelterStaffDataAccess interface {
+	GetShelterStaffByUsername(ctx context.Context, username string) (model.ShelterStaff, error)
+	GetShelterStaffById(ctx context.Context, staffId int) (model.ShelterStaff, error)
+	GetShelterStaff(ctx context.Context, shelterId int) ([]model.ShelterStaff, error)
+	UpdateShelterStaffRole(ctx context.Context, shelterId, staffId int, role model.ShelterStaffRole) error
+	DeleteShelterStaff(ctx context.Context, shelterId, staffId int) error
+	CreateStaffInvitation(ctx context.Context, invitation model.ShelterStaffInvitation) (int, error)
+	GetStaffInvitationById(ctx context.Context, shelterId, invitationId int) (model.ShelterStaffInvitation, error)
+	GetStaffInvitations(ctx context.Context, shelterId int) ([]model.ShelterStaffInvitation, error)
+	DeleteStaffInvitation(ctx context.Context, shelterId, invitationId int) error
+	AcceptStaffInvitation(ctx context.Context, tokenHash, username, passwordHash string) (int, error)
+}
+
+type GetShelterStaffDataAccess interface {
+	GetShelterStaffByUsername(ctx context.Context, username string) (model.ShelterStaff, error)
+}
+
+type GetDogShelterByIdDataAccess interface {
+	GetDogShelterById(ctx context.Context, shelterId int) (model.DogShelter, error)
+}
+
+// ShelterStaffRepository also looks up admins, dog shelters and users, since login resolves
+// usernames across all account types and a staff username must not shadow another account.
+type ShelterStaffRepository struct {
+	dataAccess            ShelterStaffDataAccess
+	usernames             usernameLookups
+	shelterByIdDataAccess GetDogShelterByIdDataAccess
+}
+
+func NewShelterStaffRepository(dataAccess ShelterStaffDataAccess, adminsDataAccess GetAdminsDataAccess,
+	dogSheltersDataAccess GetDogSheltersDataAccess, usersDataAccess GetUsersDataAccess,
+	shelterByIdDataAccess GetDogShelterByIdDataAccess) ShelterStaffRepository {
+	return ShelterStaffRepository{
+		dataAccess: dataAccess,
+		usernames: usernameLookups{
+			adminsDataAccess:      adminsDataAccess,
+			dogSheltersDataAccess: dogSheltersDataAccess,
+			staffDataAccess:       dataAccess,
+			usersDataAccess:       usersDataAccess,
+		},
+		shelterByIdDataAccess: shelterByIdDataAccess,
+	}
+}
+
+func (s ShelterStaffRepository) GetDogShelterById(ctx context.Context, shelterId int) (model.DogShelter, error) {
+	return s.shelterByIdDataAccess.GetDogShelterById(ctx, shelterId)
+}
+
+func (s ShelterStaffRepository) GetShelterStaffById(ctx context.Context, staffId int) (model.ShelterStaff, error) {
+	return s.dataAccess.GetShelterStaffById(ctx, staffId)
+}
+
+func (s ShelterStaffRepository) GetShelterStaff(ctx context.Context, shelterId int) ([]model.ShelterStaff, error) {
+	return s.dataAccess.GetShelterStaff(ctx, shelterId)
+}
+
+func (s ShelterStaffRepository) UpdateShelterStaffRole(ctx context.Context, shelterId, staffId int, role model.ShelterStaffRole) (model.ShelterStaff, error) {
+	err := s.dataAccess.UpdateShelterStaffRole(ctx, shelterId, staffId, role)
+	if err != nil {
+		return model.ShelterStaff{}, err
+	}
+	return s.dataAccess.GetShelterStaffById(ctx, staffId)
+}
+
+func (s ShelterStaffRepository) DeleteShelterStaff(ctx context.Context, shelterId, staffId int) error {
+	return s.dataAccess.DeleteShelterStaff(ctx, shelterId, staffId)
+}
+
+func (s ShelterStaffRepository) CreateStaffInvitation(ctx context.Context, invitation model.ShelterStaffInvitation) (model.ShelterStaffInvitation, error) {
+	id, err := s.dataAccess.CreateStaffInvitation(ctx, invitation)
+	if err != nil {
+		return model.ShelterStaffInvitation{}, err
+	}
+	return s.dataAccess.GetStaffInvitationById(ctx, invitation.ShelterId, id)
+}
+
+func (s ShelterStaffRepository) GetStaffInvitations(ctx context.Context, shelterId int) ([]model.ShelterStaffInvitation, error) {
+	return s.dataAccess.GetStaffInvitations(ctx, shelterId)
+}
+
+func (s ShelterStaffRepository) DeleteStaffInvitation(ctx context.Context, shelterId, invitationId int) error {
+	return s.dataAccess.DeleteStaffInvitation(ctx, shelterId, invitationId)
+}
+
+func (s ShelterStaffRepository) AcceptStaffInvitation(ctx context.Context, tokenHash, username, passwordHash string) (model.ShelterStaff, error) {
+	err := s.usernames.checkUsernameAvailable(ctx, username)
+	if err != nil {
+		return model.ShelterStaff{}, err
+	}
+	id, err := s.dataAccess.AcceptStaffInvitation(ctx, tokenHash, username, passwordHash)
+	if err != nil {
+		return model.ShelterStaff{}, err
+	}
+	return s.dataAccess.GetShelterStaffById(ctx, id)
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type UserApiKeysDataAccess interface {
+	CreateApiKey(ctx context.Context, userId int, name, prefix, keyHash string, scopes []string) (model.ApiKey, error)
+	GetUserApiKeys(ctx context.Context, userId int) ([]model.ApiKey, error)
+	GetUserApiKey(ctx context.Context, userId int, keyId int) (model.ApiKey, error)
+	GetActiveApiKeyByHash(ctx context.Context, keyHash string) (model.ApiKey, error)
+	RevokeApiKey(ctx context.Context, userId int, keyId int) error
+	UpdateApiKeyLastUsed(ctx context.Context, keyId int) error
+}
+
+type GetUserByIdDataAccess interface {
+	GetUserById(ctx context.Context, userId int) (model.User, error)
+}
+
+type UserApiKeysRepository struct {
+	dataaccess      UserApiKeysDataAccess
+	usersDataAccess GetUserByIdDataAccess
+}
+
+func NewUserApiKeysRepository(dataaccess UserApiKeysDataAccess, usersDataAccess GetUserByIdDataAccess) UserApiKeysRepository {
+	return UserApiKeysRepository{
+		dataaccess:      dataaccess,
+		usersDataAccess: usersDataAccess,
+	}
+}
+
+func (u UserApiKeysRepository) CreateApiKey(ctx context.Context, userId int, name, prefix, keyHash string, scopes []string) (model.ApiKey, error) {
+	return u.dataaccess.CreateApiKey(ctx, userId, name, prefix, keyHash, scopes)
+}
+
+func (u UserApiKeysRepository) GetUserApiKeys(ctx context.Context, userId int) ([]model.ApiKey, error) {
+	_, err := u.usersDataAccess.GetUserById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	return u.dataaccess.GetUserApiKeys(ctx, userId)
+}
+
+func (u UserApiKeysRepository) RevokeApiKey(ctx context.Context, userId int, keyId int) (model.ApiKey, error) {
+	err := u.dataaccess.RevokeApiKey(ctx, userId, keyId)
+	if err != nil {
+		return model.ApiKey{}, err
+	}
+	return u.dataaccess.GetUserApiKey(ctx, userId, keyId)
+}
+
+// GetApiKeyOwner returns the active api key matching the hash together with the user owning it,
+// and records the usage of the key.
+func (u UserApiKeysRepository) GetApiKeyOwner(ctx context.Context, keyHash string) (model.ApiKey, model.User, error) {
+	apiKey, err := u.dataaccess.GetActiveApiKeyByHash(ctx, keyHash)
+	if err != nil {
+		return model.ApiKey{}, model.User{}, err
+	}
+	user, err := u.usersDataAccess.GetUserById(ctx, apiKey.UserId)
+	if err != nil {
+		return model.ApiKey{}, model.User{}, err
+	}
+	err = u.dataaccess.UpdateApiKeyLastUsed(ctx, apiKey.Id)
+	if err != nil {
+		return model.ApiKey{}, model.User{}, err
+	}
+	return apiKey, user, nil
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	userdto "1dv027/aad/internal/dto/user"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+)
+
+type ExportWebhooksDataAccess interface {
+	GetUserWebhook(ctx context.Context, userId int) (model.Webhook, error)
+}
+
+type ExportApiKeysDataAccess interface {
+	GetUserApiKeys(ctx context.Context, userId int) ([]model.ApiKey, error)
+}
+
+type ExportOAuthDataAccess interface {
+	GetClients(ctx context.Context) ([]model.OAuthClient, error)
+	GetUserConsents(ctx context.Context, userId int) ([]model.OAuthConsent, error)
+}
+
+type ExportMfaDataAccess interface {
+	GetMfaEnrollment(ctx context.Context, accountId int, role model.UserRole) (model.MfaEnrollment, error)
+}
+
+type ExportFavoritesDataAccess interface {
+	GetUserFavorites(ctx context.Context, userId int) ([]model.UserFavorite, error)
+}
+
+type ExportSavedSearchesDataAccess interface {
+	GetUserSavedSearches(ctx context.Context, userId int) ([]model.SavedSearch, error)
+}
+
+type ExportNotificationsDataAccess interface {
+	GetAllUserNotifications(ctx context.Context, userId int) ([]model.UserNotification, error)
+}
+
+// UserExportsRepository collects the data held about a user from the tables that reference it.
+type UserExportsRepository struct {
+	usersDataAccess         GetUserByIdDataAccess
+	webhooksDataAccess      ExportWebhooksDataAccess
+	apiKeysDataAccess       ExportApiKeysDataAccess
+	oauthDataAccess         ExportOAuthDataAccess
+	mfaDataAccess           ExportMfaDataAccess
+	favoritesDataAccess     ExportFavoritesDataAccess
+	savedSearchesDataAccess ExportSavedSearchesDataAccess
+	notificationsDataAccess ExportNotificationsDataAccess
+}
+
+func NewUserExportsRepository(usersDataAccess GetUserByIdDataAccess, webhooksDataAccess ExportWebhooksDataAccess,
+	apiKeysDataAccess ExportApiKeysDataAccess, oauthDataAccess ExportOAuthDataAccess, mfaDataAccess ExportMfaDataAccess,
+	favoritesDataAccess ExportFavoritesDataAccess, savedSearchesDataAccess ExportSavedSearchesDataAccess,
+	notificationsDataAccess ExportNotificationsDataAccess) UserExportsRepository {
+	return UserExportsRepository{
+		usersDataAccess:         usersDataAccess,
+		webhooksDataAccess:      webhooksDataAccess,
+		apiKeysDataAccess:       apiKeysDataAccess,
+		oauthDataAccess:         oauthDataAccess,
+		mfaDataAccess:           mfaDataAccess,
+		favoritesDataAccess:     favoritesDataAccess,
+		savedSearchesDataAccess: savedSearchesDataAccess,
+		notificationsDataAccess: notificationsDataAccess,
+	}
+}
+
+func (u UserExportsRepository) GetUserExport(ctx context.Context, userId int) (userdto.UserExportQueryResponseDTO, error) {
+	emptyDto := userdto.UserExportQueryResponseDTO{}
+	user, err := u.usersDataAccess.GetUserById(ctx, userId)
+	if err != nil {
+		return emptyDto, err
+	}
+	export := userdto.UserExportQueryResponseDTO{User: user}
+
+	webhook, err := u.webhooksDataAccess.GetUserWebhook(ctx, userId)
+	if err != nil {
+		var webhookNotFoundError *customerrors.WebhookNotFoundError
+		if !errors.As(err, &webhookNotFoundError) {
+			return emptyDto, err
+		}
+	} else {
+		export.Webhook = &webhook
+	}
+
+	export.ApiKeys, err = u.apiKeysDataAccess.GetUserApiKeys(ctx, userId)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	clients, err := u.oauthDataAccess.GetClients(ctx)
+	if err != nil {
+		return emptyDto, err
+	}
+	for _, client := range clients {
+		if client.OwnerUserId != nil && *client.OwnerUserId == userId {
+			export.OAuthClients = append(export.OAuthClients, client)
+		}
+	}
+
+	export.OAuthConsents, err = u.oauthDataAccess.GetUserConsents(ctx, userId)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	enrollment, err := u.mfaDataAccess.GetMfaEnrollment(ctx, userId, model.USER)
+	if err != nil {
+		var enrollmentNotFoundError *customerrors.MfaEnrollmentNotFoundError
+		if !errors.As(err, &enrollmentNotFoundError) {
+			return emptyDto, err
+		}
+	} else {
+		export.MfaEnrollment = &enrollment
+	}
+
+	export.Favorites, err = u.favoritesDataAccess.GetUserFavorites(ctx, userId)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	export.SavedSearches, err = u.savedSearchesDataAccess.GetUserSavedSearches(ctx, userId)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	export.Notifications, err = u.notificationsDataAccess.GetAllUserNotifications(ctx, userId)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	return export, nil
+}
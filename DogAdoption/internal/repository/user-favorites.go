@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"1dv027/aad/internal/dto"
+	dogdto "1dv027/aad/internal/dto/dog"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type UserFavoritesDataAccess interface {
+	AddFavorite(ctx context.Context, userId int, dogId int) error
+	RemoveFavorite(ctx context.Context, userId int, dogId int) error
+	GetFavoritingUsers(ctx context.Context, dogId int) ([]model.User, error)
+}
+
+type FavoriteDogsDataAccess interface {
+	GetDogs(ctx context.Context, queryParams dto.QueryParams) (dogdto.GetDogsQueryResponseDTO, error)
+}
+
+type UserFavoritesRepository struct {
+	dataaccess      UserFavoritesDataAccess
+	usersDataAccess GetUserByIdDataAccess
+	dogsDataAccess  FavoriteDogsDataAccess
+}
+
+func NewUserFavoritesRepository(dataaccess UserFavoritesDataAccess, usersDataAccess GetUserByIdDataAccess,
+	dogsDataAccess FavoriteDogsDataAccess) UserFavoritesRepository {
+	return UserFavoritesRepository{
+		dataaccess:      dataaccess,
+		usersDataAccess: usersDataAccess,
+		dogsDataAccess:  dogsDataAccess,
+	}
+}
+
+func (u UserFavoritesRepository) AddFavorite(ctx context.Context, userId int, dogId int) error {
+	_, err := u.usersDataAccess.GetUserById(ctx, userId)
+	if err != nil {
+		return err
+	}
+	return u.dataaccess.AddFavorite(ctx, userId, dogId)
+}
+
+func (u UserFavoritesRepository) RemoveFavorite(ctx context.Context, userId int, dogId int) error {
+	return u.dataaccess.RemoveFavorite(ctx, userId, dogId)
+}
+
+// GetFavoriteDogs returns a page of the favorite dogs of the user. The dog filters of the query params apply
+// to the favorites.
+func (u UserFavoritesRepository) GetFavoriteDogs(ctx context.Context, userId int, queryParams dto.QueryParams) (dogdto.GetDogsQueryResponseDTO, error) {
+	_, err := u.usersDataAccess.GetUserById(ctx, userId)
+	if err != nil {
+		return dogdto.GetDogsQueryResponseDTO{}, err
+	}
+	dogsFilter := dto.DogsFilterParams{}
+	if queryParams.DogsFilter != nil {
+		dogsFilter = *queryParams.DogsFilter
+	}
+	dogsFilter.FavoritedBy = &userId
+	queryParams.DogsFilter = &dogsFilter
+	return u.dogsDataAccess.GetDogs(ctx, queryParams)
+}
+
+func (u UserFavoritesRepository) GetFavoritingUsers(ctx context.Context, dogId int) ([]model.User, error) {
+	return u.dataaccess.GetFavoritingUsers(ctx, dogId)
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"1dv027/aad/internal/dto"
+	usernotificationdto "1dv027/aad/internal/dto/user/notification"
+	"1dv027/aad/internal/model"
+	"context"
+	"time"
+)
+
+type UserNotificationsDataAccess interface {
+	CreateNotifications(ctx context.Context, notifications []model.UserNotification, maxPerUser int) error
+	CreateNotificationForActiveUsers(ctx context.Context, notification model.UserNotification, maxPerUser int) error
+	GetUserNotifications(ctx context.Context, userId int, queryParams dto.QueryParams) (usernotificationdto.GetNotificationsQueryResponseDTO, error)
+	GetUnreadNotificationCount(ctx context.Context, userId int) (int, error)
+	MarkNotificationRead(ctx context.Context, userId int, notificationId int) (model.UserNotification, error)
+	MarkAllNotificationsRead(ctx context.Context, userId int) error
+	DeleteNotificationsCreatedBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+type UserNotificationsRepository struct {
+	dataaccess      UserNotificationsDataAccess
+	usersDataAccess GetUserByIdDataAccess
+}
+
+func NewUserNotificationsRepository(dataaccess UserNotificationsDataAccess, usersDataAccess GetUserByIdDataAccess) UserNotificationsRepository {
+	return UserNotificationsRepository{
+		dataaccess:      dataaccess,
+		usersDataAccess: usersDataAccess,
+	}
+}
+
+func (u UserNotificationsRepository) CreateNotifications(ctx context.Context, notifications []model.UserNotification,
+	maxPerUser int) error {
+	return u.dataaccess.CreateNotifications(ctx, notifications, maxPerUser)
+}
+
+func (u UserNotificationsRepository) CreateNotificationForActiveUsers(ctx context.Context, notification model.UserNotification,
+	maxPerUser int) error {
+	return u.dataaccess.CreateNotificationForActiveUsers(ctx, notification, maxPerUser)
+}
+
+func (u UserNotificationsRepository) GetUserNotifications(ctx context.Context, userId int,
+	queryParams dto.QueryParams) (usernotificationdto.GetNotificationsQueryResponseDTO, error) {
+	_, err := u.usersDataAccess.GetUserById(ctx, userId)
+	if err != nil {
+		return usernotificationdto.GetNotificationsQueryResponseDTO{}, err
+	}
+	return u.dataaccess.GetUserNotifications(ctx, userId, queryParams)
+}
+
+func (u UserNotificationsRepository) GetUnreadNotificationCount(ctx context.Context, userId int) (int, error) {
+	_, err := u.usersDataAccess.GetUserById(ctx, userId)
+	if err != nil {
+		return 0, err
+	}
+	return u.dataaccess.GetUnreadNotificationCount(ctx, userId)
+}
+
+func (u UserNotificationsRepository) MarkNotificationRead(ctx context.Context, userId int, notificationId int) (model.UserNotification, error) {
+	return u.dataaccess.MarkNotificationRead(ctx, userId, notificationId)
+}
+
+func (u UserNotificationsRepository) MarkAllNotificationsRead(ctx context.Context, userId int) error {
+	_, err := u.usersDataAccess.GetUserById(ctx, userId)
+	if err != nil {
+		return err
+	}
+	return u.dataaccess.MarkAllNotificationsRead(ctx, userId)
+}
+
+func (u UserNotificationsRepository) DeleteNotificationsCreatedBefore(ctx context.Context, before time.Time) (int, error) {
+	return u.dataaccess.DeleteNotificationsCreatedBefore(ctx, before)
+}
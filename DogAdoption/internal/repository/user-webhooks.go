@@ -15,12 +15,14 @@ type UserWebhooksDataAccess interface {
 }
 
 type UserWebhooksRepository struct {
-	dataaccess UserWebhooksDataAccess
+	dataaccess      UserWebhooksDataAccess
+	usersDataAccess GetUserByIdDataAccess
 }
 
-func NewUserWebhooksRepository(dataaccess UserWebhooksDataAccess) UserWebhooksRepository {
+func NewUserWebhooksRepository(dataaccess UserWebhooksDataAccess, usersDataAccess GetUserByIdDataAccess) UserWebhooksRepository {
 	return UserWebhooksRepository{
-		dataaccess: dataaccess,
+		dataaccess:      dataaccess,
+		usersDataAccess: usersDataAccess,
 	}
 }
 
@@ -61,3 +63,7 @@ func (u UserWebhooksRepository) UpdateUserWebhook(ctx context.Context, userId in
 func (u UserWebhooksRepository) GetAllWebhooksByAction(ctx context.Context, action model.WebhookAction) ([]model.Webhook, error) {
 	return u.dataaccess.GetAllWebhooksByAction(ctx, action)
 }
+
+func (u UserWebhooksRepository) GetUserById(ctx context.Context, userId int) (model.User, error) {
+	return u.usersDataAccess.GetUserById(ctx, userId)
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"errors"
+)
+
+// usernameLookups looks a username up in every account type. Login and password resets resolve a username
+// across all of them, so a new shelter or staff account must not take a username that is already in use.
+type usernameLookups struct {
+	adminsDataAccess      GetAdminsDataAccess
+	dogSheltersDataAccess GetDogSheltersDataAccess
+	staffDataAccess       GetShelterStaffDataAccess
+	usersDataAccess       GetUsersDataAccess
+}
+
+// checkUsernameAvailable returns a UsernameTakenError if any account, including pending shelter
+// registrations, uses the username.
+func (u usernameLookups) checkUsernameAvailable(ctx context.Context, username string) error {
+	usernameTakenError := &customerrors.UsernameTakenError{Message: "username is already taken. try another one!"}
+
+	_, err := u.adminsDataAccess.GetAdminByUsername(ctx, username)
+	if err != nil {
+		var adminNotFoundError *customerrors.AdminNotFoundError
+		if !errors.As(err, &adminNotFoundError) {
+			return err
+		}
+	} else {
+		return usernameTakenError
+	}
+
+	_, err = u.dogSheltersDataAccess.GetDogShelterByUsername(ctx, username)
+	if err != nil {
+		var dogShelterNotFoundError *customerrors.DogShelterNotFoundError
+		if !errors.As(err, &dogShelterNotFoundError) {
+			return err
+		}
+	} else {
+		return usernameTakenError
+	}
+
+	_, err = u.staffDataAccess.GetShelterStaffByUsername(ctx, username)
+	if err != nil {
+		var staffNotFoundError *customerrors.ShelterStaffNotFoundError
+		if !errors.As(err, &staffNotFoundError) {
+			return err
+		}
+	} else {
+		return usernameTakenError
+	}
+
+	_, err = u.usersDataAccess.GetUserByUsername(ctx, username)
+	if err != nil {
+		var userNotFoundError *customerrors.UserNotFoundError
+		if !errors.As(err, &userNotFoundError) {
+			return err
+		}
+	} else {
+		return usernameTakenError
+	}
+	return nil
+}
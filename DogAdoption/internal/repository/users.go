@@ -12,7 +12,12 @@ import (
 type UsersDataAccess interface {
 	CreateNewUser(ctx context.Context, newUser userdto.NewUserDTO) (model.User, error)
 	GetUserByUsername(ctx context.Context, username string) (model.User, error)
-	DeleteUser(ctx context.Context, userId int) error
+	GetUserById(ctx context.Context, userId int) (model.User, error)
+	GetUserByEmail(ctx context.Context, email string) (model.User, error)
+	UpdateUserProfile(ctx context.Context, user model.User) error
+	EraseUser(ctx context.Context, userId int) error
+	GetUsers(ctx context.Context, queryParams dto.QueryParams) (userdto.GetUsersQueryResponseDTO, error)
+	SetUserStatus(ctx context.Context, userId int, status model.UserStatus) error
 }
 
 type UsersRepository struct {
@@ -37,6 +42,16 @@ func (u UsersRepository) CreateNewUser(ctx context.Context, newUser userdto.NewU
 		return model.User{}, &customerrors.InvalidNewUserDataError{Message: "invalid username. try another one!"}
 	}
 
+	_, err = u.usersDataAccess.GetUserByEmail(ctx, *newUser.Email)
+	if err != nil {
+		var userNotFoundError *customerrors.UserNotFoundError
+		if !errors.As(err, &userNotFoundError) {
+			return model.User{}, err
+		}
+	} else {
+		return model.User{}, &customerrors.InvalidNewUserDataError{Message: "email is already registered"}
+	}
+
 	createdUser, err := u.usersDataAccess.CreateNewUser(ctx, newUser)
 	if err != nil {
 		return model.User{}, err
@@ -44,8 +59,36 @@ func (u UsersRepository) CreateNewUser(ctx context.Context, newUser userdto.NewU
 	return createdUser, nil
 }
 
+func (u UsersRepository) GetUserById(ctx context.Context, userId int) (model.User, error) {
+	return u.usersDataAccess.GetUserById(ctx, userId)
+}
+
+func (u UsersRepository) GetUserByEmail(ctx context.Context, email string) (model.User, error) {
+	return u.usersDataAccess.GetUserByEmail(ctx, email)
+}
+
+func (u UsersRepository) UpdateUserProfile(ctx context.Context, user model.User) (model.User, error) {
+	err := u.usersDataAccess.UpdateUserProfile(ctx, user)
+	if err != nil {
+		return model.User{}, err
+	}
+	return u.usersDataAccess.GetUserById(ctx, user.Id)
+}
+
+func (u UsersRepository) GetUsers(ctx context.Context, queryParams dto.QueryParams) (userdto.GetUsersQueryResponseDTO, error) {
+	return u.usersDataAccess.GetUsers(ctx, queryParams)
+}
+
+func (u UsersRepository) SetUserStatus(ctx context.Context, userId int, status model.UserStatus) (model.User, error) {
+	err := u.usersDataAccess.SetUserStatus(ctx, userId, status)
+	if err != nil {
+		return model.User{}, err
+	}
+	return u.usersDataAccess.GetUserById(ctx, userId)
+}
+
 func (u UsersRepository) DeleteUser(ctx context.Context, userId int) error {
-	return u.usersDataAccess.DeleteUser(ctx, userId)
+	return u.usersDataAccess.EraseUser(ctx, userId)
 }
 
 func (u UsersRepository) GetAuthenticatedUser(ctx context.Context, user dto.UserCredentials) (model.User, error) {
@@ -18,6 +18,8 @@ type Handler interface {
 
 type AuthMiddleware interface {
 	AuthenticateRequest(c *fiber.Ctx) error
+	AuthenticateMfaEnrollmentRequest(c *fiber.Ctx) error
+	AuthenticateOptionalRequest(c *fiber.Ctx) error
 }
 
 type QueryParamsMiddleware interface {
@@ -35,7 +37,11 @@ func NewRouter(container IoCContainer) Router {
 }
 
 func (r Router) StartRouter() {
-	app := fiber.New()
+	// Raised from the 4 MiB default so that dog photos fit in a multipart upload. The photo size limit
+	// itself is enforced by the upload service.
+	app := fiber.New(fiber.Config{
+		BodyLimit: 16 * 1024 * 1024,
+	})
 	basePath := os.Getenv("ROUTER_BASE_PATH")
 	cfg := swagger.Config{
 		BasePath: basePath,
@@ -61,7 +67,212 @@ func (r Router) StartRouter() {
 		return loginHandler.Handle(c)
 	})
 
+	auth.Post("/password-reset", func(c *fiber.Ctx) error {
+		forgotPasswordHandler := r.container.Resolve("PasswordForgotHandler", config.Transient).(Handler)
+		return forgotPasswordHandler.Handle(c)
+	})
+	auth.Post("/password-reset/confirm", func(c *fiber.Ctx) error {
+		resetPasswordHandler := r.container.Resolve("PasswordResetHandler", config.Transient).(Handler)
+		return resetPasswordHandler.Handle(c)
+	})
+
+	mfa := auth.Group("/mfa")
+	mfa.Post("/enroll", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateMfaEnrollmentRequest(c)
+	}, func(c *fiber.Ctx) error {
+		enrollMfaHandler := r.container.Resolve("MfaEnrollHandler", config.Transient).(Handler)
+		return enrollMfaHandler.Handle(c)
+	})
+	mfa.Post("/verify", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateMfaEnrollmentRequest(c)
+	}, func(c *fiber.Ctx) error {
+		verifyMfaHandler := r.container.Resolve("MfaVerifyHandler", config.Transient).(Handler)
+		return verifyMfaHandler.Handle(c)
+	})
+	mfa.Post("/disable", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		disableMfaHandler := r.container.Resolve("MfaDisableHandler", config.Transient).(Handler)
+		return disableMfaHandler.Handle(c)
+	})
+	mfa.Post("/challenge", func(c *fiber.Ctx) error {
+		challengeMfaHandler := r.container.Resolve("MfaChallengeHandler", config.Transient).(Handler)
+		return challengeMfaHandler.Handle(c)
+	})
+	mfa.Get("/policies", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		getMfaPoliciesHandler := r.container.Resolve("MfaPoliciesGetHandler", config.Transient).(Handler)
+		return getMfaPoliciesHandler.Handle(c)
+	})
+	mfa.Put("/policies/:role", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		putMfaPolicyHandler := r.container.Resolve("MfaPolicyPutHandler", config.Transient).(Handler)
+		return putMfaPolicyHandler.Handle(c)
+	})
+
+	oauth := v1.Group("/oauth")
+	oauth.Post("/clients", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		postOAuthClientHandler := r.container.Resolve("OAuthClientPostHandler", config.Transient).(Handler)
+		return postOAuthClientHandler.Handle(c)
+	})
+	oauth.Get("/clients", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		getOAuthClientsHandler := r.container.Resolve("OAuthClientGetHandler", config.Transient).(Handler)
+		return getOAuthClientsHandler.Handle(c)
+	})
+	oauth.Delete("/clients/:clientId", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		deleteOAuthClientHandler := r.container.Resolve("OAuthClientDeleteHandler", config.Transient).(Handler)
+		return deleteOAuthClientHandler.Handle(c)
+	})
+	oauth.Get("/authorize", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		getAuthorizeHandler := r.container.Resolve("OAuthAuthorizeGetHandler", config.Transient).(Handler)
+		return getAuthorizeHandler.Handle(c)
+	})
+	oauth.Post("/authorize", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		postAuthorizeHandler := r.container.Resolve("OAuthAuthorizePostHandler", config.Transient).(Handler)
+		return postAuthorizeHandler.Handle(c)
+	})
+	oauth.Post("/token", func(c *fiber.Ctx) error {
+		tokenHandler := r.container.Resolve("OAuthTokenHandler", config.Transient).(Handler)
+		return tokenHandler.Handle(c)
+	})
+
 	dogs := v1.Group("/dogs")
+	dogs.Get("/:id/medical-records", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateOptionalRequest(c)
+	}, func(c *fiber.Ctx) error {
+		getDogMedicalRecordsHandler := r.container.Resolve("DogMedicalRecordGetHandler", config.Transient).(Handler)
+		return getDogMedicalRecordsHandler.Handle(c)
+	})
+	dogs.Post("/:id/medical-records", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		postDogMedicalRecordHandler := r.container.Resolve("DogMedicalRecordPostHandler", config.Transient).(Handler)
+		return postDogMedicalRecordHandler.Handle(c)
+	})
+	dogs.Get("/:id/medical-records/:recordId", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		getDogMedicalRecordByIdHandler := r.container.Resolve("DogMedicalRecordGetByIdHandler", config.Transient).(Handler)
+		return getDogMedicalRecordByIdHandler.Handle(c)
+	})
+	dogs.Put("/:id/medical-records/:recordId", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		putDogMedicalRecordHandler := r.container.Resolve("DogMedicalRecordPutHandler", config.Transient).(Handler)
+		return putDogMedicalRecordHandler.Handle(c)
+	})
+	dogs.Delete("/:id/medical-records/:recordId", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		deleteDogMedicalRecordHandler := r.container.Resolve("DogMedicalRecordDeleteHandler", config.Transient).(Handler)
+		return deleteDogMedicalRecordHandler.Handle(c)
+	})
+	dogs.Get("/:id/photos", func(c *fiber.Ctx) error {
+		getDogPhotosHandler := r.container.Resolve("DogPhotoGetHandler", config.Transient).(Handler)
+		return getDogPhotosHandler.Handle(c)
+	})
+	dogs.Post("/:id/photos", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		postDogPhotoHandler := r.container.Resolve("DogPhotoPostHandler", config.Transient).(Handler)
+		return postDogPhotoHandler.Handle(c)
+	})
+	dogs.Get("/:id/photos/:photoId", func(c *fiber.Ctx) error {
+		getDogPhotoByIdHandler := r.container.Resolve("DogPhotoGetByIdHandler", config.Transient).(Handler)
+		return getDogPhotoByIdHandler.Handle(c)
+	})
+	dogs.Put("/:id/photos/:photoId", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		putDogPhotoHandler := r.container.Resolve("DogPhotoPutHandler", config.Transient).(Handler)
+		return putDogPhotoHandler.Handle(c)
+	})
+	dogs.Delete("/:id/photos/:photoId", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		deleteDogPhotoHandler := r.container.Resolve("DogPhotoDeleteHandler", config.Transient).(Handler)
+		return deleteDogPhotoHandler.Handle(c)
+	})
+	dogs.Get("/:id/photos/:photoId/:size", func(c *fiber.Ctx) error {
+		dogPhotoFileHandler := r.container.Resolve("DogPhotoFileHandler", config.Transient).(Handler)
+		return dogPhotoFileHandler.Handle(c)
+	})
+	dogs.Get("/:id/transfers", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		getDogTransfersHandler := r.container.Resolve("DogTransferGetHandler", config.Transient).(Handler)
+		return getDogTransfersHandler.Handle(c)
+	})
+	dogs.Post("/:id/transfers", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		postDogTransferHandler := r.container.Resolve("DogTransferPostHandler", config.Transient).(Handler)
+		return postDogTransferHandler.Handle(c)
+	})
+	dogs.Get("/:id/transfers/:transferId", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		getDogTransferByIdHandler := r.container.Resolve("DogTransferGetByIdHandler", config.Transient).(Handler)
+		return getDogTransferByIdHandler.Handle(c)
+	})
+	dogs.Post("/:id/transfers/:transferId/accept", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		acceptDogTransferHandler := r.container.Resolve("DogTransferAcceptHandler", config.Transient).(Handler)
+		return acceptDogTransferHandler.Handle(c)
+	})
+	dogs.Post("/:id/transfers/:transferId/decline", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		declineDogTransferHandler := r.container.Resolve("DogTransferDeclineHandler", config.Transient).(Handler)
+		return declineDogTransferHandler.Handle(c)
+	})
+	dogs.Post("/:id/transfers/:transferId/cancel", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		cancelDogTransferHandler := r.container.Resolve("DogTransferCancelHandler", config.Transient).(Handler)
+		return cancelDogTransferHandler.Handle(c)
+	})
+	dogs.Get("/:id/status-history", func(c *fiber.Ctx) error {
+		getDogStatusHistoryHandler := r.container.Resolve("DogStatusHistoryHandler", config.Transient).(Handler)
+		return getDogStatusHistoryHandler.Handle(c)
+	})
 	dogs.Delete("/:id", func(c *fiber.Ctx) error {
 		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
 		return authMiddleware.AuthenticateRequest(c)
@@ -77,6 +288,9 @@ func (r Router) StartRouter() {
 		return putDogHandler.Handle(c)
 	})
 	dogs.Get("/:id", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateOptionalRequest(c)
+	}, func(c *fiber.Ctx) error {
 		getDogByIdHandler := r.container.Resolve("DogGetByIdHandler", config.Transient).(Handler)
 		return getDogByIdHandler.Handle(c)
 	})
@@ -88,6 +302,9 @@ func (r Router) StartRouter() {
 		return postDogsHandler.Handle(c)
 	})
 	dogs.Get("/", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateOptionalRequest(c)
+	}, func(c *fiber.Ctx) error {
 		queryParamsMiddleware := r.container.Resolve("QueryParamsMiddleware", config.Transient).(QueryParamsMiddleware)
 		return queryParamsMiddleware.ValidateQueryParams(c)
 	}, func(c *fiber.Ctx) error {
@@ -95,6 +312,47 @@ func (r Router) StartRouter() {
 		return getDogsHandler.Handle(c)
 	})
 
+	breeds := v1.Group("/breeds")
+	breeds.Get("/", func(c *fiber.Ctx) error {
+		queryParamsMiddleware := r.container.Resolve("QueryParamsMiddleware", config.Transient).(QueryParamsMiddleware)
+		return queryParamsMiddleware.ValidateQueryParams(c)
+	}, func(c *fiber.Ctx) error {
+		getBreedsHandler := r.container.Resolve("BreedGetHandler", config.Transient).(Handler)
+		return getBreedsHandler.Handle(c)
+	})
+	breeds.Get("/:id", func(c *fiber.Ctx) error {
+		getBreedByIdHandler := r.container.Resolve("BreedGetByIdHandler", config.Transient).(Handler)
+		return getBreedByIdHandler.Handle(c)
+	})
+	breeds.Post("/", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		postBreedHandler := r.container.Resolve("BreedPostHandler", config.Transient).(Handler)
+		return postBreedHandler.Handle(c)
+	})
+	breeds.Put("/:id", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		putBreedHandler := r.container.Resolve("BreedPutHandler", config.Transient).(Handler)
+		return putBreedHandler.Handle(c)
+	})
+	breeds.Delete("/:id", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		deleteBreedHandler := r.container.Resolve("BreedDeleteHandler", config.Transient).(Handler)
+		return deleteBreedHandler.Handle(c)
+	})
+	breeds.Post("/:id/merge", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		mergeBreedHandler := r.container.Resolve("BreedMergeHandler", config.Transient).(Handler)
+		return mergeBreedHandler.Handle(c)
+	})
+
 	dogshelters := v1.Group("/dogshelters")
 	dogshelters.Delete("/:id", func(c *fiber.Ctx) error {
 		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
@@ -110,10 +368,74 @@ func (r Router) StartRouter() {
 		putDogsheltersHandler := r.container.Resolve("DogShelterPutHandler", config.Transient).(Handler)
 		return putDogsheltersHandler.Handle(c)
 	})
+	dogshelters.Post("/:id/password", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		dogShelterPasswordHandler := r.container.Resolve("DogShelterPasswordHandler", config.Transient).(Handler)
+		return dogShelterPasswordHandler.Handle(c)
+	})
+	dogshelters.Get("/:id/staff", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		getShelterStaffHandler := r.container.Resolve("ShelterStaffGetHandler", config.Transient).(Handler)
+		return getShelterStaffHandler.Handle(c)
+	})
+	dogshelters.Put("/:id/staff/:staffId", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		putShelterStaffHandler := r.container.Resolve("ShelterStaffPutHandler", config.Transient).(Handler)
+		return putShelterStaffHandler.Handle(c)
+	})
+	dogshelters.Delete("/:id/staff/:staffId", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		deleteShelterStaffHandler := r.container.Resolve("ShelterStaffDeleteHandler", config.Transient).(Handler)
+		return deleteShelterStaffHandler.Handle(c)
+	})
+	dogshelters.Get("/:id/staff-invitations", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		getStaffInvitationsHandler := r.container.Resolve("ShelterStaffInvitationsGetHandler", config.Transient).(Handler)
+		return getStaffInvitationsHandler.Handle(c)
+	})
+	dogshelters.Post("/:id/staff-invitations", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		inviteShelterStaffHandler := r.container.Resolve("ShelterStaffInviteHandler", config.Transient).(Handler)
+		return inviteShelterStaffHandler.Handle(c)
+	})
+	dogshelters.Delete("/:id/staff-invitations/:invitationId", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		deleteStaffInvitationHandler := r.container.Resolve("ShelterStaffInvitationDeleteHandler", config.Transient).(Handler)
+		return deleteStaffInvitationHandler.Handle(c)
+	})
+	dogshelters.Post("/staff/:id/password", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		shelterStaffPasswordHandler := r.container.Resolve("ShelterStaffPasswordChangeHandler", config.Transient).(Handler)
+		return shelterStaffPasswordHandler.Handle(c)
+	})
+	dogshelters.Post("/staff-invitations/accept", func(c *fiber.Ctx) error {
+		acceptStaffInvitationHandler := r.container.Resolve("ShelterStaffAcceptHandler", config.Transient).(Handler)
+		return acceptStaffInvitationHandler.Handle(c)
+	})
 	dogshelters.Get("/:id", func(c *fiber.Ctx) error {
 		getDogSheltersByIdHandler := r.container.Resolve("DogShelterGetByIdHandler", config.Transient).(Handler)
 		return getDogSheltersByIdHandler.Handle(c)
 	})
+	dogshelters.Post("/registrations", func(c *fiber.Ctx) error {
+		registerDogShelterHandler := r.container.Resolve("DogShelterRegisterHandler", config.Transient).(Handler)
+		return registerDogShelterHandler.Handle(c)
+	})
 	dogshelters.Post("/", func(c *fiber.Ctx) error {
 		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
 		return authMiddleware.AuthenticateRequest(c)
@@ -129,11 +451,101 @@ func (r Router) StartRouter() {
 		return getDogsheltersHandler.Handle(c)
 	})
 
+	admins := v1.Group("/admins")
+	admins.Get("/", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		queryParamsMiddleware := r.container.Resolve("QueryParamsMiddleware", config.Transient).(QueryParamsMiddleware)
+		return queryParamsMiddleware.ValidateQueryParams(c)
+	}, func(c *fiber.Ctx) error {
+		getAdminsHandler := r.container.Resolve("AdminGetHandler", config.Transient).(Handler)
+		return getAdminsHandler.Handle(c)
+	})
+	admins.Post("/", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		postAdminHandler := r.container.Resolve("AdminPostHandler", config.Transient).(Handler)
+		return postAdminHandler.Handle(c)
+	})
+	admins.Get("/:id", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		getAdminByIdHandler := r.container.Resolve("AdminGetByIdHandler", config.Transient).(Handler)
+		return getAdminByIdHandler.Handle(c)
+	})
+	admins.Put("/:id", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		putAdminHandler := r.container.Resolve("AdminPutHandler", config.Transient).(Handler)
+		return putAdminHandler.Handle(c)
+	})
+	admins.Delete("/:id", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		deleteAdminHandler := r.container.Resolve("AdminDeleteHandler", config.Transient).(Handler)
+		return deleteAdminHandler.Handle(c)
+	})
+	admins.Post("/:id/password", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		adminPasswordHandler := r.container.Resolve("AdminPasswordHandler", config.Transient).(Handler)
+		return adminPasswordHandler.Handle(c)
+	})
+
+	admin := v1.Group("/admin")
+	admin.Get("/shelter-registrations", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		queryParamsMiddleware := r.container.Resolve("QueryParamsMiddleware", config.Transient).(QueryParamsMiddleware)
+		return queryParamsMiddleware.ValidateQueryParams(c)
+	}, func(c *fiber.Ctx) error {
+		getShelterRegistrationsHandler := r.container.Resolve("ShelterRegistrationsGetHandler", config.Transient).(Handler)
+		return getShelterRegistrationsHandler.Handle(c)
+	})
+	admin.Get("/shelter-registrations/:id", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		getShelterRegistrationByIdHandler := r.container.Resolve("ShelterRegistrationGetByIdHandler", config.Transient).(Handler)
+		return getShelterRegistrationByIdHandler.Handle(c)
+	})
+	admin.Post("/shelter-registrations/:id/approve", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		approveShelterRegistrationHandler := r.container.Resolve("ShelterRegistrationApproveHandler", config.Transient).(Handler)
+		return approveShelterRegistrationHandler.Handle(c)
+	})
+	admin.Post("/shelter-registrations/:id/reject", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		rejectShelterRegistrationHandler := r.container.Resolve("ShelterRegistrationRejectHandler", config.Transient).(Handler)
+		return rejectShelterRegistrationHandler.Handle(c)
+	})
+
 	users := v1.Group("/users")
 	users.Post("/", func(c *fiber.Ctx) error {
 		postUserHandler := r.container.Resolve("UserPostHandler", config.Transient).(Handler)
 		return postUserHandler.Handle(c)
 	})
+	users.Get("/", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		queryParamsMiddleware := r.container.Resolve("QueryParamsMiddleware", config.Transient).(QueryParamsMiddleware)
+		return queryParamsMiddleware.ValidateQueryParams(c)
+	}, func(c *fiber.Ctx) error {
+		getUsersHandler := r.container.Resolve("UserGetHandler", config.Transient).(Handler)
+		return getUsersHandler.Handle(c)
+	})
 	users.Delete("/:id", func(c *fiber.Ctx) error {
 		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
 		return authMiddleware.AuthenticateRequest(c)
@@ -141,6 +553,45 @@ func (r Router) StartRouter() {
 		deleteUserHandler := r.container.Resolve("UserDeleteHandler", config.Transient).(Handler)
 		return deleteUserHandler.Handle(c)
 	})
+	users.Post("/email-verification", func(c *fiber.Ctx) error {
+		verifyEmailHandler := r.container.Resolve("UserEmailVerificationVerifyHandler", config.Transient).(Handler)
+		return verifyEmailHandler.Handle(c)
+	})
+	users.Post("/:id/email-verification", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		resendEmailVerificationHandler := r.container.Resolve("UserEmailVerificationResendHandler", config.Transient).(Handler)
+		return resendEmailVerificationHandler.Handle(c)
+	})
+	users.Get("/:id/export", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		exportUserHandler := r.container.Resolve("UserExportHandler", config.Transient).(Handler)
+		return exportUserHandler.Handle(c)
+	})
+	users.Post("/:id/password", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		userPasswordHandler := r.container.Resolve("UserPasswordHandler", config.Transient).(Handler)
+		return userPasswordHandler.Handle(c)
+	})
+	users.Put("/:id/suspension", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		suspendUserHandler := r.container.Resolve("UserSuspendHandler", config.Transient).(Handler)
+		return suspendUserHandler.Handle(c)
+	})
+	users.Delete("/:id/suspension", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		unsuspendUserHandler := r.container.Resolve("UserUnsuspendHandler", config.Transient).(Handler)
+		return unsuspendUserHandler.Handle(c)
+	})
 	users.Get("/me", func(c *fiber.Ctx) error {
 		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
 		return authMiddleware.AuthenticateRequest(c)
@@ -148,6 +599,172 @@ func (r Router) StartRouter() {
 		userGetMeHandler := r.container.Resolve("UserGetMeHandler", config.Transient).(Handler)
 		return userGetMeHandler.Handle(c)
 	})
+	users.Get("/me/recommendations", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		queryParamsMiddleware := r.container.Resolve("QueryParamsMiddleware", config.Transient).(QueryParamsMiddleware)
+		return queryParamsMiddleware.ValidateQueryParams(c)
+	}, func(c *fiber.Ctx) error {
+		recommendationsHandler := r.container.Resolve("UserGetMeRecommendationsHandler", config.Transient).(Handler)
+		return recommendationsHandler.Handle(c)
+	})
+	users.Get("/:id", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		getUserHandler := r.container.Resolve("UserGetByIdHandler", config.Transient).(Handler)
+		return getUserHandler.Handle(c)
+	})
+	users.Put("/:id", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		putUserHandler := r.container.Resolve("UserPutHandler", config.Transient).(Handler)
+		return putUserHandler.Handle(c)
+	})
+	users.Patch("/:id", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		patchUserHandler := r.container.Resolve("UserPatchHandler", config.Transient).(Handler)
+		return patchUserHandler.Handle(c)
+	})
+
+	userapikeys := users.Group("/:id/api-keys")
+	userapikeys.Post("/", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		postUserApiKeyHandler := r.container.Resolve("UserApiKeyPostHandler", config.Transient).(Handler)
+		return postUserApiKeyHandler.Handle(c)
+	})
+	userapikeys.Get("/", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		getUserApiKeysHandler := r.container.Resolve("UserApiKeyGetHandler", config.Transient).(Handler)
+		return getUserApiKeysHandler.Handle(c)
+	})
+	userapikeys.Delete("/:keyId", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		deleteUserApiKeyHandler := r.container.Resolve("UserApiKeyDeleteHandler", config.Transient).(Handler)
+		return deleteUserApiKeyHandler.Handle(c)
+	})
+
+	userfavorites := users.Group("/:id/favorites")
+	userfavorites.Get("/", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		queryParamsMiddleware := r.container.Resolve("QueryParamsMiddleware", config.Transient).(QueryParamsMiddleware)
+		return queryParamsMiddleware.ValidateQueryParams(c)
+	}, func(c *fiber.Ctx) error {
+		getUserFavoritesHandler := r.container.Resolve("UserFavoriteGetHandler", config.Transient).(Handler)
+		return getUserFavoritesHandler.Handle(c)
+	})
+	userfavorites.Put("/:dogId", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		putUserFavoriteHandler := r.container.Resolve("UserFavoritePutHandler", config.Transient).(Handler)
+		return putUserFavoriteHandler.Handle(c)
+	})
+	userfavorites.Delete("/:dogId", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		deleteUserFavoriteHandler := r.container.Resolve("UserFavoriteDeleteHandler", config.Transient).(Handler)
+		return deleteUserFavoriteHandler.Handle(c)
+	})
+
+	usersavedsearches := users.Group("/:id/saved-searches")
+	usersavedsearches.Get("/", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		getUserSavedSearchesHandler := r.container.Resolve("UserSavedSearchGetHandler", config.Transient).(Handler)
+		return getUserSavedSearchesHandler.Handle(c)
+	})
+	usersavedsearches.Post("/", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		postUserSavedSearchHandler := r.container.Resolve("UserSavedSearchPostHandler", config.Transient).(Handler)
+		return postUserSavedSearchHandler.Handle(c)
+	})
+	usersavedsearches.Get("/:searchId", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		getUserSavedSearchHandler := r.container.Resolve("UserSavedSearchGetByIdHandler", config.Transient).(Handler)
+		return getUserSavedSearchHandler.Handle(c)
+	})
+	usersavedsearches.Put("/:searchId", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		putUserSavedSearchHandler := r.container.Resolve("UserSavedSearchPutHandler", config.Transient).(Handler)
+		return putUserSavedSearchHandler.Handle(c)
+	})
+	usersavedsearches.Delete("/:searchId", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		deleteUserSavedSearchHandler := r.container.Resolve("UserSavedSearchDeleteHandler", config.Transient).(Handler)
+		return deleteUserSavedSearchHandler.Handle(c)
+	})
+
+	usernotifications := users.Group("/:id/notifications")
+	usernotifications.Get("/", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		queryParamsMiddleware := r.container.Resolve("QueryParamsMiddleware", config.Transient).(QueryParamsMiddleware)
+		return queryParamsMiddleware.ValidateQueryParams(c)
+	}, func(c *fiber.Ctx) error {
+		getUserNotificationsHandler := r.container.Resolve("UserNotificationGetHandler", config.Transient).(Handler)
+		return getUserNotificationsHandler.Handle(c)
+	})
+	usernotifications.Get("/unread-count", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		unreadCountHandler := r.container.Resolve("UserNotificationUnreadCountHandler", config.Transient).(Handler)
+		return unreadCountHandler.Handle(c)
+	})
+	usernotifications.Put("/read", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		readAllNotificationsHandler := r.container.Resolve("UserNotificationReadAllHandler", config.Transient).(Handler)
+		return readAllNotificationsHandler.Handle(c)
+	})
+	usernotifications.Put("/:notificationId/read", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		readNotificationHandler := r.container.Resolve("UserNotificationReadHandler", config.Transient).(Handler)
+		return readNotificationHandler.Handle(c)
+	})
+
+	useroauthconsents := users.Group("/:id/oauth-consents")
+	useroauthconsents.Get("/", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		getOAuthConsentsHandler := r.container.Resolve("OAuthConsentGetHandler", config.Transient).(Handler)
+		return getOAuthConsentsHandler.Handle(c)
+	})
+	useroauthconsents.Delete("/:clientId", func(c *fiber.Ctx) error {
+		authMiddleware := r.container.Resolve("AuthMiddleware", config.Transient).(AuthMiddleware)
+		return authMiddleware.AuthenticateRequest(c)
+	}, func(c *fiber.Ctx) error {
+		deleteOAuthConsentHandler := r.container.Resolve("OAuthConsentDeleteHandler", config.Transient).(Handler)
+		return deleteOAuthConsentHandler.Handle(c)
+	})
 
 	userwebhook := users.Group("/:id/webhook")
 	userwebhook.Delete("/", func(c *fiber.Ctx) error {
@@ -0,0 +1,160 @@
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule decides when a job runs next.
+type Schedule interface {
+	Next(after time.Time) time.Time
+}
+
+// cronSchedule is a parsed five field cron expression: minute, hour, day of month, month and day of
+// week. Each field holds the allowed values as a set.
+type cronSchedule struct {
+	minutes     map[int]bool
+	hours       map[int]bool
+	daysOfMonth map[int]bool
+	months      map[int]bool
+	daysOfWeek  map[int]bool
+	// Like cron, a day matches either day field when both are restricted.
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+	location      *time.Location
+}
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = []cronField{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	// 7 is accepted as sunday, as in most cron implementations.
+	{"day of week", 0, 7},
+}
+
+// ParseCron parses a cron expression such as "0 8 * * 1-5". Fields accept *, numbers, ranges, lists
+// and steps. Times are evaluated in the given location.
+func ParseCron(spec string, location *time.Location) (Schedule, error) {
+	parts := strings.Fields(spec)
+	if len(parts) != len(cronFields) {
+		return nil, fmt.Errorf("cron expression %q must have %d fields", spec, len(cronFields))
+	}
+	sets := make([]map[int]bool, len(cronFields))
+	for i, part := range parts {
+		set, err := parseCronField(part, cronFields[i])
+		if err != nil {
+			return nil, fmt.Errorf("cron expression %q: %w", spec, err)
+		}
+		sets[i] = set
+	}
+	if sets[4][7] {
+		sets[4][0] = true
+	}
+	if location == nil {
+		location = time.UTC
+	}
+	return cronSchedule{
+		minutes:       sets[0],
+		hours:         sets[1],
+		daysOfMonth:   sets[2],
+		months:        sets[3],
+		daysOfWeek:    sets[4],
+		anyDayOfMonth: strings.HasPrefix(parts[2], "*"),
+		anyDayOfWeek:  strings.HasPrefix(parts[4], "*"),
+		location:      location,
+	}, nil
+}
+
+func parseCronField(part string, field cronField) (map[int]bool, error) {
+	set := map[int]bool{}
+	for _, item := range strings.Split(part, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			parsedStep, err := strconv.Atoi(stepPart)
+			if err != nil || parsedStep < 1 {
+				return nil, fmt.Errorf("invalid step %q in %s field", stepPart, field.name)
+			}
+			step = parsedStep
+		}
+
+		start, end := field.min, field.max
+		if rangePart != "*" {
+			startPart, endPart, isRange := strings.Cut(rangePart, "-")
+			var err error
+			start, err = parseCronValue(startPart, field)
+			if err != nil {
+				return nil, err
+			}
+			end = start
+			if isRange {
+				end, err = parseCronValue(endPart, field)
+				if err != nil {
+					return nil, err
+				}
+			} else if hasStep {
+				end = field.max
+			}
+			if end < start {
+				return nil, fmt.Errorf("invalid range %q in %s field", rangePart, field.name)
+			}
+		}
+		for value := start; value <= end; value += step {
+			set[value] = true
+		}
+	}
+	return set, nil
+}
+
+func parseCronValue(value string, field cronField) (int, error) {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < field.min || number > field.max {
+		return 0, fmt.Errorf("invalid value %q in %s field", value, field.name)
+	}
+	return number, nil
+}
+
+// Next returns the first matching minute after the given time. Expressions that never match, such as
+// the 31st of february, return the zero time.
+func (c cronSchedule) Next(after time.Time) time.Time {
+	next := after.In(c.location).Truncate(time.Minute).Add(time.Minute)
+	// Every combination of days repeats within a few years.
+	limit := next.AddDate(5, 0, 0)
+	for next.Before(limit) {
+		if !c.months[int(next.Month())] {
+			next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, c.location)
+			continue
+		}
+		if !c.matchesDay(next) {
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, c.location)
+			continue
+		}
+		if !c.hours[next.Hour()] {
+			next = time.Date(next.Year(), next.Month(), next.Day(), next.Hour()+1, 0, 0, 0, c.location)
+			continue
+		}
+		if !c.minutes[next.Minute()] {
+			next = next.Add(time.Minute)
+			continue
+		}
+		return next
+	}
+	return time.Time{}
+}
+
+func (c cronSchedule) matchesDay(t time.Time) bool {
+	dayOfMonth := c.daysOfMonth[t.Day()]
+	dayOfWeek := c.daysOfWeek[int(t.Weekday())]
+	if c.anyDayOfMonth || c.anyDayOfWeek {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
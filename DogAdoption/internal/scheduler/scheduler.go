@@ -0,0 +1,125 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// JobStore persists the next run of every job, so that schedules survive restarts and runs that were
+// missed while no instance was running are caught up once.
+type JobStore interface {
+	// EnsureScheduledJob registers the job, and moves its next run if the schedule changed.
+	EnsureScheduledJob(ctx context.Context, name, schedule string, nextRunAt time.Time) error
+	// ClaimDueScheduledJob moves the next run of a due job forward, and reports if the job was due.
+	ClaimDueScheduledJob(ctx context.Context, name string, now, nextRunAt time.Time) (bool, error)
+	RecordScheduledJobRun(ctx context.Context, name string, finishedAt time.Time, runErr error) error
+}
+
+// LeaderLock elects the instance that runs the jobs when several instances share the database.
+type LeaderLock interface {
+	// TryAcquire reports if this instance holds the lock, acquiring it if it is free.
+	TryAcquire(ctx context.Context) (bool, error)
+	Release(ctx context.Context)
+}
+
+type Job struct {
+	Name string
+	// Spec is the cron expression of the schedule, which is stored with the job.
+	Spec     string
+	Schedule Schedule
+	Run      func(ctx context.Context) error
+}
+
+type Scheduler struct {
+	store        JobStore
+	leaderLock   LeaderLock
+	jobs         []Job
+	tickInterval time.Duration
+}
+
+func NewScheduler(store JobStore, leaderLock LeaderLock, tickInterval time.Duration) *Scheduler {
+	if tickInterval <= 0 {
+		tickInterval = 30 * time.Second
+	}
+	return &Scheduler{
+		store:        store,
+		leaderLock:   leaderLock,
+		tickInterval: tickInterval,
+	}
+}
+
+// AddJob registers a job with a cron expression, evaluated in UTC.
+func (s *Scheduler) AddJob(name, spec string, run func(ctx context.Context) error) error {
+	schedule, err := ParseCron(spec, time.UTC)
+	if err != nil {
+		return err
+	}
+	if schedule.Next(time.Now()).IsZero() {
+		return fmt.Errorf("cron expression %q of job %s never matches", spec, name)
+	}
+	s.jobs = append(s.jobs, Job{Name: name, Spec: spec, Schedule: schedule, Run: run})
+	return nil
+}
+
+// Start runs the scheduler in the background until the context is cancelled. Every instance keeps
+// trying to become the leader, so another instance takes over when the leader stops.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		err := s.store.EnsureScheduledJob(ctx, job.Name, job.Spec, job.Schedule.Next(time.Now()))
+		if err != nil {
+			log.Printf("Failed to register scheduled job %s: %v", job.Name, err)
+		}
+	}
+	go func() {
+		ticker := time.NewTicker(s.tickInterval)
+		defer ticker.Stop()
+		defer s.leaderLock.Release(context.Background())
+		for {
+			s.tick(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *Scheduler) tick(ctx context.Context) {
+	isLeader, err := s.leaderLock.TryAcquire(ctx)
+	if err != nil {
+		log.Printf("Failed to acquire scheduler leadership: %v", err)
+		return
+	}
+	if !isLeader {
+		return
+	}
+	for _, job := range s.jobs {
+		s.runIfDue(ctx, job)
+	}
+}
+
+// runIfDue claims the run before starting it, so a run is never repeated even if leadership changes
+// while it is in progress. A failed run is recorded and retried at the next scheduled time.
+func (s *Scheduler) runIfDue(ctx context.Context, job Job) {
+	now := time.Now()
+	isDue, err := s.store.ClaimDueScheduledJob(ctx, job.Name, now, job.Schedule.Next(now))
+	if err != nil {
+		log.Printf("Failed to claim scheduled job %s: %v", job.Name, err)
+		return
+	}
+	if !isDue {
+		return
+	}
+
+	runErr := job.Run(ctx)
+	if runErr != nil {
+		log.Printf("Scheduled job %s failed: %v", job.Name, runErr)
+	}
+	err = s.store.RecordScheduledJobRun(ctx, job.Name, time.Now(), runErr)
+	if err != nil {
+		log.Printf("Failed to record run of scheduled job %s: %v", job.Name, err)
+	}
+}
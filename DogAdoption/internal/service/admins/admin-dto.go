@@ -0,0 +1,28 @@
+package adminsservice
+
+import (
+	admindto "1dv027/aad/internal/dto/admin"
+	"1dv027/aad/internal/model"
+	"encoding/json"
+	"fmt"
+)
+
+type AdminLinkGenerator interface {
+	GenerateAdminLink(adminId string) string
+}
+
+func toAdminDto(admin model.Admin, linkGenerator AdminLinkGenerator) (admindto.AdminDTO, error) {
+	var adminDto admindto.AdminDTO
+	adminJson, err := json.Marshal(admin.ToJson())
+	if err != nil {
+		return admindto.AdminDTO{}, err
+	}
+	err = json.Unmarshal(adminJson, &adminDto)
+	if err != nil {
+		return admindto.AdminDTO{}, err
+	}
+	adminDto.Links = admindto.AdminLinksDTO{
+		SelfLink: linkGenerator.GenerateAdminLink(fmt.Sprintf("%d", admin.Id)),
+	}
+	return adminDto, nil
+}
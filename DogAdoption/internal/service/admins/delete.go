@@ -0,0 +1,35 @@
+package adminsservice
+
+import (
+	"1dv027/aad/internal/dto"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strconv"
+)
+
+type DeleteAdminsRepository interface {
+	DeleteAdmin(ctx context.Context, adminId int) error
+}
+
+type DeleteAdminsService struct {
+	repo DeleteAdminsRepository
+}
+
+func NewDeleteAdminsService(repo DeleteAdminsRepository) DeleteAdminsService {
+	return DeleteAdminsService{
+		repo: repo,
+	}
+}
+
+// DeleteAdmin deletes an admin account, the last remaining admin can not be deleted.
+func (d DeleteAdminsService) DeleteAdmin(ctx context.Context, idParam string, credentials dto.UserCredentials) error {
+	idParamInt, err := strconv.Atoi(idParam)
+	if err != nil {
+		return &customerrors.IntegerConversionError{}
+	}
+	if credentials.UserRole != model.ADMIN || credentials.Scopes != nil {
+		return &customerrors.UnauthorizedError{}
+	}
+	return d.repo.DeleteAdmin(ctx, idParamInt)
+}
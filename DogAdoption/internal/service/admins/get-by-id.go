@@ -0,0 +1,42 @@
+package adminsservice
+
+import (
+	"1dv027/aad/internal/dto"
+	admindto "1dv027/aad/internal/dto/admin"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strconv"
+)
+
+type GetAdminByIdRepository interface {
+	GetAdminById(ctx context.Context, adminId int) (model.Admin, error)
+}
+
+type GetAdminByIdService struct {
+	repo          GetAdminByIdRepository
+	linkGenerator AdminLinkGenerator
+}
+
+func NewGetAdminByIdService(repo GetAdminByIdRepository, linkGenerator AdminLinkGenerator) GetAdminByIdService {
+	return GetAdminByIdService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+func (g GetAdminByIdService) GetAdminById(ctx context.Context, idParam string, credentials dto.UserCredentials) (admindto.AdminDTO, error) {
+	idParamInt, err := strconv.Atoi(idParam)
+	if err != nil {
+		return admindto.AdminDTO{}, &customerrors.IntegerConversionError{}
+	}
+	if credentials.UserRole != model.ADMIN {
+		return admindto.AdminDTO{}, &customerrors.UnauthorizedError{}
+	}
+
+	admin, err := g.repo.GetAdminById(ctx, idParamInt)
+	if err != nil {
+		return admindto.AdminDTO{}, err
+	}
+	return toAdminDto(admin, g.linkGenerator)
+}
@@ -0,0 +1,57 @@
+package adminsservice
+
+import (
+	"1dv027/aad/internal/dto"
+	admindto "1dv027/aad/internal/dto/admin"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type GetAdminsRepository interface {
+	GetAdmins(ctx context.Context, queryParams dto.QueryParams) (admindto.GetAdminsQueryResponseDTO, error)
+}
+
+type GetAdminsLinkGenerator interface {
+	AdminLinkGenerator
+	GeneratePaginationLinks(totalItems int, queryParams dto.QueryParams, apiPath string) dto.PaginationLinksDTO
+}
+
+type GetAdminsService struct {
+	repo          GetAdminsRepository
+	linkGenerator GetAdminsLinkGenerator
+}
+
+func NewGetAdminsService(repo GetAdminsRepository, linkGenerator GetAdminsLinkGenerator) GetAdminsService {
+	return GetAdminsService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+func (g GetAdminsService) GetAdmins(ctx context.Context, credentials dto.UserCredentials,
+	queryParams dto.QueryParams) (admindto.AdminsAndPaginationLinksDTO, error) {
+	emptyDto := admindto.AdminsAndPaginationLinksDTO{}
+	if credentials.UserRole != model.ADMIN {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	adminsResult, err := g.repo.GetAdmins(ctx, queryParams)
+	if err != nil {
+		return emptyDto, err
+	}
+	adminDtoSlice := []admindto.AdminDTO{}
+	for _, admin := range adminsResult.Admins {
+		adminDto, err := toAdminDto(admin, g.linkGenerator)
+		if err != nil {
+			return emptyDto, err
+		}
+		adminDtoSlice = append(adminDtoSlice, adminDto)
+	}
+
+	paginationLinks := g.linkGenerator.GeneratePaginationLinks(adminsResult.TotalAmountAvailable, queryParams, "/admins")
+	return admindto.AdminsAndPaginationLinksDTO{
+		AdminData:       adminDtoSlice,
+		PaginationLinks: paginationLinks,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package adminsservice
+
+import (
+	"1dv027/aad/internal/dto"
+	admindto "1dv027/aad/internal/dto/admin"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strings"
+)
+
+type PostAdminsRepository interface {
+	CreateAdmin(ctx context.Context, username, passwordHash string) (model.Admin, error)
+}
+
+type PostAdminsCryptographyService interface {
+	HashPassword(unhashedPassword string) (string, error)
+}
+
+type PostAdminsPasswordPolicy interface {
+	ValidatePassword(username, password string) error
+}
+
+type PostAdminsService struct {
+	repo           PostAdminsRepository
+	cryptoService  PostAdminsCryptographyService
+	passwordPolicy PostAdminsPasswordPolicy
+	linkGenerator  AdminLinkGenerator
+}
+
+func NewPostAdminsService(repo PostAdminsRepository, cryptoService PostAdminsCryptographyService,
+	passwordPolicy PostAdminsPasswordPolicy, linkGenerator AdminLinkGenerator) PostAdminsService {
+	return PostAdminsService{
+		repo:           repo,
+		cryptoService:  cryptoService,
+		passwordPolicy: passwordPolicy,
+		linkGenerator:  linkGenerator,
+	}
+}
+
+// CreateAdmin creates a new admin account. Only admins can create admins, and not with delegated credentials.
+func (p PostAdminsService) CreateAdmin(ctx context.Context, credentials dto.UserCredentials, newAdmin admindto.NewAdminDTO) (admindto.AdminDTO, error) {
+	emptyDto := admindto.AdminDTO{}
+	if credentials.UserRole != model.ADMIN || credentials.Scopes != nil {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	if newAdmin.Username == nil || newAdmin.Password == nil {
+		return emptyDto, &customerrors.InvalidAdminDataError{Message: "username and password are required"}
+	}
+	username, err := validateUsername(*newAdmin.Username)
+	if err != nil {
+		return emptyDto, err
+	}
+	err = p.passwordPolicy.ValidatePassword(username, *newAdmin.Password)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	hashedPassword, err := p.cryptoService.HashPassword(*newAdmin.Password)
+	if err != nil {
+		return emptyDto, &customerrors.CryptographyError{}
+	}
+	admin, err := p.repo.CreateAdmin(ctx, username, hashedPassword)
+	if err != nil {
+		return emptyDto, err
+	}
+	return toAdminDto(admin, p.linkGenerator)
+}
+
+func validateUsername(username string) (string, error) {
+	username = strings.TrimSpace(username)
+	if username == "" || len(username) > 64 || strings.ContainsAny(username, " \t\n") {
+		return "", &customerrors.InvalidAdminDataError{Message: "username must be 1 to 64 characters without whitespace"}
+	}
+	return username, nil
+}
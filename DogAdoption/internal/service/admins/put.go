@@ -0,0 +1,52 @@
+package adminsservice
+
+import (
+	"1dv027/aad/internal/dto"
+	admindto "1dv027/aad/internal/dto/admin"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strconv"
+)
+
+type PutAdminsRepository interface {
+	UpdateAdminUsername(ctx context.Context, adminId int, username string) (model.Admin, error)
+}
+
+type PutAdminsService struct {
+	repo          PutAdminsRepository
+	linkGenerator AdminLinkGenerator
+}
+
+func NewPutAdminsService(repo PutAdminsRepository, linkGenerator AdminLinkGenerator) PutAdminsService {
+	return PutAdminsService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+func (p PutAdminsService) UpdateAdmin(ctx context.Context, idParam string, credentials dto.UserCredentials,
+	data admindto.UpdateAdminDTO) (admindto.AdminDTO, error) {
+	emptyDto := admindto.AdminDTO{}
+	idParamInt, err := strconv.Atoi(idParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+	if credentials.UserRole != model.ADMIN || credentials.Scopes != nil {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	if data.Username == nil {
+		return emptyDto, &customerrors.InvalidAdminDataError{Message: "username is required"}
+	}
+	username, err := validateUsername(*data.Username)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	admin, err := p.repo.UpdateAdminUsername(ctx, idParamInt, username)
+	if err != nil {
+		return emptyDto, err
+	}
+	return toAdminDto(admin, p.linkGenerator)
+}
@@ -1,85 +1,134 @@
 package authservice
 
 import (
+	authdto "1dv027/aad/internal/dto/auth"
 	customerrors "1dv027/aad/internal/errors"
 	"1dv027/aad/internal/model"
 	"context"
 	"errors"
+	"fmt"
+	"log"
 )
 
 type LoginRepository interface {
 	GetAdminByUsername(ctx context.Context, username string) (model.Admin, error)
 	GetDogShelterByUsername(ctx context.Context, username string) (model.DogShelter, error)
+	GetShelterStaffByUsername(ctx context.Context, username string) (model.ShelterStaff, error)
 	GetUserByUsername(ctx context.Context, username string) (model.User, error)
 }
 
+type LoginMfaRepository interface {
+	GetMfaEnrollment(ctx context.Context, accountId int, role model.UserRole) (model.MfaEnrollment, error)
+	GetMfaPolicy(ctx context.Context, role model.UserRole) (model.MfaPolicy, error)
+}
+
+type LoginPasswordRepository interface {
+	UpdatePasswordHash(ctx context.Context, accountId int, role model.UserRole, passwordHash string) error
+}
+
 type JwtGenerator interface {
 	GenerateJwt(username string, id int, userType model.UserRole) (string, error)
+	GenerateMfaEnrollmentJwt(username string, id int, userType model.UserRole) (string, error)
+	GenerateMfaChallengeJwt(username string, id int, userType model.UserRole) (string, error)
 }
 
 type CryptographyService interface {
 	ComparePasswords(hashedPassword, passwordAttempt string) error
+	HashPassword(unhashedPassword string) (string, error)
+	PasswordNeedsRehash(hashedPassword string) bool
 }
 
 type LoginService struct {
 	loginRepo     LoginRepository
+	mfaRepo       LoginMfaRepository
+	passwordRepo  LoginPasswordRepository
 	jwtGenerator  JwtGenerator
 	cryptoService CryptographyService
 }
 
-func NewLoginService(loginRepo LoginRepository, jwtGenerator JwtGenerator, cryptoService CryptographyService) *LoginService {
+func NewLoginService(loginRepo LoginRepository, mfaRepo LoginMfaRepository, passwordRepo LoginPasswordRepository,
+	jwtGenerator JwtGenerator, cryptoService CryptographyService) *LoginService {
 	return &LoginService{
 		loginRepo:     loginRepo,
+		mfaRepo:       mfaRepo,
+		passwordRepo:  passwordRepo,
 		jwtGenerator:  jwtGenerator,
 		cryptoService: cryptoService,
 	}
 }
 
-func (l LoginService) ValidateUsernameAndPassword(ctx context.Context, username, password string) (string, error) {
+func (l LoginService) ValidateUsernameAndPassword(ctx context.Context, username, password string) (authdto.LoginResultDTO, error) {
+	emptyDto := authdto.LoginResultDTO{}
 
 	admin, err := l.loginRepo.GetAdminByUsername(ctx, username)
 	if err != nil {
 		var adminNotFoundError *customerrors.AdminNotFoundError
 		if !errors.As(err, &adminNotFoundError) {
-			return "", err
+			return emptyDto, err
 		}
 	} else {
 		err := l.cryptoService.ComparePasswords(admin.Password, password)
 		if err != nil {
-			return "", &customerrors.WrongCredentialsError{}
+			return emptyDto, &customerrors.WrongCredentialsError{}
 		}
-		return l.generateJwtForRole(admin.Username, admin.Id, model.ADMIN)
+		l.rehashPasswordIfNeeded(ctx, admin.Password, password, admin.Id, model.ADMIN)
+		return l.generateLoginResult(ctx, admin.Username, admin.Id, model.ADMIN)
 	}
 
 	dogShelter, err := l.loginRepo.GetDogShelterByUsername(ctx, username)
 	if err != nil {
 		var dogShelterNotFoundError *customerrors.DogShelterNotFoundError
 		if !errors.As(err, &dogShelterNotFoundError) {
-			return "", err
+			return emptyDto, err
 		}
 	} else {
 		err := l.cryptoService.ComparePasswords(dogShelter.Password, password)
 		if err != nil {
-			return "", &customerrors.WrongCredentialsError{}
+			return emptyDto, &customerrors.WrongCredentialsError{}
+		}
+		if !dogShelter.IsApproved() {
+			return emptyDto, &customerrors.ShelterNotApprovedError{
+				Message: fmt.Sprintf("dog shelter registration is %s", dogShelter.Status),
+			}
+		}
+		l.rehashPasswordIfNeeded(ctx, dogShelter.Password, password, dogShelter.Id, model.DOGSHELTER)
+		return l.generateLoginResult(ctx, dogShelter.Username, dogShelter.Id, model.DOGSHELTER)
+	}
+
+	staff, err := l.loginRepo.GetShelterStaffByUsername(ctx, username)
+	if err != nil {
+		var staffNotFoundError *customerrors.ShelterStaffNotFoundError
+		if !errors.As(err, &staffNotFoundError) {
+			return emptyDto, err
+		}
+	} else {
+		err := l.cryptoService.ComparePasswords(staff.Password, password)
+		if err != nil {
+			return emptyDto, &customerrors.WrongCredentialsError{}
 		}
-		return l.generateJwtForRole(dogShelter.Username, dogShelter.Id, model.DOGSHELTER)
+		l.rehashPasswordIfNeeded(ctx, staff.Password, password, staff.Id, model.SHELTERSTAFF)
+		return l.generateLoginResult(ctx, staff.Username, staff.Id, model.SHELTERSTAFF)
 	}
 
 	user, err := l.loginRepo.GetUserByUsername(ctx, username)
 	if err != nil {
 		var userNotFound *customerrors.UserNotFoundError
 		if !errors.As(err, &userNotFound) {
-			return "", err
+			return emptyDto, err
 		}
 	} else {
 		err := l.cryptoService.ComparePasswords(user.Password, password)
 		if err != nil {
-			return "", &customerrors.WrongCredentialsError{}
+			return emptyDto, &customerrors.WrongCredentialsError{}
 		}
-		return l.generateJwtForRole(user.Username, user.Id, model.USER)
+		if user.IsSuspended() {
+			return emptyDto, &customerrors.AccountSuspendedError{Message: "account is suspended"}
+		}
+		l.rehashPasswordIfNeeded(ctx, user.Password, password, user.Id, model.USER)
+		return l.generateLoginResult(ctx, user.Username, user.Id, model.USER)
 	}
 
-	return "", &customerrors.UnauthorizedError{}
+	return emptyDto, &customerrors.UnauthorizedError{}
 }
 
 func (l LoginService) GetAllowedFields() map[string]any {
@@ -89,6 +138,67 @@ func (l LoginService) GetAllowedFields() map[string]any {
 	}
 }
 
+// rehashPasswordIfNeeded replaces hashes made with a weaker algorithm or parameters than configured,
+// which is only possible at login when the plain text password is known. Failures do not stop the login.
+func (l LoginService) rehashPasswordIfNeeded(ctx context.Context, hashedPassword, password string, accountId int, role model.UserRole) {
+	if !l.cryptoService.PasswordNeedsRehash(hashedPassword) {
+		return
+	}
+	newHash, err := l.cryptoService.HashPassword(password)
+	if err != nil {
+		log.Printf("Failed to rehash password: %v", err)
+		return
+	}
+	err = l.passwordRepo.UpdatePasswordHash(ctx, accountId, role, newHash)
+	if err != nil {
+		log.Printf("Failed to store rehashed password: %v", err)
+	}
+}
+
+// generateLoginResult decides which token the account receives after a correct password.
+// Enrolled accounts get an MFA challenge token, accounts whose role requires MFA but that
+// have not enrolled get a token that only grants access to the enrollment endpoints.
+func (l LoginService) generateLoginResult(ctx context.Context, username string, accountId int, role model.UserRole) (authdto.LoginResultDTO, error) {
+	emptyDto := authdto.LoginResultDTO{}
+	enrollment, err := l.mfaRepo.GetMfaEnrollment(ctx, accountId, role)
+	if err != nil {
+		var enrollmentNotFound *customerrors.MfaEnrollmentNotFoundError
+		if !errors.As(err, &enrollmentNotFound) {
+			return emptyDto, err
+		}
+	} else if enrollment.IsEnabled {
+		challengeToken, err := l.jwtGenerator.GenerateMfaChallengeJwt(username, accountId, role)
+		if err != nil {
+			return emptyDto, &customerrors.JwtError{}
+		}
+		return authdto.LoginResultDTO{
+			MfaRequired:       true,
+			MfaChallengeToken: challengeToken,
+		}, nil
+	}
+
+	policy, err := l.mfaRepo.GetMfaPolicy(ctx, role)
+	if err != nil {
+		return emptyDto, err
+	}
+	if policy.IsRequired {
+		enrollmentToken, err := l.jwtGenerator.GenerateMfaEnrollmentJwt(username, accountId, role)
+		if err != nil {
+			return emptyDto, &customerrors.JwtError{}
+		}
+		return authdto.LoginResultDTO{
+			Token:                 enrollmentToken,
+			MfaEnrollmentRequired: true,
+		}, nil
+	}
+
+	jwt, err := l.generateJwtForRole(username, accountId, role)
+	if err != nil {
+		return emptyDto, err
+	}
+	return authdto.LoginResultDTO{Token: jwt}, nil
+}
+
 func (l LoginService) generateJwtForRole(username string, userId int, role model.UserRole) (string, error) {
 	jwt, err := l.jwtGenerator.GenerateJwt(username, userId, role)
 	if err != nil {
@@ -0,0 +1,67 @@
+package mfaservice
+
+import (
+	"1dv027/aad/internal/dto"
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type ChallengeMfaRepository interface {
+	SecondFactorRepository
+	GetMfaEnrollment(ctx context.Context, accountId int, role model.UserRole) (model.MfaEnrollment, error)
+}
+
+type ChallengeMfaJwtService interface {
+	ValidateMfaChallengeToken(tokenString string) (dto.UserCredentials, error)
+	GenerateJwt(username string, id int, userType model.UserRole) (string, error)
+}
+
+type ChallengeMfaService struct {
+	repo          ChallengeMfaRepository
+	cryptoService SecondFactorCryptographyService
+	totpService   TotpValidator
+	jwtService    ChallengeMfaJwtService
+}
+
+func NewChallengeMfaService(repo ChallengeMfaRepository, cryptoService SecondFactorCryptographyService,
+	totpService TotpValidator, jwtService ChallengeMfaJwtService) ChallengeMfaService {
+	return ChallengeMfaService{
+		repo:          repo,
+		cryptoService: cryptoService,
+		totpService:   totpService,
+		jwtService:    jwtService,
+	}
+}
+
+func (c ChallengeMfaService) CompleteChallenge(ctx context.Context, data authdto.MfaChallengeDTO) (authdto.LoginResultDTO, error) {
+	emptyDto := authdto.LoginResultDTO{}
+	if data.ChallengeToken == nil || *data.ChallengeToken == "" {
+		return emptyDto, &customerrors.InvalidMfaCodeError{Message: "challenge_token cannot be empty"}
+	}
+
+	credentials, err := c.jwtService.ValidateMfaChallengeToken(*data.ChallengeToken)
+	if err != nil {
+		return emptyDto, &customerrors.UnauthorizedError{Message: "invalid or expired challenge token"}
+	}
+
+	enrollment, err := c.repo.GetMfaEnrollment(ctx, credentials.Id, credentials.UserRole)
+	if err != nil {
+		return emptyDto, err
+	}
+	if !enrollment.IsEnabled {
+		return emptyDto, &customerrors.UnauthorizedError{Message: "mfa is not enabled for the account"}
+	}
+
+	err = verifySecondFactor(ctx, c.repo, c.cryptoService, c.totpService, enrollment, data.Code, data.RecoveryCode)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	jwt, err := c.jwtService.GenerateJwt(credentials.Username, credentials.Id, credentials.UserRole)
+	if err != nil {
+		return emptyDto, &customerrors.JwtError{}
+	}
+	return authdto.LoginResultDTO{Token: jwt}, nil
+}
@@ -0,0 +1,58 @@
+package mfaservice
+
+import (
+	"1dv027/aad/internal/dto"
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type DisableMfaRepository interface {
+	SecondFactorRepository
+	GetMfaEnrollment(ctx context.Context, accountId int, role model.UserRole) (model.MfaEnrollment, error)
+	DeleteMfaEnrollment(ctx context.Context, accountId int, role model.UserRole) error
+	GetMfaPolicy(ctx context.Context, role model.UserRole) (model.MfaPolicy, error)
+}
+
+type DisableMfaService struct {
+	repo          DisableMfaRepository
+	cryptoService SecondFactorCryptographyService
+	totpService   TotpValidator
+}
+
+func NewDisableMfaService(repo DisableMfaRepository, cryptoService SecondFactorCryptographyService, totpService TotpValidator) DisableMfaService {
+	return DisableMfaService{
+		repo:          repo,
+		cryptoService: cryptoService,
+		totpService:   totpService,
+	}
+}
+
+func (d DisableMfaService) Disable(ctx context.Context, credentials dto.UserCredentials, data authdto.MfaChallengeDTO) error {
+	if !canEnrollInMfa(credentials.UserRole) {
+		return &customerrors.UnauthorizedError{}
+	}
+
+	policy, err := d.repo.GetMfaPolicy(ctx, credentials.UserRole)
+	if err != nil {
+		return err
+	}
+	if policy.IsRequired {
+		return &customerrors.MfaRequiredError{Message: "mfa is required for this role and cannot be disabled"}
+	}
+
+	enrollment, err := d.repo.GetMfaEnrollment(ctx, credentials.Id, credentials.UserRole)
+	if err != nil {
+		return err
+	}
+
+	if enrollment.IsEnabled {
+		err = verifySecondFactor(ctx, d.repo, d.cryptoService, d.totpService, enrollment, data.Code, data.RecoveryCode)
+		if err != nil {
+			return err
+		}
+	}
+
+	return d.repo.DeleteMfaEnrollment(ctx, credentials.Id, credentials.UserRole)
+}
@@ -0,0 +1,90 @@
+package mfaservice
+
+import (
+	"1dv027/aad/internal/dto"
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+)
+
+type EnrollMfaRepository interface {
+	GetMfaEnrollment(ctx context.Context, accountId int, role model.UserRole) (model.MfaEnrollment, error)
+	CreateMfaEnrollment(ctx context.Context, accountId int, role model.UserRole, secret string, recoveryCodes []string) (model.MfaEnrollment, error)
+}
+
+type EnrollMfaCryptographyService interface {
+	EncryptPlainText(plainText string) (string, error)
+	HashPassword(unhashedPassword string) (string, error)
+}
+
+type EnrollMfaTotpService interface {
+	GenerateSecret() (string, error)
+	GenerateProvisioningUri(accountName, secret string) string
+	GenerateRecoveryCodes(amount int) ([]string, error)
+}
+
+type EnrollMfaService struct {
+	repo          EnrollMfaRepository
+	cryptoService EnrollMfaCryptographyService
+	totpService   EnrollMfaTotpService
+}
+
+func NewEnrollMfaService(repo EnrollMfaRepository, cryptoService EnrollMfaCryptographyService, totpService EnrollMfaTotpService) EnrollMfaService {
+	return EnrollMfaService{
+		repo:          repo,
+		cryptoService: cryptoService,
+		totpService:   totpService,
+	}
+}
+
+func (e EnrollMfaService) Enroll(ctx context.Context, credentials dto.UserCredentials) (authdto.MfaEnrollmentDTO, error) {
+	emptyDto := authdto.MfaEnrollmentDTO{}
+	if !canEnrollInMfa(credentials.UserRole) {
+		return emptyDto, &customerrors.UnauthorizedError{Message: "mfa is only available for admins and dog shelters"}
+	}
+
+	existing, err := e.repo.GetMfaEnrollment(ctx, credentials.Id, credentials.UserRole)
+	if err != nil {
+		var enrollmentNotFound *customerrors.MfaEnrollmentNotFoundError
+		if !errors.As(err, &enrollmentNotFound) {
+			return emptyDto, err
+		}
+	} else if existing.IsEnabled {
+		return emptyDto, &customerrors.MfaAlreadyEnabledError{Message: "mfa is already enabled"}
+	}
+
+	secret, err := e.totpService.GenerateSecret()
+	if err != nil {
+		return emptyDto, &customerrors.CryptographyError{}
+	}
+	encryptedSecret, err := e.cryptoService.EncryptPlainText(secret)
+	if err != nil {
+		return emptyDto, &customerrors.CryptographyError{}
+	}
+
+	recoveryCodes, err := e.totpService.GenerateRecoveryCodes(10)
+	if err != nil {
+		return emptyDto, &customerrors.CryptographyError{}
+	}
+	hashedRecoveryCodes := make([]string, 0, len(recoveryCodes))
+	for _, code := range recoveryCodes {
+		hashedCode, err := e.cryptoService.HashPassword(code)
+		if err != nil {
+			return emptyDto, &customerrors.CryptographyError{}
+		}
+		hashedRecoveryCodes = append(hashedRecoveryCodes, hashedCode)
+	}
+
+	_, err = e.repo.CreateMfaEnrollment(ctx, credentials.Id, credentials.UserRole, encryptedSecret, hashedRecoveryCodes)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	return authdto.MfaEnrollmentDTO{
+		Secret:          secret,
+		ProvisioningUri: e.totpService.GenerateProvisioningUri(credentials.Username, secret),
+		RecoveryCodes:   recoveryCodes,
+	}, nil
+}
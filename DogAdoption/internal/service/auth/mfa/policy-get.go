@@ -0,0 +1,41 @@
+package mfaservice
+
+import (
+	"1dv027/aad/internal/dto"
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type GetMfaPoliciesRepository interface {
+	GetMfaPolicies(ctx context.Context, roles []model.UserRole) ([]model.MfaPolicy, error)
+}
+
+type GetMfaPoliciesService struct {
+	repo GetMfaPoliciesRepository
+}
+
+func NewGetMfaPoliciesService(repo GetMfaPoliciesRepository) GetMfaPoliciesService {
+	return GetMfaPoliciesService{
+		repo: repo,
+	}
+}
+
+func (g GetMfaPoliciesService) GetPolicies(ctx context.Context, credentials dto.UserCredentials) ([]authdto.MfaPolicyDTO, error) {
+	if credentials.UserRole != model.ADMIN {
+		return nil, &customerrors.UnauthorizedError{}
+	}
+	policies, err := g.repo.GetMfaPolicies(ctx, []model.UserRole{model.ADMIN, model.DOGSHELTER, model.SHELTERSTAFF})
+	if err != nil {
+		return nil, err
+	}
+	policyDtos := []authdto.MfaPolicyDTO{}
+	for _, policy := range policies {
+		policyDtos = append(policyDtos, authdto.MfaPolicyDTO{
+			Role:       policy.Role,
+			IsRequired: policy.IsRequired,
+		})
+	}
+	return policyDtos, nil
+}
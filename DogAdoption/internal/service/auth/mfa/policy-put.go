@@ -0,0 +1,48 @@
+package mfaservice
+
+import (
+	"1dv027/aad/internal/dto"
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type PutMfaPolicyRepository interface {
+	UpdateMfaPolicy(ctx context.Context, policy model.MfaPolicy) (model.MfaPolicy, error)
+}
+
+type PutMfaPolicyService struct {
+	repo PutMfaPolicyRepository
+}
+
+func NewPutMfaPolicyService(repo PutMfaPolicyRepository) PutMfaPolicyService {
+	return PutMfaPolicyService{
+		repo: repo,
+	}
+}
+
+func (p PutMfaPolicyService) UpdatePolicy(ctx context.Context, roleParam string,
+	credentials dto.UserCredentials, data authdto.UpdateMfaPolicyDTO) (authdto.MfaPolicyDTO, error) {
+	emptyDto := authdto.MfaPolicyDTO{}
+	if credentials.UserRole != model.ADMIN {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	role, err := model.StringToUserRole(roleParam)
+	if err != nil || !canEnrollInMfa(role) {
+		return emptyDto, &customerrors.InvalidMfaPolicyDataError{Message: "mfa policies can only be set for admin and dog_shelter"}
+	}
+	if data.IsRequired == nil {
+		return emptyDto, &customerrors.InvalidMfaPolicyDataError{Message: "is_required cannot be empty"}
+	}
+
+	policy, err := p.repo.UpdateMfaPolicy(ctx, model.MfaPolicy{Role: role, IsRequired: *data.IsRequired})
+	if err != nil {
+		return emptyDto, err
+	}
+	return authdto.MfaPolicyDTO{
+		Role:       policy.Role,
+		IsRequired: policy.IsRequired,
+	}, nil
+}
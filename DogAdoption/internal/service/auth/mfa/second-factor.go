@@ -0,0 +1,56 @@
+package mfaservice
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strings"
+)
+
+type SecondFactorRepository interface {
+	UpdateMfaLastUsedStep(ctx context.Context, accountId int, role model.UserRole, usedStep int64) error
+	ConsumeMfaRecoveryCode(ctx context.Context, accountId int, role model.UserRole, hashedCode string) error
+}
+
+type SecondFactorCryptographyService interface {
+	DecryptCipherText(cipherText string) (string, error)
+	ComparePasswords(hashedPassword, passwordAttempt string) error
+}
+
+type TotpValidator interface {
+	ValidateCode(secret, code string, lastUsedStep int64) (int64, bool)
+}
+
+// verifySecondFactor accepts either a TOTP code or one of the recovery codes of an enabled
+// enrollment. Used TOTP steps are stored and used recovery codes are removed. A recovery code is only
+// accepted if it is still stored when it is removed, so concurrent logins cannot both use it.
+func verifySecondFactor(ctx context.Context, repo SecondFactorRepository, cryptoService SecondFactorCryptographyService,
+	totp TotpValidator, enrollment model.MfaEnrollment, code *string, recoveryCode *string) error {
+	if code != nil && *code != "" {
+		secret, err := cryptoService.DecryptCipherText(enrollment.Secret)
+		if err != nil {
+			return &customerrors.CryptographyError{}
+		}
+		step, ok := totp.ValidateCode(secret, *code, enrollment.LastUsedStep)
+		if !ok {
+			return &customerrors.InvalidMfaCodeError{Message: "invalid mfa code"}
+		}
+		return repo.UpdateMfaLastUsedStep(ctx, enrollment.AccountId, enrollment.AccountRole, step)
+	}
+
+	if recoveryCode != nil && *recoveryCode != "" {
+		attempt := strings.ToLower(strings.TrimSpace(*recoveryCode))
+		for _, hashedCode := range enrollment.RecoveryCodes {
+			if cryptoService.ComparePasswords(hashedCode, attempt) == nil {
+				return repo.ConsumeMfaRecoveryCode(ctx, enrollment.AccountId, enrollment.AccountRole, hashedCode)
+			}
+		}
+		return &customerrors.InvalidMfaCodeError{Message: "invalid recovery code"}
+	}
+
+	return &customerrors.InvalidMfaCodeError{Message: "code or recovery_code is required"}
+}
+
+func canEnrollInMfa(role model.UserRole) bool {
+	return role == model.ADMIN || role == model.DOGSHELTER || role == model.SHELTERSTAFF
+}
@@ -0,0 +1,60 @@
+package mfaservice
+
+import (
+	"1dv027/aad/internal/dto"
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type VerifyMfaRepository interface {
+	GetMfaEnrollment(ctx context.Context, accountId int, role model.UserRole) (model.MfaEnrollment, error)
+	EnableMfaEnrollment(ctx context.Context, accountId int, role model.UserRole, usedStep int64) error
+}
+
+type VerifyMfaCryptographyService interface {
+	DecryptCipherText(cipherText string) (string, error)
+}
+
+type VerifyMfaService struct {
+	repo          VerifyMfaRepository
+	cryptoService VerifyMfaCryptographyService
+	totpService   TotpValidator
+}
+
+func NewVerifyMfaService(repo VerifyMfaRepository, cryptoService VerifyMfaCryptographyService, totpService TotpValidator) VerifyMfaService {
+	return VerifyMfaService{
+		repo:          repo,
+		cryptoService: cryptoService,
+		totpService:   totpService,
+	}
+}
+
+func (v VerifyMfaService) Verify(ctx context.Context, credentials dto.UserCredentials, data authdto.MfaCodeDTO) error {
+	if !canEnrollInMfa(credentials.UserRole) {
+		return &customerrors.UnauthorizedError{}
+	}
+	if data.Code == nil || *data.Code == "" {
+		return &customerrors.InvalidMfaCodeError{Message: "code cannot be empty"}
+	}
+
+	enrollment, err := v.repo.GetMfaEnrollment(ctx, credentials.Id, credentials.UserRole)
+	if err != nil {
+		return err
+	}
+	if enrollment.IsEnabled {
+		return &customerrors.MfaAlreadyEnabledError{Message: "mfa is already enabled"}
+	}
+
+	secret, err := v.cryptoService.DecryptCipherText(enrollment.Secret)
+	if err != nil {
+		return &customerrors.CryptographyError{}
+	}
+	step, ok := v.totpService.ValidateCode(secret, *data.Code, enrollment.LastUsedStep)
+	if !ok {
+		return &customerrors.InvalidMfaCodeError{Message: "invalid mfa code"}
+	}
+
+	return v.repo.EnableMfaEnrollment(ctx, credentials.Id, credentials.UserRole, step)
+}
@@ -0,0 +1,82 @@
+package passwordservice
+
+import (
+	"1dv027/aad/internal/dto"
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strconv"
+)
+
+type ChangePasswordRepository interface {
+	GetPasswordHash(ctx context.Context, accountId int, role model.UserRole) (string, error)
+	UpdatePassword(ctx context.Context, accountId int, role model.UserRole, passwordHash string) error
+}
+
+type ChangePasswordCryptographyService interface {
+	HashPassword(unhashedPassword string) (string, error)
+	ComparePasswords(hashedPassword, passwordAttempt string) error
+}
+
+type PasswordPolicyValidator interface {
+	ValidatePassword(username, password string) error
+}
+
+// ChangePasswordService changes the password of accounts of a single role, since users
+// and dog shelters have their own password endpoints.
+type ChangePasswordService struct {
+	repo          ChangePasswordRepository
+	cryptoService ChangePasswordCryptographyService
+	policy        PasswordPolicyValidator
+	accountRole   model.UserRole
+}
+
+func NewChangePasswordService(repo ChangePasswordRepository, cryptoService ChangePasswordCryptographyService,
+	policy PasswordPolicyValidator, accountRole model.UserRole) ChangePasswordService {
+	return ChangePasswordService{
+		repo:          repo,
+		cryptoService: cryptoService,
+		policy:        policy,
+		accountRole:   accountRole,
+	}
+}
+
+func (c ChangePasswordService) ChangePassword(ctx context.Context, idParam string, credentials dto.UserCredentials, data authdto.ChangePasswordDTO) error {
+	idParamInt, err := strconv.Atoi(idParam)
+	if err != nil {
+		return &customerrors.IntegerConversionError{}
+	}
+
+	// Passwords can only be changed by the account itself, and not with scoped api keys or oauth tokens.
+	if credentials.Scopes != nil || credentials.UserRole != c.accountRole || credentials.Id != idParamInt {
+		return &customerrors.UnauthorizedError{}
+	}
+
+	if data.CurrentPassword == nil || data.NewPassword == nil {
+		return &customerrors.InvalidPasswordChangeDataError{Message: "current_password and new_password are required"}
+	}
+
+	currentHash, err := c.repo.GetPasswordHash(ctx, idParamInt, c.accountRole)
+	if err != nil {
+		return err
+	}
+	err = c.cryptoService.ComparePasswords(currentHash, *data.CurrentPassword)
+	if err != nil {
+		return &customerrors.WrongCredentialsError{}
+	}
+
+	err = c.policy.ValidatePassword(credentials.Username, *data.NewPassword)
+	if err != nil {
+		return err
+	}
+	if *data.NewPassword == *data.CurrentPassword {
+		return &customerrors.InvalidPasswordChangeDataError{Message: "new_password must differ from the current password"}
+	}
+
+	newHash, err := c.cryptoService.HashPassword(*data.NewPassword)
+	if err != nil {
+		return &customerrors.CryptographyError{}
+	}
+	return c.repo.UpdatePassword(ctx, idParamInt, c.accountRole, newHash)
+}
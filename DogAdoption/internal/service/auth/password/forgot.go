@@ -0,0 +1,108 @@
+package passwordservice
+
+import (
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"1dv027/aad/internal/notifier"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type ForgotPasswordRepository interface {
+	GetDogShelterByUsername(ctx context.Context, username string) (model.DogShelter, error)
+	GetShelterStaffByUsername(ctx context.Context, username string) (model.ShelterStaff, error)
+	GetUserByUsername(ctx context.Context, username string) (model.User, error)
+	CreatePasswordResetToken(ctx context.Context, token model.PasswordResetToken) error
+}
+
+type ForgotPasswordCryptographyService interface {
+	GenerateRandomToken(byteLength int) (string, error)
+	HashToken(token string) string
+}
+
+type PasswordResetNotifier interface {
+	Notify(ctx context.Context, notification notifier.Notification) error
+}
+
+type ForgotPasswordService struct {
+	repo          ForgotPasswordRepository
+	cryptoService ForgotPasswordCryptographyService
+	notifier      PasswordResetNotifier
+	tokenLifetime time.Duration
+}
+
+func NewForgotPasswordService(repo ForgotPasswordRepository, cryptoService ForgotPasswordCryptographyService,
+	notifier PasswordResetNotifier) ForgotPasswordService {
+	return ForgotPasswordService{
+		repo:          repo,
+		cryptoService: cryptoService,
+		notifier:      notifier,
+		tokenLifetime: 30 * time.Minute,
+	}
+}
+
+// RequestPasswordReset sends a single use reset token to the dog shelter, shelter staff member or user with the username.
+// No error is returned for unknown usernames, so that the endpoint can not be used to find accounts.
+func (f ForgotPasswordService) RequestPasswordReset(ctx context.Context, data authdto.ForgotPasswordDTO) error {
+	if data.Username == nil || *data.Username == "" {
+		return &customerrors.InvalidPasswordChangeDataError{Message: "username cannot be empty"}
+	}
+	username := *data.Username
+
+	dogShelter, err := f.repo.GetDogShelterByUsername(ctx, username)
+	if err != nil {
+		var dogShelterNotFoundError *customerrors.DogShelterNotFoundError
+		if !errors.As(err, &dogShelterNotFoundError) {
+			return err
+		}
+	} else {
+		return f.sendResetToken(ctx, dogShelter.Id, model.DOGSHELTER, username)
+	}
+
+	staff, err := f.repo.GetShelterStaffByUsername(ctx, username)
+	if err != nil {
+		var staffNotFoundError *customerrors.ShelterStaffNotFoundError
+		if !errors.As(err, &staffNotFoundError) {
+			return err
+		}
+	} else {
+		return f.sendResetToken(ctx, staff.Id, model.SHELTERSTAFF, username)
+	}
+
+	user, err := f.repo.GetUserByUsername(ctx, username)
+	if err != nil {
+		var userNotFoundError *customerrors.UserNotFoundError
+		if !errors.As(err, &userNotFoundError) {
+			return err
+		}
+		return nil
+	}
+	return f.sendResetToken(ctx, user.Id, model.USER, username)
+}
+
+func (f ForgotPasswordService) sendResetToken(ctx context.Context, accountId int, role model.UserRole, username string) error {
+	token, err := f.cryptoService.GenerateRandomToken(32)
+	if err != nil {
+		return &customerrors.CryptographyError{}
+	}
+	err = f.repo.CreatePasswordResetToken(ctx, model.PasswordResetToken{
+		TokenHash:   f.cryptoService.HashToken(token),
+		AccountId:   accountId,
+		AccountRole: role,
+		ExpiresAt:   time.Now().Add(f.tokenLifetime),
+	})
+	if err != nil {
+		return err
+	}
+
+	return f.notifier.Notify(ctx, notifier.Notification{
+		Recipient: fmt.Sprintf("%s:%s", role, username),
+		Subject:   "Password reset",
+		Body: fmt.Sprintf("A password reset was requested for %s. Reset the password with POST /auth/password-reset/confirm "+
+			"and the token %s within %d minutes. If you did not request it, you can ignore this message.",
+			username, token, int(f.tokenLifetime.Minutes())),
+	})
+}
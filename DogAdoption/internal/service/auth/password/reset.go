@@ -0,0 +1,67 @@
+package passwordservice
+
+import (
+	authdto "1dv027/aad/internal/dto/auth"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type ResetPasswordRepository interface {
+	GetPasswordResetToken(ctx context.Context, tokenHash string) (model.PasswordResetToken, error)
+	ConsumePasswordResetToken(ctx context.Context, tokenHash string) (model.PasswordResetToken, error)
+	UpdatePassword(ctx context.Context, accountId int, role model.UserRole, passwordHash string) error
+	GetAccountUsername(ctx context.Context, accountId int, role model.UserRole) (string, error)
+}
+
+type ResetPasswordCryptographyService interface {
+	HashToken(token string) string
+	HashPassword(unhashedPassword string) (string, error)
+}
+
+type ResetPasswordService struct {
+	repo          ResetPasswordRepository
+	cryptoService ResetPasswordCryptographyService
+	policy        PasswordPolicyValidator
+}
+
+func NewResetPasswordService(repo ResetPasswordRepository, cryptoService ResetPasswordCryptographyService,
+	policy PasswordPolicyValidator) ResetPasswordService {
+	return ResetPasswordService{
+		repo:          repo,
+		cryptoService: cryptoService,
+		policy:        policy,
+	}
+}
+
+func (r ResetPasswordService) ResetPassword(ctx context.Context, data authdto.ResetPasswordDTO) error {
+	if data.Token == nil || *data.Token == "" || data.NewPassword == nil {
+		return &customerrors.InvalidPasswordChangeDataError{Message: "token and new_password are required"}
+	}
+	tokenHash := r.cryptoService.HashToken(*data.Token)
+
+	// The policy is checked before the token is consumed, so a rejected password can be retried with the same token.
+	token, err := r.repo.GetPasswordResetToken(ctx, tokenHash)
+	if err != nil {
+		return err
+	}
+	username, err := r.repo.GetAccountUsername(ctx, token.AccountId, token.AccountRole)
+	if err != nil {
+		return err
+	}
+	err = r.policy.ValidatePassword(username, *data.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	token, err = r.repo.ConsumePasswordResetToken(ctx, tokenHash)
+	if err != nil {
+		return err
+	}
+
+	newHash, err := r.cryptoService.HashPassword(*data.NewPassword)
+	if err != nil {
+		return &customerrors.CryptographyError{}
+	}
+	return r.repo.UpdatePassword(ctx, token.AccountId, token.AccountRole, newHash)
+}
@@ -0,0 +1,50 @@
+package breedsservice
+
+import (
+	"1dv027/aad/internal/dto"
+	breeddto "1dv027/aad/internal/dto/breed"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+type BreedLinkGenerator interface {
+	GenerateBreedLink(breedId string) string
+	GenerateDogsOfBreedLink(breedId string) string
+}
+
+func toBreedDto(breed model.Breed, linkGenerator BreedLinkGenerator) (breeddto.BreedDTO, error) {
+	var breedDto breeddto.BreedDTO
+	breedJson, err := json.Marshal(breed.ToJson())
+	if err != nil {
+		return breedDto, err
+	}
+	err = json.Unmarshal(breedJson, &breedDto)
+	if err != nil {
+		return breedDto, err
+	}
+	breedId := fmt.Sprintf("%d", breed.Id)
+	breedDto.Links = breeddto.BreedLinksDTO{
+		SelfLink: linkGenerator.GenerateBreedLink(breedId),
+		DogsLink: linkGenerator.GenerateDogsOfBreedLink(breedId),
+	}
+	return breedDto, nil
+}
+
+func parseBreedId(breedIdParam string) (int, error) {
+	breedId, err := strconv.Atoi(breedIdParam)
+	if err != nil {
+		return 0, &customerrors.IntegerConversionError{}
+	}
+	return breedId, nil
+}
+
+// requireAdmin allows only admins to manage the breed taxonomy.
+func requireAdmin(credentials dto.UserCredentials) error {
+	if credentials.UserRole != model.ADMIN {
+		return &customerrors.UnauthorizedError{}
+	}
+	return nil
+}
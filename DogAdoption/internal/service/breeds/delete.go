@@ -0,0 +1,33 @@
+package breedsservice
+
+import (
+	"1dv027/aad/internal/dto"
+	"context"
+)
+
+type DeleteBreedRepository interface {
+	DeleteBreed(ctx context.Context, breedId int) error
+}
+
+type DeleteBreedService struct {
+	repo DeleteBreedRepository
+}
+
+func NewDeleteBreedService(repo DeleteBreedRepository) DeleteBreedService {
+	return DeleteBreedService{
+		repo: repo,
+	}
+}
+
+// DeleteBreed deletes a breed that no dog has. Breeds of dogs are merged into another breed instead.
+func (d DeleteBreedService) DeleteBreed(ctx context.Context, breedIdParam string, credentials dto.UserCredentials) error {
+	err := requireAdmin(credentials)
+	if err != nil {
+		return err
+	}
+	breedId, err := parseBreedId(breedIdParam)
+	if err != nil {
+		return err
+	}
+	return d.repo.DeleteBreed(ctx, breedId)
+}
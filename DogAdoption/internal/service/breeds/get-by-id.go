@@ -0,0 +1,35 @@
+package breedsservice
+
+import (
+	breeddto "1dv027/aad/internal/dto/breed"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type GetBreedByIdRepository interface {
+	GetBreedById(ctx context.Context, breedId int) (model.Breed, error)
+}
+
+type GetBreedByIdService struct {
+	repo          GetBreedByIdRepository
+	linkGenerator BreedLinkGenerator
+}
+
+func NewGetBreedByIdService(repo GetBreedByIdRepository, linkGenerator BreedLinkGenerator) GetBreedByIdService {
+	return GetBreedByIdService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+func (g GetBreedByIdService) GetBreed(ctx context.Context, breedIdParam string) (breeddto.BreedDTO, error) {
+	breedId, err := parseBreedId(breedIdParam)
+	if err != nil {
+		return breeddto.BreedDTO{}, err
+	}
+	breed, err := g.repo.GetBreedById(ctx, breedId)
+	if err != nil {
+		return breeddto.BreedDTO{}, err
+	}
+	return toBreedDto(breed, g.linkGenerator)
+}
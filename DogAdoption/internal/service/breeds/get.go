@@ -0,0 +1,48 @@
+package breedsservice
+
+import (
+	"1dv027/aad/internal/dto"
+	breeddto "1dv027/aad/internal/dto/breed"
+	"context"
+)
+
+type GetBreedsRepository interface {
+	GetBreeds(ctx context.Context, queryParams dto.QueryParams) (breeddto.GetBreedsQueryResponseDTO, error)
+}
+
+type GetBreedsLinkGenerator interface {
+	BreedLinkGenerator
+	GeneratePaginationLinks(totalItems int, queryParams dto.QueryParams, apiPath string) dto.PaginationLinksDTO
+}
+
+type GetBreedsService struct {
+	repo          GetBreedsRepository
+	linkGenerator GetBreedsLinkGenerator
+}
+
+func NewGetBreedsService(repo GetBreedsRepository, linkGenerator GetBreedsLinkGenerator) GetBreedsService {
+	return GetBreedsService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+func (g GetBreedsService) GetBreeds(ctx context.Context, queryParams dto.QueryParams) (breeddto.BreedsAndPaginationLinksDTO, error) {
+	emptyDto := breeddto.BreedsAndPaginationLinksDTO{}
+	breedsResult, err := g.repo.GetBreeds(ctx, queryParams)
+	if err != nil {
+		return emptyDto, err
+	}
+	breedDtos := []breeddto.BreedDTO{}
+	for _, breed := range breedsResult.Breeds {
+		breedDto, err := toBreedDto(breed, g.linkGenerator)
+		if err != nil {
+			return emptyDto, err
+		}
+		breedDtos = append(breedDtos, breedDto)
+	}
+	return breeddto.BreedsAndPaginationLinksDTO{
+		BreedData:       breedDtos,
+		PaginationLinks: g.linkGenerator.GeneratePaginationLinks(breedsResult.TotalAmountAvailable, queryParams, "/breeds"),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package breedsservice
+
+import (
+	"1dv027/aad/internal/dto"
+	breeddto "1dv027/aad/internal/dto/breed"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type MergeBreedRepository interface {
+	MergeBreeds(ctx context.Context, targetBreedId, sourceBreedId int) (model.Breed, error)
+}
+
+type MergeBreedService struct {
+	repo          MergeBreedRepository
+	linkGenerator BreedLinkGenerator
+}
+
+func NewMergeBreedService(repo MergeBreedRepository, linkGenerator BreedLinkGenerator) MergeBreedService {
+	return MergeBreedService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// MergeBreed merges a duplicate breed into the breed of the path, so that dogs and filters using either
+// name end up at the same breed.
+func (m MergeBreedService) MergeBreed(ctx context.Context, breedIdParam string, merge breeddto.MergeBreedDTO,
+	credentials dto.UserCredentials) (breeddto.BreedDTO, error) {
+	err := requireAdmin(credentials)
+	if err != nil {
+		return breeddto.BreedDTO{}, err
+	}
+	breedId, err := parseBreedId(breedIdParam)
+	if err != nil {
+		return breeddto.BreedDTO{}, err
+	}
+	if merge.SourceBreedId == nil {
+		return breeddto.BreedDTO{}, &customerrors.InvalidBreedDataError{Message: "source_breed_id cannot be empty"}
+	}
+	if *merge.SourceBreedId == breedId {
+		return breeddto.BreedDTO{}, &customerrors.InvalidBreedDataError{Message: "a breed cannot be merged into itself"}
+	}
+
+	mergedBreed, err := m.repo.MergeBreeds(ctx, breedId, *merge.SourceBreedId)
+	if err != nil {
+		return breeddto.BreedDTO{}, err
+	}
+	return toBreedDto(mergedBreed, m.linkGenerator)
+}
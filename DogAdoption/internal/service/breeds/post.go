@@ -0,0 +1,53 @@
+package breedsservice
+
+import (
+	"1dv027/aad/internal/dto"
+	breeddto "1dv027/aad/internal/dto/breed"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type PostBreedRepository interface {
+	CreateBreed(ctx context.Context, breed model.Breed) (model.Breed, error)
+}
+
+type PostBreedService struct {
+	repo          PostBreedRepository
+	linkGenerator BreedLinkGenerator
+}
+
+func NewPostBreedService(repo PostBreedRepository, linkGenerator BreedLinkGenerator) PostBreedService {
+	return PostBreedService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+func (p PostBreedService) CreateBreed(ctx context.Context, newBreed breeddto.NewBreedDTO,
+	credentials dto.UserCredentials) (breeddto.BreedDTO, error) {
+	err := requireAdmin(credentials)
+	if err != nil {
+		return breeddto.BreedDTO{}, err
+	}
+	breed := model.Breed{
+		Aliases:  newBreed.Aliases,
+		FciGroup: newBreed.FciGroup,
+	}
+	if newBreed.Name != nil {
+		breed.Name = *newBreed.Name
+	}
+	if newBreed.SizeGroup != nil {
+		sizeGroup := model.BreedSizeGroup(*newBreed.SizeGroup)
+		breed.SizeGroup = &sizeGroup
+	}
+	err = validateBreed(&breed)
+	if err != nil {
+		return breeddto.BreedDTO{}, err
+	}
+
+	createdBreed, err := p.repo.CreateBreed(ctx, breed)
+	if err != nil {
+		return breeddto.BreedDTO{}, err
+	}
+	return toBreedDto(createdBreed, p.linkGenerator)
+}
@@ -0,0 +1,72 @@
+package breedsservice
+
+import (
+	"1dv027/aad/internal/dto"
+	breeddto "1dv027/aad/internal/dto/breed"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type PutBreedRepository interface {
+	GetBreedById(ctx context.Context, breedId int) (model.Breed, error)
+	UpdateBreed(ctx context.Context, breed model.Breed) (model.Breed, error)
+}
+
+type PutBreedService struct {
+	repo          PutBreedRepository
+	linkGenerator BreedLinkGenerator
+}
+
+func NewPutBreedService(repo PutBreedRepository, linkGenerator BreedLinkGenerator) PutBreedService {
+	return PutBreedService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// UpdateBreed changes the provided fields of the breed. A renamed breed is renamed on its dogs as well.
+func (p PutBreedService) UpdateBreed(ctx context.Context, breedIdParam string, update breeddto.UpdateBreedDTO,
+	credentials dto.UserCredentials) (breeddto.BreedDTO, error) {
+	err := requireAdmin(credentials)
+	if err != nil {
+		return breeddto.BreedDTO{}, err
+	}
+	breedId, err := parseBreedId(breedIdParam)
+	if err != nil {
+		return breeddto.BreedDTO{}, err
+	}
+	breed, err := p.repo.GetBreedById(ctx, breedId)
+	if err != nil {
+		return breeddto.BreedDTO{}, err
+	}
+
+	if update.Name != nil {
+		breed.Name = *update.Name
+	}
+	if update.Aliases != nil {
+		breed.Aliases = *update.Aliases
+	}
+	if update.SizeGroup != nil {
+		breed.SizeGroup = nil
+		if *update.SizeGroup != "" {
+			sizeGroup := model.BreedSizeGroup(*update.SizeGroup)
+			breed.SizeGroup = &sizeGroup
+		}
+	}
+	if update.FciGroup != nil {
+		breed.FciGroup = nil
+		if *update.FciGroup != 0 {
+			breed.FciGroup = update.FciGroup
+		}
+	}
+	err = validateBreed(&breed)
+	if err != nil {
+		return breeddto.BreedDTO{}, err
+	}
+
+	updatedBreed, err := p.repo.UpdateBreed(ctx, breed)
+	if err != nil {
+		return breeddto.BreedDTO{}, err
+	}
+	return toBreedDto(updatedBreed, p.linkGenerator)
+}
@@ -0,0 +1,60 @@
+package breedsservice
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"fmt"
+	"strings"
+)
+
+const (
+	maxBreedNameLength = 64
+	maxBreedAliases    = 20
+)
+
+// validateBreed trims the names of the breed, and checks that they are unique within the breed.
+func validateBreed(breed *model.Breed) error {
+	var errMsgs []string
+	breed.Name = strings.TrimSpace(breed.Name)
+	if breed.Name == "" {
+		errMsgs = append(errMsgs, "name cannot be empty")
+	} else if len(breed.Name) > maxBreedNameLength {
+		errMsgs = append(errMsgs, fmt.Sprintf("name cannot be longer than %d characters", maxBreedNameLength))
+	}
+
+	if len(breed.Aliases) > maxBreedAliases {
+		errMsgs = append(errMsgs, fmt.Sprintf("a breed can have at most %d aliases", maxBreedAliases))
+	}
+	seenNames := map[string]bool{strings.ToLower(breed.Name): true}
+	aliases := make([]string, 0, len(breed.Aliases))
+	for _, alias := range breed.Aliases {
+		alias = strings.TrimSpace(alias)
+		switch {
+		case alias == "":
+			errMsgs = append(errMsgs, "aliases cannot be empty")
+		case len(alias) > maxBreedNameLength:
+			errMsgs = append(errMsgs, fmt.Sprintf("aliases cannot be longer than %d characters", maxBreedNameLength))
+		case seenNames[strings.ToLower(alias)]:
+			errMsgs = append(errMsgs, fmt.Sprintf("%s is given more than once", alias))
+		default:
+			seenNames[strings.ToLower(alias)] = true
+			aliases = append(aliases, alias)
+		}
+	}
+	breed.Aliases = aliases
+
+	if breed.SizeGroup != nil {
+		_, ok := model.StringToBreedSizeGroup(string(*breed.SizeGroup))
+		if !ok {
+			errMsgs = append(errMsgs, "size_group must be one of toy, small, medium, large and giant")
+		}
+	}
+	if breed.FciGroup != nil && (*breed.FciGroup < 1 || *breed.FciGroup > 10) {
+		errMsgs = append(errMsgs, "fci_group must be between 1 and 10")
+	}
+
+	if len(errMsgs) > 0 {
+		return &customerrors.InvalidBreedDataError{Message: strings.Join(errMsgs, " + ")}
+	}
+	return nil
+}
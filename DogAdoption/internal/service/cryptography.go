@@ -4,23 +4,25 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"io"
-
-	"golang.org/x/crypto/bcrypt"
 )
 
 type CryptographyService struct {
-	secretKey []byte
+	secretKey      []byte
+	passwordHasher PasswordHasher
 }
 
-func NewCryptographyService(secretKey string) (CryptographyService, error) {
+func NewCryptographyService(secretKey string, passwordHasher PasswordHasher) (CryptographyService, error) {
 	if len(secretKey) != 32 {
 		return CryptographyService{}, errors.New("invalid secretKey length: must be exactly 32 bytes for AES-256")
 	}
 	return CryptographyService{
-		secretKey: []byte(secretKey),
+		secretKey:      []byte(secretKey),
+		passwordHasher: passwordHasher,
 	}, nil
 }
 
@@ -75,13 +77,30 @@ func (c CryptographyService) DecryptCipherText(cipherText string) (string, error
 }
 
 func (c CryptographyService) HashPassword(unhashedPassword string) (string, error) {
-	hashedBytes, err := bcrypt.GenerateFromPassword([]byte(unhashedPassword), bcrypt.DefaultCost)
-	if err != nil {
+	return c.passwordHasher.HashPassword(unhashedPassword)
+}
+
+func (c CryptographyService) ComparePasswords(hashedPassword, passwordAttempt string) error {
+	return c.passwordHasher.ComparePasswords(hashedPassword, passwordAttempt)
+}
+
+// PasswordNeedsRehash reports if a stored password hash is weaker than the configured hashing.
+func (c CryptographyService) PasswordNeedsRehash(hashedPassword string) bool {
+	return c.passwordHasher.NeedsRehash(hashedPassword)
+}
+
+// GenerateRandomToken returns a url safe random token of the given amount of bytes.
+func (c CryptographyService) GenerateRandomToken(byteLength int) (string, error) {
+	tokenBytes := make([]byte, byteLength)
+	if _, err := io.ReadFull(rand.Reader, tokenBytes); err != nil {
 		return "", err
 	}
-	return string(hashedBytes), nil
+	return base64.RawURLEncoding.EncodeToString(tokenBytes), nil
 }
 
-func (c CryptographyService) ComparePasswords(hashedPassword, passwordAttempt string) error {
-	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(passwordAttempt))
+// HashToken hashes high entropy tokens such as api keys with SHA-256, which unlike
+// bcrypt allows the hash to be used for lookups.
+func (c CryptographyService) HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
 }
@@ -31,12 +31,12 @@ func (d DeleteDogSheltersService) DeleteDogShelter(ctx context.Context, shelterI
 	role := credentials.UserRole
 	if role == model.ADMIN {
 		return d.repo.DeleteDogShelter(ctx, shelterIdInt)
-	} else if role == model.DOGSHELTER {
+	} else if role == model.DOGSHELTER || role == model.SHELTERSTAFF {
 		dogShelter, err := d.repo.GetDogShelterById(ctx, shelterIdInt)
 		if err != nil {
 			return err
 		}
-		if dogShelter.Id != credentials.Id {
+		if !credentials.HasShelterRole(dogShelter.Id, model.STAFF_OWNER) {
 			return &customerrors.UnauthorizedError{}
 		}
 		return d.repo.DeleteDogShelter(ctx, shelterIdInt)
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"strconv"
+	"time"
 )
 
 type GetDogSheltersByIdRepository interface {
@@ -42,6 +43,10 @@ func (g GetDogSheltersByIdService) GetDogShelterById(ctx context.Context, dogShe
 	if err != nil {
 		return emptyDto, err
 	}
+	// Registrations under review are only visible in the admin registration queue.
+	if !dogShelter.IsApproved() {
+		return emptyDto, &customerrors.DogShelterNotFoundError{}
+	}
 	dogShelterJson, err := json.Marshal(dogShelter.ToJson())
 	if err != nil {
 		return emptyDto, err
@@ -53,6 +58,7 @@ func (g GetDogSheltersByIdService) GetDogShelterById(ctx context.Context, dogShe
 	}
 	selfLink := g.linkGenerator.GenerateShelterLink(dogShelterId)
 	dogsLink := g.linkGenerator.GenerateDogsFromDogShelterLink(dogShelterId)
+	dogShelterDto.IsOpenNow = dogShelter.IsOpenAt(time.Now())
 	dogShelterDto.Links = dogshelterdto.DogShelterDtoLinks{
 		SelfLink: selfLink,
 		DogsLink: dogsLink,
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 type GetDogSheltersRepository interface {
@@ -51,6 +52,7 @@ func (g GetDogSheltersService) GetShelters(ctx context.Context,
 
 		selfLink := g.linkGenerator.GenerateShelterLink(fmt.Sprintf("%d", shelterDto.Id))
 		dogsLink := g.linkGenerator.GenerateDogsFromDogShelterLink(fmt.Sprintf("%d", shelterDto.Id))
+		shelterDto.IsOpenNow = shelter.IsOpenAt(time.Now())
 		shelterDto.Links = dogshelterdto.DogShelterDtoLinks{
 			SelfLink: selfLink,
 			DogsLink: dogsLink,
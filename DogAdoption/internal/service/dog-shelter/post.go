@@ -4,12 +4,14 @@ import (
 	"1dv027/aad/internal/dto"
 	dogshelterdto "1dv027/aad/internal/dto/dog-shelter"
 	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/geocoder"
 	"1dv027/aad/internal/model"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 )
 
 type PostDogSheltersRepository interface {
@@ -21,6 +23,10 @@ type PostDogSheltersCryptographyService interface {
 	HashPassword(unhashedPassword string) (string, error)
 }
 
+type PostDogSheltersPasswordPolicy interface {
+	ValidatePassword(username, password string) error
+}
+
 type PostDogSheltersLinkGenerator interface {
 	GenerateDogsFromDogShelterLink(shelterId string) string
 	GenerateShelterLink(shelterId string) string
@@ -28,17 +34,22 @@ type PostDogSheltersLinkGenerator interface {
 }
 
 type PostDogSheltersService struct {
-	repo          PostDogSheltersRepository
-	linkGenerator PostDogSheltersLinkGenerator
-	cryptoService PostDogSheltersCryptographyService
+	repo           PostDogSheltersRepository
+	linkGenerator  PostDogSheltersLinkGenerator
+	cryptoService  PostDogSheltersCryptographyService
+	passwordPolicy PostDogSheltersPasswordPolicy
+	geocoder       ShelterGeocoder
 }
 
-func NewPostDogSheltersService(repo PostDogSheltersRepository,
-	linkGenerator PostDogSheltersLinkGenerator, cryptoService PostDogSheltersCryptographyService) PostDogSheltersService {
+func NewPostDogSheltersService(repo PostDogSheltersRepository, linkGenerator PostDogSheltersLinkGenerator,
+	cryptoService PostDogSheltersCryptographyService, passwordPolicy PostDogSheltersPasswordPolicy,
+	geocoder ShelterGeocoder) PostDogSheltersService {
 	return PostDogSheltersService{
-		repo:          repo,
-		linkGenerator: linkGenerator,
-		cryptoService: cryptoService,
+		repo:           repo,
+		linkGenerator:  linkGenerator,
+		cryptoService:  cryptoService,
+		passwordPolicy: passwordPolicy,
+		geocoder:       geocoder,
 	}
 }
 
@@ -67,6 +78,11 @@ func (p PostDogSheltersService) CreateDogShelter(ctx context.Context,
 			return emptyDto, &customerrors.CryptographyError{}
 		}
 		newDogShelter.Password = &hashedPassword
+		resolveShelterLocation(ctx, p.geocoder, &newDogShelter.ShelterDetailsDTO, geocoder.Query{
+			Address: *newDogShelter.Address,
+			City:    *newDogShelter.City,
+			Country: *newDogShelter.Country,
+		})
 
 		dogShelter, err := p.repo.CreateDogShelter(ctx, newDogShelter)
 		if err != nil {
@@ -85,6 +101,7 @@ func (p PostDogSheltersService) CreateDogShelter(ctx context.Context,
 
 		selfLink := p.linkGenerator.GenerateShelterLink(fmt.Sprintf("%d", dogShelter.Id))
 		dogsLink := p.linkGenerator.GenerateDogsFromDogShelterLink(fmt.Sprintf("%d", dogShelter.Id))
+		dogShelterDto.IsOpenNow = dogShelter.IsOpenAt(time.Now())
 		dogShelterDto.Links = dogshelterdto.DogShelterDtoLinks{
 			SelfLink: selfLink,
 			DogsLink: dogsLink,
@@ -111,10 +128,11 @@ func (p PostDogSheltersService) validateNewDogShelterDto(newDogShelter dogshelte
 			errMsgs = append(errMsgs, fmt.Sprintf("%s cannot be empty", fieldName))
 		}
 	}
+	errMsgs = append(errMsgs, validateShelterDetails(newDogShelter.ShelterDetailsDTO)...)
 
 	if len(errMsgs) > 0 {
 		return &customerrors.IncompleteDogShelterDataError{Message: strings.Join(errMsgs, " + ")}
 	}
 
-	return nil
+	return p.passwordPolicy.ValidatePassword(*newDogShelter.Username, *newDogShelter.Password)
 }
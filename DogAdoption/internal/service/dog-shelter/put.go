@@ -4,12 +4,14 @@ import (
 	"1dv027/aad/internal/dto"
 	dogshelterdto "1dv027/aad/internal/dto/dog-shelter"
 	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/geocoder"
 	"1dv027/aad/internal/model"
 	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type PutDogSheltersRepository interface {
@@ -26,12 +28,15 @@ type PutDogSheltersLinkGenerator interface {
 type PutDogSheltersService struct {
 	repo          PutDogSheltersRepository
 	linkGenerator PutDogSheltersLinkGenerator
+	geocoder      ShelterGeocoder
 }
 
-func NewPutDogSheltersService(repo PutDogSheltersRepository, linkGenerator PutDogSheltersLinkGenerator) PutDogSheltersService {
+func NewPutDogSheltersService(repo PutDogSheltersRepository, linkGenerator PutDogSheltersLinkGenerator,
+	geocoder ShelterGeocoder) PutDogSheltersService {
 	return PutDogSheltersService{
 		repo:          repo,
 		linkGenerator: linkGenerator,
+		geocoder:      geocoder,
 	}
 }
 
@@ -45,16 +50,16 @@ func (p PutDogSheltersService) UpdateDogShelter(ctx context.Context,
 
 	role := credentials.UserRole
 
-	if role != model.ADMIN && role != model.DOGSHELTER {
+	if role != model.ADMIN && role != model.DOGSHELTER && role != model.SHELTERSTAFF {
 		return emptyDto, &customerrors.UnauthorizedError{Message: "user role not recognized"}
 	}
 
-	if role == model.DOGSHELTER {
-		dogShelter, err := p.repo.GetDogShelterById(ctx, dogShelterIdInt)
-		if err != nil {
-			return emptyDto, err
-		}
-		if dogShelter.Id != credentials.Id {
+	currentShelter, err := p.repo.GetDogShelterById(ctx, dogShelterIdInt)
+	if err != nil {
+		return emptyDto, err
+	}
+	if role != model.ADMIN {
+		if !credentials.HasShelterRole(currentShelter.Id, model.STAFF_OWNER, model.STAFF_MANAGER) {
 			return emptyDto, &customerrors.UnauthorizedError{Message: "unauthorized to update dog"}
 		}
 	}
@@ -62,6 +67,7 @@ func (p PutDogSheltersService) UpdateDogShelter(ctx context.Context,
 	if err != nil {
 		return emptyDto, err
 	}
+	p.resolveLocation(ctx, currentShelter, &updateDogShelter)
 
 	dogShelter, err := p.repo.UpdateDogShelter(ctx, dogShelterIdInt, updateDogShelter)
 	if err != nil {
@@ -79,6 +85,7 @@ func (p PutDogSheltersService) UpdateDogShelter(ctx context.Context,
 
 	selfLink := p.linkGenerator.GenerateShelterLink(fmt.Sprintf("%d", dogShelter.Id))
 	dogsLink := p.linkGenerator.GenerateDogsFromDogShelterLink(fmt.Sprintf("%d", dogShelter.Id))
+	dogShelterDto.IsOpenNow = dogShelter.IsOpenAt(time.Now())
 	dogShelterDto.Links = dogshelterdto.DogShelterDtoLinks{
 		SelfLink: selfLink,
 		DogsLink: dogsLink,
@@ -86,8 +93,33 @@ func (p PutDogSheltersService) UpdateDogShelter(ctx context.Context,
 	return dogShelterDto, nil
 }
 
+// resolveLocation sets the location when coordinates are given, or geocodes the address when it changes.
+func (p PutDogSheltersService) resolveLocation(ctx context.Context, currentShelter model.DogShelter,
+	updateDto *dogshelterdto.UpdateDogShelterDTO) {
+	addressChanged := updateDto.Address != nil || updateDto.City != nil || updateDto.Country != nil
+	if updateDto.Latitude == nil && !addressChanged {
+		return
+	}
+	query := geocoder.Query{
+		Address: currentShelter.Address,
+		City:    currentShelter.City,
+		Country: currentShelter.Country,
+	}
+	if updateDto.Address != nil {
+		query.Address = *updateDto.Address
+	}
+	if updateDto.City != nil {
+		query.City = *updateDto.City
+	}
+	if updateDto.Country != nil {
+		query.Country = *updateDto.Country
+	}
+	resolveShelterLocation(ctx, p.geocoder, &updateDto.ShelterDetailsDTO, query)
+}
+
 func (p PutDogSheltersService) validateUpdateDogShelterDto(updateDto dogshelterdto.UpdateDogShelterDTO) error {
-	if updateDto.Name == nil && updateDto.Website == nil && updateDto.Country == nil && updateDto.City == nil && updateDto.Address == nil {
+	if updateDto.Name == nil && updateDto.Website == nil && updateDto.Country == nil && updateDto.City == nil && updateDto.Address == nil &&
+		updateDto.ShelterDetailsDTO.IsEmpty() {
 		return &customerrors.IncompleteDogShelterDataError{}
 	}
 	var errMsgs []string
@@ -116,6 +148,7 @@ func (p PutDogSheltersService) validateUpdateDogShelterDto(updateDto dogshelterd
 			errMsgs = append(errMsgs, "address cannot be empty")
 		}
 	}
+	errMsgs = append(errMsgs, validateShelterDetails(updateDto.ShelterDetailsDTO)...)
 
 	if len(errMsgs) > 0 {
 		return &customerrors.IncompleteDogShelterDataError{Message: strings.Join(errMsgs, " + ")}
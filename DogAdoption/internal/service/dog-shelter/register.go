@@ -0,0 +1,94 @@
+package dogsheltersservice
+
+import (
+	dogshelterdto "1dv027/aad/internal/dto/dog-shelter"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/geocoder"
+	"1dv027/aad/internal/model"
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+type RegisterDogShelterRepository interface {
+	CreateRegistration(ctx context.Context, registration dogshelterdto.NewDogShelterRegistrationDTO) (model.DogShelter, error)
+}
+
+// RegisterDogShelterService lets shelters register themselves without an account. The shelter is
+// created as pending and can not log in until an admin approves the registration.
+type RegisterDogShelterService struct {
+	repo           RegisterDogShelterRepository
+	linkGenerator  ShelterRegistrationLinkGenerator
+	cryptoService  PostDogSheltersCryptographyService
+	passwordPolicy PostDogSheltersPasswordPolicy
+	geocoder       ShelterGeocoder
+}
+
+func NewRegisterDogShelterService(repo RegisterDogShelterRepository, linkGenerator ShelterRegistrationLinkGenerator,
+	cryptoService PostDogSheltersCryptographyService, passwordPolicy PostDogSheltersPasswordPolicy,
+	geocoder ShelterGeocoder) RegisterDogShelterService {
+	return RegisterDogShelterService{
+		repo:           repo,
+		linkGenerator:  linkGenerator,
+		cryptoService:  cryptoService,
+		passwordPolicy: passwordPolicy,
+		geocoder:       geocoder,
+	}
+}
+
+func (r RegisterDogShelterService) RegisterDogShelter(ctx context.Context,
+	registration dogshelterdto.NewDogShelterRegistrationDTO) (dogshelterdto.DogShelterRegistrationDTO, error) {
+	emptyDto := dogshelterdto.DogShelterRegistrationDTO{}
+	err := r.validateRegistration(registration)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	hashedPassword, err := r.cryptoService.HashPassword(*registration.Password)
+	if err != nil {
+		return emptyDto, &customerrors.CryptographyError{}
+	}
+	registration.Password = &hashedPassword
+	resolveShelterLocation(ctx, r.geocoder, &registration.ShelterDetailsDTO, geocoder.Query{
+		Address: *registration.Address,
+		City:    *registration.City,
+		Country: *registration.Country,
+	})
+
+	dogShelter, err := r.repo.CreateRegistration(ctx, registration)
+	if err != nil {
+		return emptyDto, err
+	}
+	return toRegistrationDto(dogShelter, r.linkGenerator)
+}
+
+func (r RegisterDogShelterService) validateRegistration(registration dogshelterdto.NewDogShelterRegistrationDTO) error {
+	var errMsgs []string
+	stringPointerFields := map[string]*string{
+		"name":          registration.Name,
+		"website":       registration.Website,
+		"country":       registration.Country,
+		"city":          registration.City,
+		"address":       registration.Address,
+		"username":      registration.Username,
+		"password":      registration.Password,
+		"contact_email": registration.ContactEmail,
+	}
+	for fieldName, fieldValue := range stringPointerFields {
+		if fieldValue == nil || *fieldValue == "" {
+			errMsgs = append(errMsgs, fmt.Sprintf("%s cannot be empty", fieldName))
+		}
+	}
+	errMsgs = append(errMsgs, validateShelterDetails(registration.ShelterDetailsDTO)...)
+	if len(errMsgs) > 0 {
+		return &customerrors.IncompleteDogShelterDataError{Message: strings.Join(errMsgs, " + ")}
+	}
+
+	email, err := mail.ParseAddress(*registration.ContactEmail)
+	if err != nil || email.Name != "" || !strings.Contains(email.Address, ".") {
+		return &customerrors.InvalidShelterRegistrationDataError{Message: "invalid contact_email address"}
+	}
+
+	return r.passwordPolicy.ValidatePassword(*registration.Username, *registration.Password)
+}
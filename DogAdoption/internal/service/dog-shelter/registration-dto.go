@@ -0,0 +1,41 @@
+package dogsheltersservice
+
+import (
+	dogshelterdto "1dv027/aad/internal/dto/dog-shelter"
+	"1dv027/aad/internal/model"
+	"encoding/json"
+	"fmt"
+)
+
+type ShelterRegistrationLinkGenerator interface {
+	GenerateShelterRegistrationLink(shelterId string) string
+	GenerateShelterLink(shelterId string) string
+}
+
+// toRegistrationDto converts a shelter to its registration view. Review links are only added while the
+// registration is pending, and the shelter link once it is approved and publicly listed.
+func toRegistrationDto(shelter model.DogShelter, linkGenerator ShelterRegistrationLinkGenerator) (dogshelterdto.DogShelterRegistrationDTO, error) {
+	var registrationDto dogshelterdto.DogShelterRegistrationDTO
+	registrationJson, err := json.Marshal(shelter.ToJson())
+	if err != nil {
+		return registrationDto, err
+	}
+	err = json.Unmarshal(registrationJson, &registrationDto)
+	if err != nil {
+		return registrationDto, err
+	}
+
+	shelterId := fmt.Sprintf("%d", shelter.Id)
+	selfLink := linkGenerator.GenerateShelterRegistrationLink(shelterId)
+	registrationDto.Links = dogshelterdto.DogShelterRegistrationDtoLinks{
+		SelfLink: selfLink,
+	}
+	switch shelter.Status {
+	case model.SHELTER_PENDING:
+		registrationDto.Links.ApproveLink = selfLink + "/approve"
+		registrationDto.Links.RejectLink = selfLink + "/reject"
+	case model.SHELTER_APPROVED:
+		registrationDto.Links.ShelterLink = linkGenerator.GenerateShelterLink(shelterId)
+	}
+	return registrationDto, nil
+}
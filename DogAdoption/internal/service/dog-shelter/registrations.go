@@ -0,0 +1,158 @@
+package dogsheltersservice
+
+import (
+	"1dv027/aad/internal/dto"
+	dogshelterdto "1dv027/aad/internal/dto/dog-shelter"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"1dv027/aad/internal/notifier"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type ShelterRegistrationsRepository interface {
+	GetRegistrations(ctx context.Context, queryParams dto.QueryParams) (dogshelterdto.GetDogSheltersQueryResponseDTO, error)
+	GetRegistrationById(ctx context.Context, shelterId int) (model.DogShelter, error)
+	ReviewRegistration(ctx context.Context, shelterId int, status model.DogShelterStatus, reason *string) (model.DogShelter, error)
+}
+
+type ShelterRegistrationsLinkGenerator interface {
+	ShelterRegistrationLinkGenerator
+	GeneratePaginationLinks(totalItems int, queryParams dto.QueryParams, apiPath string) dto.PaginationLinksDTO
+}
+
+type ShelterRegistrationNotifier interface {
+	Notify(ctx context.Context, notification notifier.Notification) error
+}
+
+// ShelterRegistrationsService is the admin review queue for self-registered shelters.
+type ShelterRegistrationsService struct {
+	repo          ShelterRegistrationsRepository
+	linkGenerator ShelterRegistrationsLinkGenerator
+	notifier      ShelterRegistrationNotifier
+}
+
+func NewShelterRegistrationsService(repo ShelterRegistrationsRepository, linkGenerator ShelterRegistrationsLinkGenerator,
+	notifier ShelterRegistrationNotifier) ShelterRegistrationsService {
+	return ShelterRegistrationsService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+		notifier:      notifier,
+	}
+}
+
+// GetRegistrations lists registrations by registration status, oldest first. Pending registrations are listed by default.
+func (s ShelterRegistrationsService) GetRegistrations(ctx context.Context, credentials dto.UserCredentials,
+	queryParams dto.QueryParams) (dogshelterdto.DogShelterRegistrationsAndPaginationLinksDTO, error) {
+	emptyDto := dogshelterdto.DogShelterRegistrationsAndPaginationLinksDTO{}
+	if credentials.UserRole != model.ADMIN {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	registrationsResult, err := s.repo.GetRegistrations(ctx, queryParams)
+	if err != nil {
+		return emptyDto, err
+	}
+	registrationDtoSlice := []dogshelterdto.DogShelterRegistrationDTO{}
+	for _, shelter := range registrationsResult.DogShelters {
+		registrationDto, err := toRegistrationDto(shelter, s.linkGenerator)
+		if err != nil {
+			return emptyDto, err
+		}
+		registrationDtoSlice = append(registrationDtoSlice, registrationDto)
+	}
+
+	paginationLinks := s.linkGenerator.GeneratePaginationLinks(registrationsResult.TotalAmountAvailable, queryParams, "/admin/shelter-registrations")
+	return dogshelterdto.DogShelterRegistrationsAndPaginationLinksDTO{
+		RegistrationData: registrationDtoSlice,
+		PaginationLinks:  paginationLinks,
+	}, nil
+}
+
+func (s ShelterRegistrationsService) GetRegistrationById(ctx context.Context, idParam string,
+	credentials dto.UserCredentials) (dogshelterdto.DogShelterRegistrationDTO, error) {
+	emptyDto := dogshelterdto.DogShelterRegistrationDTO{}
+	idParamInt, err := strconv.Atoi(idParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+	if credentials.UserRole != model.ADMIN {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	shelter, err := s.repo.GetRegistrationById(ctx, idParamInt)
+	if err != nil {
+		return emptyDto, err
+	}
+	return toRegistrationDto(shelter, s.linkGenerator)
+}
+
+func (s ShelterRegistrationsService) ApproveRegistration(ctx context.Context, idParam string, decision dogshelterdto.ShelterRegistrationDecisionDTO,
+	credentials dto.UserCredentials) (dogshelterdto.DogShelterRegistrationDTO, error) {
+	return s.review(ctx, idParam, decision, credentials, model.SHELTER_APPROVED)
+}
+
+func (s ShelterRegistrationsService) RejectRegistration(ctx context.Context, idParam string, decision dogshelterdto.ShelterRegistrationDecisionDTO,
+	credentials dto.UserCredentials) (dogshelterdto.DogShelterRegistrationDTO, error) {
+	if decision.Reason == nil || strings.TrimSpace(*decision.Reason) == "" {
+		return dogshelterdto.DogShelterRegistrationDTO{}, &customerrors.InvalidShelterRegistrationDataError{Message: "reason cannot be empty when rejecting"}
+	}
+	return s.review(ctx, idParam, decision, credentials, model.SHELTER_REJECTED)
+}
+
+func (s ShelterRegistrationsService) review(ctx context.Context, idParam string, decision dogshelterdto.ShelterRegistrationDecisionDTO,
+	credentials dto.UserCredentials, status model.DogShelterStatus) (dogshelterdto.DogShelterRegistrationDTO, error) {
+	emptyDto := dogshelterdto.DogShelterRegistrationDTO{}
+	idParamInt, err := strconv.Atoi(idParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+	if credentials.UserRole != model.ADMIN {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+	if credentials.Scopes != nil {
+		return emptyDto, &customerrors.UnauthorizedError{Message: "delegated credentials can not review shelter registrations"}
+	}
+	var reason *string
+	if decision.Reason != nil && strings.TrimSpace(*decision.Reason) != "" {
+		trimmedReason := strings.TrimSpace(*decision.Reason)
+		if len(trimmedReason) > 1000 {
+			return emptyDto, &customerrors.InvalidShelterRegistrationDataError{Message: "reason is too long"}
+		}
+		reason = &trimmedReason
+	}
+
+	shelter, err := s.repo.ReviewRegistration(ctx, idParamInt, status, reason)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	// The decision is already stored, so a failed notification does not fail the review.
+	_ = s.notifier.Notify(ctx, s.decisionNotification(shelter))
+
+	return toRegistrationDto(shelter, s.linkGenerator)
+}
+
+func (s ShelterRegistrationsService) decisionNotification(shelter model.DogShelter) notifier.Notification {
+	notification := notifier.Notification{
+		Recipient: fmt.Sprintf("%s:%s", model.DOGSHELTER, shelter.Username),
+	}
+	if shelter.Status == model.SHELTER_APPROVED {
+		notification.Subject = "Dog shelter registration approved"
+		notification.Body = fmt.Sprintf("The registration of %s has been approved. You can now log in as %s.",
+			shelter.Name, shelter.Username)
+	} else {
+		notification.Subject = "Dog shelter registration rejected"
+		notification.Body = fmt.Sprintf("The registration of %s has been rejected.", shelter.Name)
+	}
+	if shelter.ReviewReason != nil {
+		notification.Body += fmt.Sprintf(" Reason: %s", *shelter.ReviewReason)
+	}
+	// Registrations carry a contact email, shelters created by admins are addressed by account.
+	if shelter.ContactEmail != nil {
+		notification.Recipient = *shelter.ContactEmail
+	}
+	return notification
+}
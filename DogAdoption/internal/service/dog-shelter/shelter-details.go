@@ -0,0 +1,180 @@
+package dogsheltersservice
+
+import (
+	dogshelterdto "1dv027/aad/internal/dto/dog-shelter"
+	"1dv027/aad/internal/geocoder"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/mail"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	maxOpeningHours           = 50
+	maxOpeningHoursExceptions = 100
+	maxExceptionNoteLength    = 200
+)
+
+type ShelterGeocoder interface {
+	Geocode(ctx context.Context, query geocoder.Query) (geocoder.Result, error)
+}
+
+// resolveShelterLocation sets the location of the shelter to the coordinates in the details, which are a manual
+// override, or geocodes the address. A shelter whose address can not be geocoded is stored without coordinates
+// instead of failing the request.
+func resolveShelterLocation(ctx context.Context, shelterGeocoder ShelterGeocoder,
+	details *dogshelterdto.ShelterDetailsDTO, query geocoder.Query) {
+	if details.Latitude != nil && details.Longitude != nil {
+		accuracy := string(geocoder.ACCURACY_MANUAL)
+		details.Location = &dogshelterdto.ShelterLocationDTO{
+			Latitude:  details.Latitude,
+			Longitude: details.Longitude,
+			Accuracy:  &accuracy,
+		}
+		return
+	}
+	result, err := shelterGeocoder.Geocode(ctx, query)
+	if err != nil {
+		if !errors.Is(err, geocoder.ErrNoMatch) {
+			log.Printf("Failed to geocode shelter address: %v", err)
+		}
+		details.Location = &dogshelterdto.ShelterLocationDTO{}
+		return
+	}
+	accuracy := string(result.Accuracy)
+	details.Location = &dogshelterdto.ShelterLocationDTO{
+		Latitude:  &result.Latitude,
+		Longitude: &result.Longitude,
+		Accuracy:  &accuracy,
+	}
+}
+
+// validateShelterDetails returns a message for each invalid contact detail, capacity, timezone or opening hours entry.
+func validateShelterDetails(details dogshelterdto.ShelterDetailsDTO) []string {
+	var errMsgs []string
+	if details.Phone != nil && !isValidPhone(*details.Phone) {
+		errMsgs = append(errMsgs, "phone can only contain digits, spaces, +, - and parentheses")
+	}
+	if details.Email != nil {
+		email, err := mail.ParseAddress(*details.Email)
+		if err != nil || email.Name != "" || !strings.Contains(email.Address, ".") {
+			errMsgs = append(errMsgs, "invalid email address")
+		}
+	}
+	if details.Capacity != nil && *details.Capacity < 0 {
+		errMsgs = append(errMsgs, "capacity cannot be negative")
+	}
+	if details.Timezone != nil {
+		_, err := time.LoadLocation(*details.Timezone)
+		if err != nil || *details.Timezone == "" || *details.Timezone == "Local" {
+			errMsgs = append(errMsgs, "timezone must be an IANA timezone name like Europe/Stockholm")
+		}
+	}
+	if (details.Latitude == nil) != (details.Longitude == nil) {
+		errMsgs = append(errMsgs, "latitude and longitude must be set together")
+	} else if details.Latitude != nil {
+		if *details.Latitude < -90 || *details.Latitude > 90 {
+			errMsgs = append(errMsgs, "latitude must be between -90 and 90")
+		}
+		if *details.Longitude < -180 || *details.Longitude > 180 {
+			errMsgs = append(errMsgs, "longitude must be between -180 and 180")
+		}
+	}
+	if details.OpeningHours != nil {
+		errMsgs = append(errMsgs, validateOpeningHours(*details.OpeningHours)...)
+	}
+	if details.OpeningHoursExceptions != nil {
+		errMsgs = append(errMsgs, validateOpeningHoursExceptions(*details.OpeningHoursExceptions)...)
+	}
+	return errMsgs
+}
+
+func validateOpeningHours(openingHours []dogshelterdto.OpeningHoursDTO) []string {
+	if len(openingHours) > maxOpeningHours {
+		return []string{fmt.Sprintf("at most %d opening hours can be set", maxOpeningHours)}
+	}
+	var errMsgs []string
+	intervalsByWeekday := map[int][]dogshelterdto.OpeningHoursDTO{}
+	for i, hours := range openingHours {
+		if hours.Weekday < 0 || hours.Weekday > 6 {
+			errMsgs = append(errMsgs, fmt.Sprintf("opening_hours[%d] weekday must be between 0 (sunday) and 6 (saturday)", i))
+			continue
+		}
+		if !isValidClockTime(hours.Opens) || !isValidClockTime(hours.Closes) {
+			errMsgs = append(errMsgs, fmt.Sprintf("opening_hours[%d] opens and closes must be times formatted as HH:MM", i))
+			continue
+		}
+		if hours.Opens >= hours.Closes {
+			errMsgs = append(errMsgs, fmt.Sprintf("opening_hours[%d] must close after it opens", i))
+			continue
+		}
+		intervalsByWeekday[hours.Weekday] = append(intervalsByWeekday[hours.Weekday], hours)
+	}
+	for weekday, intervals := range intervalsByWeekday {
+		sort.Slice(intervals, func(i, j int) bool { return intervals[i].Opens < intervals[j].Opens })
+		for i := 1; i < len(intervals); i++ {
+			if intervals[i].Opens < intervals[i-1].Closes {
+				errMsgs = append(errMsgs, fmt.Sprintf("opening hours on weekday %d overlap", weekday))
+				break
+			}
+		}
+	}
+	return errMsgs
+}
+
+func validateOpeningHoursExceptions(exceptions []dogshelterdto.OpeningHoursExceptionDTO) []string {
+	if len(exceptions) > maxOpeningHoursExceptions {
+		return []string{fmt.Sprintf("at most %d opening hours exceptions can be set", maxOpeningHoursExceptions)}
+	}
+	var errMsgs []string
+	dates := map[string]bool{}
+	for i, exception := range exceptions {
+		date, err := time.Parse("2006-01-02", exception.Date)
+		if err != nil || date.Format("2006-01-02") != exception.Date {
+			errMsgs = append(errMsgs, fmt.Sprintf("opening_hours_exceptions[%d] date must be formatted as YYYY-MM-DD", i))
+			continue
+		}
+		if dates[exception.Date] {
+			errMsgs = append(errMsgs, fmt.Sprintf("opening_hours_exceptions has more than one exception for %s", exception.Date))
+		}
+		dates[exception.Date] = true
+
+		if (exception.Opens == nil) != (exception.Closes == nil) {
+			errMsgs = append(errMsgs, fmt.Sprintf("opening_hours_exceptions[%d] needs both opens and closes, or neither when closed", i))
+		} else if exception.Opens != nil {
+			if !isValidClockTime(*exception.Opens) || !isValidClockTime(*exception.Closes) {
+				errMsgs = append(errMsgs, fmt.Sprintf("opening_hours_exceptions[%d] opens and closes must be times formatted as HH:MM", i))
+			} else if *exception.Opens >= *exception.Closes {
+				errMsgs = append(errMsgs, fmt.Sprintf("opening_hours_exceptions[%d] must close after it opens", i))
+			}
+		}
+		if exception.Note != nil && len(*exception.Note) > maxExceptionNoteLength {
+			errMsgs = append(errMsgs, fmt.Sprintf("opening_hours_exceptions[%d] note is too long", i))
+		}
+	}
+	return errMsgs
+}
+
+// isValidClockTime reports if value is a time formatted as HH:MM, which keeps times comparable as strings.
+func isValidClockTime(value string) bool {
+	clock, err := time.Parse("15:04", value)
+	return err == nil && clock.Format("15:04") == value
+}
+
+func isValidPhone(phone string) bool {
+	digits := 0
+	for _, r := range phone {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case strings.ContainsRune("+-() ", r):
+		default:
+			return false
+		}
+	}
+	return digits >= 5 && len(phone) <= 32
+}
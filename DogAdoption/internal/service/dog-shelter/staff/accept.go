@@ -0,0 +1,70 @@
+package shelterstaffservice
+
+import (
+	"1dv027/aad/internal/dto"
+	shelterstaffdto "1dv027/aad/internal/dto/shelter-staff"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strings"
+)
+
+type AcceptStaffInvitationRepository interface {
+	AcceptStaffInvitation(ctx context.Context, tokenHash, username, passwordHash string) (model.ShelterStaff, error)
+}
+
+type AcceptStaffInvitationCryptographyService interface {
+	HashToken(token string) string
+	HashPassword(unhashedPassword string) (string, error)
+}
+
+type AcceptStaffInvitationPasswordPolicy interface {
+	ValidatePassword(username, password string) error
+}
+
+type AcceptStaffInvitationService struct {
+	repo           AcceptStaffInvitationRepository
+	cryptoService  AcceptStaffInvitationCryptographyService
+	passwordPolicy AcceptStaffInvitationPasswordPolicy
+	linkGenerator  ShelterStaffLinkGenerator
+}
+
+func NewAcceptStaffInvitationService(repo AcceptStaffInvitationRepository, cryptoService AcceptStaffInvitationCryptographyService,
+	passwordPolicy AcceptStaffInvitationPasswordPolicy, linkGenerator ShelterStaffLinkGenerator) AcceptStaffInvitationService {
+	return AcceptStaffInvitationService{
+		repo:           repo,
+		cryptoService:  cryptoService,
+		passwordPolicy: passwordPolicy,
+		linkGenerator:  linkGenerator,
+	}
+}
+
+// AcceptInvitation creates a staff account from an invitation token. The shelter, role and email
+// are taken from the invitation.
+func (a AcceptStaffInvitationService) AcceptInvitation(ctx context.Context,
+	data shelterstaffdto.AcceptStaffInvitationDTO) (shelterstaffdto.ShelterStaffDTO, error) {
+	emptyDto := shelterstaffdto.ShelterStaffDTO{}
+	if data.Token == nil || *data.Token == "" || data.Username == nil || *data.Username == "" ||
+		data.Password == nil || *data.Password == "" {
+		return emptyDto, &customerrors.InvalidShelterStaffDataError{Message: "token, username and password are required"}
+	}
+	// Erased users are renamed with this prefix, see UserDataAccess.EraseUser.
+	if strings.HasPrefix(strings.ToLower(*data.Username), "deleted-user-") {
+		return emptyDto, &customerrors.InvalidShelterStaffDataError{Message: "invalid username. try another one!"}
+	}
+	err := a.passwordPolicy.ValidatePassword(*data.Username, *data.Password)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	hashedPassword, err := a.cryptoService.HashPassword(*data.Password)
+	if err != nil {
+		return emptyDto, &customerrors.CryptographyError{}
+	}
+	staff, err := a.repo.AcceptStaffInvitation(ctx, a.cryptoService.HashToken(*data.Token), *data.Username, hashedPassword)
+	if err != nil {
+		return emptyDto, err
+	}
+	viewer := dto.UserCredentials{Id: staff.Id, UserRole: model.SHELTERSTAFF}
+	return toStaffDto(staff, a.linkGenerator, viewer)
+}
@@ -0,0 +1,41 @@
+package shelterstaffservice
+
+import (
+	"1dv027/aad/internal/dto"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type DeleteShelterStaffRepository interface {
+	DeleteShelterStaff(ctx context.Context, shelterId, staffId int) error
+}
+
+type DeleteShelterStaffService struct {
+	repo DeleteShelterStaffRepository
+}
+
+func NewDeleteShelterStaffService(repo DeleteShelterStaffRepository) DeleteShelterStaffService {
+	return DeleteShelterStaffService{
+		repo: repo,
+	}
+}
+
+// DeleteStaff removes a staff member from the shelter. Owners can remove anyone, and staff members can leave themselves.
+func (d DeleteShelterStaffService) DeleteStaff(ctx context.Context, shelterIdParam, staffIdParam string, credentials dto.UserCredentials) error {
+	shelterId, staffId, err := parseIdParams(shelterIdParam, staffIdParam)
+	if err != nil {
+		return err
+	}
+	isSelf := credentials.UserRole == model.SHELTERSTAFF && credentials.Id == staffId && credentials.Scopes == nil
+	if !isSelf && credentials.UserRole != model.ADMIN {
+		err = requireOwner(credentials, shelterId)
+		if err != nil {
+			return err
+		}
+	}
+	if isSelf && !credentials.IsShelterMember(shelterId) {
+		return &customerrors.UnauthorizedError{}
+	}
+	return d.repo.DeleteShelterStaff(ctx, shelterId, staffId)
+}
@@ -0,0 +1,58 @@
+package shelterstaffservice
+
+import (
+	"1dv027/aad/internal/dto"
+	shelterstaffdto "1dv027/aad/internal/dto/shelter-staff"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strconv"
+)
+
+type GetShelterStaffRepository interface {
+	GetDogShelterById(ctx context.Context, shelterId int) (model.DogShelter, error)
+	GetShelterStaff(ctx context.Context, shelterId int) ([]model.ShelterStaff, error)
+}
+
+type GetShelterStaffService struct {
+	repo          GetShelterStaffRepository
+	linkGenerator ShelterStaffLinkGenerator
+}
+
+func NewGetShelterStaffService(repo GetShelterStaffRepository, linkGenerator ShelterStaffLinkGenerator) GetShelterStaffService {
+	return GetShelterStaffService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// GetStaff lists the staff of a shelter for admins and members of the shelter.
+func (g GetShelterStaffService) GetStaff(ctx context.Context, shelterIdParam string,
+	credentials dto.UserCredentials) (shelterstaffdto.ShelterStaffListDTO, error) {
+	emptyDto := shelterstaffdto.ShelterStaffListDTO{}
+	shelterId, err := strconv.Atoi(shelterIdParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+	if credentials.UserRole != model.ADMIN && !credentials.IsShelterMember(shelterId) {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	_, err = g.repo.GetDogShelterById(ctx, shelterId)
+	if err != nil {
+		return emptyDto, err
+	}
+	staff, err := g.repo.GetShelterStaff(ctx, shelterId)
+	if err != nil {
+		return emptyDto, err
+	}
+	staffDtoSlice := []shelterstaffdto.ShelterStaffDTO{}
+	for _, staffMember := range staff {
+		staffDto, err := toStaffDto(staffMember, g.linkGenerator, credentials)
+		if err != nil {
+			return emptyDto, err
+		}
+		staffDtoSlice = append(staffDtoSlice, staffDto)
+	}
+	return shelterstaffdto.ShelterStaffListDTO{StaffData: staffDtoSlice}, nil
+}
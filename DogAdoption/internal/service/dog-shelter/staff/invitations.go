@@ -0,0 +1,67 @@
+package shelterstaffservice
+
+import (
+	"1dv027/aad/internal/dto"
+	shelterstaffdto "1dv027/aad/internal/dto/shelter-staff"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strconv"
+)
+
+type StaffInvitationsRepository interface {
+	GetStaffInvitations(ctx context.Context, shelterId int) ([]model.ShelterStaffInvitation, error)
+	DeleteStaffInvitation(ctx context.Context, shelterId, invitationId int) error
+}
+
+// StaffInvitationsService lets shelter owners see and revoke the open invitations of the shelter.
+type StaffInvitationsService struct {
+	repo          StaffInvitationsRepository
+	linkGenerator ShelterStaffLinkGenerator
+}
+
+func NewStaffInvitationsService(repo StaffInvitationsRepository, linkGenerator ShelterStaffLinkGenerator) StaffInvitationsService {
+	return StaffInvitationsService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+func (s StaffInvitationsService) GetInvitations(ctx context.Context, shelterIdParam string,
+	credentials dto.UserCredentials) (shelterstaffdto.StaffInvitationListDTO, error) {
+	emptyDto := shelterstaffdto.StaffInvitationListDTO{}
+	shelterId, err := strconv.Atoi(shelterIdParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+	err = requireOwner(credentials, shelterId)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	invitations, err := s.repo.GetStaffInvitations(ctx, shelterId)
+	if err != nil {
+		return emptyDto, err
+	}
+	invitationDtoSlice := []shelterstaffdto.StaffInvitationDTO{}
+	for _, invitation := range invitations {
+		invitationDto, err := toInvitationDto(invitation, s.linkGenerator)
+		if err != nil {
+			return emptyDto, err
+		}
+		invitationDtoSlice = append(invitationDtoSlice, invitationDto)
+	}
+	return shelterstaffdto.StaffInvitationListDTO{InvitationData: invitationDtoSlice}, nil
+}
+
+func (s StaffInvitationsService) RevokeInvitation(ctx context.Context, shelterIdParam, invitationIdParam string, credentials dto.UserCredentials) error {
+	shelterId, invitationId, err := parseIdParams(shelterIdParam, invitationIdParam)
+	if err != nil {
+		return err
+	}
+	err = requireOwner(credentials, shelterId)
+	if err != nil {
+		return err
+	}
+	return s.repo.DeleteStaffInvitation(ctx, shelterId, invitationId)
+}
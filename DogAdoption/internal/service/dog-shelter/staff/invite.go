@@ -0,0 +1,108 @@
+package shelterstaffservice
+
+import (
+	"1dv027/aad/internal/dto"
+	shelterstaffdto "1dv027/aad/internal/dto/shelter-staff"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/mailer"
+	"1dv027/aad/internal/model"
+	"context"
+	"fmt"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type InviteShelterStaffRepository interface {
+	GetDogShelterById(ctx context.Context, shelterId int) (model.DogShelter, error)
+	CreateStaffInvitation(ctx context.Context, invitation model.ShelterStaffInvitation) (model.ShelterStaffInvitation, error)
+}
+
+type InviteShelterStaffCryptographyService interface {
+	GenerateRandomToken(byteLength int) (string, error)
+	HashToken(token string) string
+}
+
+type StaffInvitationMailer interface {
+	Send(ctx context.Context, mail mailer.Mail) error
+}
+
+// InviteShelterStaffService lets shelter owners invite staff by email. The invitation token is
+// only sent by email, and the invited person chooses their own username and password.
+type InviteShelterStaffService struct {
+	repo          InviteShelterStaffRepository
+	cryptoService InviteShelterStaffCryptographyService
+	mailer        StaffInvitationMailer
+	linkGenerator ShelterStaffLinkGenerator
+	tokenLifetime time.Duration
+}
+
+func NewInviteShelterStaffService(repo InviteShelterStaffRepository, cryptoService InviteShelterStaffCryptographyService,
+	mailer StaffInvitationMailer, linkGenerator ShelterStaffLinkGenerator) InviteShelterStaffService {
+	return InviteShelterStaffService{
+		repo:          repo,
+		cryptoService: cryptoService,
+		mailer:        mailer,
+		linkGenerator: linkGenerator,
+		tokenLifetime: 7 * 24 * time.Hour,
+	}
+}
+
+func (i InviteShelterStaffService) InviteStaff(ctx context.Context, shelterIdParam string, data shelterstaffdto.NewStaffInvitationDTO,
+	credentials dto.UserCredentials) (shelterstaffdto.StaffInvitationDTO, error) {
+	emptyDto := shelterstaffdto.StaffInvitationDTO{}
+	shelterId, err := strconv.Atoi(shelterIdParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+	err = requireOwner(credentials, shelterId)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	if data.Email == nil || data.Role == nil {
+		return emptyDto, &customerrors.InvalidShelterStaffDataError{Message: "email and role are required"}
+	}
+	email, err := mail.ParseAddress(*data.Email)
+	if err != nil || email.Name != "" || !strings.Contains(email.Address, ".") {
+		return emptyDto, &customerrors.InvalidShelterStaffDataError{Message: "invalid email address"}
+	}
+	role, ok := model.StringToShelterStaffRole(*data.Role)
+	if !ok {
+		return emptyDto, &customerrors.InvalidShelterStaffDataError{Message: "invalid role. only owner, manager and volunteer are accepted"}
+	}
+
+	shelter, err := i.repo.GetDogShelterById(ctx, shelterId)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	token, err := i.cryptoService.GenerateRandomToken(32)
+	if err != nil {
+		return emptyDto, &customerrors.CryptographyError{}
+	}
+	invitation, err := i.repo.CreateStaffInvitation(ctx, model.ShelterStaffInvitation{
+		ShelterId: shelterId,
+		Email:     email.Address,
+		Role:      role,
+		TokenHash: i.cryptoService.HashToken(token),
+		ExpiresAt: time.Now().Add(i.tokenLifetime),
+	})
+	if err != nil {
+		return emptyDto, err
+	}
+
+	err = i.mailer.Send(ctx, mailer.Mail{
+		To:      email.Address,
+		Subject: fmt.Sprintf("You are invited to join %s", shelter.Name),
+		Body: fmt.Sprintf("Hi,\n\n%s has invited you to join %s as %s. Create your account by sending the token below "+
+			"together with a username and password to POST /dogshelters/staff-invitations/accept within %d days.\n\n%s\n\n"+
+			"If you did not expect this invitation, you can ignore this email.\n",
+			credentials.Username, shelter.Name, role, int(i.tokenLifetime.Hours()/24), token),
+	})
+	if err != nil {
+		return emptyDto, err
+	}
+	return toInvitationDto(invitation, i.linkGenerator)
+}
@@ -0,0 +1,25 @@
+package shelterstaffservice
+
+import (
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type ShelterMembershipRepository interface {
+	GetShelterStaffById(ctx context.Context, staffId int) (model.ShelterStaff, error)
+}
+
+// ShelterMembershipService resolves which shelter a staff member acts for and with which role.
+type ShelterMembershipService struct {
+	repo ShelterMembershipRepository
+}
+
+func NewShelterMembershipService(repo ShelterMembershipRepository) ShelterMembershipService {
+	return ShelterMembershipService{
+		repo: repo,
+	}
+}
+
+func (s ShelterMembershipService) GetShelterMembership(ctx context.Context, staffId int) (model.ShelterStaff, error) {
+	return s.repo.GetShelterStaffById(ctx, staffId)
+}
@@ -0,0 +1,82 @@
+package shelterstaffservice
+
+import (
+	"1dv027/aad/internal/dto"
+	shelterstaffdto "1dv027/aad/internal/dto/shelter-staff"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+type ShelterStaffLinkGenerator interface {
+	GenerateShelterLink(shelterId string) string
+	GenerateShelterStaffLink(shelterId, staffId string) string
+	GenerateStaffInvitationLink(shelterId, invitationId string) string
+}
+
+// toStaffDto converts a staff member to its DTO. Emails are only shown to admins, owners and managers.
+func toStaffDto(staff model.ShelterStaff, linkGenerator ShelterStaffLinkGenerator, viewer dto.UserCredentials) (shelterstaffdto.ShelterStaffDTO, error) {
+	var staffDto shelterstaffdto.ShelterStaffDTO
+	staffJson, err := json.Marshal(staff.ToJson())
+	if err != nil {
+		return staffDto, err
+	}
+	err = json.Unmarshal(staffJson, &staffDto)
+	if err != nil {
+		return staffDto, err
+	}
+	if viewer.UserRole != model.ADMIN && !viewer.HasShelterRole(staff.ShelterId, model.STAFF_OWNER, model.STAFF_MANAGER) &&
+		!(viewer.UserRole == model.SHELTERSTAFF && viewer.Id == staff.Id) {
+		staffDto.Email = ""
+	}
+
+	shelterId := fmt.Sprintf("%d", staff.ShelterId)
+	staffDto.Links = shelterstaffdto.ShelterStaffDtoLinks{
+		SelfLink:    linkGenerator.GenerateShelterStaffLink(shelterId, fmt.Sprintf("%d", staff.Id)),
+		ShelterLink: linkGenerator.GenerateShelterLink(shelterId),
+	}
+	return staffDto, nil
+}
+
+func toInvitationDto(invitation model.ShelterStaffInvitation, linkGenerator ShelterStaffLinkGenerator) (shelterstaffdto.StaffInvitationDTO, error) {
+	var invitationDto shelterstaffdto.StaffInvitationDTO
+	invitationJson, err := json.Marshal(invitation.ToJson())
+	if err != nil {
+		return invitationDto, err
+	}
+	err = json.Unmarshal(invitationJson, &invitationDto)
+	if err != nil {
+		return invitationDto, err
+	}
+
+	shelterId := fmt.Sprintf("%d", invitation.ShelterId)
+	invitationDto.Links = shelterstaffdto.StaffInvitationDtoLinks{
+		SelfLink:    linkGenerator.GenerateStaffInvitationLink(shelterId, fmt.Sprintf("%d", invitation.Id)),
+		ShelterLink: linkGenerator.GenerateShelterLink(shelterId),
+	}
+	return invitationDto, nil
+}
+
+// parseIdParams converts the shelter id and the id of the nested resource from the path.
+func parseIdParams(shelterIdParam, idParam string) (int, int, error) {
+	shelterId, err := strconv.Atoi(shelterIdParam)
+	if err != nil {
+		return 0, 0, &customerrors.IntegerConversionError{}
+	}
+	id, err := strconv.Atoi(idParam)
+	if err != nil {
+		return 0, 0, &customerrors.IntegerConversionError{}
+	}
+	return shelterId, id, nil
+}
+
+// requireOwner allows the owners of the shelter to manage its staff. Delegated credentials are
+// rejected, since staff management grants access to the shelter.
+func requireOwner(credentials dto.UserCredentials, shelterId int) error {
+	if credentials.Scopes != nil || !credentials.HasShelterRole(shelterId, model.STAFF_OWNER) {
+		return &customerrors.UnauthorizedError{}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package shelterstaffservice
+
+import (
+	"1dv027/aad/internal/dto"
+	shelterstaffdto "1dv027/aad/internal/dto/shelter-staff"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type UpdateShelterStaffRepository interface {
+	UpdateShelterStaffRole(ctx context.Context, shelterId, staffId int, role model.ShelterStaffRole) (model.ShelterStaff, error)
+}
+
+type UpdateShelterStaffService struct {
+	repo          UpdateShelterStaffRepository
+	linkGenerator ShelterStaffLinkGenerator
+}
+
+func NewUpdateShelterStaffService(repo UpdateShelterStaffRepository, linkGenerator ShelterStaffLinkGenerator) UpdateShelterStaffService {
+	return UpdateShelterStaffService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// UpdateStaffRole changes the role of a staff member. Only owners of the shelter can change roles.
+func (u UpdateShelterStaffService) UpdateStaffRole(ctx context.Context, shelterIdParam, staffIdParam string,
+	data shelterstaffdto.UpdateShelterStaffDTO, credentials dto.UserCredentials) (shelterstaffdto.ShelterStaffDTO, error) {
+	emptyDto := shelterstaffdto.ShelterStaffDTO{}
+	shelterId, staffId, err := parseIdParams(shelterIdParam, staffIdParam)
+	if err != nil {
+		return emptyDto, err
+	}
+	err = requireOwner(credentials, shelterId)
+	if err != nil {
+		return emptyDto, err
+	}
+	if data.Role == nil {
+		return emptyDto, &customerrors.InvalidShelterStaffDataError{Message: "role cannot be empty"}
+	}
+	role, ok := model.StringToShelterStaffRole(*data.Role)
+	if !ok {
+		return emptyDto, &customerrors.InvalidShelterStaffDataError{Message: "invalid role. only owner, manager and volunteer are accepted"}
+	}
+
+	staff, err := u.repo.UpdateShelterStaffRole(ctx, shelterId, staffId, role)
+	if err != nil {
+		return emptyDto, err
+	}
+	return toStaffDto(staff, u.linkGenerator, credentials)
+}
@@ -0,0 +1,59 @@
+package dogsservice
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+	"strings"
+)
+
+// DogBreedResolver looks up the breeds that dogs reference.
+type DogBreedResolver interface {
+	GetBreedById(ctx context.Context, breedId int) (model.Breed, error)
+	GetBreedByName(ctx context.Context, name string) (model.Breed, error)
+}
+
+// resolvePrimaryBreed returns the primary breed given by its id, or else by the name or an alias of the
+// breed. It returns nil when neither is given.
+func resolvePrimaryBreed(ctx context.Context, resolver DogBreedResolver, breedName *string, primaryBreedId *int) (*model.Breed, error) {
+	if primaryBreedId != nil {
+		breed, err := resolver.GetBreedById(ctx, *primaryBreedId)
+		if err != nil {
+			return nil, breedLookupError(err, "primary_breed_id does not match a breed")
+		}
+		return &breed, nil
+	}
+	if breedName != nil {
+		breed, err := resolver.GetBreedByName(ctx, strings.TrimSpace(*breedName))
+		if err != nil {
+			return nil, breedLookupError(err, "breed does not match the name or an alias of a breed, see /breeds")
+		}
+		return &breed, nil
+	}
+	return nil, nil
+}
+
+// validateSecondaryBreed checks that the secondary breed of a mixed breed dog exists and differs from
+// the primary breed. A secondary breed id of 0 means that the dog has no secondary breed.
+func validateSecondaryBreed(ctx context.Context, resolver DogBreedResolver, secondaryBreedId, primaryBreedId *int) error {
+	if secondaryBreedId == nil || *secondaryBreedId == 0 {
+		return nil
+	}
+	if primaryBreedId != nil && *secondaryBreedId == *primaryBreedId {
+		return &customerrors.IncompleteDogDataError{Message: "secondary_breed_id must differ from the primary breed"}
+	}
+	_, err := resolver.GetBreedById(ctx, *secondaryBreedId)
+	if err != nil {
+		return breedLookupError(err, "secondary_breed_id does not match a breed")
+	}
+	return nil
+}
+
+func breedLookupError(err error, message string) error {
+	var breedNotFound *customerrors.BreedNotFoundError
+	if errors.As(err, &breedNotFound) {
+		return &customerrors.IncompleteDogDataError{Message: message}
+	}
+	return err
+}
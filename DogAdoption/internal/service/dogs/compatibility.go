@@ -0,0 +1,98 @@
+package dogsservice
+
+import (
+	dogdto "1dv027/aad/internal/dto/dog"
+	"1dv027/aad/internal/model"
+	"fmt"
+)
+
+// validateCompatibilityFields returns the problems with the compatibility flags, energy level and size
+// of a dog. An empty energy level or size is allowed when it removes the value of an existing dog.
+func validateCompatibilityFields(goodWith *dogdto.UpdateGoodWithDTO, energyLevel, size *string, allowEmpty bool) []string {
+	var errMsgs []string
+	if goodWith != nil {
+		flags := []struct {
+			name  string
+			value *string
+		}{
+			{"good_with.children", goodWith.Children},
+			{"good_with.dogs", goodWith.Dogs},
+			{"good_with.cats", goodWith.Cats},
+			{"good_with.small_animals", goodWith.SmallAnimals},
+		}
+		for _, flag := range flags {
+			if flag.value == nil {
+				continue
+			}
+			_, ok := model.StringToCompatibility(*flag.value)
+			if !ok {
+				errMsgs = append(errMsgs, fmt.Sprintf("%s must be either 'yes', 'no' or 'unknown'", flag.name))
+			}
+		}
+	}
+	if energyLevel != nil && !(allowEmpty && *energyLevel == "") {
+		_, ok := model.StringToEnergyLevel(*energyLevel)
+		if !ok {
+			errMsgs = append(errMsgs, "energy_level must be either 'low', 'medium' or 'high'")
+		}
+	}
+	if size != nil && !(allowEmpty && *size == "") {
+		_, ok := model.StringToBreedSizeGroup(*size)
+		if !ok {
+			errMsgs = append(errMsgs, "size must be either 'toy', 'small', 'medium', 'large' or 'giant'")
+		}
+	}
+	return errMsgs
+}
+
+// mergeCompatibility sets the given flags on the compatibility.
+func mergeCompatibility(compatibility model.DogCompatibility, goodWith *dogdto.UpdateGoodWithDTO) model.DogCompatibility {
+	if goodWith == nil {
+		return compatibility
+	}
+	if goodWith.Children != nil {
+		compatibility.Children = model.Compatibility(*goodWith.Children)
+	}
+	if goodWith.Dogs != nil {
+		compatibility.Dogs = model.Compatibility(*goodWith.Dogs)
+	}
+	if goodWith.Cats != nil {
+		compatibility.Cats = model.Compatibility(*goodWith.Cats)
+	}
+	if goodWith.SmallAnimals != nil {
+		compatibility.SmallAnimals = model.Compatibility(*goodWith.SmallAnimals)
+	}
+	return compatibility
+}
+
+func toUpdateGoodWithDto(compatibility model.DogCompatibility) *dogdto.UpdateGoodWithDTO {
+	children := string(compatibility.Children)
+	dogs := string(compatibility.Dogs)
+	cats := string(compatibility.Cats)
+	smallAnimals := string(compatibility.SmallAnimals)
+	return &dogdto.UpdateGoodWithDTO{
+		Children:     &children,
+		Dogs:         &dogs,
+		Cats:         &cats,
+		SmallAnimals: &smallAnimals,
+	}
+}
+
+// resolveCompatibility keeps the structured flags and the legacy friendly_with text in step. Flags that
+// are given are merged into the current compatibility and described in friendly_with, unless older
+// clients give only friendly_with, which then sets the flags.
+func resolveCompatibility(current model.DogCompatibility, goodWith *dogdto.UpdateGoodWithDTO,
+	friendlyWith *string) (*dogdto.UpdateGoodWithDTO, *string) {
+	switch {
+	case goodWith != nil:
+		compatibility := mergeCompatibility(current, goodWith)
+		if friendlyWith == nil {
+			description := compatibility.FriendlyWith()
+			friendlyWith = &description
+		}
+		return toUpdateGoodWithDto(compatibility), friendlyWith
+	case friendlyWith != nil:
+		return toUpdateGoodWithDto(model.ParseFriendlyWith(*friendlyWith)), friendlyWith
+	}
+	return nil, nil
+}
@@ -5,6 +5,8 @@ import (
 	customerrors "1dv027/aad/internal/errors"
 	"1dv027/aad/internal/model"
 	"context"
+	"fmt"
+	"log"
 	"strconv"
 )
 
@@ -13,13 +15,20 @@ type DeleteDogRepository interface {
 	GetDogById(ctx context.Context, dogId int) (model.Dog, error)
 }
 
+// DogPhotoFileStore removes the photo files of deleted dogs. The photo rows are removed by the database.
+type DogPhotoFileStore interface {
+	DeletePrefix(ctx context.Context, prefix string) error
+}
+
 type DeleteDogService struct {
-	repo DeleteDogRepository
+	repo           DeleteDogRepository
+	photoFileStore DogPhotoFileStore
 }
 
-func NewDeleteDogService(repo DeleteDogRepository) DeleteDogService {
+func NewDeleteDogService(repo DeleteDogRepository, photoFileStore DogPhotoFileStore) DeleteDogService {
 	return DeleteDogService{
-		repo: repo,
+		repo:           repo,
+		photoFileStore: photoFileStore,
 	}
 }
 
@@ -30,16 +39,30 @@ func (d DeleteDogService) DeleteDog(ctx context.Context, dogId string, credentia
 	}
 	role := credentials.UserRole
 	if role == model.ADMIN {
-		return d.repo.DeleteDog(ctx, dogIdInt)
-	} else if role == model.DOGSHELTER {
+		return d.deleteDog(ctx, dogIdInt)
+	} else if role == model.DOGSHELTER || role == model.SHELTERSTAFF {
 		dog, err := d.repo.GetDogById(ctx, dogIdInt)
 		if err != nil {
 			return err
 		}
-		if dog.ShelterId != credentials.Id {
+		// Volunteers can update dogs, but removing them is left to owners and managers.
+		if !credentials.HasShelterRole(dog.ShelterId, model.STAFF_OWNER, model.STAFF_MANAGER) {
 			return &customerrors.UnauthorizedError{}
 		}
-		return d.repo.DeleteDog(ctx, dogIdInt)
+		return d.deleteDog(ctx, dogIdInt)
 	}
 	return &customerrors.UnauthorizedError{}
 }
+
+func (d DeleteDogService) deleteDog(ctx context.Context, dogId int) error {
+	err := d.repo.DeleteDog(ctx, dogId)
+	if err != nil {
+		return err
+	}
+	// The dog is already deleted, so a failure only leaves unreferenced files behind.
+	err = d.photoFileStore.DeletePrefix(ctx, fmt.Sprintf("dogs/%d", dogId))
+	if err != nil {
+		log.Printf("Failed to delete the photos of dog %d: %v", dogId, err)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package dogsservice
+
+import (
+	"1dv027/aad/internal/dto"
+	dogdto "1dv027/aad/internal/dto/dog"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+// DogStatusChangeNotifier notifies the users that have a dog as a favorite when its status changes.
+type DogStatusChangeNotifier interface {
+	NotifyDogStatusChanged(ctx context.Context, dog dogdto.DogDTO, previousStatus model.DogStatus)
+}
+
+// DogSavedSearchMatcher alerts the users whose saved searches match a new or updated dog.
+type DogSavedSearchMatcher interface {
+	MatchDog(ctx context.Context, dog dogdto.DogDTO)
+}
+
+// showFavoritedCount adds how many users have the dog as a favorite for admins and members of its shelter.
+func showFavoritedCount(dogDto *dogdto.DogDTO, dog model.Dog, viewer dto.UserCredentials) {
+	if viewer.UserRole == model.ADMIN || viewer.IsShelterMember(dog.ShelterId) {
+		favoritedCount := dog.FavoritedCount
+		dogDto.FavoritedCount = &favoritedCount
+	}
+}
@@ -1,12 +1,12 @@
 package dogsservice
 
 import (
+	"1dv027/aad/internal/dto"
 	dogdto "1dv027/aad/internal/dto/dog"
 	customerrors "1dv027/aad/internal/errors"
 	"1dv027/aad/internal/model"
 	"context"
 	"encoding/json"
-	"fmt"
 	"strconv"
 )
 
@@ -15,8 +15,7 @@ type GetDogByIdRepository interface {
 }
 
 type GetDogByIdLinkGenerator interface {
-	GenerateDogLink(dogId string) string
-	GenerateShelterLink(shelterId string) string
+	DogLinkGenerator
 }
 
 type GetDogByIdService struct {
@@ -31,7 +30,8 @@ func NewGetDogByIdService(repo GetDogByIdRepository, linkGenerator GetDogByIdLin
 	}
 }
 
-func (g GetDogByIdService) GetDogById(ctx context.Context, dogId string) (dogdto.DogDTO, error) {
+// GetDogById returns the dog. Authentication is optional, and only changes which fields are shown.
+func (g GetDogByIdService) GetDogById(ctx context.Context, dogId string, viewer dto.UserCredentials) (dogdto.DogDTO, error) {
 	emptyDto := dogdto.DogDTO{}
 	dogIdInt, err := strconv.Atoi(dogId)
 	if err != nil {
@@ -53,10 +53,8 @@ func (g GetDogByIdService) GetDogById(ctx context.Context, dogId string) (dogdto
 	if err != nil {
 		return emptyDto, err
 	}
-	dogDto.Links = dogdto.DogLinksDTO{
-		ShelterLink: g.linkGenerator.GenerateShelterLink(fmt.Sprintf("%d", dogDto.ShelterId)),
-		SelfLink:    g.linkGenerator.GenerateDogLink(fmt.Sprintf("%d", dogDto.Id)),
-	}
+	dogDto.Links = generateDogLinks(dog, g.linkGenerator)
+	showFavoritedCount(&dogDto, dog, viewer)
 
 	return dogDto, nil
 }
@@ -5,12 +5,10 @@ import (
 	dogdto "1dv027/aad/internal/dto/dog"
 	"context"
 	"encoding/json"
-	"fmt"
 )
 
 type GetDogsLinkGenerator interface {
-	GenerateDogLink(dogId string) string
-	GenerateShelterLink(shelterId string) string
+	DogLinkGenerator
 	GeneratePaginationLinks(totalItems int, queryParams dto.QueryParams, apiPath string) dto.PaginationLinksDTO
 }
 
@@ -30,7 +28,8 @@ func NewGetDogsService(repo GetDogsRepository, linkGenerator GetDogsLinkGenerato
 	}
 }
 
-func (g GetDogsService) GetDogs(ctx context.Context, queryParams dto.QueryParams) (dogdto.DogsAndPaginationLinksDTO, error) {
+// GetDogs returns a page of dogs. Authentication is optional, and only changes which fields are shown.
+func (g GetDogsService) GetDogs(ctx context.Context, queryParams dto.QueryParams, viewer dto.UserCredentials) (dogdto.DogsAndPaginationLinksDTO, error) {
 	emptyDto := dogdto.DogsAndPaginationLinksDTO{}
 	dogsResult, err := g.repo.GetDogs(ctx, queryParams)
 	if err != nil {
@@ -48,12 +47,8 @@ func (g GetDogsService) GetDogs(ctx context.Context, queryParams dto.QueryParams
 		if err != nil {
 			return emptyDto, err
 		}
-		selfLink := g.linkGenerator.GenerateDogLink(fmt.Sprintf("%d", dog.Id))
-		shelterLink := g.linkGenerator.GenerateShelterLink(fmt.Sprintf("%d", dog.ShelterId))
-		dogDto.Links = dogdto.DogLinksDTO{
-			SelfLink:    selfLink,
-			ShelterLink: shelterLink,
-		}
+		dogDto.Links = generateDogLinks(dog, g.linkGenerator)
+		showFavoritedCount(&dogDto, dog, viewer)
 		dogs = append(dogs, dogDto)
 	}
 	paginationLinks := g.linkGenerator.GeneratePaginationLinks(dogsResult.TotalAmountAvailable, queryParams, "/dogs")
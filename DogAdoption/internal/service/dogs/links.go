@@ -0,0 +1,41 @@
+package dogsservice
+
+import (
+	dogdto "1dv027/aad/internal/dto/dog"
+	"1dv027/aad/internal/model"
+	"fmt"
+)
+
+type DogLinkGenerator interface {
+	GenerateDogLink(dogId string) string
+	GenerateShelterLink(shelterId string) string
+	GenerateDogTransfersLink(dogId string) string
+	GenerateDogStatusHistoryLink(dogId string) string
+	GenerateDogPhotosLink(dogId string) string
+	GenerateDogMedicalRecordsLink(dogId string) string
+	GenerateDogPhotoFileLink(dogId, photoId, size string) string
+	GenerateBreedLink(breedId string) string
+}
+
+func generateDogLinks(dog model.Dog, linkGenerator DogLinkGenerator) dogdto.DogLinksDTO {
+	dogId := fmt.Sprintf("%d", dog.Id)
+	links := dogdto.DogLinksDTO{
+		ShelterLink:        linkGenerator.GenerateShelterLink(fmt.Sprintf("%d", dog.ShelterId)),
+		SelfLink:           linkGenerator.GenerateDogLink(dogId),
+		TransfersLink:      linkGenerator.GenerateDogTransfersLink(dogId),
+		StatusHistoryLink:  linkGenerator.GenerateDogStatusHistoryLink(dogId),
+		PhotosLink:         linkGenerator.GenerateDogPhotosLink(dogId),
+		MedicalRecordsLink: linkGenerator.GenerateDogMedicalRecordsLink(dogId),
+	}
+	if dog.PrimaryPhotoId != nil {
+		links.PrimaryPhotoLink = linkGenerator.GenerateDogPhotoFileLink(dogId,
+			fmt.Sprintf("%d", *dog.PrimaryPhotoId), string(model.PHOTO_MEDIUM))
+	}
+	if dog.PrimaryBreedId != nil {
+		links.PrimaryBreedLink = linkGenerator.GenerateBreedLink(fmt.Sprintf("%d", *dog.PrimaryBreedId))
+	}
+	if dog.SecondaryBreedId != nil {
+		links.SecondaryBreedLink = linkGenerator.GenerateBreedLink(fmt.Sprintf("%d", *dog.SecondaryBreedId))
+	}
+	return links
+}
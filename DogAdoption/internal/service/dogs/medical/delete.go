@@ -0,0 +1,39 @@
+package dogmedicalservice
+
+import (
+	"1dv027/aad/internal/dto"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type DeleteDogMedicalRecordRepository interface {
+	GetDogById(ctx context.Context, dogId int) (model.Dog, error)
+	DeleteDogMedicalRecord(ctx context.Context, dogId, recordId int) error
+}
+
+type DeleteDogMedicalRecordService struct {
+	repo DeleteDogMedicalRecordRepository
+}
+
+func NewDeleteDogMedicalRecordService(repo DeleteDogMedicalRecordRepository) DeleteDogMedicalRecordService {
+	return DeleteDogMedicalRecordService{
+		repo: repo,
+	}
+}
+
+func (d DeleteDogMedicalRecordService) DeleteMedicalRecord(ctx context.Context, dogIdParam, recordIdParam string,
+	credentials dto.UserCredentials) error {
+	dogId, recordId, err := parseIdParams(dogIdParam, recordIdParam)
+	if err != nil {
+		return err
+	}
+	dog, err := d.repo.GetDogById(ctx, dogId)
+	if err != nil {
+		return err
+	}
+	if !hasFullAccess(credentials, dog) {
+		return &customerrors.UnauthorizedError{}
+	}
+	return d.repo.DeleteDogMedicalRecord(ctx, dogId, recordId)
+}
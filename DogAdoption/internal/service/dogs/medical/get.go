@@ -0,0 +1,90 @@
+package dogmedicalservice
+
+import (
+	"1dv027/aad/internal/dto"
+	dogmedicaldto "1dv027/aad/internal/dto/dog/medical"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+type GetDogMedicalRecordsRepository interface {
+	GetDogById(ctx context.Context, dogId int) (model.Dog, error)
+	GetDogMedicalRecords(ctx context.Context, dogId int) ([]model.DogMedicalRecord, error)
+	GetDogMedicalRecordById(ctx context.Context, dogId, recordId int) (model.DogMedicalRecord, error)
+}
+
+type GetDogMedicalRecordsService struct {
+	repo          GetDogMedicalRecordsRepository
+	linkGenerator DogMedicalRecordLinkGenerator
+}
+
+func NewGetDogMedicalRecordsService(repo GetDogMedicalRecordsRepository, linkGenerator DogMedicalRecordLinkGenerator) GetDogMedicalRecordsService {
+	return GetDogMedicalRecordsService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// GetMedicalRecords returns the summary of the medical record of the dog to everyone, and the full
+// records to the dog's shelter and admins.
+func (g GetDogMedicalRecordsService) GetMedicalRecords(ctx context.Context, dogIdParam string,
+	credentials dto.UserCredentials) (dogmedicaldto.DogMedicalRecordsDTO, error) {
+	emptyDto := dogmedicaldto.DogMedicalRecordsDTO{}
+	dogId, err := strconv.Atoi(dogIdParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+	dog, err := g.repo.GetDogById(ctx, dogId)
+	if err != nil {
+		return emptyDto, err
+	}
+	records, err := g.repo.GetDogMedicalRecords(ctx, dogId)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	medicalRecords := dogmedicaldto.DogMedicalRecordsDTO{
+		Summary: toSummary(dog, records, today()),
+		Links: dogmedicaldto.DogMedicalRecordsDtoLinks{
+			SelfLink: g.linkGenerator.GenerateDogMedicalRecordsLink(fmt.Sprintf("%d", dog.Id)),
+			DogLink:  g.linkGenerator.GenerateDogLink(fmt.Sprintf("%d", dog.Id)),
+		},
+	}
+	if !hasFullAccess(credentials, dog) {
+		return medicalRecords, nil
+	}
+	medicalRecords.IsFullRecord = true
+	medicalRecords.RecordData = []dogmedicaldto.DogMedicalRecordDTO{}
+	for _, record := range records {
+		recordDto, err := toRecordDto(record, g.linkGenerator)
+		if err != nil {
+			return emptyDto, err
+		}
+		medicalRecords.RecordData = append(medicalRecords.RecordData, recordDto)
+	}
+	return medicalRecords, nil
+}
+
+func (g GetDogMedicalRecordsService) GetMedicalRecord(ctx context.Context, dogIdParam, recordIdParam string,
+	credentials dto.UserCredentials) (dogmedicaldto.DogMedicalRecordDTO, error) {
+	emptyDto := dogmedicaldto.DogMedicalRecordDTO{}
+	dogId, recordId, err := parseIdParams(dogIdParam, recordIdParam)
+	if err != nil {
+		return emptyDto, err
+	}
+	dog, err := g.repo.GetDogById(ctx, dogId)
+	if err != nil {
+		return emptyDto, err
+	}
+	if !hasFullAccess(credentials, dog) {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+	record, err := g.repo.GetDogMedicalRecordById(ctx, dogId, recordId)
+	if err != nil {
+		return emptyDto, err
+	}
+	return toRecordDto(record, g.linkGenerator)
+}
@@ -0,0 +1,85 @@
+package dogmedicalservice
+
+import (
+	"1dv027/aad/internal/dto"
+	dogmedicaldto "1dv027/aad/internal/dto/dog/medical"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+type DogMedicalRecordLinkGenerator interface {
+	GenerateDogLink(dogId string) string
+	GenerateDogMedicalRecordsLink(dogId string) string
+	GenerateDogMedicalRecordLink(dogId, recordId string) string
+}
+
+func toRecordDto(record model.DogMedicalRecord, linkGenerator DogMedicalRecordLinkGenerator) (dogmedicaldto.DogMedicalRecordDTO, error) {
+	var recordDto dogmedicaldto.DogMedicalRecordDTO
+	recordJson, err := json.Marshal(record.ToJson())
+	if err != nil {
+		return recordDto, err
+	}
+	err = json.Unmarshal(recordJson, &recordDto)
+	if err != nil {
+		return recordDto, err
+	}
+	dogId := fmt.Sprintf("%d", record.DogId)
+	recordDto.Links = dogmedicaldto.DogMedicalRecordDtoLinks{
+		SelfLink: linkGenerator.GenerateDogMedicalRecordLink(dogId, fmt.Sprintf("%d", record.Id)),
+		DogLink:  linkGenerator.GenerateDogLink(dogId),
+	}
+	return recordDto, nil
+}
+
+// toSummary builds the public part of the medical record. A dog counts as vaccinated while it has a
+// vaccination that is not overdue.
+func toSummary(dog model.Dog, records []model.DogMedicalRecord, today time.Time) dogmedicaldto.DogMedicalSummaryDTO {
+	summary := dogmedicaldto.DogMedicalSummaryDTO{
+		DogId:        dog.Id,
+		IsNeutered:   dog.IsNeutered,
+		Vaccinations: []dogmedicaldto.VaccinationSummaryDTO{},
+	}
+	for _, record := range records {
+		switch record.RecordType {
+		case model.MEDICAL_VACCINATION:
+			isCurrent := record.IsCurrentVaccination(today)
+			summary.IsVaccinated = summary.IsVaccinated || isCurrent
+			vaccination := dogmedicaldto.VaccinationSummaryDTO{
+				VaccineType:    record.Title,
+				AdministeredOn: record.RecordDate.Format(model.MEDICAL_DATE_LAYOUT),
+				IsCurrent:      isCurrent,
+			}
+			if record.NextDueOn != nil {
+				nextDueOn := record.NextDueOn.Format(model.MEDICAL_DATE_LAYOUT)
+				vaccination.NextDueOn = &nextDueOn
+			}
+			summary.Vaccinations = append(summary.Vaccinations, vaccination)
+		case model.MEDICAL_TREATMENT:
+			summary.TreatmentCount++
+		}
+	}
+	return summary
+}
+
+// parseIdParams converts the dog id and the record id from the path.
+func parseIdParams(dogIdParam, recordIdParam string) (int, int, error) {
+	dogId, err := strconv.Atoi(dogIdParam)
+	if err != nil {
+		return 0, 0, &customerrors.IntegerConversionError{}
+	}
+	recordId, err := strconv.Atoi(recordIdParam)
+	if err != nil {
+		return 0, 0, &customerrors.IntegerConversionError{}
+	}
+	return dogId, recordId, nil
+}
+
+// hasFullAccess allows admins and the members of the dog's shelter to read and manage the full
+// medical record.
+func hasFullAccess(credentials dto.UserCredentials, dog model.Dog) bool {
+	return credentials.UserRole == model.ADMIN || credentials.IsShelterMember(dog.ShelterId)
+}
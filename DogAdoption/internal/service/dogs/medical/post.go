@@ -0,0 +1,92 @@
+package dogmedicalservice
+
+import (
+	"1dv027/aad/internal/dto"
+	dogmedicaldto "1dv027/aad/internal/dto/dog/medical"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strconv"
+)
+
+type PostDogMedicalRecordRepository interface {
+	GetDogById(ctx context.Context, dogId int) (model.Dog, error)
+	CreateDogMedicalRecord(ctx context.Context, record model.DogMedicalRecord) (model.DogMedicalRecord, error)
+}
+
+type PostDogMedicalRecordService struct {
+	repo          PostDogMedicalRecordRepository
+	linkGenerator DogMedicalRecordLinkGenerator
+}
+
+func NewPostDogMedicalRecordService(repo PostDogMedicalRecordRepository, linkGenerator DogMedicalRecordLinkGenerator) PostDogMedicalRecordService {
+	return PostDogMedicalRecordService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+func (p PostDogMedicalRecordService) CreateMedicalRecord(ctx context.Context, dogIdParam string,
+	newRecord dogmedicaldto.NewDogMedicalRecordDTO, credentials dto.UserCredentials) (dogmedicaldto.DogMedicalRecordDTO, error) {
+	emptyDto := dogmedicaldto.DogMedicalRecordDTO{}
+	dogId, err := strconv.Atoi(dogIdParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+	dog, err := p.repo.GetDogById(ctx, dogId)
+	if err != nil {
+		return emptyDto, err
+	}
+	if !hasFullAccess(credentials, dog) {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	record := model.DogMedicalRecord{DogId: dogId}
+	err = applyRecordFields(&record, newRecord.RecordType, newRecord.Title, newRecord.RecordDate,
+		newRecord.NextDueOn, newRecord.Notes)
+	if err != nil {
+		return emptyDto, err
+	}
+	err = validateRecord(record, today())
+	if err != nil {
+		return emptyDto, err
+	}
+
+	createdRecord, err := p.repo.CreateDogMedicalRecord(ctx, record)
+	if err != nil {
+		return emptyDto, err
+	}
+	return toRecordDto(createdRecord, p.linkGenerator)
+}
+
+// applyRecordFields sets the provided fields on the record. An empty next due date removes it.
+func applyRecordFields(record *model.DogMedicalRecord, recordType, title, recordDate, nextDueOn, notes *string) error {
+	if recordType != nil {
+		record.RecordType = model.MedicalRecordType(*recordType)
+	}
+	if title != nil {
+		record.Title = *title
+	}
+	if recordDate != nil {
+		date, err := parseDate("record_date", *recordDate)
+		if err != nil {
+			return err
+		}
+		record.RecordDate = date
+	}
+	if nextDueOn != nil {
+		if *nextDueOn == "" {
+			record.NextDueOn = nil
+		} else {
+			date, err := parseDate("next_due_on", *nextDueOn)
+			if err != nil {
+				return err
+			}
+			record.NextDueOn = &date
+		}
+	}
+	if notes != nil {
+		record.Notes = *notes
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package dogmedicalservice
+
+import (
+	"1dv027/aad/internal/dto"
+	dogmedicaldto "1dv027/aad/internal/dto/dog/medical"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type PutDogMedicalRecordRepository interface {
+	GetDogById(ctx context.Context, dogId int) (model.Dog, error)
+	GetDogMedicalRecordById(ctx context.Context, dogId, recordId int) (model.DogMedicalRecord, error)
+	UpdateDogMedicalRecord(ctx context.Context, record model.DogMedicalRecord) (model.DogMedicalRecord, error)
+}
+
+type PutDogMedicalRecordService struct {
+	repo          PutDogMedicalRecordRepository
+	linkGenerator DogMedicalRecordLinkGenerator
+}
+
+func NewPutDogMedicalRecordService(repo PutDogMedicalRecordRepository, linkGenerator DogMedicalRecordLinkGenerator) PutDogMedicalRecordService {
+	return PutDogMedicalRecordService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+func (p PutDogMedicalRecordService) UpdateMedicalRecord(ctx context.Context, dogIdParam, recordIdParam string,
+	update dogmedicaldto.UpdateDogMedicalRecordDTO, credentials dto.UserCredentials) (dogmedicaldto.DogMedicalRecordDTO, error) {
+	emptyDto := dogmedicaldto.DogMedicalRecordDTO{}
+	dogId, recordId, err := parseIdParams(dogIdParam, recordIdParam)
+	if err != nil {
+		return emptyDto, err
+	}
+	dog, err := p.repo.GetDogById(ctx, dogId)
+	if err != nil {
+		return emptyDto, err
+	}
+	if !hasFullAccess(credentials, dog) {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+	record, err := p.repo.GetDogMedicalRecordById(ctx, dogId, recordId)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	err = applyRecordFields(&record, update.RecordType, update.Title, update.RecordDate, update.NextDueOn, update.Notes)
+	if err != nil {
+		return emptyDto, err
+	}
+	err = validateRecord(record, today())
+	if err != nil {
+		return emptyDto, err
+	}
+
+	updatedRecord, err := p.repo.UpdateDogMedicalRecord(ctx, record)
+	if err != nil {
+		return emptyDto, err
+	}
+	return toRecordDto(updatedRecord, p.linkGenerator)
+}
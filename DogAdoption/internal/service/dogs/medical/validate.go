@@ -0,0 +1,56 @@
+package dogmedicalservice
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// validateRecord checks a new or updated record. All problems are reported at once.
+func validateRecord(record model.DogMedicalRecord, today time.Time) error {
+	var errMsgs []string
+	if _, ok := model.StringToMedicalRecordType(string(record.RecordType)); !ok {
+		errMsgs = append(errMsgs, "record_type must be one of vaccination, treatment and note")
+	}
+	if strings.TrimSpace(record.Title) == "" {
+		errMsgs = append(errMsgs, "title cannot be empty")
+	} else if len(record.Title) > 100 {
+		errMsgs = append(errMsgs, "title can be at most 100 characters")
+	}
+	if record.RecordDate.IsZero() {
+		errMsgs = append(errMsgs, "record_date cannot be empty")
+	} else if record.RecordDate.After(today) {
+		errMsgs = append(errMsgs, "record_date cannot be in the future")
+	}
+	if record.NextDueOn != nil {
+		if record.RecordType != model.MEDICAL_VACCINATION {
+			errMsgs = append(errMsgs, "next_due_on is only accepted for vaccinations")
+		} else if record.NextDueOn.Before(record.RecordDate) {
+			errMsgs = append(errMsgs, "next_due_on cannot be before record_date")
+		}
+	}
+	if len(record.Notes) > 2000 {
+		errMsgs = append(errMsgs, "notes can be at most 2000 characters")
+	}
+	if len(errMsgs) > 0 {
+		return &customerrors.InvalidMedicalRecordDataError{Message: strings.Join(errMsgs, ", ")}
+	}
+	return nil
+}
+
+func parseDate(fieldName, value string) (time.Time, error) {
+	date, err := time.Parse(model.MEDICAL_DATE_LAYOUT, value)
+	if err != nil {
+		return time.Time{}, &customerrors.InvalidMedicalRecordDataError{
+			Message: fmt.Sprintf("%s must be a date formatted as YYYY-MM-DD", fieldName),
+		}
+	}
+	return date, nil
+}
+
+// today is the current date in UTC, which is how dates without a time are read from the database.
+func today() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,48 @@
+package dogphotoservice
+
+import (
+	"1dv027/aad/internal/blobstore"
+	"1dv027/aad/internal/dto"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type DeleteDogPhotoRepository interface {
+	GetDogById(ctx context.Context, dogId int) (model.Dog, error)
+	DeleteDogPhoto(ctx context.Context, dogId, photoId int) (model.DogPhoto, error)
+}
+
+type DeleteDogPhotoService struct {
+	repo      DeleteDogPhotoRepository
+	blobStore blobstore.BlobStore
+}
+
+func NewDeleteDogPhotoService(repo DeleteDogPhotoRepository, blobStore blobstore.BlobStore) DeleteDogPhotoService {
+	return DeleteDogPhotoService{
+		repo:      repo,
+		blobStore: blobStore,
+	}
+}
+
+// DeletePhoto removes the photo from the gallery before its files are deleted, so that a photo is
+// never listed without its files.
+func (d DeleteDogPhotoService) DeletePhoto(ctx context.Context, dogIdParam, photoIdParam string, credentials dto.UserCredentials) error {
+	dogId, photoId, err := parseIdParams(dogIdParam, photoIdParam)
+	if err != nil {
+		return err
+	}
+	dog, err := d.repo.GetDogById(ctx, dogId)
+	if err != nil {
+		return err
+	}
+	if !canManagePhotos(credentials, dog) {
+		return &customerrors.UnauthorizedError{}
+	}
+	photo, err := d.repo.DeleteDogPhoto(ctx, dogId, photoId)
+	if err != nil {
+		return err
+	}
+	deletePhotoFiles(ctx, d.blobStore, photo)
+	return nil
+}
@@ -0,0 +1,51 @@
+package dogphotoservice
+
+import (
+	"1dv027/aad/internal/blobstore"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+	"io"
+)
+
+type GetDogPhotoFileRepository interface {
+	GetDogPhotoById(ctx context.Context, dogId, photoId int) (model.DogPhoto, error)
+}
+
+type GetDogPhotoFileService struct {
+	repo      GetDogPhotoFileRepository
+	blobStore blobstore.BlobStore
+}
+
+func NewGetDogPhotoFileService(repo GetDogPhotoFileRepository, blobStore blobstore.BlobStore) GetDogPhotoFileService {
+	return GetDogPhotoFileService{
+		repo:      repo,
+		blobStore: blobStore,
+	}
+}
+
+// GetPhotoFile opens the original or one of the thumbnails of a photo. The caller must close the
+// returned reader.
+func (g GetDogPhotoFileService) GetPhotoFile(ctx context.Context, dogIdParam, photoIdParam, sizeParam string) (io.ReadCloser, string, error) {
+	dogId, photoId, err := parseIdParams(dogIdParam, photoIdParam)
+	if err != nil {
+		return nil, "", err
+	}
+	size, ok := model.StringToDogPhotoSize(sizeParam)
+	if !ok {
+		return nil, "", &customerrors.InvalidDogPhotoError{Message: "size must be one of original, large, medium and small"}
+	}
+	photo, err := g.repo.GetDogPhotoById(ctx, dogId, photoId)
+	if err != nil {
+		return nil, "", err
+	}
+	file, err := g.blobStore.Get(ctx, photo.BlobKey(size))
+	if errors.Is(err, blobstore.ErrBlobNotFound) {
+		return nil, "", &customerrors.DogPhotoNotFoundError{}
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return file, photo.ContentTypeOf(size), nil
+}
@@ -0,0 +1,64 @@
+package dogphotoservice
+
+import (
+	dogphotodto "1dv027/aad/internal/dto/dog/photo"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strconv"
+)
+
+type GetDogPhotosRepository interface {
+	GetDogById(ctx context.Context, dogId int) (model.Dog, error)
+	GetDogPhotos(ctx context.Context, dogId int) ([]model.DogPhoto, error)
+	GetDogPhotoById(ctx context.Context, dogId, photoId int) (model.DogPhoto, error)
+}
+
+type GetDogPhotosService struct {
+	repo          GetDogPhotosRepository
+	linkGenerator DogPhotoLinkGenerator
+}
+
+func NewGetDogPhotosService(repo GetDogPhotosRepository, linkGenerator DogPhotoLinkGenerator) GetDogPhotosService {
+	return GetDogPhotosService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// GetPhotos returns the gallery of the dog in display order.
+func (g GetDogPhotosService) GetPhotos(ctx context.Context, dogIdParam string) (dogphotodto.DogPhotoGalleryDTO, error) {
+	gallery := dogphotodto.DogPhotoGalleryDTO{PhotoData: []dogphotodto.DogPhotoDTO{}}
+	dogId, err := strconv.Atoi(dogIdParam)
+	if err != nil {
+		return gallery, &customerrors.IntegerConversionError{}
+	}
+	_, err = g.repo.GetDogById(ctx, dogId)
+	if err != nil {
+		return gallery, err
+	}
+	photos, err := g.repo.GetDogPhotos(ctx, dogId)
+	if err != nil {
+		return gallery, err
+	}
+	for _, photo := range photos {
+		photoDto, err := toPhotoDto(photo, g.linkGenerator)
+		if err != nil {
+			return gallery, err
+		}
+		gallery.PhotoData = append(gallery.PhotoData, photoDto)
+	}
+	return gallery, nil
+}
+
+func (g GetDogPhotosService) GetPhoto(ctx context.Context, dogIdParam, photoIdParam string) (dogphotodto.DogPhotoDTO, error) {
+	dogId, photoId, err := parseIdParams(dogIdParam, photoIdParam)
+	if err != nil {
+		return dogphotodto.DogPhotoDTO{}, err
+	}
+	photo, err := g.repo.GetDogPhotoById(ctx, dogId, photoId)
+	if err != nil {
+		return dogphotodto.DogPhotoDTO{}, err
+	}
+	return toPhotoDto(photo, g.linkGenerator)
+}
@@ -0,0 +1,60 @@
+package dogphotoservice
+
+import (
+	"1dv027/aad/internal/dto"
+	dogphotodto "1dv027/aad/internal/dto/dog/photo"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+type DogPhotoLinkGenerator interface {
+	GenerateDogLink(dogId string) string
+	GenerateDogPhotoLink(dogId, photoId string) string
+	GenerateDogPhotoFileLink(dogId, photoId, size string) string
+}
+
+func toPhotoDto(photo model.DogPhoto, linkGenerator DogPhotoLinkGenerator) (dogphotodto.DogPhotoDTO, error) {
+	var photoDto dogphotodto.DogPhotoDTO
+	photoJson, err := json.Marshal(photo.ToJson())
+	if err != nil {
+		return photoDto, err
+	}
+	err = json.Unmarshal(photoJson, &photoDto)
+	if err != nil {
+		return photoDto, err
+	}
+
+	dogId := fmt.Sprintf("%d", photo.DogId)
+	photoId := fmt.Sprintf("%d", photo.Id)
+	photoDto.Links = dogphotodto.DogPhotoDtoLinks{
+		SelfLink:     linkGenerator.GenerateDogPhotoLink(dogId, photoId),
+		DogLink:      linkGenerator.GenerateDogLink(dogId),
+		OriginalLink: linkGenerator.GenerateDogPhotoFileLink(dogId, photoId, string(model.PHOTO_ORIGINAL)),
+		LargeLink:    linkGenerator.GenerateDogPhotoFileLink(dogId, photoId, string(model.PHOTO_LARGE)),
+		MediumLink:   linkGenerator.GenerateDogPhotoFileLink(dogId, photoId, string(model.PHOTO_MEDIUM)),
+		SmallLink:    linkGenerator.GenerateDogPhotoFileLink(dogId, photoId, string(model.PHOTO_SMALL)),
+	}
+	return photoDto, nil
+}
+
+// parseIdParams converts the dog id and the photo id from the path.
+func parseIdParams(dogIdParam, photoIdParam string) (int, int, error) {
+	dogId, err := strconv.Atoi(dogIdParam)
+	if err != nil {
+		return 0, 0, &customerrors.IntegerConversionError{}
+	}
+	photoId, err := strconv.Atoi(photoIdParam)
+	if err != nil {
+		return 0, 0, &customerrors.IntegerConversionError{}
+	}
+	return dogId, photoId, nil
+}
+
+// canManagePhotos allows admins and every member of the dog's shelter to manage its gallery, in the
+// same way as they can update the dog.
+func canManagePhotos(credentials dto.UserCredentials, dog model.Dog) bool {
+	return credentials.UserRole == model.ADMIN || credentials.IsShelterMember(dog.ShelterId)
+}
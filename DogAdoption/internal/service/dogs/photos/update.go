@@ -0,0 +1,59 @@
+package dogphotoservice
+
+import (
+	"1dv027/aad/internal/dto"
+	dogphotodto "1dv027/aad/internal/dto/dog/photo"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type UpdateDogPhotoRepository interface {
+	GetDogById(ctx context.Context, dogId int) (model.Dog, error)
+	UpdateDogPhoto(ctx context.Context, dogId, photoId int, position *int, makePrimary bool) (model.DogPhoto, error)
+}
+
+type UpdateDogPhotoService struct {
+	repo          UpdateDogPhotoRepository
+	linkGenerator DogPhotoLinkGenerator
+}
+
+func NewUpdateDogPhotoService(repo UpdateDogPhotoRepository, linkGenerator DogPhotoLinkGenerator) UpdateDogPhotoService {
+	return UpdateDogPhotoService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// UpdatePhoto moves a photo within the gallery and/or makes it the primary photo of the dog.
+func (u UpdateDogPhotoService) UpdatePhoto(ctx context.Context, dogIdParam, photoIdParam string,
+	update dogphotodto.UpdateDogPhotoDTO, credentials dto.UserCredentials) (dogphotodto.DogPhotoDTO, error) {
+	emptyDto := dogphotodto.DogPhotoDTO{}
+	dogId, photoId, err := parseIdParams(dogIdParam, photoIdParam)
+	if err != nil {
+		return emptyDto, err
+	}
+	if update.Position == nil && update.IsPrimary == nil {
+		return emptyDto, &customerrors.InvalidDogPhotoError{Message: "position or is_primary must be provided"}
+	}
+	if update.Position != nil && *update.Position < 1 {
+		return emptyDto, &customerrors.InvalidDogPhotoError{Message: "position must be a positive integer"}
+	}
+	if update.IsPrimary != nil && !*update.IsPrimary {
+		return emptyDto, &customerrors.InvalidDogPhotoError{Message: "make another photo primary instead of unsetting is_primary"}
+	}
+
+	dog, err := u.repo.GetDogById(ctx, dogId)
+	if err != nil {
+		return emptyDto, err
+	}
+	if !canManagePhotos(credentials, dog) {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	photo, err := u.repo.UpdateDogPhoto(ctx, dogId, photoId, update.Position, update.IsPrimary != nil)
+	if err != nil {
+		return emptyDto, err
+	}
+	return toPhotoDto(photo, u.linkGenerator)
+}
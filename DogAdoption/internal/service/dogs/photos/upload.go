@@ -0,0 +1,170 @@
+package dogphotoservice
+
+import (
+	"1dv027/aad/internal/blobstore"
+	"1dv027/aad/internal/dto"
+	dogphotodto "1dv027/aad/internal/dto/dog/photo"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/imaging"
+	"1dv027/aad/internal/model"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"log"
+	"strconv"
+)
+
+type UploadDogPhotoRepository interface {
+	GetDogById(ctx context.Context, dogId int) (model.Dog, error)
+	GetDogPhotos(ctx context.Context, dogId int) ([]model.DogPhoto, error)
+	CreateDogPhoto(ctx context.Context, photo model.DogPhoto, makePrimary bool) (model.DogPhoto, error)
+}
+
+type UploadDogPhotoCryptographyService interface {
+	GenerateRandomToken(byteLength int) (string, error)
+}
+
+// PhotoUploadConfig limits uploads. Zero values use the defaults.
+type PhotoUploadConfig struct {
+	MaxUploadBytes  int
+	MaxPixels       int
+	MaxPhotosPerDog int
+}
+
+type UploadDogPhotoService struct {
+	repo          UploadDogPhotoRepository
+	blobStore     blobstore.BlobStore
+	cryptoService UploadDogPhotoCryptographyService
+	linkGenerator DogPhotoLinkGenerator
+	config        PhotoUploadConfig
+}
+
+func NewUploadDogPhotoService(repo UploadDogPhotoRepository, blobStore blobstore.BlobStore,
+	cryptoService UploadDogPhotoCryptographyService, linkGenerator DogPhotoLinkGenerator,
+	config PhotoUploadConfig) UploadDogPhotoService {
+	if config.MaxUploadBytes == 0 {
+		config.MaxUploadBytes = 8 * 1024 * 1024
+	}
+	if config.MaxPixels == 0 {
+		config.MaxPixels = 40_000_000
+	}
+	if config.MaxPhotosPerDog == 0 {
+		config.MaxPhotosPerDog = 20
+	}
+	return UploadDogPhotoService{
+		repo:          repo,
+		blobStore:     blobStore,
+		cryptoService: cryptoService,
+		linkGenerator: linkGenerator,
+		config:        config,
+	}
+}
+
+// UploadPhoto adds a photo last in the gallery of the dog. The content type is sniffed from the file,
+// and the original is stored together with a JPEG thumbnail in each of the standard sizes.
+func (u UploadDogPhotoService) UploadPhoto(ctx context.Context, dogIdParam string, photo io.Reader, makePrimary bool,
+	credentials dto.UserCredentials) (dogphotodto.DogPhotoDTO, error) {
+	emptyDto := dogphotodto.DogPhotoDTO{}
+	dogId, err := strconv.Atoi(dogIdParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+	dog, err := u.repo.GetDogById(ctx, dogId)
+	if err != nil {
+		return emptyDto, err
+	}
+	if !canManagePhotos(credentials, dog) {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+	gallery, err := u.repo.GetDogPhotos(ctx, dogId)
+	if err != nil {
+		return emptyDto, err
+	}
+	if len(gallery) >= u.config.MaxPhotosPerDog {
+		return emptyDto, &customerrors.InvalidDogPhotoError{Message: fmt.Sprintf("a dog can have at most %d photos", u.config.MaxPhotosPerDog)}
+	}
+
+	data, err := io.ReadAll(io.LimitReader(photo, int64(u.config.MaxUploadBytes)+1))
+	if err != nil {
+		return emptyDto, &customerrors.InvalidDogPhotoError{Message: "could not read the photo"}
+	}
+	if len(data) > u.config.MaxUploadBytes {
+		return emptyDto, &customerrors.DogPhotoTooLargeError{Message: fmt.Sprintf("photos can be at most %d bytes", u.config.MaxUploadBytes)}
+	}
+	contentType, err := imaging.SniffContentType(data)
+	if err != nil {
+		return emptyDto, &customerrors.InvalidDogPhotoError{Message: "only jpeg, png and gif photos are accepted"}
+	}
+	img, err := imaging.Decode(data, u.config.MaxPixels)
+	if errors.Is(err, imaging.ErrTooManyPixels) {
+		return emptyDto, &customerrors.DogPhotoTooLargeError{Message: fmt.Sprintf("photos can have at most %d pixels", u.config.MaxPixels)}
+	}
+	if err != nil {
+		return emptyDto, &customerrors.InvalidDogPhotoError{Message: "the photo could not be decoded"}
+	}
+
+	token, err := u.cryptoService.GenerateRandomToken(16)
+	if err != nil {
+		return emptyDto, &customerrors.CryptographyError{}
+	}
+	newPhoto := model.DogPhoto{
+		DogId:       dogId,
+		ContentType: contentType,
+		Width:       img.Bounds().Dx(),
+		Height:      img.Bounds().Dy(),
+		SizeBytes:   len(data),
+		StorageKey:  fmt.Sprintf("dogs/%d/%s", dogId, token),
+	}
+	err = u.storeFiles(ctx, newPhoto, data, img)
+	if err != nil {
+		u.deleteFiles(ctx, newPhoto)
+		return emptyDto, err
+	}
+
+	createdPhoto, err := u.repo.CreateDogPhoto(ctx, newPhoto, makePrimary)
+	if err != nil {
+		u.deleteFiles(ctx, newPhoto)
+		return emptyDto, err
+	}
+	return toPhotoDto(createdPhoto, u.linkGenerator)
+}
+
+func (u UploadDogPhotoService) storeFiles(ctx context.Context, photo model.DogPhoto, original []byte, img image.Image) error {
+	err := u.blobStore.Put(ctx, photo.BlobKey(model.PHOTO_ORIGINAL), bytes.NewReader(original))
+	if err != nil {
+		return fmt.Errorf("could not store photo: %w", err)
+	}
+	for size, maxSide := range model.DogPhotoThumbnailSizes {
+		thumbnail, err := imaging.EncodeJpeg(imaging.Fit(img, maxSide))
+		if err != nil {
+			return fmt.Errorf("could not encode %s thumbnail: %w", size, err)
+		}
+		err = u.blobStore.Put(ctx, photo.BlobKey(size), bytes.NewReader(thumbnail))
+		if err != nil {
+			return fmt.Errorf("could not store %s thumbnail: %w", size, err)
+		}
+	}
+	return nil
+}
+
+func (u UploadDogPhotoService) deleteFiles(ctx context.Context, photo model.DogPhoto) {
+	deletePhotoFiles(ctx, u.blobStore, photo)
+}
+
+// deletePhotoFiles removes the original and the thumbnails. Failures only leave unreferenced files
+// behind, so they are logged.
+func deletePhotoFiles(ctx context.Context, blobStore blobstore.BlobStore, photo model.DogPhoto) {
+	err := blobStore.Delete(ctx, photo.BlobKey(model.PHOTO_ORIGINAL))
+	if err != nil {
+		log.Printf("Failed to delete photo %s: %v", photo.StorageKey, err)
+	}
+	for size := range model.DogPhotoThumbnailSizes {
+		err = blobStore.Delete(ctx, photo.BlobKey(size))
+		if err != nil {
+			log.Printf("Failed to delete %s thumbnail of photo %s: %v", size, photo.StorageKey, err)
+		}
+	}
+}
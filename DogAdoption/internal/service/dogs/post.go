@@ -17,8 +17,7 @@ type PostDogsRepository interface {
 }
 
 type PostDogsLinkGenerator interface {
-	GenerateDogLink(dogId string) string
-	GenerateShelterLink(shelterId string) string
+	DogLinkGenerator
 	GeneratePaginationLinks(totalItems int, queryParams dto.QueryParams, apiPath string) dto.PaginationLinksDTO
 }
 
@@ -28,15 +27,20 @@ type NewDogWebhookDispatcher interface {
 
 type PostDogService struct {
 	repo              PostDogsRepository
+	breedResolver     DogBreedResolver
 	linkGenerator     PostDogsLinkGenerator
 	webhookDispatcher NewDogWebhookDispatcher
+	searchMatcher     DogSavedSearchMatcher
 }
 
-func NewPostDogService(repo PostDogsRepository, linkGenerator PostDogsLinkGenerator, webhookDispatcher NewDogWebhookDispatcher) PostDogService {
+func NewPostDogService(repo PostDogsRepository, breedResolver DogBreedResolver, linkGenerator PostDogsLinkGenerator,
+	webhookDispatcher NewDogWebhookDispatcher, searchMatcher DogSavedSearchMatcher) PostDogService {
 	return PostDogService{
 		repo:              repo,
+		breedResolver:     breedResolver,
 		linkGenerator:     linkGenerator,
 		webhookDispatcher: webhookDispatcher,
+		searchMatcher:     searchMatcher,
 	}
 }
 
@@ -45,7 +49,7 @@ func (p PostDogService) CreateDog(ctx context.Context,
 	emptyDto := dogdto.DogDTO{}
 	role := credentials.UserRole
 
-	if role != model.ADMIN && role != model.DOGSHELTER {
+	if role != model.ADMIN && role != model.DOGSHELTER && role != model.SHELTERSTAFF {
 		return emptyDto, &customerrors.UnauthorizedError{Message: "user role not recognized"}
 	}
 
@@ -53,9 +57,41 @@ func (p PostDogService) CreateDog(ctx context.Context,
 	if err != nil {
 		return emptyDto, err
 	}
+	newDog.Status, err = resolveStatus(newDog.Status, newDog.IsAdopted, nil)
+	if err != nil {
+		return emptyDto, err
+	}
+	primaryBreed, err := resolvePrimaryBreed(ctx, p.breedResolver, newDog.Breed, newDog.PrimaryBreedId)
+	if err != nil {
+		return emptyDto, err
+	}
+	newDog.Breed = &primaryBreed.Name
+	newDog.PrimaryBreedId = &primaryBreed.Id
+	err = validateSecondaryBreed(ctx, p.breedResolver, newDog.SecondaryBreedId, newDog.PrimaryBreedId)
+	if err != nil {
+		return emptyDto, err
+	}
+	if newDog.SecondaryBreedId != nil && *newDog.SecondaryBreedId == 0 {
+		newDog.SecondaryBreedId = nil
+	}
+	newDog.GoodWith, newDog.FriendlyWith = resolveCompatibility(model.UnknownDogCompatibility(), newDog.GoodWith, newDog.FriendlyWith)
+	if newDog.GoodWith == nil {
+		noFriendlyWith := ""
+		newDog.GoodWith = toUpdateGoodWithDto(model.UnknownDogCompatibility())
+		newDog.FriendlyWith = &noFriendlyWith
+	}
+	if newDog.Size == nil && primaryBreed.SizeGroup != nil {
+		breedSize := string(*primaryBreed.SizeGroup)
+		newDog.Size = &breedSize
+	}
+	// The image url is optional since photos can be uploaded to the gallery of the dog instead.
+	if newDog.ImageUrl == nil {
+		noImageUrl := ""
+		newDog.ImageUrl = &noImageUrl
+	}
 
-	if role == model.DOGSHELTER {
-		newDog.ShelterId = &credentials.Id
+	if role == model.DOGSHELTER || role == model.SHELTERSTAFF {
+		newDog.ShelterId = &credentials.ShelterId
 	}
 
 	dog, err := p.repo.CreateDog(ctx, newDog)
@@ -73,24 +109,19 @@ func (p PostDogService) CreateDog(ctx context.Context,
 	if err != nil {
 		return emptyDto, err
 	}
-	dogDto.Links = dogdto.DogLinksDTO{
-		ShelterLink: p.linkGenerator.GenerateShelterLink(fmt.Sprintf("%d", dogDto.ShelterId)),
-		SelfLink:    p.linkGenerator.GenerateDogLink(fmt.Sprintf("%d", dogDto.Id)),
-	}
+	dogDto.Links = generateDogLinks(dog, p.linkGenerator)
 
 	p.webhookDispatcher.DispatchNewDogWebhook(ctx, dogDto)
+	p.searchMatcher.MatchDog(ctx, dogDto)
 	return dogDto, nil
 }
 
 func (p PostDogService) validateFields(newDog dogdto.NewDogDTO) error {
 	var errMsgs []string
 	stringPointerFields := map[string]*string{
-		"name":          newDog.Name,
-		"description":   newDog.Description,
-		"breed":         newDog.Breed,
-		"image_url":     newDog.ImageUrl,
-		"friendly_with": newDog.FriendlyWith,
-		"gender":        newDog.Gender,
+		"name":        newDog.Name,
+		"description": newDog.Description,
+		"gender":      newDog.Gender,
 	}
 	for fieldName, fieldValue := range stringPointerFields {
 		if fieldValue == nil || *fieldValue == "" {
@@ -98,6 +129,10 @@ func (p PostDogService) validateFields(newDog dogdto.NewDogDTO) error {
 		}
 	}
 
+	if (newDog.Breed == nil || *newDog.Breed == "") && newDog.PrimaryBreedId == nil {
+		errMsgs = append(errMsgs, "breed or primary_breed_id cannot be empty")
+	}
+
 	intPointerFields := map[string]*int{
 		"shelter_id":   newDog.ShelterId,
 		"adoption_fee": newDog.AdoptionFee,
@@ -108,19 +143,14 @@ func (p PostDogService) validateFields(newDog dogdto.NewDogDTO) error {
 		}
 	}
 
-	boolPointerFields := map[string]*bool{
-		"is_neutered": newDog.IsNeutered,
-		"is_adopted":  newDog.IsAdopted,
-	}
-	for fieldName, fieldValue := range boolPointerFields {
-		if fieldValue == nil {
-			errMsgs = append(errMsgs, fmt.Sprintf("%s cannot be empty", fieldName))
-		}
+	if newDog.IsNeutered == nil {
+		errMsgs = append(errMsgs, "is_neutered cannot be empty")
 	}
 
 	if newDog.Gender != nil && *newDog.Gender != "male" && *newDog.Gender != "female" {
 		errMsgs = append(errMsgs, "gender must be either 'male' or 'female'")
 	}
+	errMsgs = append(errMsgs, validateCompatibilityFields(newDog.GoodWith, newDog.EnergyLevel, newDog.Size, false)...)
 
 	if newDog.BirthDate == nil {
 		errMsgs = append(errMsgs, "birth_date cannot be empty")
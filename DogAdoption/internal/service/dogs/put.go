@@ -7,8 +7,8 @@ import (
 	"1dv027/aad/internal/model"
 	"context"
 	"encoding/json"
-	"fmt"
 	"strconv"
+	"strings"
 )
 
 type PutDogsRepository interface {
@@ -17,20 +17,26 @@ type PutDogsRepository interface {
 }
 
 type PutDogsLinkGenerator interface {
-	GenerateDogLink(dogId string) string
-	GenerateShelterLink(shelterId string) string
+	DogLinkGenerator
 	GeneratePaginationLinks(totalItems int, queryParams dto.QueryParams, apiPath string) dto.PaginationLinksDTO
 }
 
 type PutDogService struct {
-	repo          PutDogsRepository
-	linkGenerator PutDogsLinkGenerator
+	repo           PutDogsRepository
+	breedResolver  DogBreedResolver
+	linkGenerator  PutDogsLinkGenerator
+	statusNotifier DogStatusChangeNotifier
+	searchMatcher  DogSavedSearchMatcher
 }
 
-func NewPutDogService(repo PutDogsRepository, linkGenerator PutDogsLinkGenerator) PutDogService {
+func NewPutDogService(repo PutDogsRepository, breedResolver DogBreedResolver, linkGenerator PutDogsLinkGenerator,
+	statusNotifier DogStatusChangeNotifier, searchMatcher DogSavedSearchMatcher) PutDogService {
 	return PutDogService{
-		repo:          repo,
-		linkGenerator: linkGenerator,
+		repo:           repo,
+		breedResolver:  breedResolver,
+		linkGenerator:  linkGenerator,
+		statusNotifier: statusNotifier,
+		searchMatcher:  searchMatcher,
 	}
 }
 
@@ -44,30 +50,42 @@ func (p PutDogService) UpdateDog(ctx context.Context,
 
 	role := credentials.UserRole
 
-	if role != model.ADMIN && role != model.DOGSHELTER {
+	if role != model.ADMIN && role != model.DOGSHELTER && role != model.SHELTERSTAFF {
 		return emptyDto, &customerrors.UnauthorizedError{}
 	}
 
-	if role == model.DOGSHELTER {
-		dog, err := p.repo.GetDogById(ctx, dogIdInt)
-		if err != nil {
-			return emptyDto, err
-		}
-		if dog.ShelterId != credentials.Id {
-			return emptyDto, &customerrors.UnauthorizedError{}
-		}
+	dog, err := p.repo.GetDogById(ctx, dogIdInt)
+	if err != nil {
+		return emptyDto, err
+	}
+	if role != model.ADMIN && !credentials.IsShelterMember(dog.ShelterId) {
+		return emptyDto, &customerrors.UnauthorizedError{}
 	}
 
 	err = p.validateGenderField(updatedDog)
 	if err != nil {
 		return emptyDto, err
 	}
+	errMsgs := validateCompatibilityFields(updatedDog.GoodWith, updatedDog.EnergyLevel, updatedDog.Size, true)
+	if len(errMsgs) > 0 {
+		return emptyDto, &customerrors.IncompleteDogDataError{Message: strings.Join(errMsgs, " + ")}
+	}
+	updatedDog.GoodWith, updatedDog.FriendlyWith = resolveCompatibility(dog.Compatibility, updatedDog.GoodWith, updatedDog.FriendlyWith)
+	err = p.resolveBreeds(ctx, &updatedDog, dog)
+	if err != nil {
+		return emptyDto, err
+	}
+	// The transition graph is enforced when the dog is updated, since the status may have changed since it was read.
+	updatedDog.Status, err = resolveStatus(updatedDog.Status, updatedDog.IsAdopted, &dog.Status)
+	if err != nil {
+		return emptyDto, err
+	}
 
 	dogModel, err := p.repo.UpdateDog(ctx, dogIdInt, updatedDog)
 	if err != nil {
 		return emptyDto, err
 	}
-	dogJson, err := json.Marshal(dogModel)
+	dogJson, err := json.Marshal(dogModel.ToJson())
 	if err != nil {
 		return emptyDto, err
 	}
@@ -77,14 +95,40 @@ func (p PutDogService) UpdateDog(ctx context.Context,
 	if err != nil {
 		return emptyDto, err
 	}
-	dogDto.Links = dogdto.DogLinksDTO{
-		ShelterLink: p.linkGenerator.GenerateShelterLink(fmt.Sprintf("%d", dogDto.ShelterId)),
-		SelfLink:    p.linkGenerator.GenerateDogLink(fmt.Sprintf("%d", dogDto.Id)),
+	dogDto.Links = generateDogLinks(dogModel, p.linkGenerator)
+	// The favorited count is added after notifying, since the users that follow the dog must not see it.
+	if dogModel.Status != dog.Status {
+		p.statusNotifier.NotifyDogStatusChanged(ctx, dogDto, dog.Status)
 	}
+	if changesSearchMatches(dog, dogModel) {
+		p.searchMatcher.MatchDog(ctx, dogDto)
+	}
+	showFavoritedCount(&dogDto, dogModel, credentials)
 
 	return dogDto, nil
 }
 
+// changesSearchMatches reports if the update changed a field that saved searches filter on, since only then
+// the dog can match searches it did not match before.
+func changesSearchMatches(dog model.Dog, updatedDog model.Dog) bool {
+	return dog.Gender != updatedDog.Gender ||
+		dog.Status != updatedDog.Status ||
+		dog.IsNeutered != updatedDog.IsNeutered ||
+		dog.ShelterId != updatedDog.ShelterId ||
+		dog.Compatibility != updatedDog.Compatibility ||
+		!equalValues(dog.PrimaryBreedId, updatedDog.PrimaryBreedId) ||
+		!equalValues(dog.SecondaryBreedId, updatedDog.SecondaryBreedId) ||
+		!equalValues(dog.EnergyLevel, updatedDog.EnergyLevel) ||
+		!equalValues(dog.Size, updatedDog.Size)
+}
+
+func equalValues[T comparable](a *T, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
 func (p PutDogService) validateGenderField(updatedDog dogdto.UpdateDogDTO) error {
 	if updatedDog.Gender != nil {
 		if *updatedDog.Gender != "male" && *updatedDog.Gender != "female" {
@@ -94,3 +138,23 @@ func (p PutDogService) validateGenderField(updatedDog dogdto.UpdateDogDTO) error
 	}
 	return nil
 }
+
+// resolveBreeds links a changed breed to the breed taxonomy, and checks the secondary breed against the
+// primary breed the dog ends up with.
+func (p PutDogService) resolveBreeds(ctx context.Context, updatedDog *dogdto.UpdateDogDTO, dog model.Dog) error {
+	primaryBreed, err := resolvePrimaryBreed(ctx, p.breedResolver, updatedDog.Breed, updatedDog.PrimaryBreedId)
+	if err != nil {
+		return err
+	}
+	primaryBreedId := dog.PrimaryBreedId
+	if primaryBreed != nil {
+		updatedDog.Breed = &primaryBreed.Name
+		updatedDog.PrimaryBreedId = &primaryBreed.Id
+		primaryBreedId = &primaryBreed.Id
+	}
+	secondaryBreedId := updatedDog.SecondaryBreedId
+	if secondaryBreedId == nil {
+		secondaryBreedId = dog.SecondaryBreedId
+	}
+	return validateSecondaryBreed(ctx, p.breedResolver, secondaryBreedId, primaryBreedId)
+}
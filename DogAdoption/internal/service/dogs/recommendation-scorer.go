@@ -0,0 +1,347 @@
+package dogsservice
+
+import (
+	"1dv027/aad/internal/model"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	CRITERION_CHILDREN   = "children"
+	CRITERION_OTHER_PETS = "other_pets"
+	CRITERION_SIZE       = "size"
+	CRITERION_ENERGY     = "energy"
+	CRITERION_DISTANCE   = "distance"
+	CRITERION_FEE        = "fee"
+	CRITERION_BREED      = "breed"
+	CRITERION_GENDER     = "gender"
+	CRITERION_AGE        = "age"
+)
+
+// CriterionScore is how well a dog matches one criterion, from 0 to 1. A criterion is not applicable when
+// the adopter has not answered it, or when nothing is known to score it by.
+type CriterionScore struct {
+	Criterion   string
+	Applicable  bool
+	Score       float64
+	Weight      float64
+	Explanation string
+}
+
+// RecommendationScore is the total match of a dog from 0 to 100, and the scores of the criteria behind it.
+type RecommendationScore struct {
+	Total    int
+	Criteria []CriterionScore
+}
+
+// DogRecommendationScorer is the strategy that scores how well a dog matches the household and preferences
+// of an adopter. The distance of the dog is set when the adopter could be located.
+type DogRecommendationScorer interface {
+	Score(dog model.Dog, adopter model.User) RecommendationScore
+}
+
+// RecommendationWeights are the relative weights of the criteria of the WeightedRecommendationScorer.
+type RecommendationWeights struct {
+	Children  float64
+	OtherPets float64
+	Size      float64
+	Energy    float64
+	Distance  float64
+	Fee       float64
+	Breed     float64
+	Gender    float64
+	Age       float64
+	// DefaultMaxDistanceKm is the distance that scores fully for adopters without a max_distance_km preference.
+	DefaultMaxDistanceKm float64
+}
+
+// DefaultRecommendationWeights weighs the household most, since a dog that is not good with children or
+// other pets can not be placed there, and the wishes of the adopter after it.
+func DefaultRecommendationWeights() RecommendationWeights {
+	return RecommendationWeights{
+		Children:             3,
+		OtherPets:            3,
+		Size:                 2,
+		Energy:               2,
+		Distance:             1.5,
+		Fee:                  1.5,
+		Breed:                1,
+		Gender:               0.5,
+		Age:                  1,
+		DefaultMaxDistanceKm: 50,
+	}
+}
+
+// WeightedRecommendationScorer scores each criterion from 0 to 1, and the total as the weighted average of
+// the applicable criteria. A dog that has not been assessed for a criterion scores half.
+type WeightedRecommendationScorer struct {
+	weights RecommendationWeights
+}
+
+func NewWeightedRecommendationScorer(weights RecommendationWeights) WeightedRecommendationScorer {
+	return WeightedRecommendationScorer{
+		weights: weights,
+	}
+}
+
+func (w WeightedRecommendationScorer) Score(dog model.Dog, adopter model.User) RecommendationScore {
+	criteria := []CriterionScore{
+		w.scoreChildren(dog, adopter.Household),
+		w.scoreOtherPets(dog, adopter.Household),
+		w.scoreSize(dog, adopter.Preferences),
+		w.scoreEnergy(dog, adopter.Preferences),
+		w.scoreDistance(dog, adopter.Preferences),
+		w.scoreFee(dog, adopter.Preferences),
+		w.scoreBreed(dog, adopter.Preferences),
+		w.scoreGender(dog, adopter.Preferences),
+		w.scoreAge(dog, adopter.Preferences),
+	}
+
+	var weightedScore, totalWeight float64
+	for i, criterion := range criteria {
+		criteria[i].Score = math.Round(criterion.Score*100) / 100
+		if criterion.Applicable {
+			weightedScore += criterion.Score * criterion.Weight
+			totalWeight += criterion.Weight
+		}
+	}
+	total := 0
+	if totalWeight > 0 {
+		total = int(math.Round(weightedScore / totalWeight * 100))
+	}
+	return RecommendationScore{
+		Total:    total,
+		Criteria: criteria,
+	}
+}
+
+func (w WeightedRecommendationScorer) scoreChildren(dog model.Dog, household model.UserHousehold) CriterionScore {
+	score := CriterionScore{Criterion: CRITERION_CHILDREN, Weight: w.weights.Children}
+	if household.HasChildren == nil {
+		score.Explanation = "The household has not said whether there are children"
+		return score
+	}
+	if !*household.HasChildren {
+		score.Explanation = "There are no children in the household"
+		return score
+	}
+	score.Applicable = true
+	score.Score = compatibilityScore(dog.Compatibility.Children)
+	score.Explanation = fmt.Sprintf("There are children in the household, and the dog is %s with children",
+		describeCompatibility(dog.Compatibility.Children))
+	return score
+}
+
+// scoreOtherPets scores the dog by how it gets along with dogs and cats, the most common pets, since the
+// household does not say which pets it has.
+func (w WeightedRecommendationScorer) scoreOtherPets(dog model.Dog, household model.UserHousehold) CriterionScore {
+	score := CriterionScore{Criterion: CRITERION_OTHER_PETS, Weight: w.weights.OtherPets}
+	if household.HasOtherPets == nil {
+		score.Explanation = "The household has not said whether there are other pets"
+		return score
+	}
+	if !*household.HasOtherPets {
+		score.Explanation = "There are no other pets in the household"
+		return score
+	}
+	score.Applicable = true
+	score.Score = math.Min(compatibilityScore(dog.Compatibility.Dogs), compatibilityScore(dog.Compatibility.Cats))
+	score.Explanation = fmt.Sprintf("There are other pets in the household, and the dog is %s with dogs and %s with cats",
+		describeCompatibility(dog.Compatibility.Dogs), describeCompatibility(dog.Compatibility.Cats))
+	return score
+}
+
+func (w WeightedRecommendationScorer) scoreSize(dog model.Dog, preferences model.UserPreferences) CriterionScore {
+	score := CriterionScore{Criterion: CRITERION_SIZE, Weight: w.weights.Size}
+	if len(preferences.Sizes) == 0 {
+		score.Explanation = "No preferred sizes"
+		return score
+	}
+	score.Applicable = true
+	if dog.Size == nil {
+		score.Score = 0.5
+		score.Explanation = "The size of the dog is unknown"
+		return score
+	}
+	sizes := []string{
+		string(model.BREED_SIZE_TOY),
+		string(model.BREED_SIZE_SMALL),
+		string(model.BREED_SIZE_MEDIUM),
+		string(model.BREED_SIZE_LARGE),
+		string(model.BREED_SIZE_GIANT),
+	}
+	score.Score = orderedPreferenceScore(sizes, string(*dog.Size), preferences.Sizes)
+	score.Explanation = fmt.Sprintf("The dog is %s, and the preferred sizes are %s", *dog.Size, strings.Join(preferences.Sizes, ", "))
+	return score
+}
+
+func (w WeightedRecommendationScorer) scoreEnergy(dog model.Dog, preferences model.UserPreferences) CriterionScore {
+	score := CriterionScore{Criterion: CRITERION_ENERGY, Weight: w.weights.Energy}
+	if len(preferences.EnergyLevels) == 0 {
+		score.Explanation = "No preferred energy levels"
+		return score
+	}
+	score.Applicable = true
+	if dog.EnergyLevel == nil {
+		score.Score = 0.5
+		score.Explanation = "The energy level of the dog is unknown"
+		return score
+	}
+	energyLevels := []string{string(model.ENERGY_LOW), string(model.ENERGY_MEDIUM), string(model.ENERGY_HIGH)}
+	score.Score = orderedPreferenceScore(energyLevels, string(*dog.EnergyLevel), preferences.EnergyLevels)
+	score.Explanation = fmt.Sprintf("The dog has %s energy, and the preferred energy levels are %s",
+		*dog.EnergyLevel, strings.Join(preferences.EnergyLevels, ", "))
+	return score
+}
+
+// scoreDistance scores shelters within the max distance fully, and further shelters less the further away they
+// are, down to 0 at twice the max distance.
+func (w WeightedRecommendationScorer) scoreDistance(dog model.Dog, preferences model.UserPreferences) CriterionScore {
+	score := CriterionScore{Criterion: CRITERION_DISTANCE, Weight: w.weights.Distance}
+	if dog.DistanceKm == nil {
+		score.Explanation = "The distance to the shelter is unknown"
+		return score
+	}
+	maxDistanceKm := w.weights.DefaultMaxDistanceKm
+	if preferences.MaxDistanceKm != nil {
+		maxDistanceKm = *preferences.MaxDistanceKm
+	}
+	score.Applicable = true
+	distanceKm := *dog.DistanceKm
+	if distanceKm <= maxDistanceKm {
+		score.Score = 1
+		score.Explanation = fmt.Sprintf("The shelter is %.0f km away, within %.0f km", distanceKm, maxDistanceKm)
+		return score
+	}
+	score.Score = math.Max(0, 1-(distanceKm-maxDistanceKm)/maxDistanceKm)
+	score.Explanation = fmt.Sprintf("The shelter is %.0f km away, further than %.0f km", distanceKm, maxDistanceKm)
+	return score
+}
+
+// scoreFee scores fees within the budget fully, and higher fees less the more they exceed it, down to 0 at
+// twice the budget.
+func (w WeightedRecommendationScorer) scoreFee(dog model.Dog, preferences model.UserPreferences) CriterionScore {
+	score := CriterionScore{Criterion: CRITERION_FEE, Weight: w.weights.Fee}
+	if preferences.MaxAdoptionFee == nil {
+		score.Explanation = "No adoption fee budget"
+		return score
+	}
+	score.Applicable = true
+	budget := *preferences.MaxAdoptionFee
+	if dog.AdoptionFee <= budget {
+		score.Score = 1
+		score.Explanation = fmt.Sprintf("The adoption fee of %d is within the budget of %d", dog.AdoptionFee, budget)
+		return score
+	}
+	if budget > 0 {
+		score.Score = math.Max(0, 1-float64(dog.AdoptionFee-budget)/float64(budget))
+	}
+	score.Explanation = fmt.Sprintf("The adoption fee of %d exceeds the budget of %d", dog.AdoptionFee, budget)
+	return score
+}
+
+func (w WeightedRecommendationScorer) scoreBreed(dog model.Dog, preferences model.UserPreferences) CriterionScore {
+	score := CriterionScore{Criterion: CRITERION_BREED, Weight: w.weights.Breed}
+	if len(preferences.Breeds) == 0 {
+		score.Explanation = "No preferred breeds"
+		return score
+	}
+	score.Applicable = true
+	for _, breed := range preferences.Breeds {
+		if strings.EqualFold(strings.TrimSpace(breed), dog.Breed) {
+			score.Score = 1
+			score.Explanation = fmt.Sprintf("%s is one of the preferred breeds", dog.Breed)
+			return score
+		}
+	}
+	score.Explanation = fmt.Sprintf("%s is not one of the preferred breeds", dog.Breed)
+	return score
+}
+
+func (w WeightedRecommendationScorer) scoreGender(dog model.Dog, preferences model.UserPreferences) CriterionScore {
+	score := CriterionScore{Criterion: CRITERION_GENDER, Weight: w.weights.Gender}
+	if preferences.Gender == nil {
+		score.Explanation = "No preferred gender"
+		return score
+	}
+	score.Applicable = true
+	if dog.Gender == *preferences.Gender {
+		score.Score = 1
+		score.Explanation = fmt.Sprintf("The dog is %s as preferred", dog.Gender)
+		return score
+	}
+	score.Explanation = fmt.Sprintf("The dog is %s, and %s is preferred", dog.Gender, *preferences.Gender)
+	return score
+}
+
+// scoreAge scores ages within the preferred range fully, and half for each year outside of it.
+func (w WeightedRecommendationScorer) scoreAge(dog model.Dog, preferences model.UserPreferences) CriterionScore {
+	score := CriterionScore{Criterion: CRITERION_AGE, Weight: w.weights.Age}
+	if preferences.MinAgeYears == nil && preferences.MaxAgeYears == nil {
+		score.Explanation = "No preferred age"
+		return score
+	}
+	score.Applicable = true
+	ageYears := ageInYears(dog.BirthDate, time.Now())
+	yearsOutside := 0
+	if preferences.MinAgeYears != nil && ageYears < *preferences.MinAgeYears {
+		yearsOutside = *preferences.MinAgeYears - ageYears
+	}
+	if preferences.MaxAgeYears != nil && ageYears > *preferences.MaxAgeYears {
+		yearsOutside = ageYears - *preferences.MaxAgeYears
+	}
+	score.Score = math.Max(0, 1-0.5*float64(yearsOutside))
+	if yearsOutside == 0 {
+		score.Explanation = fmt.Sprintf("The dog is %d years old, within the preferred age", ageYears)
+	} else {
+		score.Explanation = fmt.Sprintf("The dog is %d years old, %d years outside of the preferred age", ageYears, yearsOutside)
+	}
+	return score
+}
+
+func compatibilityScore(compatibility model.Compatibility) float64 {
+	switch compatibility {
+	case model.COMPATIBILITY_YES:
+		return 1
+	case model.COMPATIBILITY_NO:
+		return 0
+	}
+	return 0.5
+}
+
+func describeCompatibility(compatibility model.Compatibility) string {
+	switch compatibility {
+	case model.COMPATIBILITY_YES:
+		return "good"
+	case model.COMPATIBILITY_NO:
+		return "not good"
+	}
+	return "not assessed"
+}
+
+// orderedPreferenceScore scores a value of an ordered scale fully when it is preferred, half when it is next to
+// a preferred value, and 0 otherwise.
+func orderedPreferenceScore(scale []string, value string, preferred []string) float64 {
+	if slices.Contains(preferred, value) {
+		return 1
+	}
+	index := slices.Index(scale, value)
+	for _, preferredValue := range preferred {
+		preferredIndex := slices.Index(scale, preferredValue)
+		if index >= 0 && preferredIndex >= 0 && (index-preferredIndex == 1 || preferredIndex-index == 1) {
+			return 0.5
+		}
+	}
+	return 0
+}
+
+func ageInYears(birthDate time.Time, now time.Time) int {
+	years := now.Year() - birthDate.Year()
+	if now.YearDay() < birthDate.YearDay() {
+		years--
+	}
+	return max(years, 0)
+}
@@ -0,0 +1,161 @@
+package dogsservice
+
+import (
+	dto "1dv027/aad/internal/dto"
+	dogdto "1dv027/aad/internal/dto/dog"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/geocoder"
+	"1dv027/aad/internal/model"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"slices"
+)
+
+type RecommendDogsRepository interface {
+	GetDogs(ctx context.Context, params dto.QueryParams) (dogdto.GetDogsQueryResponseDTO, error)
+}
+
+type RecommendDogsUserRepository interface {
+	GetAuthenticatedUser(ctx context.Context, user dto.UserCredentials) (model.User, error)
+}
+
+type RecommendDogsGeocoder interface {
+	Geocode(ctx context.Context, query geocoder.Query) (geocoder.Result, error)
+}
+
+type RecommendDogsService struct {
+	repo          RecommendDogsRepository
+	userRepo      RecommendDogsUserRepository
+	geocoder      RecommendDogsGeocoder
+	scorer        DogRecommendationScorer
+	linkGenerator GetDogsLinkGenerator
+}
+
+func NewRecommendDogsService(repo RecommendDogsRepository, userRepo RecommendDogsUserRepository, geocoder RecommendDogsGeocoder,
+	scorer DogRecommendationScorer, linkGenerator GetDogsLinkGenerator) RecommendDogsService {
+	return RecommendDogsService{
+		repo:          repo,
+		userRepo:      userRepo,
+		geocoder:      geocoder,
+		scorer:        scorer,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// RecommendDogs scores the available dogs against the household and preferences of the authenticated user, and
+// returns a page of them with the best matches first. The distance is measured from the near param, or else from
+// the city of the user. All available dogs are scored, since the score can not be computed in the database.
+func (r RecommendDogsService) RecommendDogs(ctx context.Context, user dto.UserCredentials,
+	queryParams dto.QueryParams) (dogdto.DogRecommendationsAndPaginationLinksDTO, error) {
+	emptyDto := dogdto.DogRecommendationsAndPaginationLinksDTO{}
+	if user.UserRole != model.USER {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+	adopter, err := r.userRepo.GetAuthenticatedUser(ctx, user)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	candidateParams := dto.QueryParams{
+		DogsFilter: &dto.DogsFilterParams{Statuses: []string{string(model.DOG_AVAILABLE)}},
+		GeoFilter:  queryParams.GeoFilter,
+	}
+	if candidateParams.GeoFilter == nil {
+		candidateParams.GeoFilter = r.locateAdopter(ctx, adopter)
+	}
+	dogsResult, err := r.repo.GetDogs(ctx, candidateParams)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	type scoredDog struct {
+		dog   model.Dog
+		score RecommendationScore
+	}
+	scoredDogs := make([]scoredDog, 0, len(dogsResult.Dogs))
+	for _, dog := range dogsResult.Dogs {
+		scoredDogs = append(scoredDogs, scoredDog{dog: dog, score: r.scorer.Score(dog, adopter)})
+	}
+	// Equal scores are ordered by distance, and dogs with an unknown distance come last.
+	slices.SortStableFunc(scoredDogs, func(a, b scoredDog) int {
+		if a.score.Total != b.score.Total {
+			return cmp.Compare(b.score.Total, a.score.Total)
+		}
+		if (a.dog.DistanceKm == nil) != (b.dog.DistanceKm == nil) {
+			if a.dog.DistanceKm == nil {
+				return 1
+			}
+			return -1
+		}
+		if a.dog.DistanceKm != nil && *a.dog.DistanceKm != *b.dog.DistanceKm {
+			return cmp.Compare(*a.dog.DistanceKm, *b.dog.DistanceKm)
+		}
+		return cmp.Compare(a.dog.Id, b.dog.Id)
+	})
+
+	limit := *queryParams.Pagination.Limit
+	start := min(max(*queryParams.Pagination.Page-1, 0)*limit, len(scoredDogs))
+	end := min(start+limit, len(scoredDogs))
+
+	recommendations := []dogdto.DogRecommendationDTO{}
+	for _, scored := range scoredDogs[start:end] {
+		dogJson, err := json.Marshal(scored.dog.ToJson())
+		if err != nil {
+			return emptyDto, err
+		}
+		var dogDto dogdto.DogDTO
+		err = json.Unmarshal(dogJson, &dogDto)
+		if err != nil {
+			return emptyDto, err
+		}
+		dogDto.Links = generateDogLinks(scored.dog, r.linkGenerator)
+
+		criteria := make([]dogdto.RecommendationCriterionScoreDTO, 0, len(scored.score.Criteria))
+		for _, criterion := range scored.score.Criteria {
+			criteria = append(criteria, dogdto.RecommendationCriterionScoreDTO{
+				Criterion:   criterion.Criterion,
+				Applicable:  criterion.Applicable,
+				Score:       criterion.Score,
+				Weight:      criterion.Weight,
+				Explanation: criterion.Explanation,
+			})
+		}
+		recommendations = append(recommendations, dogdto.DogRecommendationDTO{
+			Dog:      dogDto,
+			Score:    scored.score.Total,
+			Criteria: criteria,
+		})
+	}
+
+	paginationLinks := r.linkGenerator.GeneratePaginationLinks(len(scoredDogs), queryParams, "/users/me/recommendations")
+	return dogdto.DogRecommendationsAndPaginationLinksDTO{
+		Recommendations: recommendations,
+		PaginationLinks: paginationLinks,
+	}, nil
+}
+
+// locateAdopter geocodes the city of the adopter. Adopters that can not be located are recommended dogs
+// without scoring the distance.
+func (r RecommendDogsService) locateAdopter(ctx context.Context, adopter model.User) *dto.GeoFilterParams {
+	if adopter.City == nil {
+		return nil
+	}
+	query := geocoder.Query{City: *adopter.City}
+	if adopter.Country != nil {
+		query.Country = *adopter.Country
+	}
+	result, err := r.geocoder.Geocode(ctx, query)
+	if err != nil {
+		if !errors.Is(err, geocoder.ErrNoMatch) {
+			log.Printf("Failed to geocode the city of user %d: %v", adopter.Id, err)
+		}
+		return nil
+	}
+	return &dto.GeoFilterParams{
+		Latitude:  result.Latitude,
+		Longitude: result.Longitude,
+	}
+}
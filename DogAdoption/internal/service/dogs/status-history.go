@@ -0,0 +1,78 @@
+package dogsservice
+
+import (
+	dogdto "1dv027/aad/internal/dto/dog"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+type GetDogStatusHistoryRepository interface {
+	GetDogById(ctx context.Context, dogId int) (model.Dog, error)
+	GetDogStatusHistory(ctx context.Context, dogId int) ([]model.DogStatusChange, error)
+}
+
+type GetDogStatusHistoryLinkGenerator interface {
+	GenerateDogLink(dogId string) string
+	GenerateDogStatusHistoryLink(dogId string) string
+}
+
+type GetDogStatusHistoryService struct {
+	repo          GetDogStatusHistoryRepository
+	linkGenerator GetDogStatusHistoryLinkGenerator
+}
+
+func NewGetDogStatusHistoryService(repo GetDogStatusHistoryRepository,
+	linkGenerator GetDogStatusHistoryLinkGenerator) GetDogStatusHistoryService {
+	return GetDogStatusHistoryService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// GetStatusHistory returns the current status of the dog, the statuses it can move to and the
+// status changes so far, oldest first.
+func (g GetDogStatusHistoryService) GetStatusHistory(ctx context.Context, dogId string) (dogdto.DogStatusHistoryDTO, error) {
+	emptyDto := dogdto.DogStatusHistoryDTO{}
+	dogIdInt, err := strconv.Atoi(dogId)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+	dog, err := g.repo.GetDogById(ctx, dogIdInt)
+	if err != nil {
+		return emptyDto, err
+	}
+	history, err := g.repo.GetDogStatusHistory(ctx, dogIdInt)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	changeDtos := []dogdto.DogStatusChangeDTO{}
+	for _, change := range history {
+		changeJson, err := json.Marshal(change.ToJson())
+		if err != nil {
+			return emptyDto, err
+		}
+		var changeDto dogdto.DogStatusChangeDTO
+		err = json.Unmarshal(changeJson, &changeDto)
+		if err != nil {
+			return emptyDto, err
+		}
+		changeDtos = append(changeDtos, changeDto)
+	}
+	allowedTransitions := []string{}
+	for _, status := range dog.Status.AllowedTransitions() {
+		allowedTransitions = append(allowedTransitions, string(status))
+	}
+	return dogdto.DogStatusHistoryDTO{
+		Status:             string(dog.Status),
+		AllowedTransitions: allowedTransitions,
+		StatusHistory:      changeDtos,
+		Links: dogdto.DogStatusHistoryLinksDTO{
+			SelfLink: g.linkGenerator.GenerateDogStatusHistoryLink(dogId),
+			DogLink:  g.linkGenerator.GenerateDogLink(dogId),
+		},
+	}, nil
+}
@@ -0,0 +1,47 @@
+package dogsservice
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"fmt"
+	"strings"
+)
+
+// resolveStatus returns the status to save from the status and the is_adopted field, which is kept
+// for clients written before the status lifecycle. currentStatus is nil for new dogs, which are
+// available by default. Setting is_adopted to false returns an adopted dog and leaves others as they are.
+func resolveStatus(status *string, isAdopted *bool, currentStatus *model.DogStatus) (*string, error) {
+	if status != nil {
+		dogStatus, ok := model.StringToDogStatus(*status)
+		if !ok {
+			validStatuses := []string{}
+			for _, validStatus := range model.DogStatuses {
+				validStatuses = append(validStatuses, string(validStatus))
+			}
+			return nil, &customerrors.IncompleteDogDataError{
+				Message: fmt.Sprintf("invalid status. valid statuses are %s", strings.Join(validStatuses, ", ")),
+			}
+		}
+		if isAdopted != nil && *isAdopted != (dogStatus == model.DOG_ADOPTED) {
+			return nil, &customerrors.IncompleteDogDataError{Message: "is_adopted does not match status"}
+		}
+		return status, nil
+	}
+
+	resolved := model.DOG_AVAILABLE
+	if currentStatus != nil {
+		resolved = *currentStatus
+	}
+	if isAdopted != nil {
+		if *isAdopted {
+			resolved = model.DOG_ADOPTED
+		} else if resolved == model.DOG_ADOPTED {
+			resolved = model.DOG_RETURNED
+		}
+	}
+	if currentStatus != nil && resolved == *currentStatus {
+		return nil, nil
+	}
+	resolvedString := string(resolved)
+	return &resolvedString, nil
+}
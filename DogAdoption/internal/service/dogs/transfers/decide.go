@@ -0,0 +1,166 @@
+package dogtransferservice
+
+import (
+	"1dv027/aad/internal/dto"
+	dogdto "1dv027/aad/internal/dto/dog"
+	dogtransferdto "1dv027/aad/internal/dto/dog/transfer"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+)
+
+type DecideDogTransferRepository interface {
+	GetDogById(ctx context.Context, dogId int) (model.Dog, error)
+	GetDogTransferById(ctx context.Context, dogId, transferId int) (model.DogTransfer, error)
+	AcceptDogTransfer(ctx context.Context, dogId, transferId int) (model.DogTransfer, error)
+	CloseDogTransfer(ctx context.Context, dogId, transferId int, status model.DogTransferStatus, reason *string) (model.DogTransfer, error)
+}
+
+type DogTransferredWebhookDispatcher interface {
+	DispatchDogTransferredWebhook(ctx context.Context, transferData dogtransferdto.DogTransferDTO, dogData dogdto.DogDTO)
+}
+
+type DecideDogTransferLinkGenerator interface {
+	DogTransferLinkGenerator
+	GenerateDogTransfersLink(dogId string) string
+	GenerateDogStatusHistoryLink(dogId string) string
+	GenerateDogPhotosLink(dogId string) string
+	GenerateDogMedicalRecordsLink(dogId string) string
+	GenerateDogPhotoFileLink(dogId, photoId, size string) string
+	GenerateBreedLink(breedId string) string
+}
+
+type DecideDogTransferService struct {
+	repo              DecideDogTransferRepository
+	linkGenerator     DecideDogTransferLinkGenerator
+	webhookDispatcher DogTransferredWebhookDispatcher
+}
+
+func NewDecideDogTransferService(repo DecideDogTransferRepository, linkGenerator DecideDogTransferLinkGenerator,
+	webhookDispatcher DogTransferredWebhookDispatcher) DecideDogTransferService {
+	return DecideDogTransferService{
+		repo:              repo,
+		linkGenerator:     linkGenerator,
+		webhookDispatcher: webhookDispatcher,
+	}
+}
+
+// AcceptTransfer is used by the destination shelter. The dog moves to the destination in the same
+// transaction, and subscribers to the dog_transferred webhook are notified.
+func (d DecideDogTransferService) AcceptTransfer(ctx context.Context, dogIdParam, transferIdParam string,
+	credentials dto.UserCredentials) (dogtransferdto.DogTransferDTO, error) {
+	emptyDto := dogtransferdto.DogTransferDTO{}
+	dogId, transfer, err := d.getTransfer(ctx, dogIdParam, transferIdParam)
+	if err != nil {
+		return emptyDto, err
+	}
+	if !canDecide(credentials, transfer.ToShelterId) {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	transfer, err = d.repo.AcceptDogTransfer(ctx, dogId, transfer.Id)
+	if err != nil {
+		return emptyDto, err
+	}
+	transferDto, err := toTransferDto(transfer, d.linkGenerator)
+	if err != nil {
+		return emptyDto, err
+	}
+	d.dispatchDogTransferred(ctx, transferDto)
+	return transferDto, nil
+}
+
+// DeclineTransfer is used by the destination shelter, the dog stays where it is.
+func (d DecideDogTransferService) DeclineTransfer(ctx context.Context, dogIdParam, transferIdParam string,
+	data dogtransferdto.DogTransferDecisionDTO, credentials dto.UserCredentials) (dogtransferdto.DogTransferDTO, error) {
+	return d.closeTransfer(ctx, dogIdParam, transferIdParam, data, credentials, model.TRANSFER_DECLINED)
+}
+
+// CancelTransfer lets the source shelter withdraw its proposal.
+func (d DecideDogTransferService) CancelTransfer(ctx context.Context, dogIdParam, transferIdParam string,
+	data dogtransferdto.DogTransferDecisionDTO, credentials dto.UserCredentials) (dogtransferdto.DogTransferDTO, error) {
+	return d.closeTransfer(ctx, dogIdParam, transferIdParam, data, credentials, model.TRANSFER_CANCELLED)
+}
+
+func (d DecideDogTransferService) closeTransfer(ctx context.Context, dogIdParam, transferIdParam string,
+	data dogtransferdto.DogTransferDecisionDTO, credentials dto.UserCredentials,
+	status model.DogTransferStatus) (dogtransferdto.DogTransferDTO, error) {
+	emptyDto := dogtransferdto.DogTransferDTO{}
+	dogId, transfer, err := d.getTransfer(ctx, dogIdParam, transferIdParam)
+	if err != nil {
+		return emptyDto, err
+	}
+	decidingShelterId := transfer.ToShelterId
+	if status == model.TRANSFER_CANCELLED {
+		decidingShelterId = transfer.FromShelterId
+	}
+	if !canDecide(credentials, decidingShelterId) {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+	if data.Reason != nil && len(strings.TrimSpace(*data.Reason)) > 1000 {
+		return emptyDto, &customerrors.InvalidDogTransferDataError{Message: "reason can be at most 1000 characters"}
+	}
+
+	transfer, err = d.repo.CloseDogTransfer(ctx, dogId, transfer.Id, status, data.Reason)
+	if err != nil {
+		return emptyDto, err
+	}
+	return toTransferDto(transfer, d.linkGenerator)
+}
+
+func (d DecideDogTransferService) getTransfer(ctx context.Context, dogIdParam, transferIdParam string) (int, model.DogTransfer, error) {
+	dogId, transferId, err := parseIdParams(dogIdParam, transferIdParam)
+	if err != nil {
+		return 0, model.DogTransfer{}, err
+	}
+	transfer, err := d.repo.GetDogTransferById(ctx, dogId, transferId)
+	if err != nil {
+		return 0, model.DogTransfer{}, err
+	}
+	return dogId, transfer, nil
+}
+
+// dispatchDogTransferred sends the webhook with the dog as it is after the transfer. The transfer
+// is already committed, so failures are only logged.
+func (d DecideDogTransferService) dispatchDogTransferred(ctx context.Context, transferDto dogtransferdto.DogTransferDTO) {
+	dog, err := d.repo.GetDogById(ctx, transferDto.DogId)
+	if err != nil {
+		log.Printf("Failed to load transferred dog %d: %v", transferDto.DogId, err)
+		return
+	}
+	dogJson, err := json.Marshal(dog.ToJson())
+	if err != nil {
+		log.Printf("Failed to marshal transferred dog %d: %v", transferDto.DogId, err)
+		return
+	}
+	var dogDto dogdto.DogDTO
+	err = json.Unmarshal(dogJson, &dogDto)
+	if err != nil {
+		log.Printf("Failed to unmarshal transferred dog %d: %v", transferDto.DogId, err)
+		return
+	}
+	dogId := fmt.Sprintf("%d", dog.Id)
+	dogDto.Links = dogdto.DogLinksDTO{
+		ShelterLink:        d.linkGenerator.GenerateShelterLink(fmt.Sprintf("%d", dog.ShelterId)),
+		SelfLink:           d.linkGenerator.GenerateDogLink(dogId),
+		TransfersLink:      d.linkGenerator.GenerateDogTransfersLink(dogId),
+		StatusHistoryLink:  d.linkGenerator.GenerateDogStatusHistoryLink(dogId),
+		PhotosLink:         d.linkGenerator.GenerateDogPhotosLink(dogId),
+		MedicalRecordsLink: d.linkGenerator.GenerateDogMedicalRecordsLink(dogId),
+	}
+	if dog.PrimaryPhotoId != nil {
+		dogDto.Links.PrimaryPhotoLink = d.linkGenerator.GenerateDogPhotoFileLink(dogId,
+			fmt.Sprintf("%d", *dog.PrimaryPhotoId), string(model.PHOTO_MEDIUM))
+	}
+	if dog.PrimaryBreedId != nil {
+		dogDto.Links.PrimaryBreedLink = d.linkGenerator.GenerateBreedLink(fmt.Sprintf("%d", *dog.PrimaryBreedId))
+	}
+	if dog.SecondaryBreedId != nil {
+		dogDto.Links.SecondaryBreedLink = d.linkGenerator.GenerateBreedLink(fmt.Sprintf("%d", *dog.SecondaryBreedId))
+	}
+	d.webhookDispatcher.DispatchDogTransferredWebhook(ctx, transferDto, dogDto)
+}
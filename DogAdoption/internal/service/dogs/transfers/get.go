@@ -0,0 +1,80 @@
+package dogtransferservice
+
+import (
+	"1dv027/aad/internal/dto"
+	dogtransferdto "1dv027/aad/internal/dto/dog/transfer"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strconv"
+)
+
+type GetDogTransfersRepository interface {
+	GetDogById(ctx context.Context, dogId int) (model.Dog, error)
+	GetDogTransfers(ctx context.Context, dogId int) ([]model.DogTransfer, error)
+	GetDogTransferById(ctx context.Context, dogId, transferId int) (model.DogTransfer, error)
+}
+
+type GetDogTransfersService struct {
+	repo          GetDogTransfersRepository
+	linkGenerator DogTransferLinkGenerator
+}
+
+func NewGetDogTransfersService(repo GetDogTransfersRepository, linkGenerator DogTransferLinkGenerator) GetDogTransfersService {
+	return GetDogTransfersService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// GetTransfers returns the transfer history of a dog. The history is visible to admins and to the
+// members of the shelter the dog is currently at.
+func (g GetDogTransfersService) GetTransfers(ctx context.Context, dogIdParam string,
+	credentials dto.UserCredentials) (dogtransferdto.DogTransferListDTO, error) {
+	emptyDto := dogtransferdto.DogTransferListDTO{}
+	dogId, err := strconv.Atoi(dogIdParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+	dog, err := g.repo.GetDogById(ctx, dogId)
+	if err != nil {
+		return emptyDto, err
+	}
+	if credentials.UserRole != model.ADMIN && !credentials.IsShelterMember(dog.ShelterId) {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	transfers, err := g.repo.GetDogTransfers(ctx, dogId)
+	if err != nil {
+		return emptyDto, err
+	}
+	transferDtoSlice := []dogtransferdto.DogTransferDTO{}
+	for _, transfer := range transfers {
+		transferDto, err := toTransferDto(transfer, g.linkGenerator)
+		if err != nil {
+			return emptyDto, err
+		}
+		transferDtoSlice = append(transferDtoSlice, transferDto)
+	}
+	return dogtransferdto.DogTransferListDTO{TransferData: transferDtoSlice}, nil
+}
+
+// GetTransfer returns a single transfer to admins and to the members of either shelter involved,
+// so the destination can review a proposal before the dog is theirs.
+func (g GetDogTransfersService) GetTransfer(ctx context.Context, dogIdParam, transferIdParam string,
+	credentials dto.UserCredentials) (dogtransferdto.DogTransferDTO, error) {
+	emptyDto := dogtransferdto.DogTransferDTO{}
+	dogId, transferId, err := parseIdParams(dogIdParam, transferIdParam)
+	if err != nil {
+		return emptyDto, err
+	}
+	transfer, err := g.repo.GetDogTransferById(ctx, dogId, transferId)
+	if err != nil {
+		return emptyDto, err
+	}
+	if credentials.UserRole != model.ADMIN && !credentials.IsShelterMember(transfer.FromShelterId) &&
+		!credentials.IsShelterMember(transfer.ToShelterId) {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+	return toTransferDto(transfer, g.linkGenerator)
+}
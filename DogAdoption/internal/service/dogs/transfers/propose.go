@@ -0,0 +1,76 @@
+package dogtransferservice
+
+import (
+	"1dv027/aad/internal/dto"
+	dogtransferdto "1dv027/aad/internal/dto/dog/transfer"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type ProposeDogTransferRepository interface {
+	GetDogById(ctx context.Context, dogId int) (model.Dog, error)
+	GetDogShelterById(ctx context.Context, shelterId int) (model.DogShelter, error)
+	CreateDogTransfer(ctx context.Context, transfer model.DogTransfer) (model.DogTransfer, error)
+}
+
+type ProposeDogTransferService struct {
+	repo          ProposeDogTransferRepository
+	linkGenerator DogTransferLinkGenerator
+}
+
+func NewProposeDogTransferService(repo ProposeDogTransferRepository, linkGenerator DogTransferLinkGenerator) ProposeDogTransferService {
+	return ProposeDogTransferService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// ProposeTransfer lets the shelter of a dog propose to move it to another shelter. The dog stays
+// where it is until the destination accepts.
+func (p ProposeDogTransferService) ProposeTransfer(ctx context.Context, dogIdParam string, data dogtransferdto.NewDogTransferDTO,
+	credentials dto.UserCredentials) (dogtransferdto.DogTransferDTO, error) {
+	emptyDto := dogtransferdto.DogTransferDTO{}
+	dogId, err := strconv.Atoi(dogIdParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+	dog, err := p.repo.GetDogById(ctx, dogId)
+	if err != nil {
+		return emptyDto, err
+	}
+	if !canDecide(credentials, dog.ShelterId) {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+	if dog.Status == model.DOG_ADOPTED || dog.Status == model.DOG_DECEASED {
+		return emptyDto, &customerrors.DogTransferConflictError{Message: fmt.Sprintf("%s dogs cannot be transferred", dog.Status)}
+	}
+
+	if data.ToShelterId == nil {
+		return emptyDto, &customerrors.InvalidDogTransferDataError{Message: "to_shelter_id is required"}
+	}
+	if *data.ToShelterId == dog.ShelterId {
+		return emptyDto, &customerrors.InvalidDogTransferDataError{Message: "the dog is already at this shelter"}
+	}
+	if data.Note != nil && len(strings.TrimSpace(*data.Note)) > 1000 {
+		return emptyDto, &customerrors.InvalidDogTransferDataError{Message: "note can be at most 1000 characters"}
+	}
+	destination, err := p.repo.GetDogShelterById(ctx, *data.ToShelterId)
+	if err != nil || !destination.IsApproved() {
+		return emptyDto, &customerrors.InvalidDogTransferDataError{Message: "to_shelter_id does not belong to a dog shelter"}
+	}
+
+	transfer, err := p.repo.CreateDogTransfer(ctx, model.DogTransfer{
+		DogId:         dogId,
+		FromShelterId: dog.ShelterId,
+		ToShelterId:   destination.Id,
+		Note:          data.Note,
+	})
+	if err != nil {
+		return emptyDto, err
+	}
+	return toTransferDto(transfer, p.linkGenerator)
+}
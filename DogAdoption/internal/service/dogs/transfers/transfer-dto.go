@@ -0,0 +1,56 @@
+package dogtransferservice
+
+import (
+	"1dv027/aad/internal/dto"
+	dogtransferdto "1dv027/aad/internal/dto/dog/transfer"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+type DogTransferLinkGenerator interface {
+	GenerateDogLink(dogId string) string
+	GenerateShelterLink(shelterId string) string
+	GenerateDogTransferLink(dogId, transferId string) string
+}
+
+func toTransferDto(transfer model.DogTransfer, linkGenerator DogTransferLinkGenerator) (dogtransferdto.DogTransferDTO, error) {
+	var transferDto dogtransferdto.DogTransferDTO
+	transferJson, err := json.Marshal(transfer.ToJson())
+	if err != nil {
+		return transferDto, err
+	}
+	err = json.Unmarshal(transferJson, &transferDto)
+	if err != nil {
+		return transferDto, err
+	}
+
+	dogId := fmt.Sprintf("%d", transfer.DogId)
+	transferDto.Links = dogtransferdto.DogTransferDtoLinks{
+		SelfLink:        linkGenerator.GenerateDogTransferLink(dogId, fmt.Sprintf("%d", transfer.Id)),
+		DogLink:         linkGenerator.GenerateDogLink(dogId),
+		FromShelterLink: linkGenerator.GenerateShelterLink(fmt.Sprintf("%d", transfer.FromShelterId)),
+		ToShelterLink:   linkGenerator.GenerateShelterLink(fmt.Sprintf("%d", transfer.ToShelterId)),
+	}
+	return transferDto, nil
+}
+
+// parseIdParams converts the dog id and the transfer id from the path.
+func parseIdParams(dogIdParam, transferIdParam string) (int, int, error) {
+	dogId, err := strconv.Atoi(dogIdParam)
+	if err != nil {
+		return 0, 0, &customerrors.IntegerConversionError{}
+	}
+	transferId, err := strconv.Atoi(transferIdParam)
+	if err != nil {
+		return 0, 0, &customerrors.IntegerConversionError{}
+	}
+	return dogId, transferId, nil
+}
+
+// canDecide allows admins and the owners and managers of the shelter to act on a transfer.
+func canDecide(credentials dto.UserCredentials, shelterId int) bool {
+	return credentials.UserRole == model.ADMIN || credentials.HasShelterRole(shelterId, model.STAFF_OWNER, model.STAFF_MANAGER)
+}
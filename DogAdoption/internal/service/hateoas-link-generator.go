@@ -4,6 +4,7 @@ import (
 	"1dv027/aad/internal/dto"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -24,6 +25,7 @@ func (d HateoasLinkGenerator) GenerateEntryPointLinks() dto.EntryPointLinksDTO {
 		DogSheltersUrl:    fmt.Sprintf("%s/dogshelters", d.basePath),
 		AuthenticationUrl: fmt.Sprintf("%s/auth/login", d.basePath),
 		UsersUrl:          fmt.Sprintf("%s/users", d.basePath),
+		BreedsUrl:         fmt.Sprintf("%s/breeds", d.basePath),
 	}
 }
 
@@ -39,6 +41,98 @@ func (d HateoasLinkGenerator) GenerateDogsFromDogShelterLink(shelterId string) s
 	return fmt.Sprintf("%s/dogs?shelter-id=%s", d.basePath, shelterId)
 }
 
+func (d HateoasLinkGenerator) GenerateDogTransfersLink(dogId string) string {
+	return fmt.Sprintf("%s/dogs/%s/transfers", d.basePath, dogId)
+}
+
+func (d HateoasLinkGenerator) GenerateDogTransferLink(dogId, transferId string) string {
+	return fmt.Sprintf("%s/dogs/%s/transfers/%s", d.basePath, dogId, transferId)
+}
+
+func (d HateoasLinkGenerator) GenerateDogStatusHistoryLink(dogId string) string {
+	return fmt.Sprintf("%s/dogs/%s/status-history", d.basePath, dogId)
+}
+
+func (d HateoasLinkGenerator) GenerateDogPhotosLink(dogId string) string {
+	return fmt.Sprintf("%s/dogs/%s/photos", d.basePath, dogId)
+}
+
+func (d HateoasLinkGenerator) GenerateDogPhotoLink(dogId, photoId string) string {
+	return fmt.Sprintf("%s/dogs/%s/photos/%s", d.basePath, dogId, photoId)
+}
+
+func (d HateoasLinkGenerator) GenerateDogMedicalRecordsLink(dogId string) string {
+	return fmt.Sprintf("%s/dogs/%s/medical-records", d.basePath, dogId)
+}
+
+func (d HateoasLinkGenerator) GenerateDogMedicalRecordLink(dogId, recordId string) string {
+	return fmt.Sprintf("%s/dogs/%s/medical-records/%s", d.basePath, dogId, recordId)
+}
+
+func (d HateoasLinkGenerator) GenerateDogPhotoFileLink(dogId, photoId, size string) string {
+	return fmt.Sprintf("%s/dogs/%s/photos/%s/%s", d.basePath, dogId, photoId, size)
+}
+
+func (d HateoasLinkGenerator) GenerateBreedLink(breedId string) string {
+	return fmt.Sprintf("%s/breeds/%s", d.basePath, breedId)
+}
+
+func (d HateoasLinkGenerator) GenerateDogsOfBreedLink(breedId string) string {
+	return fmt.Sprintf("%s/dogs?breed-id=%s", d.basePath, breedId)
+}
+
+func (d HateoasLinkGenerator) GenerateUserLink(userId string) string {
+	return fmt.Sprintf("%s/users/%s", d.basePath, userId)
+}
+
+func (d HateoasLinkGenerator) GenerateUserWebhookLink(userId string) string {
+	return fmt.Sprintf("%s/users/%s/webhook", d.basePath, userId)
+}
+
+func (d HateoasLinkGenerator) GenerateUserFavoritesLink(userId string) string {
+	return fmt.Sprintf("%s/users/%s/favorites", d.basePath, userId)
+}
+
+func (d HateoasLinkGenerator) GenerateUserSavedSearchesLink(userId string) string {
+	return fmt.Sprintf("%s/users/%s/saved-searches", d.basePath, userId)
+}
+
+func (d HateoasLinkGenerator) GenerateUserSavedSearchLink(userId, searchId string) string {
+	return fmt.Sprintf("%s/users/%s/saved-searches/%s", d.basePath, userId, searchId)
+}
+
+func (d HateoasLinkGenerator) GenerateUserNotificationsLink(userId string) string {
+	return fmt.Sprintf("%s/users/%s/notifications", d.basePath, userId)
+}
+
+func (d HateoasLinkGenerator) GenerateUserNotificationReadLink(userId, notificationId string) string {
+	return fmt.Sprintf("%s/users/%s/notifications/%s/read", d.basePath, userId, notificationId)
+}
+
+// GenerateDogsSearchLink links to GET /dogs with the query string of a saved search.
+func (d HateoasLinkGenerator) GenerateDogsSearchLink(query string) string {
+	if query == "" {
+		return fmt.Sprintf("%s/dogs", d.basePath)
+	}
+	return fmt.Sprintf("%s/dogs?%s", d.basePath, query)
+}
+
+func (d HateoasLinkGenerator) GenerateAdminLink(adminId string) string {
+	return fmt.Sprintf("%s/admins/%s", d.basePath, adminId)
+}
+
+func (d HateoasLinkGenerator) GenerateShelterStaffLink(shelterId, staffId string) string {
+	return fmt.Sprintf("%s/dogshelters/%s/staff/%s", d.basePath, shelterId, staffId)
+}
+
+func (d HateoasLinkGenerator) GenerateStaffInvitationLink(shelterId, invitationId string) string {
+	return fmt.Sprintf("%s/dogshelters/%s/staff-invitations/%s", d.basePath, shelterId, invitationId)
+}
+
+func (d HateoasLinkGenerator) GenerateShelterRegistrationLink(shelterId string) string {
+	return fmt.Sprintf("%s/admin/shelter-registrations/%s", d.basePath, shelterId)
+}
+
 func (d HateoasLinkGenerator) GeneratePaginationLinks(totalItems int, queryParams dto.QueryParams, apiPath string) dto.PaginationLinksDTO {
 	pageSize := *queryParams.Pagination.Limit
 	currentPage := *queryParams.Pagination.Page
@@ -84,12 +178,30 @@ func (d HateoasLinkGenerator) getFiltersMapFromDto(queryParams dto.QueryParams)
 		if dogsFilters.Breed != nil {
 			appliedFilters["breed"] = *dogsFilters.Breed
 		}
+		if dogsFilters.BreedId != nil {
+			appliedFilters["breed-id"] = fmt.Sprintf("%d", *dogsFilters.BreedId)
+		}
 		if dogsFilters.IsAdopted != nil {
 			appliedFilters["is-adopted"] = *dogsFilters.IsAdopted
 		}
 		if dogsFilters.IsNeutered != nil {
 			appliedFilters["is-neutered"] = *dogsFilters.IsNeutered
 		}
+		if dogsFilters.IsVaccinated != nil {
+			appliedFilters["vaccinated"] = strconv.FormatBool(*dogsFilters.IsVaccinated)
+		}
+		if len(dogsFilters.Statuses) > 0 {
+			appliedFilters["status"] = strings.Join(dogsFilters.Statuses, ",")
+		}
+		if len(dogsFilters.GoodWith) > 0 {
+			appliedFilters["good-with"] = strings.Join(dogsFilters.GoodWith, ",")
+		}
+		if len(dogsFilters.EnergyLevels) > 0 {
+			appliedFilters["energy-level"] = strings.Join(dogsFilters.EnergyLevels, ",")
+		}
+		if len(dogsFilters.Sizes) > 0 {
+			appliedFilters["dog-size"] = strings.Join(dogsFilters.Sizes, ",")
+		}
 		if dogsFilters.ShelterId != nil {
 			appliedFilters["shelter-id"] = fmt.Sprintf("%d", *dogsFilters.ShelterId)
 		}
@@ -105,6 +217,50 @@ func (d HateoasLinkGenerator) getFiltersMapFromDto(queryParams dto.QueryParams)
 		if dogShelterFilters.Name != nil {
 			appliedFilters["name"] = *dogShelterFilters.Name
 		}
+		if dogShelterFilters.OpenNow != nil {
+			appliedFilters["open-now"] = strconv.FormatBool(*dogShelterFilters.OpenNow)
+		}
+	}
+	usersFilters := queryParams.UsersFilter
+	if usersFilters != nil {
+		if usersFilters.UsernamePrefix != nil {
+			appliedFilters["username-prefix"] = *usersFilters.UsernamePrefix
+		}
+		if usersFilters.Status != nil {
+			appliedFilters["account-status"] = *usersFilters.Status
+		}
+	}
+	shelterRegistrationFilters := queryParams.ShelterRegistrationFilter
+	if shelterRegistrationFilters != nil {
+		if shelterRegistrationFilters.Status != nil {
+			appliedFilters["registration-status"] = *shelterRegistrationFilters.Status
+		}
+	}
+	breedsFilters := queryParams.BreedsFilter
+	if breedsFilters != nil {
+		if breedsFilters.Search != nil {
+			appliedFilters["search"] = *breedsFilters.Search
+		}
+		if breedsFilters.SizeGroup != nil {
+			appliedFilters["size-group"] = *breedsFilters.SizeGroup
+		}
+		if breedsFilters.FciGroup != nil {
+			appliedFilters["fci-group"] = fmt.Sprintf("%d", *breedsFilters.FciGroup)
+		}
+	}
+	notificationsFilters := queryParams.NotificationsFilter
+	if notificationsFilters != nil {
+		if notificationsFilters.Unread != nil {
+			appliedFilters["unread"] = strconv.FormatBool(*notificationsFilters.Unread)
+		}
+	}
+	geoFilter := queryParams.GeoFilter
+	if geoFilter != nil {
+		appliedFilters["near"] = strconv.FormatFloat(geoFilter.Latitude, 'f', -1, 64) + "," +
+			strconv.FormatFloat(geoFilter.Longitude, 'f', -1, 64)
+		if geoFilter.RadiusKm != nil {
+			appliedFilters["radius-km"] = strconv.FormatFloat(*geoFilter.RadiusKm, 'f', -1, 64)
+		}
 	}
 
 	return appliedFilters
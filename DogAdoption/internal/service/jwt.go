@@ -5,14 +5,24 @@ import (
 	"1dv027/aad/internal/model"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+const (
+	accessTokenType       = ""
+	mfaChallengeTokenType = "mfa_challenge"
+)
+
 type CustomClaims struct {
-	Username string `json:"username"`
-	UserType string `json:"userType"`
+	Username              string `json:"username"`
+	UserType              string `json:"userType"`
+	TokenType             string `json:"tokenType,omitempty"`
+	MfaEnrollmentRequired bool   `json:"mfaEnrollmentRequired,omitempty"`
+	ClientId              string `json:"client_id,omitempty"`
+	Scope                 string `json:"scope,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -27,18 +37,59 @@ func NewJwtService(signingKey string) JwtService {
 }
 
 func (j JwtService) GenerateJwt(username string, id int, userType model.UserRole) (string, error) {
-	var mySigningKey = []byte(j.signingKey)
+	claims := j.newClaims(username, id, userType, 72*time.Hour)
+	return j.signClaims(claims)
+}
+
+// GenerateMfaEnrollmentJwt issues a short lived token for accounts whose role requires
+// MFA but that have not enrolled yet. It is only accepted by the MFA enrollment endpoints.
+func (j JwtService) GenerateMfaEnrollmentJwt(username string, id int, userType model.UserRole) (string, error) {
+	claims := j.newClaims(username, id, userType, 15*time.Minute)
+	claims.MfaEnrollmentRequired = true
+	return j.signClaims(claims)
+}
 
-	claims := CustomClaims{
+func (j JwtService) GenerateMfaChallengeJwt(username string, id int, userType model.UserRole) (string, error) {
+	claims := j.newClaims(username, id, userType, 5*time.Minute)
+	claims.TokenType = mfaChallengeTokenType
+	return j.signClaims(claims)
+}
+
+// OAuthAccessTokenLifetime is the lifetime of access tokens issued to oauth clients.
+const OAuthAccessTokenLifetime = time.Hour
+
+// GenerateOAuthAccessToken issues an access token for an oauth client acting on behalf of
+// the account, restricted to the given scopes.
+func (j JwtService) GenerateOAuthAccessToken(username string, id int, userType model.UserRole, clientId string, scopes []string) (string, error) {
+	claims := j.newClaims(username, id, userType, OAuthAccessTokenLifetime)
+	claims.ClientId = clientId
+	claims.Scope = strings.Join(scopes, " ")
+	return j.signClaims(claims)
+}
+
+func (j JwtService) ValidateToken(tokenString string) (dto.UserCredentials, error) {
+	return j.validateTokenOfType(tokenString, accessTokenType)
+}
+
+func (j JwtService) ValidateMfaChallengeToken(tokenString string) (dto.UserCredentials, error) {
+	return j.validateTokenOfType(tokenString, mfaChallengeTokenType)
+}
+
+func (j JwtService) newClaims(username string, id int, userType model.UserRole, lifetime time.Duration) CustomClaims {
+	return CustomClaims{
 		Username: username,
 		UserType: string(userType),
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    "DogAdoptionApp",
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(72 * time.Hour)),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(lifetime)),
 			Subject:   fmt.Sprintf("%d", id),
 		},
 	}
+}
+
+func (j JwtService) signClaims(claims CustomClaims) (string, error) {
+	var mySigningKey = []byte(j.signingKey)
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
 	tokenString, err := token.SignedString(mySigningKey)
@@ -50,7 +101,7 @@ func (j JwtService) GenerateJwt(username string, id int, userType model.UserRole
 	return tokenString, err
 }
 
-func (j JwtService) ValidateToken(tokenString string) (dto.UserCredentials, error) {
+func (j JwtService) validateTokenOfType(tokenString string, tokenType string) (dto.UserCredentials, error) {
 	claims := &CustomClaims{}
 
 	validatedToken, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
@@ -68,6 +119,10 @@ func (j JwtService) ValidateToken(tokenString string) (dto.UserCredentials, erro
 		return dto.UserCredentials{}, fmt.Errorf("invalid token")
 	}
 
+	if claims.TokenType != tokenType {
+		return dto.UserCredentials{}, fmt.Errorf("invalid token type")
+	}
+
 	id, err := strconv.Atoi(claims.Subject)
 	if err != nil {
 		return dto.UserCredentials{}, fmt.Errorf("could not format subject to integer")
@@ -79,9 +134,14 @@ func (j JwtService) ValidateToken(tokenString string) (dto.UserCredentials, erro
 	}
 
 	userCredentials := dto.UserCredentials{
-		Id:       id,
-		Username: claims.Username,
-		UserRole: userRole,
+		Id:                    id,
+		Username:              claims.Username,
+		UserRole:              userRole,
+		MfaEnrollmentRequired: claims.MfaEnrollmentRequired,
+	}
+	if claims.ClientId != "" {
+		userCredentials.OAuthClientId = claims.ClientId
+		userCredentials.Scopes = append([]string{}, strings.Fields(claims.Scope)...)
 	}
 
 	return userCredentials, nil
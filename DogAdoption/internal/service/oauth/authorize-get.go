@@ -0,0 +1,59 @@
+package oauthservice
+
+import (
+	"1dv027/aad/internal/dto"
+	oauthdto "1dv027/aad/internal/dto/oauth"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+)
+
+type GetAuthorizeRepository interface {
+	AuthorizeClientRepository
+	GetConsent(ctx context.Context, userId int, clientId string) (model.OAuthConsent, error)
+}
+
+type GetAuthorizeService struct {
+	repo GetAuthorizeRepository
+}
+
+func NewGetAuthorizeService(repo GetAuthorizeRepository) GetAuthorizeService {
+	return GetAuthorizeService{
+		repo: repo,
+	}
+}
+
+// GetConsentPrompt validates the authorization request and describes what the user is asked
+// to consent to, and if the user already has consented to it.
+func (g GetAuthorizeService) GetConsentPrompt(ctx context.Context, credentials dto.UserCredentials,
+	request oauthdto.AuthorizeRequestDTO) (oauthdto.ConsentPromptDTO, error) {
+	emptyDto := oauthdto.ConsentPromptDTO{}
+	if credentials.UserRole != model.USER || credentials.Scopes != nil {
+		return emptyDto, &customerrors.UnauthorizedError{Message: "only users can authorize oauth clients"}
+	}
+
+	client, redirectUri, scopes, err := validateAuthorizeRequest(ctx, g.repo, request)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	hasConsent := false
+	consent, err := g.repo.GetConsent(ctx, credentials.Id, client.ClientId)
+	if err != nil {
+		var consentNotFoundError *customerrors.OAuthConsentNotFoundError
+		if !errors.As(err, &consentNotFoundError) {
+			return emptyDto, err
+		}
+	} else {
+		hasConsent = containsAllScopes(consent.Scopes, scopes)
+	}
+
+	return oauthdto.ConsentPromptDTO{
+		ClientId:    client.ClientId,
+		ClientName:  client.Name,
+		RedirectUri: redirectUri,
+		Scopes:      scopes,
+		HasConsent:  hasConsent,
+	}, nil
+}
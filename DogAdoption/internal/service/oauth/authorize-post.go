@@ -0,0 +1,101 @@
+package oauthservice
+
+import (
+	"1dv027/aad/internal/dto"
+	oauthdto "1dv027/aad/internal/dto/oauth"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"net/url"
+	"time"
+)
+
+type PostAuthorizeRepository interface {
+	AuthorizeClientRepository
+	SaveConsent(ctx context.Context, userId int, clientId string, scopes []string) (model.OAuthConsent, error)
+	CreateAuthorizationCode(ctx context.Context, code model.OAuthAuthorizationCode) error
+}
+
+type PostAuthorizeCryptographyService interface {
+	GenerateRandomToken(byteLength int) (string, error)
+	HashToken(token string) string
+}
+
+type PostAuthorizeService struct {
+	repo          PostAuthorizeRepository
+	cryptoService PostAuthorizeCryptographyService
+}
+
+func NewPostAuthorizeService(repo PostAuthorizeRepository, cryptoService PostAuthorizeCryptographyService) PostAuthorizeService {
+	return PostAuthorizeService{
+		repo:          repo,
+		cryptoService: cryptoService,
+	}
+}
+
+// Authorize records the decision of the user. When approved, the consent is saved and an
+// authorization code valid for 10 minutes is added to the redirect uri, otherwise access_denied is.
+func (p PostAuthorizeService) Authorize(ctx context.Context, credentials dto.UserCredentials,
+	decision oauthdto.AuthorizeDecisionDTO) (oauthdto.AuthorizeResultDTO, error) {
+	emptyDto := oauthdto.AuthorizeResultDTO{}
+	if credentials.UserRole != model.USER || credentials.Scopes != nil {
+		return emptyDto, &customerrors.UnauthorizedError{Message: "only users can authorize oauth clients"}
+	}
+	if decision.Approve == nil {
+		return emptyDto, &customerrors.OAuthError{Code: "invalid_request", Message: "approve is required"}
+	}
+
+	client, redirectUri, scopes, err := validateAuthorizeRequest(ctx, p.repo, decision.AuthorizeRequestDTO)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	redirectParams := url.Values{}
+	if decision.State != "" {
+		redirectParams.Set("state", decision.State)
+	}
+
+	if !*decision.Approve {
+		redirectParams.Set("error", "access_denied")
+		return oauthdto.AuthorizeResultDTO{RedirectTo: buildRedirectUri(redirectUri, redirectParams)}, nil
+	}
+
+	_, err = p.repo.SaveConsent(ctx, credentials.Id, client.ClientId, scopes)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	code, err := p.cryptoService.GenerateRandomToken(32)
+	if err != nil {
+		return emptyDto, &customerrors.CryptographyError{}
+	}
+	err = p.repo.CreateAuthorizationCode(ctx, model.OAuthAuthorizationCode{
+		CodeHash:            p.cryptoService.HashToken(code),
+		ClientId:            client.ClientId,
+		UserId:              credentials.Id,
+		RedirectUri:         redirectUri,
+		RedirectUriSupplied: decision.RedirectUri != "",
+		Scopes:              scopes,
+		CodeChallenge:       decision.CodeChallenge,
+		ExpiresAt:           time.Now().Add(10 * time.Minute),
+	})
+	if err != nil {
+		return emptyDto, err
+	}
+
+	redirectParams.Set("code", code)
+	return oauthdto.AuthorizeResultDTO{RedirectTo: buildRedirectUri(redirectUri, redirectParams)}, nil
+}
+
+func buildRedirectUri(redirectUri string, params url.Values) string {
+	parsedUri, err := url.Parse(redirectUri)
+	if err != nil {
+		return redirectUri
+	}
+	query := parsedUri.Query()
+	for key, values := range params {
+		query[key] = values
+	}
+	parsedUri.RawQuery = query.Encode()
+	return parsedUri.String()
+}
@@ -0,0 +1,81 @@
+package oauthservice
+
+import (
+	oauthdto "1dv027/aad/internal/dto/oauth"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+)
+
+type AuthorizeClientRepository interface {
+	GetClient(ctx context.Context, clientId string) (model.OAuthClient, error)
+}
+
+// validateAuthorizeRequest validates an authorization request and returns the client,
+// the redirect uri to use and the requested scopes. Scopes default to all scopes of the client.
+func validateAuthorizeRequest(ctx context.Context, repo AuthorizeClientRepository,
+	request oauthdto.AuthorizeRequestDTO) (model.OAuthClient, string, []string, error) {
+	client, err := repo.GetClient(ctx, request.ClientId)
+	if err != nil {
+		var clientNotFoundError *customerrors.OAuthClientNotFoundError
+		if errors.As(err, &clientNotFoundError) {
+			return model.OAuthClient{}, "", nil, &customerrors.OAuthError{Code: "invalid_client", Message: "unknown client_id"}
+		}
+		return model.OAuthClient{}, "", nil, err
+	}
+
+	redirectUri := request.RedirectUri
+	if redirectUri == "" && len(client.RedirectUris) == 1 {
+		redirectUri = client.RedirectUris[0]
+	}
+	if !slices.Contains(client.RedirectUris, redirectUri) {
+		return model.OAuthClient{}, "", nil, &customerrors.OAuthError{Code: "invalid_request", Message: "redirect_uri is not registered for the client"}
+	}
+
+	if request.ResponseType != "code" {
+		return model.OAuthClient{}, "", nil, &customerrors.OAuthError{Code: "unsupported_response_type", Message: "response_type must be code"}
+	}
+	if !slices.Contains(client.GrantTypes, model.AUTHORIZATION_CODE) {
+		return model.OAuthClient{}, "", nil, &customerrors.OAuthError{Code: "unauthorized_client", Message: "the client may not use the authorization_code grant"}
+	}
+	if request.CodeChallenge == "" || request.CodeChallengeMethod != "S256" {
+		return model.OAuthClient{}, "", nil, &customerrors.OAuthError{Code: "invalid_request", Message: "pkce with code_challenge_method S256 is required"}
+	}
+
+	scopes, err := parseScopes(request.Scope, client.Scopes)
+	if err != nil {
+		return model.OAuthClient{}, "", nil, err
+	}
+	return client, redirectUri, scopes, nil
+}
+
+// parseScopes parses a space delimited scope parameter, which must be a subset of the allowed scopes.
+// An empty parameter results in all allowed scopes.
+func parseScopes(scopeParam string, allowedScopes []string) ([]string, error) {
+	requestedScopes := strings.Fields(scopeParam)
+	if len(requestedScopes) == 0 {
+		return append([]string{}, allowedScopes...), nil
+	}
+	scopes := []string{}
+	for _, scope := range requestedScopes {
+		if !slices.Contains(allowedScopes, scope) {
+			return nil, &customerrors.OAuthError{Code: "invalid_scope", Message: "scope not allowed: " + scope}
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, nil
+}
+
+func containsAllScopes(grantedScopes []string, scopes []string) bool {
+	for _, scope := range scopes {
+		if !slices.Contains(grantedScopes, scope) {
+			return false
+		}
+	}
+	return true
+}
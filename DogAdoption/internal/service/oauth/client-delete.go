@@ -0,0 +1,31 @@
+package oauthservice
+
+import (
+	"1dv027/aad/internal/dto"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type DeleteOAuthClientRepository interface {
+	DeleteClient(ctx context.Context, clientId string) error
+}
+
+type DeleteOAuthClientService struct {
+	repo DeleteOAuthClientRepository
+}
+
+func NewDeleteOAuthClientService(repo DeleteOAuthClientRepository) DeleteOAuthClientService {
+	return DeleteOAuthClientService{
+		repo: repo,
+	}
+}
+
+// DeleteClient removes the client together with its consents, codes and refresh tokens.
+// Access tokens already issued stay valid until they expire.
+func (d DeleteOAuthClientService) DeleteClient(ctx context.Context, clientIdParam string, credentials dto.UserCredentials) error {
+	if credentials.UserRole != model.ADMIN || credentials.Scopes != nil {
+		return &customerrors.UnauthorizedError{}
+	}
+	return d.repo.DeleteClient(ctx, clientIdParam)
+}
@@ -0,0 +1,43 @@
+package oauthservice
+
+import (
+	"1dv027/aad/internal/dto"
+	oauthdto "1dv027/aad/internal/dto/oauth"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type GetOAuthClientsRepository interface {
+	GetClients(ctx context.Context) ([]model.OAuthClient, error)
+}
+
+type GetOAuthClientsService struct {
+	repo GetOAuthClientsRepository
+}
+
+func NewGetOAuthClientsService(repo GetOAuthClientsRepository) GetOAuthClientsService {
+	return GetOAuthClientsService{
+		repo: repo,
+	}
+}
+
+func (g GetOAuthClientsService) GetClients(ctx context.Context, credentials dto.UserCredentials) ([]oauthdto.OAuthClientDTO, error) {
+	if credentials.UserRole != model.ADMIN || credentials.Scopes != nil {
+		return nil, &customerrors.UnauthorizedError{}
+	}
+
+	clientModels, err := g.repo.GetClients(ctx)
+	if err != nil {
+		return nil, err
+	}
+	clientDtos := []oauthdto.OAuthClientDTO{}
+	for _, clientModel := range clientModels {
+		clientDto, err := clientModelToDto(clientModel)
+		if err != nil {
+			return nil, err
+		}
+		clientDtos = append(clientDtos, clientDto)
+	}
+	return clientDtos, nil
+}
@@ -0,0 +1,154 @@
+package oauthservice
+
+import (
+	"1dv027/aad/internal/dto"
+	oauthdto "1dv027/aad/internal/dto/oauth"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+type PostOAuthClientRepository interface {
+	CreateClient(ctx context.Context, client model.OAuthClient) (model.OAuthClient, error)
+}
+
+type PostOAuthClientCryptographyService interface {
+	GenerateRandomToken(byteLength int) (string, error)
+	HashToken(token string) string
+}
+
+type PostOAuthClientService struct {
+	repo          PostOAuthClientRepository
+	cryptoService PostOAuthClientCryptographyService
+}
+
+func NewPostOAuthClientService(repo PostOAuthClientRepository, cryptoService PostOAuthClientCryptographyService) PostOAuthClientService {
+	return PostOAuthClientService{
+		repo:          repo,
+		cryptoService: cryptoService,
+	}
+}
+
+func (p PostOAuthClientService) RegisterClient(ctx context.Context, credentials dto.UserCredentials,
+	data oauthdto.NewOAuthClientDTO) (oauthdto.CreatedOAuthClientDTO, error) {
+	emptyDto := oauthdto.CreatedOAuthClientDTO{}
+	if credentials.UserRole != model.ADMIN || credentials.Scopes != nil {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	client, err := p.validateNewClientData(data)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	clientIdPart, err := p.cryptoService.GenerateRandomToken(12)
+	if err != nil {
+		return emptyDto, &customerrors.CryptographyError{}
+	}
+	client.ClientId = "dac_" + clientIdPart
+
+	clientSecret := ""
+	if client.IsConfidential {
+		clientSecret, err = p.cryptoService.GenerateRandomToken(32)
+		if err != nil {
+			return emptyDto, &customerrors.CryptographyError{}
+		}
+		client.ClientSecretHash = p.cryptoService.HashToken(clientSecret)
+	}
+
+	createdClient, err := p.repo.CreateClient(ctx, client)
+	if err != nil {
+		return emptyDto, err
+	}
+	clientDto, err := clientModelToDto(createdClient)
+	if err != nil {
+		return emptyDto, err
+	}
+	return oauthdto.CreatedOAuthClientDTO{
+		OAuthClientDTO: clientDto,
+		ClientSecret:   clientSecret,
+	}, nil
+}
+
+func (p PostOAuthClientService) validateNewClientData(data oauthdto.NewOAuthClientDTO) (model.OAuthClient, error) {
+	if data.Name == nil || data.Scopes == nil || data.GrantTypes == nil || data.IsConfidential == nil {
+		return model.OAuthClient{}, &customerrors.InvalidOAuthClientDataError{Message: "name, scopes, grant_types and is_confidential are required"}
+	}
+
+	client := model.OAuthClient{
+		Name:           strings.TrimSpace(*data.Name),
+		IsConfidential: *data.IsConfidential,
+		OwnerUserId:    data.OwnerUserId,
+		RedirectUris:   []string{},
+		Scopes:         []string{},
+		GrantTypes:     []model.OAuthGrantType{},
+	}
+	if client.Name == "" || len(client.Name) > 100 {
+		return model.OAuthClient{}, &customerrors.InvalidOAuthClientDataError{Message: "name must be between 1 and 100 characters"}
+	}
+
+	if len(*data.Scopes) == 0 {
+		return model.OAuthClient{}, &customerrors.InvalidOAuthClientDataError{Message: "at least one scope is required"}
+	}
+	for _, scope := range *data.Scopes {
+		if !slices.Contains(model.AccessScopes, scope) {
+			return model.OAuthClient{}, &customerrors.InvalidOAuthClientDataError{
+				Message: "invalid scope: " + scope + ". valid scopes are " + strings.Join(model.AccessScopes, ", "),
+			}
+		}
+		if !slices.Contains(client.Scopes, scope) {
+			client.Scopes = append(client.Scopes, scope)
+		}
+	}
+
+	validGrantTypes := []model.OAuthGrantType{model.AUTHORIZATION_CODE, model.REFRESH_TOKEN, model.CLIENT_CREDENTIALS}
+	if len(*data.GrantTypes) == 0 {
+		return model.OAuthClient{}, &customerrors.InvalidOAuthClientDataError{Message: "at least one grant type is required"}
+	}
+	for _, grantType := range *data.GrantTypes {
+		if !slices.Contains(validGrantTypes, grantType) {
+			return model.OAuthClient{}, &customerrors.InvalidOAuthClientDataError{Message: "invalid grant type: " + string(grantType)}
+		}
+		if !slices.Contains(client.GrantTypes, grantType) {
+			client.GrantTypes = append(client.GrantTypes, grantType)
+		}
+	}
+
+	if slices.Contains(client.GrantTypes, model.AUTHORIZATION_CODE) {
+		if data.RedirectUris == nil || len(*data.RedirectUris) == 0 {
+			return model.OAuthClient{}, &customerrors.InvalidOAuthClientDataError{Message: "redirect_uris are required for the authorization_code grant"}
+		}
+		for _, redirectUri := range *data.RedirectUris {
+			parsedUri, err := url.Parse(redirectUri)
+			if err != nil || !parsedUri.IsAbs() || parsedUri.Fragment != "" {
+				return model.OAuthClient{}, &customerrors.InvalidOAuthClientDataError{Message: "invalid redirect uri: " + redirectUri}
+			}
+			client.RedirectUris = append(client.RedirectUris, redirectUri)
+		}
+	}
+
+	if slices.Contains(client.GrantTypes, model.CLIENT_CREDENTIALS) && (!client.IsConfidential || client.OwnerUserId == nil) {
+		return model.OAuthClient{}, &customerrors.InvalidOAuthClientDataError{
+			Message: "the client_credentials grant requires a confidential client with an owner_user_id to act as",
+		}
+	}
+
+	return client, nil
+}
+
+func clientModelToDto(clientModel model.OAuthClient) (oauthdto.OAuthClientDTO, error) {
+	clientJson, err := json.Marshal(clientModel.ToJson())
+	if err != nil {
+		return oauthdto.OAuthClientDTO{}, err
+	}
+	var clientDto oauthdto.OAuthClientDTO
+	err = json.Unmarshal(clientJson, &clientDto)
+	if err != nil {
+		return oauthdto.OAuthClientDTO{}, err
+	}
+	return clientDto, nil
+}
@@ -0,0 +1,38 @@
+package oauthservice
+
+import (
+	"1dv027/aad/internal/dto"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strconv"
+)
+
+type DeleteOAuthConsentRepository interface {
+	DeleteConsent(ctx context.Context, userId int, clientId string) error
+}
+
+type DeleteOAuthConsentService struct {
+	repo DeleteOAuthConsentRepository
+}
+
+func NewDeleteOAuthConsentService(repo DeleteOAuthConsentRepository) DeleteOAuthConsentService {
+	return DeleteOAuthConsentService{
+		repo: repo,
+	}
+}
+
+// DeleteConsent withdraws the consent given to a client, which also revokes its refresh tokens.
+func (d DeleteOAuthConsentService) DeleteConsent(ctx context.Context, idParam string, clientIdParam string, credentials dto.UserCredentials) error {
+	idParamInt, err := strconv.Atoi(idParam)
+	if err != nil {
+		return &customerrors.IntegerConversionError{}
+	}
+	if credentials.Scopes != nil || (credentials.UserRole != model.ADMIN && credentials.UserRole != model.USER) {
+		return &customerrors.UnauthorizedError{}
+	}
+	if credentials.UserRole == model.USER && idParamInt != credentials.Id {
+		return &customerrors.UnauthorizedError{}
+	}
+	return d.repo.DeleteConsent(ctx, idParamInt, clientIdParam)
+}
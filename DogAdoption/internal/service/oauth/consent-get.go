@@ -0,0 +1,57 @@
+package oauthservice
+
+import (
+	"1dv027/aad/internal/dto"
+	oauthdto "1dv027/aad/internal/dto/oauth"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+type GetOAuthConsentsRepository interface {
+	GetUserConsents(ctx context.Context, userId int) ([]model.OAuthConsent, error)
+}
+
+type GetOAuthConsentsService struct {
+	repo GetOAuthConsentsRepository
+}
+
+func NewGetOAuthConsentsService(repo GetOAuthConsentsRepository) GetOAuthConsentsService {
+	return GetOAuthConsentsService{
+		repo: repo,
+	}
+}
+
+func (g GetOAuthConsentsService) GetConsents(ctx context.Context, idParam string, credentials dto.UserCredentials) ([]oauthdto.OAuthConsentDTO, error) {
+	idParamInt, err := strconv.Atoi(idParam)
+	if err != nil {
+		return nil, &customerrors.IntegerConversionError{}
+	}
+	if credentials.Scopes != nil || (credentials.UserRole != model.ADMIN && credentials.UserRole != model.USER) {
+		return nil, &customerrors.UnauthorizedError{}
+	}
+	if credentials.UserRole == model.USER && idParamInt != credentials.Id {
+		return nil, &customerrors.UnauthorizedError{}
+	}
+
+	consentModels, err := g.repo.GetUserConsents(ctx, idParamInt)
+	if err != nil {
+		return nil, err
+	}
+	consentDtos := []oauthdto.OAuthConsentDTO{}
+	for _, consentModel := range consentModels {
+		consentJson, err := json.Marshal(consentModel.ToJson())
+		if err != nil {
+			return nil, err
+		}
+		var consentDto oauthdto.OAuthConsentDTO
+		err = json.Unmarshal(consentJson, &consentDto)
+		if err != nil {
+			return nil, err
+		}
+		consentDtos = append(consentDtos, consentDto)
+	}
+	return consentDtos, nil
+}
@@ -0,0 +1,229 @@
+package oauthservice
+
+import (
+	oauthdto "1dv027/aad/internal/dto/oauth"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+type TokenRepository interface {
+	GetClient(ctx context.Context, clientId string) (model.OAuthClient, error)
+	ConsumeAuthorizationCode(ctx context.Context, codeHash string, clientId string) (model.OAuthAuthorizationCode, error)
+	CreateRefreshToken(ctx context.Context, token model.OAuthRefreshToken) error
+	ConsumeRefreshToken(ctx context.Context, tokenHash string, clientId string) (model.OAuthRefreshToken, error)
+	GetUserById(ctx context.Context, userId int) (model.User, error)
+}
+
+type TokenCryptographyService interface {
+	GenerateRandomToken(byteLength int) (string, error)
+	HashToken(token string) string
+}
+
+type TokenJwtService interface {
+	GenerateOAuthAccessToken(username string, id int, userType model.UserRole, clientId string, scopes []string) (string, error)
+}
+
+type TokenService struct {
+	repo                 TokenRepository
+	cryptoService        TokenCryptographyService
+	jwtService           TokenJwtService
+	accessTokenLifetime  time.Duration
+	refreshTokenLifetime time.Duration
+}
+
+func NewTokenService(repo TokenRepository, cryptoService TokenCryptographyService, jwtService TokenJwtService, accessTokenLifetime time.Duration) TokenService {
+	return TokenService{
+		repo:                 repo,
+		cryptoService:        cryptoService,
+		jwtService:           jwtService,
+		accessTokenLifetime:  accessTokenLifetime,
+		refreshTokenLifetime: 30 * 24 * time.Hour,
+	}
+}
+
+func (t TokenService) IssueToken(ctx context.Context, request oauthdto.TokenRequestDTO) (oauthdto.TokenResponseDTO, error) {
+	emptyDto := oauthdto.TokenResponseDTO{}
+	client, err := t.authenticateClient(ctx, request)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	grantType := model.OAuthGrantType(request.GrantType)
+	switch grantType {
+	case model.AUTHORIZATION_CODE, model.REFRESH_TOKEN, model.CLIENT_CREDENTIALS:
+	default:
+		return emptyDto, &customerrors.OAuthError{Code: "unsupported_grant_type", Message: "unsupported grant_type"}
+	}
+	if !slices.Contains(client.GrantTypes, grantType) {
+		return emptyDto, &customerrors.OAuthError{Code: "unauthorized_client", Message: "the client may not use the " + request.GrantType + " grant"}
+	}
+
+	switch grantType {
+	case model.AUTHORIZATION_CODE:
+		return t.exchangeAuthorizationCode(ctx, client, request)
+	case model.REFRESH_TOKEN:
+		return t.exchangeRefreshToken(ctx, client, request)
+	default:
+		return t.issueClientCredentialsToken(ctx, client, request)
+	}
+}
+
+// authenticateClient requires the client secret for confidential clients. Public clients are
+// identified by the client_id only and have to rely on pkce.
+func (t TokenService) authenticateClient(ctx context.Context, request oauthdto.TokenRequestDTO) (model.OAuthClient, error) {
+	invalidClientError := &customerrors.OAuthError{Code: "invalid_client", Message: "client authentication failed"}
+	if request.ClientId == "" {
+		return model.OAuthClient{}, invalidClientError
+	}
+	client, err := t.repo.GetClient(ctx, request.ClientId)
+	if err != nil {
+		var clientNotFoundError *customerrors.OAuthClientNotFoundError
+		if errors.As(err, &clientNotFoundError) {
+			return model.OAuthClient{}, invalidClientError
+		}
+		return model.OAuthClient{}, err
+	}
+
+	if client.IsConfidential {
+		secretHash := t.cryptoService.HashToken(request.ClientSecret)
+		if request.ClientSecret == "" || subtle.ConstantTimeCompare([]byte(secretHash), []byte(client.ClientSecretHash)) != 1 {
+			return model.OAuthClient{}, invalidClientError
+		}
+	}
+	return client, nil
+}
+
+func (t TokenService) exchangeAuthorizationCode(ctx context.Context, client model.OAuthClient,
+	request oauthdto.TokenRequestDTO) (oauthdto.TokenResponseDTO, error) {
+	invalidGrantError := &customerrors.OAuthError{Code: "invalid_grant", Message: "invalid, expired or already used authorization code"}
+	if request.Code == "" || request.CodeVerifier == "" {
+		return oauthdto.TokenResponseDTO{}, &customerrors.OAuthError{Code: "invalid_request", Message: "code and code_verifier are required"}
+	}
+
+	// The code is only consumed for the client it was issued to, so other clients cannot burn it.
+	code, err := t.repo.ConsumeAuthorizationCode(ctx, t.cryptoService.HashToken(request.Code), client.ClientId)
+	if err != nil {
+		var codeNotFoundError *customerrors.OAuthCodeNotFoundError
+		if errors.As(err, &codeNotFoundError) {
+			return oauthdto.TokenResponseDTO{}, invalidGrantError
+		}
+		return oauthdto.TokenResponseDTO{}, err
+	}
+
+	// The redirect_uri must be repeated exactly when it was included in the authorization request (RFC 6749 4.1.3).
+	if (code.RedirectUriSupplied && code.RedirectUri != request.RedirectUri) ||
+		(request.RedirectUri != "" && code.RedirectUri != request.RedirectUri) {
+		return oauthdto.TokenResponseDTO{}, invalidGrantError
+	}
+
+	verifierHash := sha256.Sum256([]byte(request.CodeVerifier))
+	codeChallenge := base64.RawURLEncoding.EncodeToString(verifierHash[:])
+	if subtle.ConstantTimeCompare([]byte(codeChallenge), []byte(code.CodeChallenge)) != 1 {
+		return oauthdto.TokenResponseDTO{}, &customerrors.OAuthError{Code: "invalid_grant", Message: "code_verifier does not match the code_challenge"}
+	}
+
+	return t.issueTokens(ctx, client, code.UserId, code.Scopes)
+}
+
+func (t TokenService) exchangeRefreshToken(ctx context.Context, client model.OAuthClient,
+	request oauthdto.TokenRequestDTO) (oauthdto.TokenResponseDTO, error) {
+	if request.RefreshToken == "" {
+		return oauthdto.TokenResponseDTO{}, &customerrors.OAuthError{Code: "invalid_request", Message: "refresh_token is required"}
+	}
+
+	refreshToken, err := t.repo.ConsumeRefreshToken(ctx, t.cryptoService.HashToken(request.RefreshToken), client.ClientId)
+	if err != nil {
+		var refreshTokenNotFoundError *customerrors.OAuthRefreshTokenNotFoundError
+		if errors.As(err, &refreshTokenNotFoundError) {
+			return oauthdto.TokenResponseDTO{}, &customerrors.OAuthError{Code: "invalid_grant", Message: "invalid, expired or revoked refresh token"}
+		}
+		return oauthdto.TokenResponseDTO{}, err
+	}
+
+	// The scope can only be narrowed when refreshing.
+	scopes, err := parseScopes(request.Scope, refreshToken.Scopes)
+	if err != nil {
+		return oauthdto.TokenResponseDTO{}, err
+	}
+	return t.issueTokens(ctx, client, refreshToken.UserId, scopes)
+}
+
+func (t TokenService) issueClientCredentialsToken(ctx context.Context, client model.OAuthClient,
+	request oauthdto.TokenRequestDTO) (oauthdto.TokenResponseDTO, error) {
+	if !client.IsConfidential || client.OwnerUserId == nil {
+		return oauthdto.TokenResponseDTO{}, &customerrors.OAuthError{Code: "unauthorized_client", Message: "the client has no owner account to act as"}
+	}
+	scopes, err := parseScopes(request.Scope, client.Scopes)
+	if err != nil {
+		return oauthdto.TokenResponseDTO{}, err
+	}
+
+	user, err := t.repo.GetUserById(ctx, *client.OwnerUserId)
+	if err != nil {
+		return oauthdto.TokenResponseDTO{}, err
+	}
+	if user.IsSuspended() {
+		return oauthdto.TokenResponseDTO{}, &customerrors.OAuthError{Code: "invalid_grant", Message: "the account is suspended"}
+	}
+	accessToken, err := t.jwtService.GenerateOAuthAccessToken(user.Username, user.Id, model.USER, client.ClientId, scopes)
+	if err != nil {
+		return oauthdto.TokenResponseDTO{}, &customerrors.JwtError{}
+	}
+	return oauthdto.TokenResponseDTO{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(t.accessTokenLifetime.Seconds()),
+		Scope:       strings.Join(scopes, " "),
+	}, nil
+}
+
+// issueTokens issues an access token and a new refresh token for the user.
+func (t TokenService) issueTokens(ctx context.Context, client model.OAuthClient, userId int, scopes []string) (oauthdto.TokenResponseDTO, error) {
+	emptyDto := oauthdto.TokenResponseDTO{}
+	user, err := t.repo.GetUserById(ctx, userId)
+	if err != nil {
+		return emptyDto, err
+	}
+	if user.IsSuspended() {
+		return emptyDto, &customerrors.OAuthError{Code: "invalid_grant", Message: "the account is suspended"}
+	}
+
+	accessToken, err := t.jwtService.GenerateOAuthAccessToken(user.Username, user.Id, model.USER, client.ClientId, scopes)
+	if err != nil {
+		return emptyDto, &customerrors.JwtError{}
+	}
+
+	tokenResponse := oauthdto.TokenResponseDTO{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(t.accessTokenLifetime.Seconds()),
+		Scope:       strings.Join(scopes, " "),
+	}
+
+	if slices.Contains(client.GrantTypes, model.REFRESH_TOKEN) {
+		refreshToken, err := t.cryptoService.GenerateRandomToken(32)
+		if err != nil {
+			return emptyDto, &customerrors.CryptographyError{}
+		}
+		err = t.repo.CreateRefreshToken(ctx, model.OAuthRefreshToken{
+			TokenHash: t.cryptoService.HashToken(refreshToken),
+			ClientId:  client.ClientId,
+			UserId:    user.Id,
+			Scopes:    scopes,
+			ExpiresAt: time.Now().Add(t.refreshTokenLifetime),
+		})
+		if err != nil {
+			return emptyDto, err
+		}
+		tokenResponse.RefreshToken = refreshToken
+	}
+	return tokenResponse, nil
+}
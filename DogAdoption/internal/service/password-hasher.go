@@ -0,0 +1,149 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	BcryptAlgorithm   = "bcrypt"
+	Argon2idAlgorithm = "argon2id"
+)
+
+// PasswordHashingConfig selects the algorithm and parameters for new password hashes.
+// Zero values fall back to the defaults.
+type PasswordHashingConfig struct {
+	Algorithm         string
+	BcryptCost        int
+	Argon2MemoryKiB   uint32
+	Argon2Iterations  uint32
+	Argon2Parallelism uint8
+}
+
+// PasswordHasher hashes passwords with the configured algorithm, and verifies hashes
+// of both algorithms so that the configuration can be changed without locking accounts out.
+type PasswordHasher struct {
+	config PasswordHashingConfig
+}
+
+func NewPasswordHasher(config PasswordHashingConfig) (PasswordHasher, error) {
+	if config.Algorithm == "" {
+		config.Algorithm = BcryptAlgorithm
+	}
+	if config.BcryptCost == 0 {
+		config.BcryptCost = bcrypt.DefaultCost
+	}
+	if config.Argon2MemoryKiB == 0 {
+		config.Argon2MemoryKiB = 64 * 1024
+	}
+	if config.Argon2Iterations == 0 {
+		config.Argon2Iterations = 3
+	}
+	if config.Argon2Parallelism == 0 {
+		config.Argon2Parallelism = 2
+	}
+
+	if config.Algorithm != BcryptAlgorithm && config.Algorithm != Argon2idAlgorithm {
+		return PasswordHasher{}, fmt.Errorf("unknown password hashing algorithm %q", config.Algorithm)
+	}
+	if config.BcryptCost < bcrypt.MinCost || config.BcryptCost > bcrypt.MaxCost {
+		return PasswordHasher{}, fmt.Errorf("bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+	}
+	return PasswordHasher{
+		config: config,
+	}, nil
+}
+
+func (p PasswordHasher) HashPassword(unhashedPassword string) (string, error) {
+	if p.config.Algorithm == Argon2idAlgorithm {
+		return p.hashArgon2id(unhashedPassword)
+	}
+	hashedBytes, err := bcrypt.GenerateFromPassword([]byte(unhashedPassword), p.config.BcryptCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hashedBytes), nil
+}
+
+func (p PasswordHasher) ComparePasswords(hashedPassword, passwordAttempt string) error {
+	if strings.HasPrefix(hashedPassword, "$argon2id$") {
+		params, salt, hash, err := decodeArgon2idHash(hashedPassword)
+		if err != nil {
+			return err
+		}
+		attemptHash := argon2.IDKey([]byte(passwordAttempt), salt, params.Argon2Iterations, params.Argon2MemoryKiB,
+			params.Argon2Parallelism, uint32(len(hash)))
+		if subtle.ConstantTimeCompare(hash, attemptHash) != 1 {
+			return errors.New("password does not match hash")
+		}
+		return nil
+	}
+	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(passwordAttempt))
+}
+
+// NeedsRehash reports if the hash was made with another algorithm or weaker parameters than configured.
+func (p PasswordHasher) NeedsRehash(hashedPassword string) bool {
+	if p.config.Algorithm == Argon2idAlgorithm {
+		params, _, _, err := decodeArgon2idHash(hashedPassword)
+		if err != nil {
+			return true
+		}
+		return params.Argon2MemoryKiB < p.config.Argon2MemoryKiB ||
+			params.Argon2Iterations < p.config.Argon2Iterations ||
+			params.Argon2Parallelism < p.config.Argon2Parallelism
+	}
+
+	cost, err := bcrypt.Cost([]byte(hashedPassword))
+	if err != nil {
+		return true
+	}
+	return cost < p.config.BcryptCost
+}
+
+// hashArgon2id encodes the hash in the PHC string format, $argon2id$v=19$m=..,t=..,p=..$salt$hash.
+func (p PasswordHasher) hashArgon2id(unhashedPassword string) (string, error) {
+	salt := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return "", err
+	}
+	hash := argon2.IDKey([]byte(unhashedPassword), salt, p.config.Argon2Iterations, p.config.Argon2MemoryKiB,
+		p.config.Argon2Parallelism, 32)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, p.config.Argon2MemoryKiB,
+		p.config.Argon2Iterations, p.config.Argon2Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(hash)), nil
+}
+
+func decodeArgon2idHash(hashedPassword string) (PasswordHashingConfig, []byte, []byte, error) {
+	params := PasswordHashingConfig{Algorithm: Argon2idAlgorithm}
+	parts := strings.Split(hashedPassword, "$")
+	if len(parts) != 6 || parts[1] != Argon2idAlgorithm {
+		return params, nil, nil, errors.New("invalid argon2id hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, errors.New("unsupported argon2id version")
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Argon2MemoryKiB, &params.Argon2Iterations,
+		&params.Argon2Parallelism); err != nil {
+		return params, nil, nil, errors.New("invalid argon2id parameters")
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, err
+	}
+	hash, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return params, nil, nil, err
+	}
+	return params, salt, hash, nil
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	_ "embed"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// commonPasswords is an offline list of the most common passwords found in public breaches, one per line.
+//
+//go:embed common-passwords.txt
+var commonPasswords string
+
+type PasswordPolicyConfig struct {
+	MinLength        int
+	MaxLength        int
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireDigit     bool
+	RequireSymbol    bool
+}
+
+type PasswordPolicy struct {
+	config            PasswordPolicyConfig
+	breachedPasswords map[string]struct{}
+}
+
+func NewPasswordPolicy(config PasswordPolicyConfig) PasswordPolicy {
+	if config.MinLength == 0 {
+		config.MinLength = 8
+	}
+	// bcrypt only uses the first 72 bytes of a password.
+	if config.MaxLength == 0 {
+		config.MaxLength = 72
+	}
+
+	breachedPasswords := make(map[string]struct{})
+	for _, line := range strings.Split(commonPasswords, "\n") {
+		password := strings.TrimSpace(line)
+		if password != "" {
+			breachedPasswords[strings.ToLower(password)] = struct{}{}
+		}
+	}
+	return PasswordPolicy{
+		config:            config,
+		breachedPasswords: breachedPasswords,
+	}
+}
+
+// ValidatePassword checks a new password against the policy, and returns a WeakPasswordError
+// listing every rule that is not met.
+func (p PasswordPolicy) ValidatePassword(username, password string) error {
+	var errMsgs []string
+	length := len([]rune(password))
+	if length < p.config.MinLength {
+		errMsgs = append(errMsgs, fmt.Sprintf("password must be at least %d characters", p.config.MinLength))
+	}
+	if length > p.config.MaxLength {
+		errMsgs = append(errMsgs, fmt.Sprintf("password must be at most %d characters", p.config.MaxLength))
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, char := range password {
+		switch {
+		case unicode.IsUpper(char):
+			hasUpper = true
+		case unicode.IsLower(char):
+			hasLower = true
+		case unicode.IsDigit(char):
+			hasDigit = true
+		case unicode.IsPunct(char) || unicode.IsSymbol(char) || unicode.IsSpace(char):
+			hasSymbol = true
+		}
+	}
+	if p.config.RequireUppercase && !hasUpper {
+		errMsgs = append(errMsgs, "password must contain an uppercase letter")
+	}
+	if p.config.RequireLowercase && !hasLower {
+		errMsgs = append(errMsgs, "password must contain a lowercase letter")
+	}
+	if p.config.RequireDigit && !hasDigit {
+		errMsgs = append(errMsgs, "password must contain a digit")
+	}
+	if p.config.RequireSymbol && !hasSymbol {
+		errMsgs = append(errMsgs, "password must contain a symbol")
+	}
+
+	if username != "" && strings.EqualFold(password, username) {
+		errMsgs = append(errMsgs, "password cannot be the same as the username")
+	}
+	if _, isBreached := p.breachedPasswords[strings.ToLower(password)]; isBreached {
+		errMsgs = append(errMsgs, "password is too common and has appeared in data breaches")
+	}
+
+	if len(errMsgs) > 0 {
+		return &customerrors.WeakPasswordError{Message: strings.Join(errMsgs, " + ")}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package reminderservice
+
+import (
+	reminderdto "1dv027/aad/internal/dto/reminder"
+	"1dv027/aad/internal/model"
+	"1dv027/aad/internal/notifier"
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+type PostAdoptionFollowupRepository interface {
+	GetAdoptionsForFollowup(ctx context.Context, from, to time.Time) ([]model.PostAdoptionFollowupReminder, error)
+	MarkReminderSent(ctx context.Context, kind model.ReminderKind, referenceId int, dueOn time.Time) (bool, error)
+	ShelterStaffRepository
+}
+
+type PostAdoptionFollowupReminderService struct {
+	repo          PostAdoptionFollowupRepository
+	notifier      notifier.Notifier
+	linkGenerator ReminderLinkGenerator
+	followupDays  []int
+}
+
+// NewPostAdoptionFollowupReminderService reminds shelters to check in on adoptions the given numbers of
+// days after the adoption, after 14 and 90 days if none are given.
+func NewPostAdoptionFollowupReminderService(repo PostAdoptionFollowupRepository, notifier notifier.Notifier,
+	linkGenerator ReminderLinkGenerator, followupDays []int) PostAdoptionFollowupReminderService {
+	if len(followupDays) == 0 {
+		followupDays = []int{14, 90}
+	}
+	return PostAdoptionFollowupReminderService{
+		repo:          repo,
+		notifier:      notifier,
+		linkGenerator: linkGenerator,
+		followupDays:  followupDays,
+	}
+}
+
+// followupWindow is how long after a follow-up becomes due it is still sent, so that runs missed
+// while the scheduler was down are caught up without reminding of old adoptions.
+const followupWindow = 7 * 24 * time.Hour
+
+// SendReminders sends a post_adoption_followup event to the shelter of every adoption that reached one
+// of the follow-up days. A reminder is recorded before it is sent, so it is sent at most once.
+func (p PostAdoptionFollowupReminderService) SendReminders(ctx context.Context) error {
+	now := time.Now()
+	for _, days := range p.followupDays {
+		adoptedBefore := now.AddDate(0, 0, -days)
+		reminders, err := p.repo.GetAdoptionsForFollowup(ctx, adoptedBefore.Add(-followupWindow), adoptedBefore)
+		if err != nil {
+			return err
+		}
+		for _, reminder := range reminders {
+			dueOn := reminder.AdoptedAt.UTC().AddDate(0, 0, days).Truncate(24 * time.Hour)
+			isNew, err := p.repo.MarkReminderSent(ctx, model.REMINDER_POST_ADOPTION_FOLLOWUP, reminder.StatusChangeId, dueOn)
+			if err != nil {
+				return err
+			}
+			if !isNew {
+				continue
+			}
+
+			reminderDto := p.toDto(reminder, days)
+			err = notifyShelter(ctx, p.repo, p.notifier, reminder.Shelter, model.REMINDER_POST_ADOPTION_FOLLOWUP,
+				fmt.Sprintf("Time to follow up on the adoption of %s", reminder.DogName),
+				fmt.Sprintf("%s was adopted %d days ago. Check in with the new family to see how it is going. See %s",
+					reminder.DogName, days, reminderDto.Links.DogLink),
+				reminderDto)
+			if err != nil {
+				log.Printf("Failed to notify shelter %d of an adoption follow-up: %v", reminder.Shelter.Id, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (p PostAdoptionFollowupReminderService) toDto(reminder model.PostAdoptionFollowupReminder, days int) reminderdto.PostAdoptionFollowupDTO {
+	dogId := fmt.Sprintf("%d", reminder.DogId)
+	return reminderdto.PostAdoptionFollowupDTO{
+		ShelterId:         reminder.Shelter.Id,
+		ShelterName:       reminder.Shelter.Name,
+		DogId:             reminder.DogId,
+		DogName:           reminder.DogName,
+		AdoptedAt:         reminder.AdoptedAt,
+		DaysSinceAdoption: days,
+		Links: reminderdto.PostAdoptionFollowupDtoLinks{
+			DogLink:           p.linkGenerator.GenerateDogLink(dogId),
+			ShelterLink:       p.linkGenerator.GenerateShelterLink(fmt.Sprintf("%d", reminder.Shelter.Id)),
+			StatusHistoryLink: p.linkGenerator.GenerateDogStatusHistoryLink(dogId),
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package reminderservice
+
+import (
+	"1dv027/aad/internal/model"
+	"1dv027/aad/internal/notifier"
+	"context"
+	"fmt"
+	"log"
+)
+
+type ShelterStaffRepository interface {
+	GetShelterStaff(ctx context.Context, shelterId int) ([]model.ShelterStaff, error)
+}
+
+type ReminderLinkGenerator interface {
+	GenerateDogLink(dogId string) string
+	GenerateShelterLink(shelterId string) string
+	GenerateDogMedicalRecordsLink(dogId string) string
+	GenerateDogStatusHistoryLink(dogId string) string
+}
+
+// shelterNotification addresses a notification to the public email of the shelter, then to its
+// registration contact, and otherwise to its account.
+func shelterNotification(shelter model.ReminderShelter, subject, body string) notifier.Notification {
+	notification := notifier.Notification{
+		Recipient: fmt.Sprintf("%s:%s", model.DOGSHELTER, shelter.Username),
+		Subject:   subject,
+		Body:      body,
+	}
+	if shelter.Email != nil {
+		notification.Recipient = *shelter.Email
+	} else if shelter.ContactEmail != nil {
+		notification.Recipient = *shelter.ContactEmail
+	}
+	return notification
+}
+
+// notifyShelter sends the reminder event to the shelter and to each of its staff. Reminders hold medical and
+// adoption details, so they are only sent to the accounts of the shelter that owns the dog.
+func notifyShelter(ctx context.Context, repo ShelterStaffRepository, shelterNotifier notifier.Notifier,
+	shelter model.ReminderShelter, kind model.ReminderKind, subject, body string, payload any) error {
+	notification := shelterNotification(shelter, subject, body)
+	notification.Event = string(kind)
+	notification.Data = payload
+	shelterErr := shelterNotifier.Notify(ctx, notification)
+
+	staff, err := repo.GetShelterStaff(ctx, shelter.Id)
+	if err != nil {
+		return err
+	}
+	for _, member := range staff {
+		notification.Recipient = member.Email
+		err = shelterNotifier.Notify(ctx, notification)
+		if err != nil {
+			log.Printf("Failed to notify staff member %d of shelter %d: %v", member.Id, shelter.Id, err)
+		}
+	}
+	return shelterErr
+}
@@ -0,0 +1,87 @@
+package reminderservice
+
+import (
+	reminderdto "1dv027/aad/internal/dto/reminder"
+	"1dv027/aad/internal/model"
+	"1dv027/aad/internal/notifier"
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+type VaccinationDueRepository interface {
+	GetDueVaccinations(ctx context.Context, from, to time.Time) ([]model.VaccinationDueReminder, error)
+	MarkReminderSent(ctx context.Context, kind model.ReminderKind, referenceId int, dueOn time.Time) (bool, error)
+	ShelterStaffRepository
+}
+
+type VaccinationDueReminderService struct {
+	repo          VaccinationDueRepository
+	notifier      notifier.Notifier
+	linkGenerator ReminderLinkGenerator
+	leadDays      int
+}
+
+// NewVaccinationDueReminderService reminds shelters of vaccinations that are due within leadDays,
+// 7 days if it is zero.
+func NewVaccinationDueReminderService(repo VaccinationDueRepository, notifier notifier.Notifier,
+	linkGenerator ReminderLinkGenerator, leadDays int) VaccinationDueReminderService {
+	if leadDays == 0 {
+		leadDays = 7
+	}
+	return VaccinationDueReminderService{
+		repo:          repo,
+		notifier:      notifier,
+		linkGenerator: linkGenerator,
+		leadDays:      leadDays,
+	}
+}
+
+// SendReminders sends a vaccination_due event to the shelter of every upcoming vaccination that has not
+// been reminded of yet. A reminder is recorded before it is sent, so it is sent at most once.
+func (v VaccinationDueReminderService) SendReminders(ctx context.Context) error {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	reminders, err := v.repo.GetDueVaccinations(ctx, today, today.AddDate(0, 0, v.leadDays))
+	if err != nil {
+		return err
+	}
+	for _, reminder := range reminders {
+		vaccination := reminder.Vaccination
+		isNew, err := v.repo.MarkReminderSent(ctx, model.REMINDER_VACCINATION_DUE, vaccination.Id, *vaccination.NextDueOn)
+		if err != nil {
+			return err
+		}
+		if !isNew {
+			continue
+		}
+
+		reminderDto := v.toDto(reminder)
+		err = notifyShelter(ctx, v.repo, v.notifier, reminder.Shelter, model.REMINDER_VACCINATION_DUE,
+			fmt.Sprintf("%s vaccination due for %s", reminderDto.VaccineType, reminderDto.DogName),
+			fmt.Sprintf("The next %s vaccination of %s is due on %s. See %s",
+				reminderDto.VaccineType, reminderDto.DogName, reminderDto.NextDueOn, reminderDto.Links.MedicalRecordsLink),
+			reminderDto)
+		if err != nil {
+			log.Printf("Failed to notify shelter %d of a due vaccination: %v", reminder.Shelter.Id, err)
+		}
+	}
+	return nil
+}
+
+func (v VaccinationDueReminderService) toDto(reminder model.VaccinationDueReminder) reminderdto.VaccinationDueDTO {
+	dogId := fmt.Sprintf("%d", reminder.Vaccination.DogId)
+	return reminderdto.VaccinationDueDTO{
+		ShelterId:   reminder.Shelter.Id,
+		ShelterName: reminder.Shelter.Name,
+		DogId:       reminder.Vaccination.DogId,
+		DogName:     reminder.DogName,
+		VaccineType: reminder.Vaccination.Title,
+		NextDueOn:   reminder.Vaccination.NextDueOn.Format(model.MEDICAL_DATE_LAYOUT),
+		Links: reminderdto.VaccinationDueDtoLinks{
+			DogLink:            v.linkGenerator.GenerateDogLink(dogId),
+			ShelterLink:        v.linkGenerator.GenerateShelterLink(fmt.Sprintf("%d", reminder.Shelter.Id)),
+			MedicalRecordsLink: v.linkGenerator.GenerateDogMedicalRecordsLink(dogId),
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package userapikeyservice
+
+import (
+	"1dv027/aad/internal/dto"
+	userapikeydto "1dv027/aad/internal/dto/user/api-key"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strconv"
+)
+
+type DeleteUserApiKeyRepository interface {
+	RevokeApiKey(ctx context.Context, userId int, keyId int) (model.ApiKey, error)
+}
+
+type DeleteUserApiKeyService struct {
+	repo DeleteUserApiKeyRepository
+}
+
+func NewDeleteUserApiKeyService(repo DeleteUserApiKeyRepository) DeleteUserApiKeyService {
+	return DeleteUserApiKeyService{
+		repo: repo,
+	}
+}
+
+// RevokeApiKey revokes the api key. Revoked keys are kept so that they can still be listed.
+func (d DeleteUserApiKeyService) RevokeApiKey(ctx context.Context, idParam string, keyIdParam string, user dto.UserCredentials) (userapikeydto.ApiKeyDTO, error) {
+	emptyDto := userapikeydto.ApiKeyDTO{}
+	idParamInt, err := strconv.Atoi(idParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+	keyIdParamInt, err := strconv.Atoi(keyIdParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+
+	if user.Scopes != nil || (user.UserRole != model.ADMIN && user.UserRole != model.USER) {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	if user.UserRole == model.USER && idParamInt != user.Id {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	apiKeyModel, err := d.repo.RevokeApiKey(ctx, idParamInt, keyIdParamInt)
+	if err != nil {
+		return emptyDto, err
+	}
+	return apiKeyModelToDto(apiKeyModel)
+}
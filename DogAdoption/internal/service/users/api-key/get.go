@@ -0,0 +1,54 @@
+package userapikeyservice
+
+import (
+	"1dv027/aad/internal/dto"
+	userapikeydto "1dv027/aad/internal/dto/user/api-key"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strconv"
+)
+
+type GetUserApiKeysRepository interface {
+	GetUserApiKeys(ctx context.Context, userId int) ([]model.ApiKey, error)
+}
+
+type GetUserApiKeysService struct {
+	repo GetUserApiKeysRepository
+}
+
+func NewGetUserApiKeysService(repo GetUserApiKeysRepository) GetUserApiKeysService {
+	return GetUserApiKeysService{
+		repo: repo,
+	}
+}
+
+func (g GetUserApiKeysService) GetApiKeys(ctx context.Context, idParam string, user dto.UserCredentials) ([]userapikeydto.ApiKeyDTO, error) {
+	idParamInt, err := strconv.Atoi(idParam)
+	if err != nil {
+		return nil, &customerrors.IntegerConversionError{}
+	}
+
+	if user.Scopes != nil || (user.UserRole != model.ADMIN && user.UserRole != model.USER) {
+		return nil, &customerrors.UnauthorizedError{}
+	}
+
+	if user.UserRole == model.USER && idParamInt != user.Id {
+		return nil, &customerrors.UnauthorizedError{}
+	}
+
+	apiKeyModels, err := g.repo.GetUserApiKeys(ctx, idParamInt)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeyDtos := []userapikeydto.ApiKeyDTO{}
+	for _, apiKeyModel := range apiKeyModels {
+		apiKeyDto, err := apiKeyModelToDto(apiKeyModel)
+		if err != nil {
+			return nil, err
+		}
+		apiKeyDtos = append(apiKeyDtos, apiKeyDto)
+	}
+	return apiKeyDtos, nil
+}
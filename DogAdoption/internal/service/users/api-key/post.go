@@ -0,0 +1,118 @@
+package userapikeyservice
+
+import (
+	"1dv027/aad/internal/dto"
+	userapikeydto "1dv027/aad/internal/dto/user/api-key"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type PostUserApiKeysRepository interface {
+	CreateApiKey(ctx context.Context, userId int, name, prefix, keyHash string, scopes []string) (model.ApiKey, error)
+}
+
+type PostUserApiKeysCryptographyService interface {
+	GenerateRandomToken(byteLength int) (string, error)
+	HashToken(token string) string
+}
+
+type PostUserApiKeyService struct {
+	repo          PostUserApiKeysRepository
+	cryptoService PostUserApiKeysCryptographyService
+}
+
+func NewPostUserApiKeyService(repo PostUserApiKeysRepository, cryptoService PostUserApiKeysCryptographyService) PostUserApiKeyService {
+	return PostUserApiKeyService{
+		repo:          repo,
+		cryptoService: cryptoService,
+	}
+}
+
+func (p PostUserApiKeyService) CreateApiKey(ctx context.Context, idParam string,
+	user dto.UserCredentials, data userapikeydto.NewApiKeyDTO) (userapikeydto.CreatedApiKeyDTO, error) {
+	emptyDto := userapikeydto.CreatedApiKeyDTO{}
+	idParamInt, err := strconv.Atoi(idParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+
+	// Api keys can only be created by the user, and not with scoped api keys or oauth tokens.
+	if user.UserRole != model.USER || idParamInt != user.Id || user.Scopes != nil {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	name, scopes, err := p.validateNewApiKeyData(data)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	prefixPart, err := p.cryptoService.GenerateRandomToken(6)
+	if err != nil {
+		return emptyDto, &customerrors.CryptographyError{}
+	}
+	secretPart, err := p.cryptoService.GenerateRandomToken(32)
+	if err != nil {
+		return emptyDto, &customerrors.CryptographyError{}
+	}
+	prefix := "dak_" + prefixPart
+	key := prefix + "." + secretPart
+
+	apiKeyModel, err := p.repo.CreateApiKey(ctx, idParamInt, name, prefix, p.cryptoService.HashToken(key), scopes)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	apiKeyDto, err := apiKeyModelToDto(apiKeyModel)
+	if err != nil {
+		return emptyDto, err
+	}
+	return userapikeydto.CreatedApiKeyDTO{
+		ApiKeyDTO: apiKeyDto,
+		Key:       key,
+	}, nil
+}
+
+func (p PostUserApiKeyService) validateNewApiKeyData(data userapikeydto.NewApiKeyDTO) (string, []string, error) {
+	if data.Name == nil || data.Scopes == nil {
+		return "", nil, &customerrors.InvalidApiKeyDataError{Message: "name and scopes are required"}
+	}
+
+	name := strings.TrimSpace(*data.Name)
+	if name == "" || len(name) > 100 {
+		return "", nil, &customerrors.InvalidApiKeyDataError{Message: "name must be between 1 and 100 characters"}
+	}
+
+	if len(*data.Scopes) == 0 {
+		return "", nil, &customerrors.InvalidApiKeyDataError{Message: "at least one scope is required"}
+	}
+	scopes := []string{}
+	for _, scope := range *data.Scopes {
+		if !slices.Contains(model.AccessScopes, scope) {
+			return "", nil, &customerrors.InvalidApiKeyDataError{
+				Message: "invalid scope: " + scope + ". valid scopes are " + strings.Join(model.AccessScopes, ", "),
+			}
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return name, scopes, nil
+}
+
+func apiKeyModelToDto(apiKeyModel model.ApiKey) (userapikeydto.ApiKeyDTO, error) {
+	apiKeyJson, err := json.Marshal(apiKeyModel.ToJson())
+	if err != nil {
+		return userapikeydto.ApiKeyDTO{}, err
+	}
+	var apiKeyDto userapikeydto.ApiKeyDTO
+	err = json.Unmarshal(apiKeyJson, &apiKeyDto)
+	if err != nil {
+		return userapikeydto.ApiKeyDTO{}, err
+	}
+	return apiKeyDto, nil
+}
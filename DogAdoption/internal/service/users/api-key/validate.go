@@ -0,0 +1,43 @@
+package userapikeyservice
+
+import (
+	"1dv027/aad/internal/dto"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type ValidateApiKeyRepository interface {
+	GetApiKeyOwner(ctx context.Context, keyHash string) (model.ApiKey, model.User, error)
+}
+
+type ValidateApiKeyCryptographyService interface {
+	HashToken(token string) string
+}
+
+type ValidateApiKeyService struct {
+	repo          ValidateApiKeyRepository
+	cryptoService ValidateApiKeyCryptographyService
+}
+
+func NewValidateApiKeyService(repo ValidateApiKeyRepository, cryptoService ValidateApiKeyCryptographyService) ValidateApiKeyService {
+	return ValidateApiKeyService{
+		repo:          repo,
+		cryptoService: cryptoService,
+	}
+}
+
+// ValidateApiKey maps an active api key to the credentials of the user owning it,
+// restricted to the scopes of the key.
+func (v ValidateApiKeyService) ValidateApiKey(ctx context.Context, key string) (dto.UserCredentials, error) {
+	apiKey, user, err := v.repo.GetApiKeyOwner(ctx, v.cryptoService.HashToken(key))
+	if err != nil {
+		return dto.UserCredentials{}, err
+	}
+	return dto.UserCredentials{
+		Username: user.Username,
+		UserRole: model.USER,
+		Id:       user.Id,
+		ApiKeyId: apiKey.Id,
+		Scopes:   apiKey.Scopes,
+	}, nil
+}
@@ -22,12 +22,19 @@ func NewDeleteUsersService(repo DeleteUsersRepository) DeleteUsersService {
 	}
 }
 
+// DeleteUser erases the account. The user is anonymized rather than removed, so records that
+// must be kept stay intact without holding personal data.
 func (d DeleteUsersService) DeleteUser(ctx context.Context, idParam string, user dto.UserCredentials) error {
 	idParamInt, err := strconv.Atoi(idParam)
 	if err != nil {
 		return &customerrors.IntegerConversionError{}
 	}
 
+	// Accounts can not be deleted with scoped api keys or oauth tokens.
+	if user.Scopes != nil {
+		return &customerrors.UnauthorizedError{}
+	}
+
 	if user.UserRole == model.ADMIN {
 		err := d.repo.DeleteUser(ctx, idParamInt)
 		if err != nil {
@@ -0,0 +1,50 @@
+package useremailverificationservice
+
+import (
+	"1dv027/aad/internal/dto"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strconv"
+)
+
+type ResendEmailVerificationRepository interface {
+	GetUserById(ctx context.Context, userId int) (model.User, error)
+}
+
+type VerificationEmailSender interface {
+	SendVerificationEmail(ctx context.Context, user model.User) error
+}
+
+type ResendEmailVerificationService struct {
+	repo   ResendEmailVerificationRepository
+	sender VerificationEmailSender
+}
+
+func NewResendEmailVerificationService(repo ResendEmailVerificationRepository, sender VerificationEmailSender) ResendEmailVerificationService {
+	return ResendEmailVerificationService{
+		repo:   repo,
+		sender: sender,
+	}
+}
+
+// ResendVerificationEmail sends a new verification email, which invalidates earlier tokens.
+// Nothing is sent if the email is already verified.
+func (r ResendEmailVerificationService) ResendVerificationEmail(ctx context.Context, idParam string, credentials dto.UserCredentials) error {
+	idParamInt, err := strconv.Atoi(idParam)
+	if err != nil {
+		return &customerrors.IntegerConversionError{}
+	}
+	if credentials.Scopes != nil || credentials.UserRole != model.USER || credentials.Id != idParamInt {
+		return &customerrors.UnauthorizedError{}
+	}
+
+	user, err := r.repo.GetUserById(ctx, idParamInt)
+	if err != nil {
+		return err
+	}
+	if user.IsEmailVerified() {
+		return nil
+	}
+	return r.sender.SendVerificationEmail(ctx, user)
+}
@@ -0,0 +1,69 @@
+package useremailverificationservice
+
+import (
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/mailer"
+	"1dv027/aad/internal/model"
+	"context"
+	"fmt"
+	"time"
+)
+
+type EmailVerificationSenderRepository interface {
+	CreateEmailVerificationToken(ctx context.Context, token model.EmailVerificationToken) error
+}
+
+type EmailVerificationCryptographyService interface {
+	GenerateRandomToken(byteLength int) (string, error)
+	HashToken(token string) string
+}
+
+type EmailVerificationMailer interface {
+	Send(ctx context.Context, mail mailer.Mail) error
+}
+
+// EmailVerificationSender creates verification tokens and mails them to the pending email of a user.
+type EmailVerificationSender struct {
+	repo          EmailVerificationSenderRepository
+	cryptoService EmailVerificationCryptographyService
+	mailer        EmailVerificationMailer
+	tokenLifetime time.Duration
+}
+
+func NewEmailVerificationSender(repo EmailVerificationSenderRepository, cryptoService EmailVerificationCryptographyService,
+	mailer EmailVerificationMailer) EmailVerificationSender {
+	return EmailVerificationSender{
+		repo:          repo,
+		cryptoService: cryptoService,
+		mailer:        mailer,
+		tokenLifetime: 24 * time.Hour,
+	}
+}
+
+func (e EmailVerificationSender) SendVerificationEmail(ctx context.Context, user model.User) error {
+	if user.Email == nil {
+		return &customerrors.InvalidNewUserDataError{Message: "user has no email"}
+	}
+
+	token, err := e.cryptoService.GenerateRandomToken(32)
+	if err != nil {
+		return &customerrors.CryptographyError{}
+	}
+	err = e.repo.CreateEmailVerificationToken(ctx, model.EmailVerificationToken{
+		TokenHash: e.cryptoService.HashToken(token),
+		UserId:    user.Id,
+		Email:     *user.Email,
+		ExpiresAt: time.Now().Add(e.tokenLifetime),
+	})
+	if err != nil {
+		return err
+	}
+
+	return e.mailer.Send(ctx, mailer.Mail{
+		To:      *user.Email,
+		Subject: "Verify your email",
+		Body: fmt.Sprintf("Hi %s,\n\nVerify your email by sending the token below to POST /users/email-verification "+
+			"within %d hours.\n\n%s\n\nIf you did not create an account, you can ignore this email.\n",
+			user.Username, int(e.tokenLifetime.Hours()), token),
+	})
+}
@@ -0,0 +1,36 @@
+package useremailverificationservice
+
+import (
+	userdto "1dv027/aad/internal/dto/user"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type VerifyEmailRepository interface {
+	VerifyEmail(ctx context.Context, tokenHash string) (model.EmailVerificationToken, error)
+}
+
+type VerifyEmailCryptographyService interface {
+	HashToken(token string) string
+}
+
+type VerifyEmailService struct {
+	repo          VerifyEmailRepository
+	cryptoService VerifyEmailCryptographyService
+}
+
+func NewVerifyEmailService(repo VerifyEmailRepository, cryptoService VerifyEmailCryptographyService) VerifyEmailService {
+	return VerifyEmailService{
+		repo:          repo,
+		cryptoService: cryptoService,
+	}
+}
+
+func (v VerifyEmailService) VerifyEmail(ctx context.Context, data userdto.VerifyEmailDTO) error {
+	if data.Token == nil || *data.Token == "" {
+		return &customerrors.EmailVerificationTokenNotFoundError{Message: "token is required"}
+	}
+	_, err := v.repo.VerifyEmail(ctx, v.cryptoService.HashToken(*data.Token))
+	return err
+}
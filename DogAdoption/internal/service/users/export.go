@@ -0,0 +1,161 @@
+package usersservice
+
+import (
+	"1dv027/aad/internal/dto"
+	oauthdto "1dv027/aad/internal/dto/oauth"
+	userdto "1dv027/aad/internal/dto/user"
+	userapikeydto "1dv027/aad/internal/dto/user/api-key"
+	userfavoritedto "1dv027/aad/internal/dto/user/favorite"
+	usernotificationdto "1dv027/aad/internal/dto/user/notification"
+	usersavedsearchdto "1dv027/aad/internal/dto/user/saved-search"
+	userwebhookdto "1dv027/aad/internal/dto/user/webhook"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+type ExportUsersRepository interface {
+	GetUserExport(ctx context.Context, userId int) (userdto.UserExportQueryResponseDTO, error)
+}
+
+type ExportUsersLinkGenerator interface {
+	UserLinksGenerator
+	GenerateDogLink(dogId string) string
+	GenerateUserSavedSearchLink(userId, searchId string) string
+	GenerateDogsSearchLink(query string) string
+	GenerateUserNotificationReadLink(userId, notificationId string) string
+}
+
+type ExportUsersService struct {
+	repo          ExportUsersRepository
+	linkGenerator ExportUsersLinkGenerator
+}
+
+func NewExportUsersService(repo ExportUsersRepository, linkGenerator ExportUsersLinkGenerator) ExportUsersService {
+	return ExportUsersService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// ExportUser returns everything held about the user. Only the user itself and admins can export,
+// and not with scoped api keys or oauth tokens.
+func (e ExportUsersService) ExportUser(ctx context.Context, idParam string, credentials dto.UserCredentials) (userdto.UserExportDTO, error) {
+	emptyDto := userdto.UserExportDTO{}
+	idParamInt, err := strconv.Atoi(idParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+	if credentials.Scopes != nil {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+	if credentials.UserRole != model.ADMIN && (credentials.UserRole != model.USER || credentials.Id != idParamInt) {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	exportData, err := e.repo.GetUserExport(ctx, idParamInt)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	// The profile is exported as the user sees it, regardless of who requests the export.
+	profile, err := toUserDto(exportData.User, e.linkGenerator, dto.UserCredentials{UserRole: model.USER, Id: exportData.User.Id})
+	if err != nil {
+		return emptyDto, err
+	}
+	export := userdto.UserExportDTO{
+		ExportedAt:    time.Now().UTC(),
+		Profile:       profile,
+		ApiKeys:       []userapikeydto.ApiKeyDTO{},
+		OAuthClients:  []oauthdto.OAuthClientDTO{},
+		OAuthConsents: []oauthdto.OAuthConsentDTO{},
+		MfaEnabled:    exportData.MfaEnrollment != nil && exportData.MfaEnrollment.IsEnabled,
+		Favorites:     []userfavoritedto.FavoriteDTO{},
+		SavedSearches: []usersavedsearchdto.SavedSearchDTO{},
+		Notifications: []usernotificationdto.NotificationDTO{},
+	}
+
+	if exportData.Webhook != nil {
+		var webhookDto userwebhookdto.UserWebhookDTO
+		err = convertModelJson(exportData.Webhook.ToJson(), &webhookDto)
+		if err != nil {
+			return emptyDto, err
+		}
+		export.Webhook = &webhookDto
+	}
+	for _, apiKey := range exportData.ApiKeys {
+		var apiKeyDto userapikeydto.ApiKeyDTO
+		err = convertModelJson(apiKey.ToJson(), &apiKeyDto)
+		if err != nil {
+			return emptyDto, err
+		}
+		export.ApiKeys = append(export.ApiKeys, apiKeyDto)
+	}
+	for _, client := range exportData.OAuthClients {
+		var clientDto oauthdto.OAuthClientDTO
+		err = convertModelJson(client.ToJson(), &clientDto)
+		if err != nil {
+			return emptyDto, err
+		}
+		export.OAuthClients = append(export.OAuthClients, clientDto)
+	}
+	for _, consent := range exportData.OAuthConsents {
+		var consentDto oauthdto.OAuthConsentDTO
+		err = convertModelJson(consent.ToJson(), &consentDto)
+		if err != nil {
+			return emptyDto, err
+		}
+		export.OAuthConsents = append(export.OAuthConsents, consentDto)
+	}
+	for _, favorite := range exportData.Favorites {
+		export.Favorites = append(export.Favorites, userfavoritedto.FavoriteDTO{
+			DogId:       favorite.DogId,
+			FavoritedAt: favorite.CreatedAt,
+			DogLink:     e.linkGenerator.GenerateDogLink(fmt.Sprintf("%d", favorite.DogId)),
+		})
+	}
+	for _, savedSearch := range exportData.SavedSearches {
+		var savedSearchDto usersavedsearchdto.SavedSearchDTO
+		err = convertModelJson(savedSearch.ToJson(), &savedSearchDto)
+		if err != nil {
+			return emptyDto, err
+		}
+		savedSearchDto.Links = usersavedsearchdto.SavedSearchLinksDTO{
+			SelfLink: e.linkGenerator.GenerateUserSavedSearchLink(fmt.Sprintf("%d", savedSearch.UserId),
+				fmt.Sprintf("%d", savedSearch.Id)),
+			DogsLink: e.linkGenerator.GenerateDogsSearchLink(savedSearch.Query),
+		}
+		export.SavedSearches = append(export.SavedSearches, savedSearchDto)
+	}
+	for _, notification := range exportData.Notifications {
+		var notificationDto usernotificationdto.NotificationDTO
+		err = convertModelJson(notification.ToJson(), &notificationDto)
+		if err != nil {
+			return emptyDto, err
+		}
+		if notificationDto.Data == nil {
+			notificationDto.Data = map[string]any{}
+		}
+		notificationDto.Links = usernotificationdto.NotificationLinksDTO{
+			ReadLink: e.linkGenerator.GenerateUserNotificationReadLink(fmt.Sprintf("%d", notification.UserId),
+				fmt.Sprintf("%d", notification.Id)),
+		}
+		export.Notifications = append(export.Notifications, notificationDto)
+	}
+
+	return export, nil
+}
+
+// convertModelJson fills the dto from the json of a model. Fields the dto does not have,
+// such as secrets and hashes, are dropped.
+func convertModelJson(modelJson map[string]any, target any) error {
+	jsonBytes, err := json.Marshal(modelJson)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(jsonBytes, target)
+}
@@ -0,0 +1,34 @@
+package userfavoriteservice
+
+import (
+	"1dv027/aad/internal/dto"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"strconv"
+)
+
+type RemoveFavoriteRepository interface {
+	RemoveFavorite(ctx context.Context, userId int, dogId int) error
+}
+
+type RemoveFavoriteService struct {
+	repo RemoveFavoriteRepository
+}
+
+func NewRemoveFavoriteService(repo RemoveFavoriteRepository) RemoveFavoriteService {
+	return RemoveFavoriteService{
+		repo: repo,
+	}
+}
+
+func (r RemoveFavoriteService) RemoveFavorite(ctx context.Context, idParam, dogIdParam string, credentials dto.UserCredentials) error {
+	userId, err := parseFavoriteOwner(idParam, credentials, false)
+	if err != nil {
+		return err
+	}
+	dogId, err := strconv.Atoi(dogIdParam)
+	if err != nil {
+		return &customerrors.IntegerConversionError{}
+	}
+	return r.repo.RemoveFavorite(ctx, userId, dogId)
+}
@@ -0,0 +1,70 @@
+package userfavoriteservice
+
+import (
+	"1dv027/aad/internal/dto"
+	dogdto "1dv027/aad/internal/dto/dog"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+type FavoriteDogLinkGenerator interface {
+	GenerateDogLink(dogId string) string
+	GenerateShelterLink(shelterId string) string
+	GenerateDogTransfersLink(dogId string) string
+	GenerateDogStatusHistoryLink(dogId string) string
+	GenerateDogPhotosLink(dogId string) string
+	GenerateDogMedicalRecordsLink(dogId string) string
+	GenerateDogPhotoFileLink(dogId, photoId, size string) string
+	GenerateBreedLink(breedId string) string
+}
+
+func toDogDto(dog model.Dog, linkGenerator FavoriteDogLinkGenerator) (dogdto.DogDTO, error) {
+	dogJson, err := json.Marshal(dog.ToJson())
+	if err != nil {
+		return dogdto.DogDTO{}, err
+	}
+	var dogDto dogdto.DogDTO
+	err = json.Unmarshal(dogJson, &dogDto)
+	if err != nil {
+		return dogdto.DogDTO{}, err
+	}
+	dogId := fmt.Sprintf("%d", dog.Id)
+	dogDto.Links = dogdto.DogLinksDTO{
+		ShelterLink:        linkGenerator.GenerateShelterLink(fmt.Sprintf("%d", dog.ShelterId)),
+		SelfLink:           linkGenerator.GenerateDogLink(dogId),
+		TransfersLink:      linkGenerator.GenerateDogTransfersLink(dogId),
+		StatusHistoryLink:  linkGenerator.GenerateDogStatusHistoryLink(dogId),
+		PhotosLink:         linkGenerator.GenerateDogPhotosLink(dogId),
+		MedicalRecordsLink: linkGenerator.GenerateDogMedicalRecordsLink(dogId),
+	}
+	if dog.PrimaryPhotoId != nil {
+		dogDto.Links.PrimaryPhotoLink = linkGenerator.GenerateDogPhotoFileLink(dogId,
+			fmt.Sprintf("%d", *dog.PrimaryPhotoId), string(model.PHOTO_MEDIUM))
+	}
+	if dog.PrimaryBreedId != nil {
+		dogDto.Links.PrimaryBreedLink = linkGenerator.GenerateBreedLink(fmt.Sprintf("%d", *dog.PrimaryBreedId))
+	}
+	if dog.SecondaryBreedId != nil {
+		dogDto.Links.SecondaryBreedLink = linkGenerator.GenerateBreedLink(fmt.Sprintf("%d", *dog.SecondaryBreedId))
+	}
+	return dogDto, nil
+}
+
+// parseFavoriteOwner returns the id of the user whose favorites are requested. Users can only manage their
+// own favorites, and admins can read the favorites of any user.
+func parseFavoriteOwner(idParam string, credentials dto.UserCredentials, allowAdmin bool) (int, error) {
+	userId, err := strconv.Atoi(idParam)
+	if err != nil {
+		return 0, &customerrors.IntegerConversionError{}
+	}
+	if credentials.UserRole == model.ADMIN && allowAdmin {
+		return userId, nil
+	}
+	if credentials.UserRole != model.USER || credentials.Id != userId {
+		return 0, &customerrors.UnauthorizedError{}
+	}
+	return userId, nil
+}
@@ -0,0 +1,58 @@
+package userfavoriteservice
+
+import (
+	"1dv027/aad/internal/dto"
+	dogdto "1dv027/aad/internal/dto/dog"
+	"context"
+	"fmt"
+)
+
+type GetFavoritesRepository interface {
+	GetFavoriteDogs(ctx context.Context, userId int, queryParams dto.QueryParams) (dogdto.GetDogsQueryResponseDTO, error)
+}
+
+type GetFavoritesLinkGenerator interface {
+	FavoriteDogLinkGenerator
+	GeneratePaginationLinks(totalItems int, queryParams dto.QueryParams, apiPath string) dto.PaginationLinksDTO
+}
+
+type GetFavoritesService struct {
+	repo          GetFavoritesRepository
+	linkGenerator GetFavoritesLinkGenerator
+}
+
+func NewGetFavoritesService(repo GetFavoritesRepository, linkGenerator GetFavoritesLinkGenerator) GetFavoritesService {
+	return GetFavoritesService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// GetFavorites returns a page of the favorite dogs of the user, filtered by the dog filters of the query params.
+func (g GetFavoritesService) GetFavorites(ctx context.Context, idParam string, credentials dto.UserCredentials,
+	queryParams dto.QueryParams) (dogdto.DogsAndPaginationLinksDTO, error) {
+	emptyDto := dogdto.DogsAndPaginationLinksDTO{}
+	userId, err := parseFavoriteOwner(idParam, credentials, true)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	dogsResult, err := g.repo.GetFavoriteDogs(ctx, userId, queryParams)
+	if err != nil {
+		return emptyDto, err
+	}
+	dogs := []dogdto.DogDTO{}
+	for _, dog := range dogsResult.Dogs {
+		dogDto, err := toDogDto(dog, g.linkGenerator)
+		if err != nil {
+			return emptyDto, err
+		}
+		dogs = append(dogs, dogDto)
+	}
+	paginationLinks := g.linkGenerator.GeneratePaginationLinks(dogsResult.TotalAmountAvailable, queryParams,
+		fmt.Sprintf("/users/%d/favorites", userId))
+	return dogdto.DogsAndPaginationLinksDTO{
+		Dogs:            dogs,
+		PaginationLinks: paginationLinks,
+	}, nil
+}
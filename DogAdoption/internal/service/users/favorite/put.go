@@ -0,0 +1,35 @@
+package userfavoriteservice
+
+import (
+	"1dv027/aad/internal/dto"
+	customerrors "1dv027/aad/internal/errors"
+	"context"
+	"strconv"
+)
+
+type AddFavoriteRepository interface {
+	AddFavorite(ctx context.Context, userId int, dogId int) error
+}
+
+type AddFavoriteService struct {
+	repo AddFavoriteRepository
+}
+
+func NewAddFavoriteService(repo AddFavoriteRepository) AddFavoriteService {
+	return AddFavoriteService{
+		repo: repo,
+	}
+}
+
+// AddFavorite saves the dog to the favorites of the user. Adding a favorite again changes nothing.
+func (a AddFavoriteService) AddFavorite(ctx context.Context, idParam, dogIdParam string, credentials dto.UserCredentials) error {
+	userId, err := parseFavoriteOwner(idParam, credentials, false)
+	if err != nil {
+		return err
+	}
+	dogId, err := strconv.Atoi(dogIdParam)
+	if err != nil {
+		return &customerrors.IntegerConversionError{}
+	}
+	return a.repo.AddFavorite(ctx, userId, dogId)
+}
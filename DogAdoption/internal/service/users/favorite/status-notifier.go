@@ -0,0 +1,79 @@
+package userfavoriteservice
+
+import (
+	dogdto "1dv027/aad/internal/dto/dog"
+	userfavoritedto "1dv027/aad/internal/dto/user/favorite"
+	"1dv027/aad/internal/model"
+	"1dv027/aad/internal/notifier"
+	"context"
+	"fmt"
+	"log"
+)
+
+type FavoriteStatusNotifierRepository interface {
+	GetFavoritingUsers(ctx context.Context, dogId int) ([]model.User, error)
+}
+
+type FavoriteStatusChangedWebhookDispatcher interface {
+	DispatchFavoriteStatusChangedWebhook(ctx context.Context, userIds []int, statusChange userfavoritedto.FavoriteStatusChangedDTO)
+}
+
+// FavoriteStatusNotifier tells the users that have a dog as a favorite when its status changes, with the
+// favorite_status_changed webhook, which also adds it to their inbox, and a notification.
+type FavoriteStatusNotifier struct {
+	repo              FavoriteStatusNotifierRepository
+	webhookDispatcher FavoriteStatusChangedWebhookDispatcher
+	notifier          notifier.Notifier
+}
+
+func NewFavoriteStatusNotifier(repo FavoriteStatusNotifierRepository, webhookDispatcher FavoriteStatusChangedWebhookDispatcher,
+	notifier notifier.Notifier) FavoriteStatusNotifier {
+	return FavoriteStatusNotifier{
+		repo:              repo,
+		webhookDispatcher: webhookDispatcher,
+		notifier:          notifier,
+	}
+}
+
+// NotifyDogStatusChanged is called after the status change is saved, so failures are only logged.
+func (f FavoriteStatusNotifier) NotifyDogStatusChanged(ctx context.Context, dog dogdto.DogDTO, previousStatus model.DogStatus) {
+	users, err := f.repo.GetFavoritingUsers(ctx, dog.Id)
+	if err != nil {
+		log.Printf("Failed to load the users following dog %d: %v", dog.Id, err)
+		return
+	}
+	if len(users) == 0 {
+		return
+	}
+
+	userIds := make([]int, 0, len(users))
+	for _, user := range users {
+		userIds = append(userIds, user.Id)
+	}
+	statusChange := userfavoritedto.FavoriteStatusChangedDTO{
+		Dog:            dog,
+		PreviousStatus: string(previousStatus),
+		Status:         dog.Status,
+		ChangedAt:      dog.StatusChangedAt,
+	}
+	f.webhookDispatcher.DispatchFavoriteStatusChangedWebhook(ctx, userIds, statusChange)
+
+	subject := fmt.Sprintf("%s is now %s", dog.Name, model.DogStatus(dog.Status).Describe())
+	body := fmt.Sprintf("%s, one of your favorite dogs, changed from %s to %s. See %s",
+		dog.Name, previousStatus.Describe(), model.DogStatus(dog.Status).Describe(), dog.Links.SelfLink)
+
+	for _, user := range users {
+		notification := notifier.Notification{
+			Recipient: fmt.Sprintf("%s:%s", model.USER, user.Username),
+			Subject:   subject,
+			Body:      body,
+		}
+		if user.IsEmailVerified() {
+			notification.Recipient = *user.Email
+		}
+		err = f.notifier.Notify(ctx, notification)
+		if err != nil {
+			log.Printf("Failed to notify user %d of a favorite status change: %v", user.Id, err)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package usersservice
+
+import (
+	"1dv027/aad/internal/dto"
+	userdto "1dv027/aad/internal/dto/user"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strconv"
+)
+
+type GetUserByIdRepository interface {
+	GetUserById(ctx context.Context, userId int) (model.User, error)
+}
+
+type GetUserByIdService struct {
+	repo          GetUserByIdRepository
+	linkGenerator UserLinksGenerator
+}
+
+func NewGetUserByIdService(repo GetUserByIdRepository, linkGenerator UserLinksGenerator) GetUserByIdService {
+	return GetUserByIdService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// GetUserById returns the profile of a user. Users can only read their own profile,
+// dog shelters can read the adopter profile of any user.
+func (g GetUserByIdService) GetUserById(ctx context.Context, idParam string, credentials dto.UserCredentials) (userdto.UserDTO, error) {
+	emptyDto := userdto.UserDTO{}
+	idParamInt, err := strconv.Atoi(idParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+
+	if credentials.UserRole == model.USER && credentials.Id != idParamInt {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	user, err := g.repo.GetUserById(ctx, idParamInt)
+	if err != nil {
+		return emptyDto, err
+	}
+	return toUserDto(user, g.linkGenerator, credentials)
+}
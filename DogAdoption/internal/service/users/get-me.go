@@ -6,7 +6,6 @@ import (
 	customerrors "1dv027/aad/internal/errors"
 	"1dv027/aad/internal/model"
 	"context"
-	"encoding/json"
 )
 
 type GetUsersMeRepository interface {
@@ -14,12 +13,14 @@ type GetUsersMeRepository interface {
 }
 
 type GetUsersMeService struct {
-	repo GetUsersMeRepository
+	repo          GetUsersMeRepository
+	linkGenerator UserLinksGenerator
 }
 
-func NewGetUsersMeService(repo GetUsersMeRepository) GetUsersMeService {
+func NewGetUsersMeService(repo GetUsersMeRepository, linkGenerator UserLinksGenerator) GetUsersMeService {
 	return GetUsersMeService{
-		repo: repo,
+		repo:          repo,
+		linkGenerator: linkGenerator,
 	}
 }
 
@@ -33,15 +34,5 @@ func (g GetUsersMeService) GetAuthenticatedUser(ctx context.Context, user dto.Us
 		return emptyDto, err
 	}
 
-	userJson, err := json.Marshal(userModel.ToJson())
-	if err != nil {
-		return emptyDto, err
-	}
-	var userDto userdto.UserDTO
-	err = json.Unmarshal(userJson, &userDto)
-	if err != nil {
-		return emptyDto, err
-	}
-
-	return userDto, nil
+	return toUserDto(userModel, g.linkGenerator, user)
 }
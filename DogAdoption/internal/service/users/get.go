@@ -0,0 +1,58 @@
+package usersservice
+
+import (
+	"1dv027/aad/internal/dto"
+	userdto "1dv027/aad/internal/dto/user"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type GetUsersRepository interface {
+	GetUsers(ctx context.Context, queryParams dto.QueryParams) (userdto.GetUsersQueryResponseDTO, error)
+}
+
+type GetUsersLinkGenerator interface {
+	UserLinksGenerator
+	GeneratePaginationLinks(totalItems int, queryParams dto.QueryParams, apiPath string) dto.PaginationLinksDTO
+}
+
+type GetUsersService struct {
+	repo          GetUsersRepository
+	linkGenerator GetUsersLinkGenerator
+}
+
+func NewGetUsersService(repo GetUsersRepository, linkGenerator GetUsersLinkGenerator) GetUsersService {
+	return GetUsersService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// GetUsers lists users for admins, filtered by username prefix and account status.
+func (g GetUsersService) GetUsers(ctx context.Context, credentials dto.UserCredentials,
+	queryParams dto.QueryParams) (userdto.UsersAndPaginationLinksDTO, error) {
+	emptyDto := userdto.UsersAndPaginationLinksDTO{}
+	if credentials.UserRole != model.ADMIN {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	usersResult, err := g.repo.GetUsers(ctx, queryParams)
+	if err != nil {
+		return emptyDto, err
+	}
+	userDtoSlice := []userdto.UserDTO{}
+	for _, user := range usersResult.Users {
+		userDto, err := toUserDto(user, g.linkGenerator, credentials)
+		if err != nil {
+			return emptyDto, err
+		}
+		userDtoSlice = append(userDtoSlice, userDto)
+	}
+
+	paginationLinks := g.linkGenerator.GeneratePaginationLinks(usersResult.TotalAmountAvailable, queryParams, "/users")
+	return userdto.UsersAndPaginationLinksDTO{
+		UserData:        userDtoSlice,
+		PaginationLinks: paginationLinks,
+	}, nil
+}
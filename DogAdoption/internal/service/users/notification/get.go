@@ -0,0 +1,69 @@
+package usernotificationservice
+
+import (
+	"1dv027/aad/internal/dto"
+	usernotificationdto "1dv027/aad/internal/dto/user/notification"
+	"context"
+	"fmt"
+)
+
+type GetNotificationsRepository interface {
+	GetUserNotifications(ctx context.Context, userId int, queryParams dto.QueryParams) (usernotificationdto.GetNotificationsQueryResponseDTO, error)
+	GetUnreadNotificationCount(ctx context.Context, userId int) (int, error)
+}
+
+type GetNotificationsLinkGenerator interface {
+	NotificationLinkGenerator
+	GeneratePaginationLinks(totalItems int, queryParams dto.QueryParams, apiPath string) dto.PaginationLinksDTO
+}
+
+type GetNotificationsService struct {
+	repo          GetNotificationsRepository
+	linkGenerator GetNotificationsLinkGenerator
+}
+
+func NewGetNotificationsService(repo GetNotificationsRepository, linkGenerator GetNotificationsLinkGenerator) GetNotificationsService {
+	return GetNotificationsService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// GetNotifications returns a page of the inbox of the user, newest first.
+func (g GetNotificationsService) GetNotifications(ctx context.Context, idParam string, credentials dto.UserCredentials,
+	queryParams dto.QueryParams) (usernotificationdto.NotificationsAndPaginationLinksDTO, error) {
+	emptyDto := usernotificationdto.NotificationsAndPaginationLinksDTO{}
+	userId, err := parseNotificationOwner(idParam, credentials)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	result, err := g.repo.GetUserNotifications(ctx, userId, queryParams)
+	if err != nil {
+		return emptyDto, err
+	}
+	notifications := []usernotificationdto.NotificationDTO{}
+	for _, notification := range result.Notifications {
+		notifications = append(notifications, toNotificationDto(notification, g.linkGenerator))
+	}
+	paginationLinks := g.linkGenerator.GeneratePaginationLinks(result.TotalAmountAvailable, queryParams,
+		fmt.Sprintf("/users/%d/notifications", userId))
+	return usernotificationdto.NotificationsAndPaginationLinksDTO{
+		Notifications:   notifications,
+		UnreadCount:     result.UnreadCount,
+		PaginationLinks: paginationLinks,
+	}, nil
+}
+
+func (g GetNotificationsService) GetUnreadCount(ctx context.Context, idParam string,
+	credentials dto.UserCredentials) (usernotificationdto.UnreadCountDTO, error) {
+	userId, err := parseNotificationOwner(idParam, credentials)
+	if err != nil {
+		return usernotificationdto.UnreadCountDTO{}, err
+	}
+	unreadCount, err := g.repo.GetUnreadNotificationCount(ctx, userId)
+	if err != nil {
+		return usernotificationdto.UnreadCountDTO{}, err
+	}
+	return usernotificationdto.UnreadCountDTO{UnreadCount: unreadCount}, nil
+}
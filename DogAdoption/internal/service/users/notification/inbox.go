@@ -0,0 +1,107 @@
+package usernotificationservice
+
+import (
+	"1dv027/aad/internal/model"
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+)
+
+type NotificationInboxRepository interface {
+	CreateNotifications(ctx context.Context, notifications []model.UserNotification, maxPerUser int) error
+	CreateNotificationForActiveUsers(ctx context.Context, notification model.UserNotification, maxPerUser int) error
+	DeleteNotificationsCreatedBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+// NotificationInbox is the in-app channel for the events that are sent to users, so that users without a
+// webhook endpoint are notified too. Each user keeps at most maxPerUser notifications, and notifications
+// are deleted after retentionDays.
+type NotificationInbox struct {
+	repo          NotificationInboxRepository
+	maxPerUser    int
+	retentionDays int
+}
+
+// NewNotificationInbox keeps the newest 200 notifications of a user for 90 days, unless other limits are given.
+func NewNotificationInbox(repo NotificationInboxRepository, maxPerUser int, retentionDays int) NotificationInbox {
+	if maxPerUser <= 0 {
+		maxPerUser = 200
+	}
+	if retentionDays <= 0 {
+		retentionDays = 90
+	}
+	return NotificationInbox{
+		repo:          repo,
+		maxPerUser:    maxPerUser,
+		retentionDays: retentionDays,
+	}
+}
+
+// Deliver adds a notification of the event to the inbox of each of the users. The payload is the payload of
+// the webhook of the event, which is kept as the data of the notification without the secret.
+func (n NotificationInbox) Deliver(ctx context.Context, userIds []int, event model.WebhookAction, title, body string,
+	payload any) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+	data, err := toNotificationData(payload)
+	if err != nil {
+		return err
+	}
+
+	notifications := make([]model.UserNotification, 0, len(userIds))
+	for _, userId := range userIds {
+		notifications = append(notifications, model.UserNotification{
+			UserId: userId,
+			Event:  event,
+			Title:  title,
+			Body:   body,
+			Data:   data,
+		})
+	}
+	return n.repo.CreateNotifications(ctx, notifications, n.maxPerUser)
+}
+
+// Broadcast adds a notification of the event to the inbox of every active user, for the events that are
+// of interest to all users, such as new dogs.
+func (n NotificationInbox) Broadcast(ctx context.Context, event model.WebhookAction, title, body string, payload any) error {
+	data, err := toNotificationData(payload)
+	if err != nil {
+		return err
+	}
+	return n.repo.CreateNotificationForActiveUsers(ctx, model.UserNotification{
+		Event: event,
+		Title: title,
+		Body:  body,
+		Data:  data,
+	}, n.maxPerUser)
+}
+
+// toNotificationData converts the webhook payload of an event to the data of its notifications, without
+// the secret.
+func toNotificationData(payload any) (map[string]any, error) {
+	payloadJson, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	var data map[string]any
+	err = json.Unmarshal(payloadJson, &data)
+	if err != nil {
+		return nil, err
+	}
+	delete(data, "secret")
+	return data, nil
+}
+
+// DeleteExpiredNotifications is run daily by the scheduler.
+func (n NotificationInbox) DeleteExpiredNotifications(ctx context.Context) error {
+	deleted, err := n.repo.DeleteNotificationsCreatedBefore(ctx, time.Now().AddDate(0, 0, -n.retentionDays))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		log.Printf("Deleted %d notifications older than %d days", deleted, n.retentionDays)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package usernotificationservice
+
+import (
+	"1dv027/aad/internal/dto"
+	usernotificationdto "1dv027/aad/internal/dto/user/notification"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"fmt"
+	"strconv"
+)
+
+type NotificationLinkGenerator interface {
+	GenerateUserNotificationReadLink(userId, notificationId string) string
+}
+
+func toNotificationDto(notification model.UserNotification, linkGenerator NotificationLinkGenerator) usernotificationdto.NotificationDTO {
+	data := notification.Data
+	if data == nil {
+		data = map[string]any{}
+	}
+	return usernotificationdto.NotificationDTO{
+		Id:        notification.Id,
+		UserId:    notification.UserId,
+		Event:     string(notification.Event),
+		Title:     notification.Title,
+		Body:      notification.Body,
+		Data:      data,
+		IsRead:    notification.ReadAt != nil,
+		CreatedAt: notification.CreatedAt,
+		ReadAt:    notification.ReadAt,
+		Links: usernotificationdto.NotificationLinksDTO{
+			ReadLink: linkGenerator.GenerateUserNotificationReadLink(fmt.Sprintf("%d", notification.UserId),
+				fmt.Sprintf("%d", notification.Id)),
+		},
+	}
+}
+
+// parseNotificationOwner returns the id of the user whose inbox is requested. The inbox is private, so only
+// the user itself has access.
+func parseNotificationOwner(idParam string, credentials dto.UserCredentials) (int, error) {
+	userId, err := strconv.Atoi(idParam)
+	if err != nil {
+		return 0, &customerrors.IntegerConversionError{}
+	}
+	if credentials.UserRole != model.USER || credentials.Id != userId {
+		return 0, &customerrors.UnauthorizedError{}
+	}
+	return userId, nil
+}
@@ -0,0 +1,53 @@
+package usernotificationservice
+
+import (
+	"1dv027/aad/internal/dto"
+	usernotificationdto "1dv027/aad/internal/dto/user/notification"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strconv"
+)
+
+type ReadNotificationsRepository interface {
+	MarkNotificationRead(ctx context.Context, userId int, notificationId int) (model.UserNotification, error)
+	MarkAllNotificationsRead(ctx context.Context, userId int) error
+}
+
+type ReadNotificationsService struct {
+	repo          ReadNotificationsRepository
+	linkGenerator NotificationLinkGenerator
+}
+
+func NewReadNotificationsService(repo ReadNotificationsRepository, linkGenerator NotificationLinkGenerator) ReadNotificationsService {
+	return ReadNotificationsService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// MarkRead marks the notification as read. Marking a notification that is already read changes nothing.
+func (r ReadNotificationsService) MarkRead(ctx context.Context, idParam, notificationIdParam string,
+	credentials dto.UserCredentials) (usernotificationdto.NotificationDTO, error) {
+	userId, err := parseNotificationOwner(idParam, credentials)
+	if err != nil {
+		return usernotificationdto.NotificationDTO{}, err
+	}
+	notificationId, err := strconv.Atoi(notificationIdParam)
+	if err != nil {
+		return usernotificationdto.NotificationDTO{}, &customerrors.IntegerConversionError{}
+	}
+	notification, err := r.repo.MarkNotificationRead(ctx, userId, notificationId)
+	if err != nil {
+		return usernotificationdto.NotificationDTO{}, err
+	}
+	return toNotificationDto(notification, r.linkGenerator), nil
+}
+
+func (r ReadNotificationsService) MarkAllRead(ctx context.Context, idParam string, credentials dto.UserCredentials) error {
+	userId, err := parseNotificationOwner(idParam, credentials)
+	if err != nil {
+		return err
+	}
+	return r.repo.MarkAllNotificationsRead(ctx, userId)
+}
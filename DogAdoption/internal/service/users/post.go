@@ -6,6 +6,9 @@ import (
 	"1dv027/aad/internal/model"
 	"context"
 	"encoding/json"
+	"log"
+	"net/mail"
+	"strings"
 )
 
 type PostUsersRepository interface {
@@ -16,15 +19,28 @@ type PostUsersCryptographyService interface {
 	HashPassword(unhashedPassword string) (string, error)
 }
 
+type PostUsersPasswordPolicy interface {
+	ValidatePassword(username, password string) error
+}
+
+type PostUsersEmailVerificationSender interface {
+	SendVerificationEmail(ctx context.Context, user model.User) error
+}
+
 type PostUsersService struct {
-	userRepo      PostUsersRepository
-	cryptoService PostUsersCryptographyService
+	userRepo          PostUsersRepository
+	cryptoService     PostUsersCryptographyService
+	passwordPolicy    PostUsersPasswordPolicy
+	verificationMails PostUsersEmailVerificationSender
 }
 
-func NewPostUsersService(userRepo PostUsersRepository, cryptoService PostUsersCryptographyService) PostUsersService {
+func NewPostUsersService(userRepo PostUsersRepository, cryptoService PostUsersCryptographyService,
+	passwordPolicy PostUsersPasswordPolicy, verificationMails PostUsersEmailVerificationSender) PostUsersService {
 	return PostUsersService{
-		userRepo:      userRepo,
-		cryptoService: cryptoService,
+		userRepo:          userRepo,
+		cryptoService:     cryptoService,
+		passwordPolicy:    passwordPolicy,
+		verificationMails: verificationMails,
 	}
 }
 
@@ -45,6 +61,12 @@ func (p PostUsersService) CreateNewUser(ctx context.Context, newUser userdto.New
 		return emptyDto, err
 	}
 
+	// The account is created even if the mail can not be sent, since the verification can be resent.
+	err = p.verificationMails.SendVerificationEmail(ctx, user)
+	if err != nil {
+		log.Printf("Failed to send verification email: %v", err)
+	}
+
 	var userDto userdto.UserDTO
 	userJson, err := json.Marshal(user.ToJson())
 	if err != nil {
@@ -65,6 +87,19 @@ func (p PostUsersService) validateNewUserDto(dto userdto.NewUserDTO) error {
 	if dto.Password == nil || *dto.Password == "" {
 		return &customerrors.IncompleteNewUserError{}
 	}
+	if dto.Email == nil || *dto.Email == "" {
+		return &customerrors.IncompleteNewUserError{}
+	}
+
+	// Erased users are renamed with this prefix, see UserDataAccess.EraseUser.
+	if strings.HasPrefix(strings.ToLower(*dto.Username), "deleted-user-") {
+		return &customerrors.InvalidNewUserDataError{Message: "invalid username. try another one!"}
+	}
+
+	email, err := mail.ParseAddress(*dto.Email)
+	if err != nil || email.Name != "" || !strings.Contains(email.Address, ".") {
+		return &customerrors.InvalidNewUserDataError{Message: "invalid email address"}
+	}
 
-	return nil
+	return p.passwordPolicy.ValidatePassword(*dto.Username, *dto.Password)
 }
@@ -0,0 +1,212 @@
+package usersavedsearchservice
+
+import (
+	"1dv027/aad/internal/dto"
+	dogdto "1dv027/aad/internal/dto/dog"
+	usersavedsearchdto "1dv027/aad/internal/dto/user/saved-search"
+	webhookdto "1dv027/aad/internal/dto/user/webhook"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"1dv027/aad/internal/notifier"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+)
+
+type SavedSearchAlertRepository interface {
+	GetActiveSavedSearches(ctx context.Context) ([]model.SavedSearch, error)
+	GetSavedSearchesMatchingDog(ctx context.Context, dogId int, searches map[int]dto.QueryParams) ([]int, error)
+	AddSavedSearchMatch(ctx context.Context, searchId int, dogId int) (bool, error)
+	MarkSavedSearchMatchesNotified(ctx context.Context, searchId int, dogIds []int) error
+	GetPendingSavedSearchDigests(ctx context.Context) ([]model.SavedSearchDigest, error)
+	GetUserById(ctx context.Context, userId int) (model.User, error)
+	GetDogById(ctx context.Context, dogId int) (model.Dog, error)
+}
+
+type SavedSearchMatchWebhookDispatcher interface {
+	DeliverSavedSearchMatchWebhook(ctx context.Context, userId int, match usersavedsearchdto.SavedSearchMatchDTO) bool
+}
+
+type SavedSearchMatchInbox interface {
+	Deliver(ctx context.Context, userIds []int, event model.WebhookAction, title, body string, payload any) error
+}
+
+type SavedSearchAlertLinkGenerator interface {
+	SavedSearchLinkGenerator
+	SavedSearchDogLinkGenerator
+}
+
+// SavedSearchAlertService matches new and updated dogs against the saved searches of users, and tells the
+// users about the matches with the saved_search_match webhook or an in-app notification. Instant searches are sent
+// when the dog is saved, and daily searches are collected into a digest by the scheduler.
+type SavedSearchAlertService struct {
+	repo              SavedSearchAlertRepository
+	queryParser       SavedSearchQueryParser
+	webhookDispatcher SavedSearchMatchWebhookDispatcher
+	inbox             SavedSearchMatchInbox
+	notifier          notifier.Notifier
+	linkGenerator     SavedSearchAlertLinkGenerator
+}
+
+func NewSavedSearchAlertService(repo SavedSearchAlertRepository, queryParser SavedSearchQueryParser,
+	webhookDispatcher SavedSearchMatchWebhookDispatcher, inbox SavedSearchMatchInbox, notifier notifier.Notifier,
+	linkGenerator SavedSearchAlertLinkGenerator) SavedSearchAlertService {
+	return SavedSearchAlertService{
+		repo:              repo,
+		queryParser:       queryParser,
+		webhookDispatcher: webhookDispatcher,
+		inbox:             inbox,
+		notifier:          notifier,
+		linkGenerator:     linkGenerator,
+	}
+}
+
+// MatchDog is called after a dog is created or updated. The saved searches of all users are matched in the
+// background, so the request does not wait for them and failures are only logged. A dog is only sent once
+// per saved search, however often it is updated.
+func (s SavedSearchAlertService) MatchDog(_ context.Context, dog dogdto.DogDTO) {
+	// The request context ends with the request, so the matching gets its own.
+	go s.matchDog(context.Background(), dog)
+}
+
+func (s SavedSearchAlertService) matchDog(ctx context.Context, dog dogdto.DogDTO) {
+	savedSearches, err := s.repo.GetActiveSavedSearches(ctx)
+	if err != nil {
+		log.Printf("Failed to load the saved searches to match dog %d: %v", dog.Id, err)
+		return
+	}
+	savedSearchesById := map[int]model.SavedSearch{}
+	searchParams := map[int]dto.QueryParams{}
+	for _, savedSearch := range savedSearches {
+		params, err := s.queryParser.ParseDogsQuery(savedSearch.Query)
+		if err != nil {
+			log.Printf("Saved search %d has an invalid query: %v", savedSearch.Id, err)
+			continue
+		}
+		savedSearchesById[savedSearch.Id] = savedSearch
+		searchParams[savedSearch.Id] = params
+	}
+
+	matchingIds, err := s.repo.GetSavedSearchesMatchingDog(ctx, dog.Id, searchParams)
+	if err != nil {
+		log.Printf("Failed to match dog %d against the saved searches: %v", dog.Id, err)
+		return
+	}
+	for _, searchId := range matchingIds {
+		savedSearch := savedSearchesById[searchId]
+		isNew, err := s.repo.AddSavedSearchMatch(ctx, savedSearch.Id, dog.Id)
+		if err != nil {
+			log.Printf("Failed to record the match of dog %d for saved search %d: %v", dog.Id, savedSearch.Id, err)
+			continue
+		}
+		if !isNew || savedSearch.Frequency != model.SAVED_SEARCH_INSTANT {
+			continue
+		}
+		s.sendAlert(ctx, savedSearch, []dogdto.DogDTO{dog})
+	}
+}
+
+// SendDigests sends the matches that have not been sent yet, one alert per saved search. It is run daily
+// by the scheduler, and also retries instant alerts that could not be sent.
+func (s SavedSearchAlertService) SendDigests(ctx context.Context) error {
+	digests, err := s.repo.GetPendingSavedSearchDigests(ctx)
+	if err != nil {
+		return err
+	}
+	for _, digest := range digests {
+		dogs := []dogdto.DogDTO{}
+		for _, dogId := range digest.DogIds {
+			dog, err := s.repo.GetDogById(ctx, dogId)
+			if err != nil {
+				var dogNotFoundError *customerrors.DogNotFoundError
+				if errors.As(err, &dogNotFoundError) {
+					continue
+				}
+				return err
+			}
+			dogDto, err := toDogDto(dog, s.linkGenerator)
+			if err != nil {
+				return err
+			}
+			dogs = append(dogs, dogDto)
+		}
+		if len(dogs) > 0 {
+			s.sendAlert(ctx, digest.SavedSearch, dogs)
+		}
+	}
+	return nil
+}
+
+// sendAlert delivers the matched dogs on the channel of the saved search. The matches are only marked as
+// notified once they are delivered, otherwise they are sent again with the next digest.
+func (s SavedSearchAlertService) sendAlert(ctx context.Context, savedSearch model.SavedSearch, dogs []dogdto.DogDTO) {
+	match := usersavedsearchdto.SavedSearchMatchDTO{
+		SavedSearch: toSavedSearchDto(savedSearch, s.linkGenerator),
+		Dogs:        dogs,
+	}
+	dogIds := make([]int, 0, len(dogs))
+	for _, dog := range dogs {
+		dogIds = append(dogIds, dog.Id)
+	}
+
+	switch savedSearch.Channel {
+	case model.SAVED_SEARCH_WEBHOOK:
+		if !s.webhookDispatcher.DeliverSavedSearchMatchWebhook(ctx, savedSearch.UserId, match) {
+			log.Printf("No webhook of user %d accepted the alert of saved search %d", savedSearch.UserId, savedSearch.Id)
+			return
+		}
+	case model.SAVED_SEARCH_INBOX:
+		err := s.notifyUser(ctx, match)
+		if err != nil {
+			log.Printf("Failed to notify user %d of saved search %d: %v", savedSearch.UserId, savedSearch.Id, err)
+			return
+		}
+	}
+
+	err := s.repo.MarkSavedSearchMatchesNotified(ctx, savedSearch.Id, dogIds)
+	if err != nil {
+		log.Printf("Failed to mark the matches of saved search %d as notified: %v", savedSearch.Id, err)
+	}
+}
+
+// notifyUser adds the match to the notifications of the user. The notification through the notifier is
+// only logged when it fails, since the match is already in the inbox.
+func (s SavedSearchAlertService) notifyUser(ctx context.Context, match usersavedsearchdto.SavedSearchMatchDTO) error {
+	user, err := s.repo.GetUserById(ctx, match.SavedSearch.UserId)
+	if err != nil {
+		return err
+	}
+	dogLines := make([]string, 0, len(match.Dogs))
+	for _, dog := range match.Dogs {
+		dogLines = append(dogLines, fmt.Sprintf("%s, %s: %s", dog.Name, dog.Breed, dog.Links.SelfLink))
+	}
+	subject := fmt.Sprintf("A new dog matches %s", match.SavedSearch.Name)
+	if len(match.Dogs) > 1 {
+		subject = fmt.Sprintf("%d new dogs match %s", len(match.Dogs), match.SavedSearch.Name)
+	}
+	body := fmt.Sprintf("These dogs match your saved search %s:\n%s\nSee all matching dogs at %s",
+		match.SavedSearch.Name, strings.Join(dogLines, "\n"), match.SavedSearch.Links.DogsLink)
+
+	// The data of the notification has the shape of the saved_search_match webhook, like the other events.
+	err = s.inbox.Deliver(ctx, []int{user.Id}, model.SAVED_SEARCH_MATCH, subject, body,
+		webhookdto.SavedSearchMatchDispatchDTO{SavedSearchMatch: match})
+	if err != nil {
+		return err
+	}
+
+	notification := notifier.Notification{
+		Recipient: fmt.Sprintf("%s:%s", model.USER, user.Username),
+		Subject:   subject,
+		Body:      body,
+	}
+	if user.IsEmailVerified() {
+		notification.Recipient = *user.Email
+	}
+	err = s.notifier.Notify(ctx, notification)
+	if err != nil {
+		log.Printf("Failed to notify user %d of saved search %d: %v", user.Id, match.SavedSearch.Id, err)
+	}
+	return nil
+}
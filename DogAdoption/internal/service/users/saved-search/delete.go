@@ -0,0 +1,29 @@
+package usersavedsearchservice
+
+import (
+	"1dv027/aad/internal/dto"
+	"context"
+)
+
+type DeleteSavedSearchRepository interface {
+	DeleteSavedSearch(ctx context.Context, userId int, searchId int) error
+}
+
+type DeleteSavedSearchService struct {
+	repo DeleteSavedSearchRepository
+}
+
+func NewDeleteSavedSearchService(repo DeleteSavedSearchRepository) DeleteSavedSearchService {
+	return DeleteSavedSearchService{
+		repo: repo,
+	}
+}
+
+func (d DeleteSavedSearchService) DeleteSavedSearch(ctx context.Context, idParam, searchIdParam string,
+	credentials dto.UserCredentials) error {
+	userId, searchId, err := parseSavedSearchIds(idParam, searchIdParam, credentials, false)
+	if err != nil {
+		return err
+	}
+	return d.repo.DeleteSavedSearch(ctx, userId, searchId)
+}
@@ -0,0 +1,51 @@
+package usersavedsearchservice
+
+import (
+	dogdto "1dv027/aad/internal/dto/dog"
+	"1dv027/aad/internal/model"
+	"encoding/json"
+	"fmt"
+)
+
+type SavedSearchDogLinkGenerator interface {
+	GenerateDogLink(dogId string) string
+	GenerateShelterLink(shelterId string) string
+	GenerateDogTransfersLink(dogId string) string
+	GenerateDogStatusHistoryLink(dogId string) string
+	GenerateDogPhotosLink(dogId string) string
+	GenerateDogMedicalRecordsLink(dogId string) string
+	GenerateDogPhotoFileLink(dogId, photoId, size string) string
+	GenerateBreedLink(breedId string) string
+}
+
+func toDogDto(dog model.Dog, linkGenerator SavedSearchDogLinkGenerator) (dogdto.DogDTO, error) {
+	dogJson, err := json.Marshal(dog.ToJson())
+	if err != nil {
+		return dogdto.DogDTO{}, err
+	}
+	var dogDto dogdto.DogDTO
+	err = json.Unmarshal(dogJson, &dogDto)
+	if err != nil {
+		return dogdto.DogDTO{}, err
+	}
+	dogId := fmt.Sprintf("%d", dog.Id)
+	dogDto.Links = dogdto.DogLinksDTO{
+		ShelterLink:        linkGenerator.GenerateShelterLink(fmt.Sprintf("%d", dog.ShelterId)),
+		SelfLink:           linkGenerator.GenerateDogLink(dogId),
+		TransfersLink:      linkGenerator.GenerateDogTransfersLink(dogId),
+		StatusHistoryLink:  linkGenerator.GenerateDogStatusHistoryLink(dogId),
+		PhotosLink:         linkGenerator.GenerateDogPhotosLink(dogId),
+		MedicalRecordsLink: linkGenerator.GenerateDogMedicalRecordsLink(dogId),
+	}
+	if dog.PrimaryPhotoId != nil {
+		dogDto.Links.PrimaryPhotoLink = linkGenerator.GenerateDogPhotoFileLink(dogId,
+			fmt.Sprintf("%d", *dog.PrimaryPhotoId), string(model.PHOTO_MEDIUM))
+	}
+	if dog.PrimaryBreedId != nil {
+		dogDto.Links.PrimaryBreedLink = linkGenerator.GenerateBreedLink(fmt.Sprintf("%d", *dog.PrimaryBreedId))
+	}
+	if dog.SecondaryBreedId != nil {
+		dogDto.Links.SecondaryBreedLink = linkGenerator.GenerateBreedLink(fmt.Sprintf("%d", *dog.SecondaryBreedId))
+	}
+	return dogDto, nil
+}
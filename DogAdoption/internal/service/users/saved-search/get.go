@@ -0,0 +1,55 @@
+package usersavedsearchservice
+
+import (
+	"1dv027/aad/internal/dto"
+	usersavedsearchdto "1dv027/aad/internal/dto/user/saved-search"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type GetSavedSearchesRepository interface {
+	GetUserSavedSearches(ctx context.Context, userId int) ([]model.SavedSearch, error)
+	GetUserSavedSearch(ctx context.Context, userId int, searchId int) (model.SavedSearch, error)
+}
+
+type GetSavedSearchesService struct {
+	repo          GetSavedSearchesRepository
+	linkGenerator SavedSearchLinkGenerator
+}
+
+func NewGetSavedSearchesService(repo GetSavedSearchesRepository, linkGenerator SavedSearchLinkGenerator) GetSavedSearchesService {
+	return GetSavedSearchesService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+func (g GetSavedSearchesService) GetSavedSearches(ctx context.Context, idParam string,
+	credentials dto.UserCredentials) ([]usersavedsearchdto.SavedSearchDTO, error) {
+	userId, err := parseSavedSearchOwner(idParam, credentials, true)
+	if err != nil {
+		return nil, err
+	}
+	savedSearches, err := g.repo.GetUserSavedSearches(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	savedSearchDtos := []usersavedsearchdto.SavedSearchDTO{}
+	for _, savedSearch := range savedSearches {
+		savedSearchDtos = append(savedSearchDtos, toSavedSearchDto(savedSearch, g.linkGenerator))
+	}
+	return savedSearchDtos, nil
+}
+
+func (g GetSavedSearchesService) GetSavedSearch(ctx context.Context, idParam, searchIdParam string,
+	credentials dto.UserCredentials) (usersavedsearchdto.SavedSearchDTO, error) {
+	userId, searchId, err := parseSavedSearchIds(idParam, searchIdParam, credentials, true)
+	if err != nil {
+		return usersavedsearchdto.SavedSearchDTO{}, err
+	}
+	savedSearch, err := g.repo.GetUserSavedSearch(ctx, userId, searchId)
+	if err != nil {
+		return usersavedsearchdto.SavedSearchDTO{}, err
+	}
+	return toSavedSearchDto(savedSearch, g.linkGenerator), nil
+}
@@ -0,0 +1,55 @@
+package usersavedsearchservice
+
+import (
+	"1dv027/aad/internal/dto"
+	usersavedsearchdto "1dv027/aad/internal/dto/user/saved-search"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type PostSavedSearchRepository interface {
+	CreateSavedSearch(ctx context.Context, savedSearch model.SavedSearch) (model.SavedSearch, error)
+}
+
+type PostSavedSearchService struct {
+	repo          PostSavedSearchRepository
+	queryParser   SavedSearchQueryParser
+	linkGenerator SavedSearchLinkGenerator
+}
+
+func NewPostSavedSearchService(repo PostSavedSearchRepository, queryParser SavedSearchQueryParser,
+	linkGenerator SavedSearchLinkGenerator) PostSavedSearchService {
+	return PostSavedSearchService{
+		repo:          repo,
+		queryParser:   queryParser,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// CreateSavedSearch saves a search for the user. Matches go to the inbox as they happen, unless another
+// channel or frequency is given.
+func (p PostSavedSearchService) CreateSavedSearch(ctx context.Context, idParam string,
+	newSearch usersavedsearchdto.NewSavedSearchDTO, credentials dto.UserCredentials) (usersavedsearchdto.SavedSearchDTO, error) {
+	emptyDto := usersavedsearchdto.SavedSearchDTO{}
+	userId, err := parseSavedSearchOwner(idParam, credentials, false)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	savedSearch := model.SavedSearch{
+		UserId:    userId,
+		Channel:   model.SAVED_SEARCH_INBOX,
+		Frequency: model.SAVED_SEARCH_INSTANT,
+	}
+	applySavedSearchFields(&savedSearch, newSearch)
+	err = validateSavedSearch(savedSearch, p.queryParser)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	createdSearch, err := p.repo.CreateSavedSearch(ctx, savedSearch)
+	if err != nil {
+		return emptyDto, err
+	}
+	return toSavedSearchDto(createdSearch, p.linkGenerator), nil
+}
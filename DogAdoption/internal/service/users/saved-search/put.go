@@ -0,0 +1,55 @@
+package usersavedsearchservice
+
+import (
+	"1dv027/aad/internal/dto"
+	usersavedsearchdto "1dv027/aad/internal/dto/user/saved-search"
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type PutSavedSearchRepository interface {
+	GetUserSavedSearch(ctx context.Context, userId int, searchId int) (model.SavedSearch, error)
+	UpdateSavedSearch(ctx context.Context, savedSearch model.SavedSearch) (model.SavedSearch, error)
+}
+
+type PutSavedSearchService struct {
+	repo          PutSavedSearchRepository
+	queryParser   SavedSearchQueryParser
+	linkGenerator SavedSearchLinkGenerator
+}
+
+func NewPutSavedSearchService(repo PutSavedSearchRepository, queryParser SavedSearchQueryParser,
+	linkGenerator SavedSearchLinkGenerator) PutSavedSearchService {
+	return PutSavedSearchService{
+		repo:          repo,
+		queryParser:   queryParser,
+		linkGenerator: linkGenerator,
+	}
+}
+
+// UpdateSavedSearch changes the given fields of the saved search. Dogs that already matched the search are
+// not sent again when the query changes.
+func (p PutSavedSearchService) UpdateSavedSearch(ctx context.Context, idParam, searchIdParam string,
+	update usersavedsearchdto.NewSavedSearchDTO, credentials dto.UserCredentials) (usersavedsearchdto.SavedSearchDTO, error) {
+	emptyDto := usersavedsearchdto.SavedSearchDTO{}
+	userId, searchId, err := parseSavedSearchIds(idParam, searchIdParam, credentials, false)
+	if err != nil {
+		return emptyDto, err
+	}
+	savedSearch, err := p.repo.GetUserSavedSearch(ctx, userId, searchId)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	applySavedSearchFields(&savedSearch, update)
+	err = validateSavedSearch(savedSearch, p.queryParser)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	updatedSearch, err := p.repo.UpdateSavedSearch(ctx, savedSearch)
+	if err != nil {
+		return emptyDto, err
+	}
+	return toSavedSearchDto(updatedSearch, p.linkGenerator), nil
+}
@@ -0,0 +1,61 @@
+package usersavedsearchservice
+
+import (
+	"1dv027/aad/internal/dto"
+	usersavedsearchdto "1dv027/aad/internal/dto/user/saved-search"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"fmt"
+	"strconv"
+)
+
+type SavedSearchLinkGenerator interface {
+	GenerateUserSavedSearchLink(userId, searchId string) string
+	GenerateDogsSearchLink(query string) string
+}
+
+func toSavedSearchDto(savedSearch model.SavedSearch, linkGenerator SavedSearchLinkGenerator) usersavedsearchdto.SavedSearchDTO {
+	return usersavedsearchdto.SavedSearchDTO{
+		Id:        savedSearch.Id,
+		UserId:    savedSearch.UserId,
+		Name:      savedSearch.Name,
+		Query:     savedSearch.Query,
+		Channel:   string(savedSearch.Channel),
+		Frequency: string(savedSearch.Frequency),
+		CreatedAt: savedSearch.CreatedAt,
+		UpdatedAt: savedSearch.UpdatedAt,
+		Links: usersavedsearchdto.SavedSearchLinksDTO{
+			SelfLink: linkGenerator.GenerateUserSavedSearchLink(fmt.Sprintf("%d", savedSearch.UserId),
+				fmt.Sprintf("%d", savedSearch.Id)),
+			DogsLink: linkGenerator.GenerateDogsSearchLink(savedSearch.Query),
+		},
+	}
+}
+
+// parseSavedSearchOwner returns the id of the user whose saved searches are requested. Users can only manage
+// their own saved searches, and admins can read the saved searches of any user.
+func parseSavedSearchOwner(idParam string, credentials dto.UserCredentials, allowAdmin bool) (int, error) {
+	userId, err := strconv.Atoi(idParam)
+	if err != nil {
+		return 0, &customerrors.IntegerConversionError{}
+	}
+	if credentials.UserRole == model.ADMIN && allowAdmin {
+		return userId, nil
+	}
+	if credentials.UserRole != model.USER || credentials.Id != userId {
+		return 0, &customerrors.UnauthorizedError{}
+	}
+	return userId, nil
+}
+
+func parseSavedSearchIds(idParam, searchIdParam string, credentials dto.UserCredentials, allowAdmin bool) (int, int, error) {
+	userId, err := parseSavedSearchOwner(idParam, credentials, allowAdmin)
+	if err != nil {
+		return 0, 0, err
+	}
+	searchId, err := strconv.Atoi(searchIdParam)
+	if err != nil {
+		return 0, 0, &customerrors.IntegerConversionError{}
+	}
+	return userId, searchId, nil
+}
@@ -0,0 +1,55 @@
+package usersavedsearchservice
+
+import (
+	"1dv027/aad/internal/dto"
+	usersavedsearchdto "1dv027/aad/internal/dto/user/saved-search"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"strings"
+)
+
+// SavedSearchQueryParser validates a GET /dogs query string with the rules of the query params middleware.
+type SavedSearchQueryParser interface {
+	ParseDogsQuery(rawQuery string) (dto.QueryParams, error)
+}
+
+// applySavedSearchFields copies the given fields onto the saved search. Missing fields are left unchanged.
+func applySavedSearchFields(savedSearch *model.SavedSearch, fields usersavedsearchdto.NewSavedSearchDTO) {
+	if fields.Name != nil {
+		savedSearch.Name = strings.TrimSpace(*fields.Name)
+	}
+	if fields.Query != nil {
+		savedSearch.Query = strings.TrimPrefix(strings.TrimSpace(*fields.Query), "?")
+	}
+	if fields.Channel != nil {
+		savedSearch.Channel = model.SavedSearchChannel(*fields.Channel)
+	}
+	if fields.Frequency != nil {
+		savedSearch.Frequency = model.SavedSearchFrequency(*fields.Frequency)
+	}
+}
+
+// validateSavedSearch checks a new or updated saved search. All problems are reported at once.
+func validateSavedSearch(savedSearch model.SavedSearch, queryParser SavedSearchQueryParser) error {
+	var errMsgs []string
+	if savedSearch.Name == "" {
+		errMsgs = append(errMsgs, "name cannot be empty")
+	} else if len(savedSearch.Name) > 100 {
+		errMsgs = append(errMsgs, "name can be at most 100 characters")
+	}
+	if len(savedSearch.Query) > 1000 {
+		errMsgs = append(errMsgs, "query can be at most 1000 characters")
+	} else if _, err := queryParser.ParseDogsQuery(savedSearch.Query); err != nil {
+		errMsgs = append(errMsgs, err.Error())
+	}
+	if _, ok := model.StringToSavedSearchChannel(string(savedSearch.Channel)); !ok {
+		errMsgs = append(errMsgs, "channel must be either webhook or inbox")
+	}
+	if _, ok := model.StringToSavedSearchFrequency(string(savedSearch.Frequency)); !ok {
+		errMsgs = append(errMsgs, "frequency must be either instant or daily")
+	}
+	if len(errMsgs) > 0 {
+		return &customerrors.InvalidSavedSearchDataError{Message: strings.Join(errMsgs, ", ")}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package usersservice
+
+import (
+	"1dv027/aad/internal/model"
+	"context"
+)
+
+type UserStatusRepository interface {
+	GetUserById(ctx context.Context, userId int) (model.User, error)
+}
+
+// UserStatusService is used by the auth middleware to reject requests from suspended users,
+// since their already issued tokens stay valid until they expire.
+type UserStatusService struct {
+	repo UserStatusRepository
+}
+
+func NewUserStatusService(repo UserStatusRepository) UserStatusService {
+	return UserStatusService{
+		repo: repo,
+	}
+}
+
+func (u UserStatusService) IsUserSuspended(ctx context.Context, userId int) (bool, error) {
+	user, err := u.repo.GetUserById(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+	return user.IsSuspended(), nil
+}
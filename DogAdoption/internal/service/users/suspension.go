@@ -0,0 +1,54 @@
+package usersservice
+
+import (
+	"1dv027/aad/internal/dto"
+	userdto "1dv027/aad/internal/dto/user"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"strconv"
+)
+
+type UserSuspensionRepository interface {
+	SetUserStatus(ctx context.Context, userId int, status model.UserStatus) (model.User, error)
+}
+
+// UserSuspensionService lets admins suspend and unsuspend users. Suspended users can not log in,
+// and their tokens and api keys are rejected until they are unsuspended.
+type UserSuspensionService struct {
+	repo          UserSuspensionRepository
+	linkGenerator UserLinksGenerator
+}
+
+func NewUserSuspensionService(repo UserSuspensionRepository, linkGenerator UserLinksGenerator) UserSuspensionService {
+	return UserSuspensionService{
+		repo:          repo,
+		linkGenerator: linkGenerator,
+	}
+}
+
+func (u UserSuspensionService) SuspendUser(ctx context.Context, idParam string, credentials dto.UserCredentials) (userdto.UserDTO, error) {
+	return u.setStatus(ctx, idParam, credentials, model.USER_SUSPENDED)
+}
+
+func (u UserSuspensionService) UnsuspendUser(ctx context.Context, idParam string, credentials dto.UserCredentials) (userdto.UserDTO, error) {
+	return u.setStatus(ctx, idParam, credentials, model.USER_ACTIVE)
+}
+
+func (u UserSuspensionService) setStatus(ctx context.Context, idParam string, credentials dto.UserCredentials,
+	status model.UserStatus) (userdto.UserDTO, error) {
+	emptyDto := userdto.UserDTO{}
+	idParamInt, err := strconv.Atoi(idParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+	if credentials.UserRole != model.ADMIN {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	user, err := u.repo.SetUserStatus(ctx, idParamInt, status)
+	if err != nil {
+		return emptyDto, err
+	}
+	return toUserDto(user, u.linkGenerator, credentials)
+}
@@ -0,0 +1,239 @@
+package usersservice
+
+import (
+	"1dv027/aad/internal/dto"
+	userdto "1dv027/aad/internal/dto/user"
+	customerrors "1dv027/aad/internal/errors"
+	"1dv027/aad/internal/model"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var phonePattern = regexp.MustCompile(`^\+?[0-9 ()-]{6,20}$`)
+
+type UpdateUsersRepository interface {
+	GetUserById(ctx context.Context, userId int) (model.User, error)
+	GetUserByEmail(ctx context.Context, email string) (model.User, error)
+	UpdateUserProfile(ctx context.Context, user model.User) (model.User, error)
+}
+
+type UpdateUsersEmailVerificationSender interface {
+	SendVerificationEmail(ctx context.Context, user model.User) error
+}
+
+// UpdateUsersService updates user profiles, PUT replaces the whole profile and PATCH only the given fields.
+type UpdateUsersService struct {
+	repo              UpdateUsersRepository
+	linkGenerator     UserLinksGenerator
+	verificationMails UpdateUsersEmailVerificationSender
+}
+
+func NewUpdateUsersService(repo UpdateUsersRepository, linkGenerator UserLinksGenerator,
+	verificationMails UpdateUsersEmailVerificationSender) UpdateUsersService {
+	return UpdateUsersService{
+		repo:              repo,
+		linkGenerator:     linkGenerator,
+		verificationMails: verificationMails,
+	}
+}
+
+func (u UpdateUsersService) ReplaceUser(ctx context.Context, idParam string, credentials dto.UserCredentials,
+	data userdto.UpdateUserDTO) (userdto.UserDTO, error) {
+	if data.Email == nil {
+		return userdto.UserDTO{}, &customerrors.InvalidUserProfileDataError{Message: "email is required"}
+	}
+	return u.updateUser(ctx, idParam, credentials, data, true)
+}
+
+func (u UpdateUsersService) UpdateUser(ctx context.Context, idParam string, credentials dto.UserCredentials,
+	data userdto.UpdateUserDTO) (userdto.UserDTO, error) {
+	return u.updateUser(ctx, idParam, credentials, data, false)
+}
+
+func (u UpdateUsersService) updateUser(ctx context.Context, idParam string, credentials dto.UserCredentials,
+	data userdto.UpdateUserDTO, replace bool) (userdto.UserDTO, error) {
+	emptyDto := userdto.UserDTO{}
+	idParamInt, err := strconv.Atoi(idParam)
+	if err != nil {
+		return emptyDto, &customerrors.IntegerConversionError{}
+	}
+
+	if credentials.UserRole != model.ADMIN && (credentials.UserRole != model.USER || credentials.Id != idParamInt) {
+		return emptyDto, &customerrors.UnauthorizedError{}
+	}
+
+	user, err := u.repo.GetUserById(ctx, idParamInt)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	previousEmail := user.Email
+	applyProfileUpdate(&user, data, replace)
+	err = validateProfile(user)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	emailChanged := previousEmail == nil || !strings.EqualFold(*previousEmail, *user.Email)
+	if emailChanged {
+		// The email is where password resets and verification links end up, so it can not be changed with delegated access.
+		if credentials.Scopes != nil {
+			return emptyDto, &customerrors.UnauthorizedError{Message: "email can not be changed with api keys or oauth tokens"}
+		}
+		existingUser, err := u.repo.GetUserByEmail(ctx, *user.Email)
+		if err != nil {
+			var userNotFoundError *customerrors.UserNotFoundError
+			if !errors.As(err, &userNotFoundError) {
+				return emptyDto, err
+			}
+		} else if existingUser.Id != user.Id {
+			return emptyDto, &customerrors.InvalidUserProfileDataError{Message: "email is already registered"}
+		}
+		user.EmailStatus = model.EMAIL_PENDING
+	}
+
+	updatedUser, err := u.repo.UpdateUserProfile(ctx, user)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	if emailChanged {
+		err = u.verificationMails.SendVerificationEmail(ctx, updatedUser)
+		if err != nil {
+			log.Printf("Failed to send verification email: %v", err)
+		}
+	}
+
+	return toUserDto(updatedUser, u.linkGenerator, credentials)
+}
+
+// applyProfileUpdate copies the update onto the user. When replacing, omitted fields are cleared.
+func applyProfileUpdate(user *model.User, data userdto.UpdateUserDTO, replace bool) {
+	if replace || data.Email != nil {
+		user.Email = trimmed(data.Email)
+	}
+	if replace || data.FullName != nil {
+		user.FullName = trimmed(data.FullName)
+	}
+	if replace || data.Phone != nil {
+		user.Phone = trimmed(data.Phone)
+	}
+	if replace || data.City != nil {
+		user.City = trimmed(data.City)
+	}
+	if replace || data.Country != nil {
+		user.Country = trimmed(data.Country)
+	}
+
+	if data.Household != nil {
+		if replace || data.Household.HasChildren != nil {
+			user.Household.HasChildren = data.Household.HasChildren
+		}
+		if replace || data.Household.HasOtherPets != nil {
+			user.Household.HasOtherPets = data.Household.HasOtherPets
+		}
+		if replace || data.Household.HasGarden != nil {
+			user.Household.HasGarden = data.Household.HasGarden
+		}
+	} else if replace {
+		user.Household = model.UserHousehold{}
+	}
+
+	if data.Preferences != nil {
+		user.Preferences = model.UserPreferences{
+			Breeds:         data.Preferences.Breeds,
+			Gender:         data.Preferences.Gender,
+			MinAgeYears:    data.Preferences.MinAgeYears,
+			MaxAgeYears:    data.Preferences.MaxAgeYears,
+			MaxAdoptionFee: data.Preferences.MaxAdoptionFee,
+			Sizes:          data.Preferences.Sizes,
+			EnergyLevels:   data.Preferences.EnergyLevels,
+			MaxDistanceKm:  data.Preferences.MaxDistanceKm,
+		}
+	} else if replace {
+		user.Preferences = model.UserPreferences{}
+	}
+}
+
+func validateProfile(user model.User) error {
+	var errMsgs []string
+	if user.Email == nil || *user.Email == "" {
+		errMsgs = append(errMsgs, "email cannot be empty")
+	} else {
+		email, err := mail.ParseAddress(*user.Email)
+		if err != nil || email.Name != "" || !strings.Contains(email.Address, ".") {
+			errMsgs = append(errMsgs, "email must be a valid email address")
+		}
+	}
+
+	textFields := map[string]*string{
+		"full_name": user.FullName,
+		"city":      user.City,
+		"country":   user.Country,
+	}
+	for fieldName, fieldValue := range textFields {
+		if fieldValue != nil && (*fieldValue == "" || len(*fieldValue) > 100) {
+			errMsgs = append(errMsgs, fmt.Sprintf("%s must be between 1 and 100 characters", fieldName))
+		}
+	}
+	if user.Phone != nil && !phonePattern.MatchString(*user.Phone) {
+		errMsgs = append(errMsgs, "phone must be 6 to 20 digits, and may start with + and contain spaces, dashes and parentheses")
+	}
+
+	preferences := user.Preferences
+	if preferences.Gender != nil && *preferences.Gender != "male" && *preferences.Gender != "female" {
+		errMsgs = append(errMsgs, "preferences.gender must be either 'male' or 'female'")
+	}
+	if preferences.MinAgeYears != nil && *preferences.MinAgeYears < 0 {
+		errMsgs = append(errMsgs, "preferences.min_age_years cannot be negative")
+	}
+	if preferences.MaxAgeYears != nil && *preferences.MaxAgeYears < 0 {
+		errMsgs = append(errMsgs, "preferences.max_age_years cannot be negative")
+	}
+	if preferences.MinAgeYears != nil && preferences.MaxAgeYears != nil && *preferences.MinAgeYears > *preferences.MaxAgeYears {
+		errMsgs = append(errMsgs, "preferences.min_age_years cannot be greater than preferences.max_age_years")
+	}
+	if preferences.MaxAdoptionFee != nil && *preferences.MaxAdoptionFee < 0 {
+		errMsgs = append(errMsgs, "preferences.max_adoption_fee cannot be negative")
+	}
+	if preferences.MaxDistanceKm != nil && (*preferences.MaxDistanceKm <= 0 || *preferences.MaxDistanceKm > 20040) {
+		errMsgs = append(errMsgs, "preferences.max_distance_km must be a positive number of kilometers")
+	}
+	for _, size := range preferences.Sizes {
+		if _, ok := model.StringToBreedSizeGroup(size); !ok {
+			errMsgs = append(errMsgs, "preferences.sizes can only contain toy, small, medium, large and giant")
+			break
+		}
+	}
+	for _, energyLevel := range preferences.EnergyLevels {
+		if _, ok := model.StringToEnergyLevel(energyLevel); !ok {
+			errMsgs = append(errMsgs, "preferences.energy_levels can only contain low, medium and high")
+			break
+		}
+	}
+	for _, breed := range preferences.Breeds {
+		if strings.TrimSpace(breed) == "" {
+			errMsgs = append(errMsgs, "preferences.breeds cannot contain empty breeds")
+			break
+		}
+	}
+
+	if len(errMsgs) > 0 {
+		return &customerrors.InvalidUserProfileDataError{Message: strings.Join(errMsgs, " + ")}
+	}
+	return nil
+}
+
+func trimmed(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	trimmedValue := strings.TrimSpace(*value)
+	return &trimmedValue
+}
@@ -0,0 +1,55 @@
+package usersservice
+
+import (
+	"1dv027/aad/internal/dto"
+	userdto "1dv027/aad/internal/dto/user"
+	"1dv027/aad/internal/model"
+	"encoding/json"
+	"fmt"
+)
+
+type UserLinksGenerator interface {
+	GenerateUserLink(userId string) string
+	GenerateUserWebhookLink(userId string) string
+	GenerateUserFavoritesLink(userId string) string
+	GenerateUserSavedSearchesLink(userId string) string
+	GenerateUserNotificationsLink(userId string) string
+}
+
+// toUserDto converts the user model and applies the visibility rules. Admins and the user itself
+// see the full profile, dog shelters only see the adopter profile without contact details.
+func toUserDto(user model.User, linkGenerator UserLinksGenerator, viewer dto.UserCredentials) (userdto.UserDTO, error) {
+	emptyDto := userdto.UserDTO{}
+	userJson, err := json.Marshal(user.ToJson())
+	if err != nil {
+		return emptyDto, err
+	}
+	var userDto userdto.UserDTO
+	err = json.Unmarshal(userJson, &userDto)
+	if err != nil {
+		return emptyDto, err
+	}
+
+	userId := fmt.Sprintf("%d", user.Id)
+	userDto.Links = userdto.UserLinksDTO{
+		SelfLink: linkGenerator.GenerateUserLink(userId),
+	}
+
+	isOwner := viewer.UserRole == model.USER && viewer.Id == user.Id
+	if !isOwner && viewer.UserRole != model.ADMIN {
+		userDto.Email = nil
+		userDto.EmailStatus = ""
+		userDto.Phone = nil
+		userDto.Status = ""
+		userDto.SuspendedAt = nil
+		return userDto, nil
+	}
+
+	userDto.Links.WebhookLink = linkGenerator.GenerateUserWebhookLink(userId)
+	userDto.Links.FavoritesLink = linkGenerator.GenerateUserFavoritesLink(userId)
+	userDto.Links.SavedSearchesLink = linkGenerator.GenerateUserSavedSearchesLink(userId)
+	if isOwner {
+		userDto.Links.NotificationsLink = linkGenerator.GenerateUserNotificationsLink(userId)
+	}
+	return userDto, nil
+}
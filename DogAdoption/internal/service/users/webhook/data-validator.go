@@ -18,7 +18,8 @@ func (w WebhookDataValidatorService) validateWebhookActions(actions []string) er
 	var errMsgs []string
 	for _, webhookAction := range actions {
 		switch webhookAction {
-		case string(model.NEW_DOG_ADDED):
+		case string(model.NEW_DOG_ADDED), string(model.DOG_TRANSFERRED), string(model.FAVORITE_STATUS_CHANGED),
+			string(model.SAVED_SEARCH_MATCH):
 		default:
 			errMsgs = append(errMsgs, fmt.Sprintf("invalid webhook action: %s", webhookAction))
 		}
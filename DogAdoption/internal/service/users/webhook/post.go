@@ -18,6 +18,7 @@ type PostUserWebhooksDataValidator interface {
 
 type PostUserWebhooksRepository interface {
 	CreateNewUserWebhook(ctx context.Context, userId int, data webhookdto.NewUserWebhookDTO) (model.Webhook, error)
+	GetUserById(ctx context.Context, userId int) (model.User, error)
 }
 
 type PostUserWebhooksCryptographyService interface {
@@ -60,6 +61,17 @@ func (p PostUserWebhookService) CreateNewWebhook(ctx context.Context, idParam st
 		return emptyDto, err
 	}
 
+	// Webhooks send requests to arbitrary urls, so only users with a verified email can create them.
+	if user.UserRole == model.USER {
+		userModel, err := p.repo.GetUserById(ctx, idParamInt)
+		if err != nil {
+			return emptyDto, err
+		}
+		if !userModel.IsEmailVerified() {
+			return emptyDto, &customerrors.EmailNotVerifiedError{Message: "email must be verified before creating a webhook"}
+		}
+	}
+
 	encryptedClientSecret, err := p.cryptoService.EncryptPlainText(*webhookData.ClientSecret)
 	if err != nil {
 		return emptyDto, &customerrors.CryptographyError{}
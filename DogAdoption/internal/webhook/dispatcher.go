@@ -2,6 +2,9 @@ package webhook
 
 import (
 	dogdto "1dv027/aad/internal/dto/dog"
+	dogtransferdto "1dv027/aad/internal/dto/dog/transfer"
+	userfavoritedto "1dv027/aad/internal/dto/user/favorite"
+	usersavedsearchdto "1dv027/aad/internal/dto/user/saved-search"
 	webhookdto "1dv027/aad/internal/dto/user/webhook"
 	"1dv027/aad/internal/model"
 	"bytes"
@@ -9,6 +12,7 @@ import (
 	"encoding/json"
 	"log"
 	"net/http"
+	"slices"
 	"time"
 )
 
@@ -20,66 +24,183 @@ type CryptographyService interface {
 	DecryptCipherText(cipherText string) (string, error)
 }
 
+// NotificationInbox is the in-app channel of the events, so that users without a webhook endpoint are
+// notified too.
+type NotificationInbox interface {
+	Deliver(ctx context.Context, userIds []int, event model.WebhookAction, title, body string, payload any) error
+	Broadcast(ctx context.Context, event model.WebhookAction, title, body string, payload any) error
+}
+
+// WebhookDispatcher sends the events to the users, both to their webhooks subscribed to the event and to
+// their inbox.
 type WebhookDispatcher struct {
 	userWebhookRepo UserWebhooksRepository
 	cryptoService   CryptographyService
+	inbox           NotificationInbox
 }
 
-func NewWebhookDispatcher(userWebhookRepo UserWebhooksRepository, cryptoService CryptographyService) WebhookDispatcher {
+func NewWebhookDispatcher(userWebhookRepo UserWebhooksRepository, cryptoService CryptographyService,
+	inbox NotificationInbox) WebhookDispatcher {
 	return WebhookDispatcher{
 		userWebhookRepo: userWebhookRepo,
 		cryptoService:   cryptoService,
+		inbox:           inbox,
 	}
 }
 
 func (w WebhookDispatcher) DispatchNewDogWebhook(ctx context.Context, dogData dogdto.DogDTO) {
+	w.dispatch(ctx, model.NEW_DOG_ADDED, newDogMessage(dogData), func(secret string) any {
+		return webhookdto.NewDogDispatchDTO{
+			NewDog: dogData,
+			Secret: secret,
+		}
+	})
+}
+
+func (w WebhookDispatcher) DispatchDogTransferredWebhook(ctx context.Context, transferData dogtransferdto.DogTransferDTO,
+	dogData dogdto.DogDTO) {
+	w.dispatch(ctx, model.DOG_TRANSFERRED, dogTransferredMessage(dogData), func(secret string) any {
+		return webhookdto.DogTransferredDispatchDTO{
+			Transfer: transferData,
+			Dog:      dogData,
+			Secret:   secret,
+		}
+	})
+}
+
+// DispatchFavoriteStatusChangedWebhook is only sent to the users that have the dog as a favorite. All of them
+// get it in their inbox, even those without a webhook.
+func (w WebhookDispatcher) DispatchFavoriteStatusChangedWebhook(ctx context.Context, userIds []int,
+	statusChange userfavoritedto.FavoriteStatusChangedDTO) {
+	if len(userIds) == 0 {
+		return
+	}
+	w.dispatchToUsers(ctx, model.FAVORITE_STATUS_CHANGED, userIds, favoriteStatusChangedMessage(statusChange), func(secret string) any {
+		return webhookdto.FavoriteStatusChangedDispatchDTO{
+			FavoriteStatusChanged: statusChange,
+			Secret:                secret,
+		}
+	})
+}
+
+// DeliverSavedSearchMatchWebhook is only sent to the webhook of the user that saved the search. Unlike the
+// other webhooks it waits for the delivery, and reports if a webhook of the user accepted the payload. It is
+// not added to the inbox, since a saved search alerts on the one channel the user chose for it.
+func (w WebhookDispatcher) DeliverSavedSearchMatchWebhook(ctx context.Context, userId int,
+	match usersavedsearchdto.SavedSearchMatchDTO) bool {
+	delivered := w.deliverToUsers(ctx, model.SAVED_SEARCH_MATCH, []int{userId}, func(secret string) any {
+		return webhookdto.SavedSearchMatchDispatchDTO{
+			SavedSearchMatch: match,
+			Secret:           secret,
+		}
+	})
+	return delivered > 0
+}
+
+// dispatch sends the event to the inbox of every active user, and to every webhook subscribed to the action.
+func (w WebhookDispatcher) dispatch(ctx context.Context, action model.WebhookAction, message inboxMessage,
+	buildPayload func(secret string) any) {
+	w.dispatchToUsers(ctx, action, nil, message, buildPayload)
+}
+
+// dispatchToUsers sends the event in the background to the given users, or to every active user when userIds
+// is nil. The users get the event in their inbox, whether or not they have a webhook, and their webhooks
+// subscribed to the action are posted the payload.
+func (w WebhookDispatcher) dispatchToUsers(ctx context.Context, action model.WebhookAction, userIds []int,
+	message inboxMessage, buildPayload func(secret string) any) {
 	go func() {
-		allWebhooks, err := w.userWebhookRepo.GetAllWebhooksByAction(ctx, model.NEW_DOG_ADDED)
+		var err error
+		if userIds == nil {
+			err = w.inbox.Broadcast(ctx, action, message.title, message.body, buildPayload(""))
+		} else {
+			err = w.inbox.Deliver(ctx, userIds, action, message.title, message.body, buildPayload(""))
+		}
+		if err != nil {
+			log.Printf("Failed to add the %s event to the notifications of its users: %v", action, err)
+		}
+		webhooks, err := w.getSubscribedWebhooks(ctx, action, userIds)
 		if err != nil {
 			log.Printf("Failed to retrieve webhooks: %v", err)
 			return
 		}
-		for _, userWebhook := range allWebhooks {
-			decryptedSecret, err := w.cryptoService.DecryptCipherText(userWebhook.ClientSecret)
+		w.postToWebhooks(webhooks, buildPayload)
+	}()
+}
+
+// deliverToUsers posts the payload to the webhooks of the given users subscribed to the action, and returns
+// how many webhooks accepted it.
+func (w WebhookDispatcher) deliverToUsers(ctx context.Context, action model.WebhookAction, userIds []int,
+	buildPayload func(secret string) any) int {
+	webhooks, err := w.getSubscribedWebhooks(ctx, action, userIds)
+	if err != nil {
+		log.Printf("Failed to retrieve webhooks: %v", err)
+		return 0
+	}
+	return w.postToWebhooks(webhooks, buildPayload)
+}
+
+// getSubscribedWebhooks returns the webhooks subscribed to the action, only those of the given users when
+// userIds is not nil.
+func (w WebhookDispatcher) getSubscribedWebhooks(ctx context.Context, action model.WebhookAction,
+	userIds []int) ([]model.Webhook, error) {
+	allWebhooks, err := w.userWebhookRepo.GetAllWebhooksByAction(ctx, action)
+	if err != nil {
+		return nil, err
+	}
+	if userIds == nil {
+		return allWebhooks, nil
+	}
+	webhooks := []model.Webhook{}
+	for _, userWebhook := range allWebhooks {
+		if slices.Contains(userIds, userWebhook.UserId) {
+			webhooks = append(webhooks, userWebhook)
+		}
+	}
+	return webhooks, nil
+}
+
+// postToWebhooks posts the payload to the webhooks and returns how many accepted it. Each webhook gets its
+// own secret in the payload, so the payload is built per webhook.
+func (w WebhookDispatcher) postToWebhooks(webhooks []model.Webhook, buildPayload func(secret string) any) int {
+	delivered := 0
+	for _, userWebhook := range webhooks {
+		decryptedSecret, err := w.cryptoService.DecryptCipherText(userWebhook.ClientSecret)
+		if err != nil {
+			log.Printf("Failed to decrypt client secret: %v", err)
+			continue
+		}
+		retries := 3
+		for attempt := 0; attempt < retries; attempt++ {
+			data, err := json.Marshal(buildPayload(decryptedSecret))
 			if err != nil {
-				log.Printf("Failed to decrypt client secret: %v", err)
-				continue
+				log.Printf("Error marshaling payload: %v", err)
+				break
 			}
-			retries := 3
-			for attempt := 0; attempt < retries; attempt++ {
-				payLoad := webhookdto.NewDogDispatchDTO{
-					NewDog: dogData,
-					Secret: decryptedSecret,
-				}
-				data, err := json.Marshal(payLoad)
-				if err != nil {
-					log.Printf("Error marshaling payload: %v", err)
-					break
-				}
 
-				req, err := http.NewRequest("POST", userWebhook.EndpointUrl, bytes.NewBuffer(data))
-				if err != nil {
-					log.Printf("Error creating HTTP request: %v", err)
-					continue
-				}
-				req.Header.Set("Content-Type", "application/json")
-
-				resp, err := http.DefaultClient.Do(req)
-				if err != nil || (resp != nil && resp.StatusCode >= 400) {
-					log.Printf("Error dispatching webhook to %s, attempt %d: %v", userWebhook.EndpointUrl, attempt+1, err)
-					if resp != nil {
-						resp.Body.Close()
-					}
-					time.Sleep(2 * time.Second)
-					continue
-				}
+			req, err := http.NewRequest("POST", userWebhook.EndpointUrl, bytes.NewBuffer(data))
+			if err != nil {
+				log.Printf("Error creating HTTP request: %v", err)
+				continue
+			}
+			req.Header.Set("Content-Type", "application/json")
 
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil || (resp != nil && resp.StatusCode >= 400) {
+				log.Printf("Error dispatching webhook to %s, attempt %d: %v", userWebhook.EndpointUrl, attempt+1, err)
 				if resp != nil {
 					resp.Body.Close()
 				}
-				log.Printf("Successfully dispatched webhook to %s", userWebhook.EndpointUrl)
-				break
+				time.Sleep(2 * time.Second)
+				continue
+			}
+
+			if resp != nil {
+				resp.Body.Close()
 			}
+			log.Printf("Successfully dispatched webhook to %s", userWebhook.EndpointUrl)
+			delivered++
+			break
 		}
-	}()
+	}
+	return delivered
 }
@@ -0,0 +1,38 @@
+package webhook
+
+import (
+	dogdto "1dv027/aad/internal/dto/dog"
+	userfavoritedto "1dv027/aad/internal/dto/user/favorite"
+	"1dv027/aad/internal/model"
+	"fmt"
+)
+
+// inboxMessage is the title and body of the in-app notification of an event.
+type inboxMessage struct {
+	title string
+	body  string
+}
+
+func newDogMessage(dog dogdto.DogDTO) inboxMessage {
+	return inboxMessage{
+		title: fmt.Sprintf("%s is looking for a home", dog.Name),
+		body:  fmt.Sprintf("%s, a %s, was added for adoption. See %s", dog.Name, dog.Breed, dog.Links.SelfLink),
+	}
+}
+
+func dogTransferredMessage(dog dogdto.DogDTO) inboxMessage {
+	return inboxMessage{
+		title: fmt.Sprintf("%s moved to another shelter", dog.Name),
+		body:  fmt.Sprintf("%s was transferred to a new shelter. See %s", dog.Name, dog.Links.SelfLink),
+	}
+}
+
+func favoriteStatusChangedMessage(statusChange userfavoritedto.FavoriteStatusChangedDTO) inboxMessage {
+	dog := statusChange.Dog
+	return inboxMessage{
+		title: fmt.Sprintf("%s is now %s", dog.Name, model.DogStatus(statusChange.Status).Describe()),
+		body: fmt.Sprintf("%s, one of your favorite dogs, changed from %s to %s. See %s", dog.Name,
+			model.DogStatus(statusChange.PreviousStatus).Describe(), model.DogStatus(statusChange.Status).Describe(),
+			dog.Links.SelfLink),
+	}
+}